@@ -19,9 +19,47 @@ package v1alpha1
 import (
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// LabelKeyCertificate is the label of the ResourceRecordSets that are created
+// to validate a Certificate. Its value is the name of the Certificate.
+const LabelKeyCertificate = "acm.aws.crossplane.io/certificate"
+
+// TypeValidated indicates whether ACM has validated the domains of a
+// Certificate and issued it.
+const TypeValidated xpv1.ConditionType = "Validated"
+
+// Reasons a Certificate is or is not validated.
+const (
+	ReasonIssued            xpv1.ConditionReason = "Issued"
+	ReasonPendingValidation xpv1.ConditionReason = "PendingValidation"
+)
+
+// Validated returns a condition that indicates the Certificate has been
+// issued.
+func Validated() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValidated,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonIssued,
+	}
+}
+
+// NotValidated returns a condition that indicates the Certificate has not
+// been issued. The message is the status of the Certificate.
+func NotValidated(status acm.CertificateStatus) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValidated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPendingValidation,
+		Message:            string(status),
+	}
+}
+
 // Tag represents user-provided metadata that can be associated
 type Tag struct {
 
@@ -65,6 +103,71 @@ type CertificateExternalStatus struct {
 	// Type of the certificate
 	// +kubebuilder:validation:Enum=IMPORTED;AMAZON_ISSUED;PRIVATE
 	Type acm.CertificateType `json:"type,omitempty"`
+
+	// DomainValidationRecords are the CNAME records that have to be added to
+	// the DNS of each domain of the certificate to validate it.
+	DomainValidationRecords []DomainValidationRecord `json:"domainValidationRecords,omitempty"`
+}
+
+// DomainValidationRecord is the CNAME record that validates a domain of a
+// certificate.
+type DomainValidationRecord struct {
+	// DomainName is the domain that is validated by the record.
+	DomainName string `json:"domainName"`
+
+	// ValidationStatus is the validation status of the domain.
+	ValidationStatus acm.DomainStatus `json:"validationStatus,omitempty"`
+
+	// Name of the CNAME record.
+	Name string `json:"name"`
+
+	// Type of the record, which is always CNAME.
+	Type acm.RecordType `json:"type"`
+
+	// Value of the CNAME record.
+	Value string `json:"value"`
+}
+
+// CertificateImport refers to the Secret a certificate is imported from.
+type CertificateImport struct {
+	// SecretRef is the Secret of type kubernetes.io/tls that contains the
+	// certificate. The first certificate of tls.crt is imported along with
+	// tls.key. The remaining certificates of tls.crt form the chain, or
+	// ca.crt if there are none.
+	SecretRef xpv1.SecretReference `json:"secretRef"`
+}
+
+// CertificateValidation configures how the domains of a certificate are
+// validated automatically.
+type CertificateValidation struct {
+	// Route53 creates the DNS validation records of the certificate in a
+	// Route53 hosted zone. The records are ResourceRecordSets owned by the
+	// Certificate; they are kept after the certificate is issued so that
+	// ACM can renew it, and are deleted together with the Certificate.
+	// +optional
+	Route53 *Route53Validation `json:"route53,omitempty"`
+}
+
+// Route53Validation refers to the hosted zone the DNS validation records of
+// a certificate are created in.
+type Route53Validation struct {
+	// HostedZoneID is the ID of the hosted zone that contains the domains of
+	// the certificate.
+	// +optional
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a HostedZone to retrieve its ID.
+	// +optional
+	HostedZoneIDRef *xpv1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a HostedZone to retrieve
+	// its ID.
+	// +optional
+	HostedZoneIDSelector *xpv1.Selector `json:"hostedZoneIdSelector,omitempty"`
+
+	// TTL of the validation records in seconds. Defaults to 300.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
@@ -92,8 +195,10 @@ type CertificateParameters struct {
 	CertificateAuthorityARNSelector *xpv1.Selector `json:"certificateAuthorityARNSelector,omitempty"`
 
 	// Fully qualified domain name (FQDN),that to secure with an ACM certificate.
+	// It is required unless the certificate is imported.
+	// +optional
 	// +immutable
-	DomainName string `json:"domainName"`
+	DomainName string `json:"domainName,omitempty"`
 
 	// The domain name that you want ACM to use to send you emails so that you can
 	// validate domain ownership.
@@ -122,6 +227,26 @@ type CertificateParameters struct {
 	// Flag to renew the certificate
 	// +optional
 	RenewCertificate *bool `json:"renewCertificate,omitempty"`
+
+	// Import imports the certificate, its private key and its chain from a
+	// Secret of type kubernetes.io/tls instead of requesting a certificate.
+	// The certificate is imported again whenever the certificate in the
+	// Secret changes, e.g. when it is renewed by cert-manager.
+	// +optional
+	// +immutable
+	Import *CertificateImport `json:"import,omitempty"`
+
+	// ExportToConnectionSecret writes the certificate, its private key and
+	// its chain to the connection secret once it is issued, and again after
+	// each renewal. Only certificates issued by a private certificate
+	// authority can be exported.
+	// +optional
+	ExportToConnectionSecret *bool `json:"exportToConnectionSecret,omitempty"`
+
+	// Validation publishes the DNS validation records of the certificate
+	// automatically. It is only used when ValidationMethod is DNS.
+	// +optional
+	Validation *CertificateValidation `json:"validation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -129,6 +254,7 @@ type CertificateParameters struct {
 // Certificate is a managed resource that represents an AWS Certificate Manager.
 // +kubebuilder:printcolumn:name="DOMAINNAME",type="string",JSONPath=".spec.forProvider.domainName"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="VALIDATED",type="string",JSONPath=".status.conditions[?(@.type=='Validated')].status"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
@@ -56,6 +56,11 @@ func (in *Certificate) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus) {
 	*out = *in
+	if in.DomainValidationRecords != nil {
+		in, out := &in.DomainValidationRecords, &out.DomainValidationRecords
+		*out = make([]DomainValidationRecord, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
@@ -68,6 +73,22 @@ func (in *CertificateExternalStatus) DeepCopy() *CertificateExternalStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateImport) DeepCopyInto(out *CertificateImport) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateImport.
+func (in *CertificateImport) DeepCopy() *CertificateImport {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateList) DeepCopyInto(out *CertificateList) {
 	*out = *in
@@ -160,6 +181,21 @@ func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(CertificateImport)
+		**out = **in
+	}
+	if in.ExportToConnectionSecret != nil {
+		in, out := &in.ExportToConnectionSecret, &out.ExportToConnectionSecret
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(CertificateValidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateParameters.
@@ -193,7 +229,7 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
@@ -206,6 +242,26 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateValidation) DeepCopyInto(out *CertificateValidation) {
+	*out = *in
+	if in.Route53 != nil {
+		in, out := &in.Route53, &out.Route53
+		*out = new(Route53Validation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateValidation.
+func (in *CertificateValidation) DeepCopy() *CertificateValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainValidationOption) DeepCopyInto(out *DomainValidationOption) {
 	*out = *in
@@ -221,6 +277,56 @@ func (in *DomainValidationOption) DeepCopy() *DomainValidationOption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainValidationRecord) DeepCopyInto(out *DomainValidationRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainValidationRecord.
+func (in *DomainValidationRecord) DeepCopy() *DomainValidationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainValidationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53Validation) DeepCopyInto(out *Route53Validation) {
+	*out = *in
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneIDRef != nil {
+		in, out := &in.HostedZoneIDRef, &out.HostedZoneIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.HostedZoneIDSelector != nil {
+		in, out := &in.HostedZoneIDSelector, &out.HostedZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53Validation.
+func (in *Route53Validation) DeepCopy() *Route53Validation {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53Validation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
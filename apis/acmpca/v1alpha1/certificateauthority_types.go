@@ -22,6 +22,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// AnnotationKeyIssuedCertificateARN records the ARN of the certificate that
+// was issued for a CertificateAuthority, so that it is installed once it is
+// ready instead of being issued again.
+const AnnotationKeyIssuedCertificateARN = "acmpca.aws.crossplane.io/issued-certificate-arn"
+
 // CertificateAuthorityParameters defines the desired state of an AWS CertificateAuthority.
 type CertificateAuthorityParameters struct {
 	// Region is the region you'd like your CertificateAuthority to be created in.
@@ -51,6 +56,52 @@ type CertificateAuthorityParameters struct {
 
 	// One or more resource tags to associate with the certificateAuthority.
 	Tags []Tag `json:"tags"`
+
+	// ParentCertificateAuthorityARN is the ARN of the certificate authority
+	// that signs the certificate of a SUBORDINATE certificate authority. ROOT
+	// certificate authorities sign their own certificate. A SUBORDINATE
+	// certificate authority without a parent stays in PENDING_CERTIFICATE
+	// until its certificate is installed out of band.
+	// +optional
+	// +immutable
+	ParentCertificateAuthorityARN *string `json:"parentCertificateAuthorityARN,omitempty"`
+
+	// ParentCertificateAuthorityARNRef references a CertificateAuthority to
+	// retrieve its ARN.
+	// +optional
+	// +immutable
+	ParentCertificateAuthorityARNRef *xpv1.Reference `json:"parentCertificateAuthorityARNRef,omitempty"`
+
+	// ParentCertificateAuthorityARNSelector selects a reference to a
+	// CertificateAuthority to retrieve its ARN.
+	// +optional
+	// +immutable
+	ParentCertificateAuthorityARNSelector *xpv1.Selector `json:"parentCertificateAuthorityARNSelector,omitempty"`
+
+	// Validity of the certificate of the certificate authority. Defaults to 10
+	// years for ROOT and 5 years for SUBORDINATE certificate authorities.
+	// +optional
+	// +immutable
+	Validity *Validity `json:"validity,omitempty"`
+
+	// TemplateARN is the ARN of the ACM Private CA template used to issue the
+	// certificate of the certificate authority. Defaults to
+	// RootCACertificate/V1 for ROOT and SubordinateCACertificate_PathLen0/V1
+	// for SUBORDINATE certificate authorities.
+	// +optional
+	// +immutable
+	TemplateARN *string `json:"templateARN,omitempty"`
+}
+
+// Validity of a certificate issued by a certificate authority.
+type Validity struct {
+	// Type of the validity period. Value is a Unix timestamp for ABSOLUTE and
+	// a YYYYMMDDHHMMSS date for END_DATE.
+	// +kubebuilder:validation:Enum=END_DATE;ABSOLUTE;DAYS;MONTHS;YEARS
+	Type acmpca.ValidityPeriodType `json:"type"`
+
+	// Value of the validity period.
+	Value int64 `json:"value"`
 }
 
 // Tag represents user-provided metadata that can be associated
@@ -175,6 +226,14 @@ type CertificateAuthorityExternalStatus struct {
 
 	// Status is the current status of the CertificateAuthority.
 	Status string `json:"status,omitempty"`
+
+	// NotBefore is the time before which the certificate of the Certificate
+	// Authority is not valid.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the time after which the certificate of the Certificate
+	// Authority is not valid.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
 }
 
 // CertificateAuthoritySpec defines the desired state of CertificateAuthority
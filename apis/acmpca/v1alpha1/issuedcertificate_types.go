@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IssuedCertificateParameters defines the desired state of a certificate
+// issued by an AWS CertificateAuthority.
+type IssuedCertificateParameters struct {
+	// Region is the region of the CertificateAuthority that issues the
+	// certificate.
+	Region string `json:"region"`
+
+	// CertificateAuthorityARN is the ARN of the CertificateAuthority that
+	// issues the certificate.
+	// +optional
+	// +immutable
+	CertificateAuthorityARN *string `json:"certificateAuthorityARN,omitempty"`
+
+	// CertificateAuthorityARNRef references a CertificateAuthority to
+	// retrieve its ARN.
+	// +optional
+	// +immutable
+	CertificateAuthorityARNRef *xpv1.Reference `json:"certificateAuthorityARNRef,omitempty"`
+
+	// CertificateAuthorityARNSelector selects a reference to a
+	// CertificateAuthority to retrieve its ARN.
+	// +optional
+	// +immutable
+	CertificateAuthorityARNSelector *xpv1.Selector `json:"certificateAuthorityARNSelector,omitempty"`
+
+	// CertificateSigningRequest is a PEM encoded certificate signing request.
+	// A private key is generated and written to the connection secret when
+	// it is omitted.
+	// +optional
+	// +immutable
+	CertificateSigningRequest *string `json:"certificateSigningRequest,omitempty"`
+
+	// KeyAlgorithm of the generated private key. Ignored when a
+	// CertificateSigningRequest is given.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=RSA_2048;EC_secp384r1;EC_prime256v1;RSA_4096
+	// +kubebuilder:default:=RSA_2048
+	KeyAlgorithm acmpca.KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+
+	// CommonName of the subject of the generated certificate signing request.
+	// Ignored when a CertificateSigningRequest is given.
+	// +optional
+	// +immutable
+	CommonName *string `json:"commonName,omitempty"`
+
+	// DNSNames are the subject alternative names of the generated certificate
+	// signing request. Ignored when a CertificateSigningRequest is given.
+	// +optional
+	// +immutable
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// SigningAlgorithm the CertificateAuthority uses to sign the certificate.
+	// +kubebuilder:validation:Enum=SHA512WITHECDSA;SHA256WITHECDSA;SHA384WITHECDSA;SHA512WITHRSA;SHA256WITHRSA;SHA384WITHRSA
+	SigningAlgorithm acmpca.SigningAlgorithm `json:"signingAlgorithm"`
+
+	// Validity of the certificate.
+	Validity Validity `json:"validity"`
+
+	// TemplateARN is the ARN of the ACM Private CA template used to issue the
+	// certificate. Defaults to EndEntityCertificate/V1.
+	// +optional
+	// +immutable
+	TemplateARN *string `json:"templateARN,omitempty"`
+
+	// RenewBefore is how long before its expiry the certificate is renewed.
+	// Defaults to a third of the lifetime of the certificate. Renewed
+	// certificates keep the private key found in the connection secret.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// RevocationReason is the reason the certificate is revoked with when the
+	// IssuedCertificate is deleted. The certificate is left to expire when it
+	// is omitted.
+	// +optional
+	// +kubebuilder:validation:Enum=UNSPECIFIED;KEY_COMPROMISE;CERTIFICATE_AUTHORITY_COMPROMISE;AFFILIATION_CHANGED;SUPERSEDED;CESSATION_OF_OPERATION;PRIVILEGE_WITHDRAWN;A_A_COMPROMISE
+	RevocationReason *string `json:"revocationReason,omitempty"`
+}
+
+// IssuedCertificateObservation keeps the state of the issued certificate.
+type IssuedCertificateObservation struct {
+	// Serial of the certificate.
+	Serial string `json:"serial,omitempty"`
+
+	// NotBefore is the time before which the certificate is not valid.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the time after which the certificate is not valid.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalTime is the time the certificate is renewed at.
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
+
+// IssuedCertificateSpec defines the desired state of IssuedCertificate
+type IssuedCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IssuedCertificateParameters `json:"forProvider"`
+}
+
+// IssuedCertificateStatus represents the observed state of IssuedCertificate
+type IssuedCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IssuedCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssuedCertificate is a managed resource that represents a certificate
+// issued by an AWS CertificateAuthority. The certificate, its chain and the
+// generated private key are written to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NOT-AFTER",type="string",JSONPath=".status.atProvider.notAfter"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IssuedCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IssuedCertificateSpec   `json:"spec"`
+	Status IssuedCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssuedCertificateList contains a list of IssuedCertificate
+type IssuedCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IssuedCertificate `json:"items"`
+}
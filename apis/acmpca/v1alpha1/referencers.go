@@ -25,6 +25,27 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 )
 
+// ResolveReferences of this CertificateAuthority
+func (mg *CertificateAuthority) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.ParentCertificateAuthorityARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ParentCertificateAuthorityARN),
+		Reference:    mg.Spec.ForProvider.ParentCertificateAuthorityARNRef,
+		Selector:     mg.Spec.ForProvider.ParentCertificateAuthorityARNSelector,
+		To:           reference.To{Managed: &CertificateAuthority{}, List: &CertificateAuthorityList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ParentCertificateAuthorityARN")
+	}
+	mg.Spec.ForProvider.ParentCertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ParentCertificateAuthorityARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this CertificateAuthorityPermission
 func (mg *CertificateAuthorityPermission) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,3 +66,24 @@ func (mg *CertificateAuthorityPermission) ResolveReferences(ctx context.Context,
 
 	return nil
 }
+
+// ResolveReferences of this IssuedCertificate
+func (mg *IssuedCertificate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.CertificateAuthorityARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CertificateAuthorityARN),
+		Reference:    mg.Spec.ForProvider.CertificateAuthorityARNRef,
+		Selector:     mg.Spec.ForProvider.CertificateAuthorityARNSelector,
+		To:           reference.To{Managed: &CertificateAuthority{}, List: &CertificateAuthorityList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.CertificateAuthorityARN")
+	}
+	mg.Spec.ForProvider.CertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CertificateAuthorityARNRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -57,7 +57,16 @@ var (
 	CertificateAuthorityPermissionGroupVersionKind = SchemeGroupVersion.WithKind(CertificateAuthorityPermissionKind)
 )
 
+// IssuedCertificate type metadata.
+var (
+	IssuedCertificateKind             = reflect.TypeOf(IssuedCertificate{}).Name()
+	IssuedCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: IssuedCertificateKind}.String()
+	IssuedCertificateKindAPIVersion   = IssuedCertificateKind + "." + SchemeGroupVersion.String()
+	IssuedCertificateGroupVersionKind = SchemeGroupVersion.WithKind(IssuedCertificateKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CertificateAuthority{}, &CertificateAuthorityList{})
 	SchemeBuilder.Register(&CertificateAuthorityPermission{}, &CertificateAuthorityPermissionList{})
+	SchemeBuilder.Register(&IssuedCertificate{}, &IssuedCertificateList{})
 }
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -71,6 +72,14 @@ func (in *CertificateAuthorityConfiguration) DeepCopy() *CertificateAuthorityCon
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateAuthorityExternalStatus) DeepCopyInto(out *CertificateAuthorityExternalStatus) {
 	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityExternalStatus.
@@ -139,6 +148,31 @@ func (in *CertificateAuthorityParameters) DeepCopyInto(out *CertificateAuthority
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.ParentCertificateAuthorityARN != nil {
+		in, out := &in.ParentCertificateAuthorityARN, &out.ParentCertificateAuthorityARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARNRef != nil {
+		in, out := &in.ParentCertificateAuthorityARNRef, &out.ParentCertificateAuthorityARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARNSelector != nil {
+		in, out := &in.ParentCertificateAuthorityARNSelector, &out.ParentCertificateAuthorityARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(Validity)
+		**out = **in
+	}
+	if in.TemplateARN != nil {
+		in, out := &in.TemplateARN, &out.TemplateARN
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityParameters.
@@ -304,7 +338,7 @@ func (in *CertificateAuthoritySpec) DeepCopy() *CertificateAuthoritySpec {
 func (in *CertificateAuthorityStatus) DeepCopyInto(out *CertificateAuthorityStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityStatus.
@@ -317,6 +351,187 @@ func (in *CertificateAuthorityStatus) DeepCopy() *CertificateAuthorityStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedCertificate) DeepCopyInto(out *IssuedCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedCertificate.
+func (in *IssuedCertificate) DeepCopy() *IssuedCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssuedCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedCertificateList) DeepCopyInto(out *IssuedCertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IssuedCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedCertificateList.
+func (in *IssuedCertificateList) DeepCopy() *IssuedCertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedCertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssuedCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedCertificateObservation) DeepCopyInto(out *IssuedCertificateObservation) {
+	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedCertificateObservation.
+func (in *IssuedCertificateObservation) DeepCopy() *IssuedCertificateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedCertificateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedCertificateParameters) DeepCopyInto(out *IssuedCertificateParameters) {
+	*out = *in
+	if in.CertificateAuthorityARN != nil {
+		in, out := &in.CertificateAuthorityARN, &out.CertificateAuthorityARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateAuthorityARNRef != nil {
+		in, out := &in.CertificateAuthorityARNRef, &out.CertificateAuthorityARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CertificateAuthorityARNSelector != nil {
+		in, out := &in.CertificateAuthorityARNSelector, &out.CertificateAuthorityARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateSigningRequest != nil {
+		in, out := &in.CertificateSigningRequest, &out.CertificateSigningRequest
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommonName != nil {
+		in, out := &in.CommonName, &out.CommonName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Validity = in.Validity
+	if in.TemplateARN != nil {
+		in, out := &in.TemplateARN, &out.TemplateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RevocationReason != nil {
+		in, out := &in.RevocationReason, &out.RevocationReason
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedCertificateParameters.
+func (in *IssuedCertificateParameters) DeepCopy() *IssuedCertificateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedCertificateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedCertificateSpec) DeepCopyInto(out *IssuedCertificateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedCertificateSpec.
+func (in *IssuedCertificateSpec) DeepCopy() *IssuedCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedCertificateStatus) DeepCopyInto(out *IssuedCertificateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedCertificateStatus.
+func (in *IssuedCertificateStatus) DeepCopy() *IssuedCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RevocationConfiguration) DeepCopyInto(out *RevocationConfiguration) {
 	*out = *in
@@ -416,3 +631,18 @@ func (in *Tag) DeepCopy() *Tag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Validity) DeepCopyInto(out *Validity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Validity.
+func (in *Validity) DeepCopy() *Validity {
+	if in == nil {
+		return nil
+	}
+	out := new(Validity)
+	in.DeepCopyInto(out)
+	return out
+}
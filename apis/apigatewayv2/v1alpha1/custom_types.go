@@ -19,7 +19,70 @@ package v1alpha1
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 // CustomAPIParameters includes the custom fields.
-type CustomAPIParameters struct{}
+type CustomAPIParameters struct {
+	// Body is the OpenAPI 3 definition the API is imported from. When it is
+	// set, the API is created with ImportApi and re-imported with
+	// ReimportApi whenever the definition changes. Routes, integrations,
+	// models and authorizers described in the definition are then managed by
+	// API Gateway and do not need their own resources.
+	// +optional
+	Body *APIBody `json:"body,omitempty"`
+
+	// Basepath specifies how to interpret the base path of the API during
+	// import. Supported only for HTTP APIs.
+	// +optional
+	// +kubebuilder:validation:Enum=ignore;prepend;split
+	Basepath *string `json:"basepath,omitempty"`
+
+	// FailOnWarnings specifies whether to roll back the import when a
+	// warning is encountered.
+	// +optional
+	FailOnWarnings *bool `json:"failOnWarnings,omitempty"`
+}
+
+// APIBody is the source of an OpenAPI definition. Exactly one of its fields
+// should be set.
+type APIBody struct {
+	// Inline is the OpenAPI definition in JSON or YAML format.
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef is a reference to a key of a ConfigMap whose value is
+	// the OpenAPI definition.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// S3 is a reference to an S3 object whose content is the OpenAPI
+	// definition. The bucket must be in the same region as the API.
+	// +optional
+	S3 *S3ObjectReference `json:"s3,omitempty"`
+}
+
+// ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key whose value is selected.
+	Key string `json:"key"`
+}
+
+// S3ObjectReference is a reference to an S3 object.
+type S3ObjectReference struct {
+	// Bucket is the name of the bucket the object is stored in.
+	Bucket string `json:"bucket"`
+
+	// Key of the object.
+	Key string `json:"key"`
+
+	// Version of the object. The latest version is used if omitted.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
 
 // CustomAPIMappingParameters includes the custom fields.
 type CustomAPIMappingParameters struct {
@@ -320,4 +383,28 @@ type CustomStageParameters struct {
 	// to set the APIID.
 	// +optional
 	APIIDSelector *xpv1.Selector `json:"apiIdSelector,omitempty"`
+
+	// DeploymentID is the ID of the Deployment that the Stage points to. It
+	// is ignored when AutoDeploy or RedeployOnChange is enabled.
+	// +optional
+	DeploymentID *string `json:"deploymentID,omitempty"`
+
+	// RedeployOnChange creates a new deployment of the API and deploys it to
+	// this stage whenever the routes or integrations of the API change. It
+	// can be used for APIs that do not support AutoDeploy, such as WebSocket
+	// APIs.
+	// +optional
+	RedeployOnChange *bool `json:"redeployOnChange,omitempty"`
 }
+
+const (
+	// AnnotationKeyImportedBody is the annotation that stores the hash of the
+	// OpenAPI definition an API was last imported from. The hash of an S3
+	// object is derived from its location and ETag.
+	AnnotationKeyImportedBody = "apigatewayv2.aws.crossplane.io/imported-body"
+
+	// AnnotationKeyDeployedRevision is the annotation that stores the
+	// revision of the API that was last deployed to a Stage with
+	// RedeployOnChange enabled.
+	AnnotationKeyDeployedRevision = "apigatewayv2.aws.crossplane.io/deployed-revision"
+)
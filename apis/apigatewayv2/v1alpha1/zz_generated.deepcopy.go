@@ -52,6 +52,36 @@ func (in *API) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIBody) DeepCopyInto(out *APIBody) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3ObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIBody.
+func (in *APIBody) DeepCopy() *APIBody {
+	if in == nil {
+		return nil
+	}
+	out := new(APIBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIList) DeepCopyInto(out *APIList) {
 	*out = *in
@@ -397,7 +427,7 @@ func (in *APIParameters) DeepCopyInto(out *APIParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	out.CustomAPIParameters = in.CustomAPIParameters
+	in.CustomAPIParameters.DeepCopyInto(&out.CustomAPIParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIParameters.
@@ -846,6 +876,21 @@ func (in *Authorizer_SDK) DeepCopy() *Authorizer_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cors) DeepCopyInto(out *Cors) {
 	*out = *in
@@ -978,6 +1023,21 @@ func (in *CustomAPIMappingParameters) DeepCopy() *CustomAPIMappingParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomAPIParameters) DeepCopyInto(out *CustomAPIParameters) {
 	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(APIBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Basepath != nil {
+		in, out := &in.Basepath, &out.Basepath
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailOnWarnings != nil {
+		in, out := &in.FailOnWarnings, &out.FailOnWarnings
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAPIParameters.
@@ -1290,6 +1350,16 @@ func (in *CustomStageParameters) DeepCopyInto(out *CustomStageParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeploymentID != nil {
+		in, out := &in.DeploymentID, &out.DeploymentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RedeployOnChange != nil {
+		in, out := &in.RedeployOnChange, &out.RedeployOnChange
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomStageParameters.
@@ -3426,6 +3496,26 @@ func (in *Route_SDK) DeepCopy() *Route_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ObjectReference) DeepCopyInto(out *S3ObjectReference) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ObjectReference.
+func (in *S3ObjectReference) DeepCopy() *S3ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -3497,6 +3587,11 @@ func (in *StageObservation) DeepCopyInto(out *StageObservation) {
 		in, out := &in.CreatedDate, &out.CreatedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.DeploymentID != nil {
+		in, out := &in.DeploymentID, &out.DeploymentID
+		*out = new(string)
+		**out = **in
+	}
 	if in.LastDeploymentStatusMessage != nil {
 		in, out := &in.LastDeploymentStatusMessage, &out.LastDeploymentStatusMessage
 		*out = new(string)
@@ -3546,11 +3641,6 @@ func (in *StageParameters) DeepCopyInto(out *StageParameters) {
 		*out = new(RouteSettings)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DeploymentID != nil {
-		in, out := &in.DeploymentID, &out.DeploymentID
-		*out = new(string)
-		**out = **in
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -38,8 +38,6 @@ type StageParameters struct {
 
 	DefaultRouteSettings *RouteSettings `json:"defaultRouteSettings,omitempty"`
 
-	DeploymentID *string `json:"deploymentID,omitempty"`
-
 	Description *string `json:"description,omitempty"`
 
 	RouteSettings map[string]*RouteSettings `json:"routeSettings,omitempty"`
@@ -62,6 +60,8 @@ type StageObservation struct {
 
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 
+	DeploymentID *string `json:"deploymentID,omitempty"`
+
 	LastDeploymentStatusMessage *string `json:"lastDeploymentStatusMessage,omitempty"`
 
 	LastUpdatedDate *metav1.Time `json:"lastUpdatedDate,omitempty"`
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ParameterNameValue describes a name-value pair that is used to update the
+// value of a parameter.
+type ParameterNameValue struct {
+	// The name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// The value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+}
+
+// CacheParameterGroupParameters define the desired state of an AWS ElastiCache
+// Cache Parameter Group.
+type CacheParameterGroupParameters struct {
+	// Region is the region you'd like your CacheParameterGroup to be created
+	// in.
+	Region string `json:"region"`
+
+	// The name of the cache parameter group family that the cache parameter
+	// group can be used with, e.g. redis6.x.
+	// +immutable
+	CacheParameterGroupFamily string `json:"cacheParameterGroupFamily"`
+
+	// A user-specified description for the cache parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters are the parameters whose values differ from the defaults of
+	// the family. Parameters that are removed from this list are reset to
+	// their default values.
+	// +optional
+	Parameters []ParameterNameValue `json:"parameters,omitempty"`
+}
+
+// CacheParameterGroupExternalStatus keeps the state for the external resource.
+type CacheParameterGroupExternalStatus struct {
+	// The ARN (Amazon Resource Name) of the cache parameter group.
+	ARN string `json:"arn,omitempty"`
+
+	// Indicates whether the parameter group is associated with a Global
+	// datastore.
+	IsGlobal bool `json:"isGlobal,omitempty"`
+}
+
+// A CacheParameterGroupSpec defines the desired state of a
+// CacheParameterGroup.
+type CacheParameterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CacheParameterGroupParameters `json:"forProvider"`
+}
+
+// A CacheParameterGroupResourceStatus represents the observed state of a
+// CacheParameterGroup. CacheParameterGroupStatus is already used by the
+// CacheCluster observation.
+type CacheParameterGroupResourceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CacheParameterGroupExternalStatus `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheParameterGroup is a managed resource that represents an AWS
+// ElastiCache Cache Parameter Group.
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.cacheParameterGroupFamily"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CacheParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheParameterGroupSpec           `json:"spec"`
+	Status CacheParameterGroupResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheParameterGroupList contains a list of CacheParameterGroup
+type CacheParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheParameterGroup `json:"items"`
+}
@@ -207,6 +207,14 @@ type CacheClusterParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// A referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameRef *xpv1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameSelector *xpv1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// A list of security group names to associate with this cluster.
 	// +optional
 	CacheSecurityGroupNames []string `json:"cacheSecurityGroupNames,omitempty"`
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GlobalReplicationGroup states.
+const (
+	// StatusPrimaryOnly is the status of a global replication group that has
+	// no secondary members.
+	StatusPrimaryOnly = "primary-only"
+)
+
+// GlobalReplicationGroupParameters define the desired state of an AWS
+// ElastiCache Global Replication Group (Global Datastore).
+type GlobalReplicationGroupParameters struct {
+	// Region is the region of the primary replication group, which is where
+	// the GlobalReplicationGroup is created.
+	Region string `json:"region"`
+
+	// The suffix name of the global replication group. ElastiCache
+	// automatically applies a region-specific prefix to it to create the full
+	// global replication group ID, which is set as the external name.
+	// +immutable
+	GlobalReplicationGroupIDSuffix string `json:"globalReplicationGroupIdSuffix"`
+
+	// Provides details of the global replication group.
+	// +optional
+	GlobalReplicationGroupDescription *string `json:"globalReplicationGroupDescription,omitempty"`
+
+	// The ID of the existing replication group that becomes the primary of
+	// the global replication group.
+	// +immutable
+	// +optional
+	PrimaryReplicationGroupID *string `json:"primaryReplicationGroupId,omitempty"`
+
+	// PrimaryReplicationGroupIDRef is a reference to a ReplicationGroup used
+	// to set the PrimaryReplicationGroupID.
+	// +immutable
+	// +optional
+	PrimaryReplicationGroupIDRef *xpv1.Reference `json:"primaryReplicationGroupIdRef,omitempty"`
+
+	// PrimaryReplicationGroupIDSelector selects a reference to a
+	// ReplicationGroup used to set the PrimaryReplicationGroupID.
+	// +immutable
+	// +optional
+	PrimaryReplicationGroupIDSelector *xpv1.Selector `json:"primaryReplicationGroupIdSelector,omitempty"`
+
+	// The compute and memory capacity of the nodes in the global replication
+	// group. It is inherited from the primary replication group if omitted.
+	// +optional
+	CacheNodeType *string `json:"cacheNodeType,omitempty"`
+
+	// The upgraded version of the cache engine to be run on the clusters in
+	// the global replication group. It is inherited from the primary
+	// replication group if omitted.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+}
+
+// GlobalReplicationGroupMember is a member of a global replication group.
+type GlobalReplicationGroupMember struct {
+	// The replication group id of the global replication group member.
+	ReplicationGroupID string `json:"replicationGroupId,omitempty"`
+
+	// The AWS region of the global replication group member.
+	ReplicationGroupRegion string `json:"replicationGroupRegion,omitempty"`
+
+	// Indicates the role of the replication group, primary or secondary.
+	Role string `json:"role,omitempty"`
+
+	// Indicates whether automatic failover is enabled for the replication
+	// group.
+	AutomaticFailover string `json:"automaticFailover,omitempty"`
+
+	// The status of the membership of the replication group.
+	Status string `json:"status,omitempty"`
+}
+
+// GlobalReplicationGroupObservation keeps the state for the external resource.
+type GlobalReplicationGroupObservation struct {
+	// The Amazon Resource Name (ARN) of the global replication group.
+	ARN string `json:"arn,omitempty"`
+
+	// The status of the global replication group.
+	Status string `json:"status,omitempty"`
+
+	// The ElastiCache engine. For Redis only.
+	Engine string `json:"engine,omitempty"`
+
+	// A flag that indicates whether the global replication group is cluster
+	// enabled.
+	ClusterEnabled bool `json:"clusterEnabled,omitempty"`
+
+	// A flag that enables using an AuthToken (password) when issuing Redis
+	// commands.
+	AuthTokenEnabled bool `json:"authTokenEnabled,omitempty"`
+
+	// A flag that enables in-transit encryption when set to true.
+	TransitEncryptionEnabled bool `json:"transitEncryptionEnabled,omitempty"`
+
+	// A flag that enables encryption at rest when set to true.
+	AtRestEncryptionEnabled bool `json:"atRestEncryptionEnabled,omitempty"`
+
+	// The replication groups that comprise the global replication group.
+	Members []GlobalReplicationGroupMember `json:"members,omitempty"`
+}
+
+// A GlobalReplicationGroupSpec defines the desired state of a
+// GlobalReplicationGroup.
+type GlobalReplicationGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GlobalReplicationGroupParameters `json:"forProvider"`
+}
+
+// A GlobalReplicationGroupStatus represents the observed state of a
+// GlobalReplicationGroup.
+type GlobalReplicationGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GlobalReplicationGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GlobalReplicationGroup is a managed resource that represents an AWS
+// ElastiCache Global Datastore. Secondary replication groups join it by
+// referencing it from their globalReplicationGroupId.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type GlobalReplicationGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalReplicationGroupSpec   `json:"spec"`
+	Status GlobalReplicationGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalReplicationGroupList contains a list of GlobalReplicationGroup
+type GlobalReplicationGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalReplicationGroup `json:"items"`
+}
@@ -65,6 +65,20 @@ func (mg *CacheCluster) ResolveReferences(ctx context.Context, c client.Reader)
 	mg.Spec.ForProvider.CacheSubnetGroupName = aws.String(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CacheSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &CacheParameterGroup{}, List: &CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.securityGroupIds
 	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
@@ -81,3 +95,24 @@ func (mg *CacheCluster) ResolveReferences(ctx context.Context, c client.Reader)
 
 	return nil
 }
+
+// ResolveReferences of this UserGroup
+func (mg *UserGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.userIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.UserIDs,
+		References:    mg.Spec.ForProvider.UserIDRefs,
+		Selector:      mg.Spec.ForProvider.UserIDSelector,
+		To:            reference.To{Managed: &User{}, List: &UserList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.UserIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.UserIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -53,7 +53,44 @@ var (
 	CacheClusterGroupVersionKind = SchemeGroupVersion.WithKind(CacheClusterKind)
 )
 
+// CacheParameterGroup type metadata.
+var (
+	CacheParameterGroupKind             = reflect.TypeOf(CacheParameterGroup{}).Name()
+	CacheParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: CacheParameterGroupKind}.String()
+	CacheParameterGroupKindAPIVersion   = CacheParameterGroupKind + "." + SchemeGroupVersion.String()
+	CacheParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheParameterGroupKind)
+)
+
+// User type metadata. The group kind of User is named UserKindGroupKind
+// because UserGroupKind is the kind of UserGroup.
+var (
+	UserKind             = reflect.TypeOf(User{}).Name()
+	UserKindGroupKind    = schema.GroupKind{Group: Group, Kind: UserKind}.String()
+	UserKindAPIVersion   = UserKind + "." + SchemeGroupVersion.String()
+	UserGroupVersionKind = SchemeGroupVersion.WithKind(UserKind)
+)
+
+// UserGroup type metadata.
+var (
+	UserGroupKind             = reflect.TypeOf(UserGroup{}).Name()
+	UserGroupGroupKind        = schema.GroupKind{Group: Group, Kind: UserGroupKind}.String()
+	UserGroupKindAPIVersion   = UserGroupKind + "." + SchemeGroupVersion.String()
+	UserGroupGroupVersionKind = SchemeGroupVersion.WithKind(UserGroupKind)
+)
+
+// GlobalReplicationGroup type metadata.
+var (
+	GlobalReplicationGroupKind             = reflect.TypeOf(GlobalReplicationGroup{}).Name()
+	GlobalReplicationGroupGroupKind        = schema.GroupKind{Group: Group, Kind: GlobalReplicationGroupKind}.String()
+	GlobalReplicationGroupKindAPIVersion   = GlobalReplicationGroupKind + "." + SchemeGroupVersion.String()
+	GlobalReplicationGroupGroupVersionKind = SchemeGroupVersion.WithKind(GlobalReplicationGroupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CacheCluster{}, &CacheClusterList{})
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&CacheParameterGroup{}, &CacheParameterGroupList{})
+	SchemeBuilder.Register(&User{}, &UserList{})
+	SchemeBuilder.Register(&UserGroup{}, &UserGroupList{})
+	SchemeBuilder.Register(&GlobalReplicationGroup{}, &GlobalReplicationGroupList{})
 }
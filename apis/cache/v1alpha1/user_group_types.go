@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UserGroupParameters define the desired state of an AWS ElastiCache User
+// Group.
+type UserGroupParameters struct {
+	// Region is the region you'd like your UserGroup to be created in.
+	Region string `json:"region"`
+
+	// The engine the user group is meant to be used with. Only redis is
+	// supported.
+	// +kubebuilder:validation:Enum=redis
+	// +kubebuilder:default=redis
+	// +immutable
+	// +optional
+	Engine string `json:"engine,omitempty"`
+
+	// The IDs of the users that belong to the user group. A user group must
+	// contain a user with the user name "default".
+	// +optional
+	UserIDs []string `json:"userIds,omitempty"`
+
+	// UserIDRefs are references to Users used to set the UserIDs.
+	// +optional
+	UserIDRefs []xpv1.Reference `json:"userIdRefs,omitempty"`
+
+	// UserIDSelector selects references to Users used to set the UserIDs.
+	// +optional
+	UserIDSelector *xpv1.Selector `json:"userIdSelector,omitempty"`
+}
+
+// UserGroupObservation keeps the state for the external resource.
+type UserGroupObservation struct {
+	// The Amazon Resource Name (ARN) of the user group.
+	ARN string `json:"arn,omitempty"`
+
+	// Indicates the user group status. Can be "creating", "active",
+	// "modifying" or "deleting".
+	Status string `json:"status,omitempty"`
+
+	// The IDs of the users that currently belong to the user group.
+	UserIDs []string `json:"userIds,omitempty"`
+
+	// The IDs of the users that are being added to the user group.
+	PendingUserIDsToAdd []string `json:"pendingUserIdsToAdd,omitempty"`
+
+	// The IDs of the users that are being removed from the user group.
+	PendingUserIDsToRemove []string `json:"pendingUserIdsToRemove,omitempty"`
+
+	// The IDs of the replication groups the user group is associated with.
+	ReplicationGroups []string `json:"replicationGroups,omitempty"`
+}
+
+// A UserGroupSpec defines the desired state of a UserGroup.
+type UserGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserGroupParameters `json:"forProvider"`
+}
+
+// A UserGroupStatus represents the observed state of a UserGroup.
+type UserGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserGroup is a managed resource that represents an AWS ElastiCache user
+// group used for Redis role-based access control. The external name of the
+// resource is used as the user group ID.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type UserGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserGroupSpec   `json:"spec"`
+	Status UserGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserGroupList contains a list of UserGroup
+type UserGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserGroup `json:"items"`
+}
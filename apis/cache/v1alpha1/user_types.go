@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// User and UserGroup states.
+const (
+	StatusActive = "active"
+)
+
+// UserParameters define the desired state of an AWS ElastiCache User.
+type UserParameters struct {
+	// Region is the region you'd like your User to be created in.
+	Region string `json:"region"`
+
+	// The username of the user.
+	// +immutable
+	UserName string `json:"userName"`
+
+	// The engine the user is meant to be used with. Only redis is supported.
+	// +kubebuilder:validation:Enum=redis
+	// +kubebuilder:default=redis
+	// +immutable
+	// +optional
+	Engine string `json:"engine,omitempty"`
+
+	// Access permissions string used for this user, e.g. "on ~* +@all".
+	AccessString string `json:"accessString"`
+
+	// NoPasswordRequired indicates that no password is required for the user.
+	// Either NoPasswordRequired must be true or at least one password must be
+	// referenced.
+	// +optional
+	NoPasswordRequired *bool `json:"noPasswordRequired,omitempty"`
+
+	// PasswordSecretRefs reference the Secret keys holding the passwords
+	// associated with the user. ElastiCache accepts up to two passwords per
+	// user, which allows rotating them without downtime.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	PasswordSecretRefs []xpv1.SecretKeySelector `json:"passwordSecretRefs,omitempty"`
+}
+
+// UserObservation keeps the state for the external resource.
+type UserObservation struct {
+	// The Amazon Resource Name (ARN) of the user.
+	ARN string `json:"arn,omitempty"`
+
+	// Indicates the user status. Can be "active", "modifying" or "deleting".
+	Status string `json:"status,omitempty"`
+
+	// The authentication type of the user, either password or no-password.
+	AuthenticationType string `json:"authenticationType,omitempty"`
+
+	// The number of passwords belonging to the user.
+	PasswordCount int64 `json:"passwordCount,omitempty"`
+
+	// The IDs of the user groups the user belongs to.
+	UserGroupIDs []string `json:"userGroupIds,omitempty"`
+}
+
+// A UserSpec defines the desired state of a User.
+type UserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserParameters `json:"forProvider"`
+}
+
+// A UserStatus represents the observed state of a User.
+type UserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User is a managed resource that represents an AWS ElastiCache user used
+// for Redis role-based access control. The external name of the resource is
+// used as the user ID.
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
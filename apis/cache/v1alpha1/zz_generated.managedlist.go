@@ -29,6 +29,15 @@ func (l *CacheClusterList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this CacheParameterGroupList.
+func (l *CacheParameterGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this CacheSubnetGroupList.
 func (l *CacheSubnetGroupList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -37,3 +46,30 @@ func (l *CacheSubnetGroupList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this GlobalReplicationGroupList.
+func (l *GlobalReplicationGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this UserGroupList.
+func (l *UserGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this UserList.
+func (l *UserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
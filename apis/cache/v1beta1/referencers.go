@@ -98,5 +98,47 @@ func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.CacheSecurityGroupNames = mrsp.ResolvedValues
 	mg.Spec.ForProvider.CacheSecurityGroupNameRefs = mrsp.ResolvedReferences
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha1.CacheParameterGroup{}, List: &v1alpha1.CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cacheParameterGroupName")
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.globalReplicationGroupId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.GlobalReplicationGroupID),
+		Reference:    mg.Spec.ForProvider.GlobalReplicationGroupIDRef,
+		Selector:     mg.Spec.ForProvider.GlobalReplicationGroupIDSelector,
+		To:           reference.To{Managed: &v1alpha1.GlobalReplicationGroup{}, List: &v1alpha1.GlobalReplicationGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.globalReplicationGroupId")
+	}
+	mg.Spec.ForProvider.GlobalReplicationGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GlobalReplicationGroupIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.userGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.UserGroupIDs,
+		References:    mg.Spec.ForProvider.UserGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.UserGroupIDSelector,
+		To:            reference.To{Managed: &v1alpha1.UserGroup{}, List: &v1alpha1.UserGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.userGroupIds")
+	}
+	mg.Spec.ForProvider.UserGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.UserGroupIDRefs = mrsp.ResolvedReferences
+
 	return nil
 }
@@ -178,6 +178,14 @@ type ReplicationGroupObservation struct {
 	// endpoint to connect to this replication group.
 	ConfigurationEndpoint Endpoint `json:"configurationEndpoint,omitempty"`
 
+	// GlobalReplicationGroupID is the ID of the global replication group this
+	// replication group is a member of, if any.
+	GlobalReplicationGroupID string `json:"globalReplicationGroupId,omitempty"`
+
+	// GlobalReplicationGroupMemberRole is the role of this replication group
+	// in its global replication group, either primary or secondary.
+	GlobalReplicationGroupMemberRole string `json:"globalReplicationGroupMemberRole,omitempty"`
+
 	// MemberClusters is the list of names of all the cache clusters that are
 	// part of this replication group.
 	MemberClusters []string `json:"memberClusters,omitempty"`
@@ -195,6 +203,10 @@ type ReplicationGroupObservation struct {
 	// Status is the current state of this replication group - creating,
 	// available, modifying, deleting, create-failed, snapshotting.
 	Status string `json:"status,omitempty"`
+
+	// UserGroupIDs is the list of user groups associated with this
+	// replication group.
+	UserGroupIDs []string `json:"userGroupIds,omitempty"`
 }
 
 // A Tag is used to tag the ElastiCache resources in AWS.
@@ -318,6 +330,16 @@ type ReplicationGroupParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// CacheParameterGroupNameRef is a reference to a CacheParameterGroup
+	// used to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameRef *xpv1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// CacheParameterGroupNameSelector selects a reference to a
+	// CacheParameterGroup used to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameSelector *xpv1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// CacheSecurityGroupNames specifies a list of cache security group names to
 	// associate with this replication group. Only for EC2-Classic mode.
 	// +optional
@@ -386,6 +408,27 @@ type ReplicationGroupParameters struct {
 	// +optional
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
+	// GlobalReplicationGroupID is the name of the global replication group
+	// this replication group joins as a secondary member. The engine, engine
+	// version, cache node type and encryption settings are inherited from the
+	// primary replication group of the global replication group and should be
+	// omitted.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupID *string `json:"globalReplicationGroupId,omitempty"`
+
+	// GlobalReplicationGroupIDRef is a reference to a GlobalReplicationGroup
+	// used to set the GlobalReplicationGroupID.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupIDRef *xpv1.Reference `json:"globalReplicationGroupIdRef,omitempty"`
+
+	// GlobalReplicationGroupIDSelector selects a reference to a
+	// GlobalReplicationGroup used to set the GlobalReplicationGroupID.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupIDSelector *xpv1.Selector `json:"globalReplicationGroupIdSelector,omitempty"`
+
 	// NodeGroupConfigurationSpec specifies a list of node group (shard)
 	// configuration options.
 	//
@@ -576,6 +619,23 @@ type ReplicationGroupParameters struct {
 	// +immutable
 	// +optional
 	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
+
+	// UserGroupIDs specifies the user groups to associate with this
+	// replication group for Redis role-based access control. User groups
+	// require TransitEncryptionEnabled and cannot be combined with
+	// AuthEnabled.
+	// +optional
+	UserGroupIDs []string `json:"userGroupIds,omitempty"`
+
+	// UserGroupIDRefs are references to UserGroups used to set the
+	// UserGroupIDs.
+	// +optional
+	UserGroupIDRefs []xpv1.Reference `json:"userGroupIdRefs,omitempty"`
+
+	// UserGroupIDSelector selects references to UserGroups used to set the
+	// UserGroupIDs.
+	// +optional
+	UserGroupIDSelector *xpv1.Selector `json:"userGroupIdSelector,omitempty"`
 }
 
 // A ReplicationGroupSpec defines the desired state of a ReplicationGroup.
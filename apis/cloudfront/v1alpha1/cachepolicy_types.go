@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CachePolicyParameters define the desired state of a CloudFront
+// CachePolicy. The external name of the resource is the ID of the cache
+// policy.
+type CachePolicyParameters struct {
+	// Region is which region the CachePolicy will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// A unique name to identify the cache policy.
+	Name string `json:"name"`
+
+	// A comment to describe the cache policy.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// The default amount of time, in seconds, that objects stay in the
+	// CloudFront cache before CloudFront sends another request to the origin
+	// to see if the object has been updated.
+	// +optional
+	DefaultTTL *int64 `json:"defaultTTL,omitempty"`
+
+	// The maximum amount of time, in seconds, that objects stay in the
+	// CloudFront cache before CloudFront sends another request to the origin
+	// to see if the object has been updated.
+	// +optional
+	MaxTTL *int64 `json:"maxTTL,omitempty"`
+
+	// The minimum amount of time, in seconds, that objects stay in the
+	// CloudFront cache before CloudFront sends another request to the origin
+	// to see if the object has been updated.
+	MinTTL int64 `json:"minTTL"`
+
+	// The HTTP headers, cookies, and URL query strings to include in the
+	// cache key. The values included in the cache key are automatically
+	// included in requests that CloudFront sends to the origin.
+	ParametersInCacheKeyAndForwardedToOrigin CacheKeyParameters `json:"parametersInCacheKeyAndForwardedToOrigin"`
+}
+
+// CacheKeyParameters are the HTTP headers, cookies, and URL query strings
+// included in the cache key.
+type CacheKeyParameters struct {
+	// EnableAcceptEncodingGzip includes the Accept-Encoding header in the
+	// cache key and the origin requests when the viewer supports gzip.
+	EnableAcceptEncodingGzip bool `json:"enableAcceptEncodingGzip"`
+
+	// EnableAcceptEncodingBrotli includes the Accept-Encoding header in the
+	// cache key and the origin requests when the viewer supports brotli.
+	// +optional
+	EnableAcceptEncodingBrotli *bool `json:"enableAcceptEncodingBrotli,omitempty"`
+
+	// CookiesConfig determines which cookies are included in the cache key.
+	CookiesConfig CacheKeyCookies `json:"cookiesConfig"`
+
+	// HeadersConfig determines which headers are included in the cache key.
+	HeadersConfig CacheKeyHeaders `json:"headersConfig"`
+
+	// QueryStringsConfig determines which query strings are included in the
+	// cache key.
+	QueryStringsConfig CacheKeyQueryStrings `json:"queryStringsConfig"`
+}
+
+// CacheKeyCookies determines which cookies are included in the cache key.
+type CacheKeyCookies struct {
+	// CookieBehavior determines whether any cookies in viewer requests are
+	// included in the cache key.
+	// +kubebuilder:validation:Enum=none;whitelist;allExcept;all
+	CookieBehavior string `json:"cookieBehavior"`
+
+	// Cookies is the list of cookie names the behavior applies to.
+	// +optional
+	Cookies []string `json:"cookies,omitempty"`
+}
+
+// CacheKeyHeaders determines which headers are included in the cache key.
+type CacheKeyHeaders struct {
+	// HeaderBehavior determines whether any HTTP headers are included in the
+	// cache key.
+	// +kubebuilder:validation:Enum=none;whitelist
+	HeaderBehavior string `json:"headerBehavior"`
+
+	// Headers is the list of header names the behavior applies to.
+	// +optional
+	Headers []string `json:"headers,omitempty"`
+}
+
+// CacheKeyQueryStrings determines which query strings are included in the
+// cache key.
+type CacheKeyQueryStrings struct {
+	// QueryStringBehavior determines whether any URL query strings in viewer
+	// requests are included in the cache key.
+	// +kubebuilder:validation:Enum=none;whitelist;allExcept;all
+	QueryStringBehavior string `json:"queryStringBehavior"`
+
+	// QueryStrings is the list of query string names the behavior applies
+	// to.
+	// +optional
+	QueryStrings []string `json:"queryStrings,omitempty"`
+}
+
+// CachePolicyObservation is the observed state of a CachePolicy.
+type CachePolicyObservation struct {
+	// The current version of the cache policy.
+	ETag *string `json:"eTag,omitempty"`
+
+	// The date and time when the cache policy was last modified.
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+}
+
+// A CachePolicySpec defines the desired state of a CachePolicy.
+type CachePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CachePolicyParameters `json:"forProvider"`
+}
+
+// A CachePolicyStatus represents the observed state of a CachePolicy.
+type CachePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CachePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CachePolicy is a managed resource that represents a CloudFront cache
+// policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CachePolicySpec   `json:"spec"`
+	Status CachePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CachePolicyList contains a list of CachePolicies
+type CachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CachePolicy `json:"items"`
+}
+
+// CachePolicy type metadata.
+var (
+	CachePolicyKind             = "CachePolicy"
+	CachePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: CachePolicyKind}.String()
+	CachePolicyKindAPIVersion   = CachePolicyKind + "." + GroupVersion.String()
+	CachePolicyGroupVersionKind = GroupVersion.WithKind(CachePolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CachePolicy{}, &CachePolicyList{})
+}
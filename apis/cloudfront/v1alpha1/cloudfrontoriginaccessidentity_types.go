@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudFrontOriginAccessIdentityParameters define the desired state of a
+// CloudFront origin access identity. The external name of the resource is the
+// ID of the origin access identity.
+type CloudFrontOriginAccessIdentityParameters struct {
+	// Region is which region the CloudFrontOriginAccessIdentity will be
+	// created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// A comment to describe the origin access identity.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// CloudFrontOriginAccessIdentityObservation is the observed state of a
+// CloudFrontOriginAccessIdentity.
+type CloudFrontOriginAccessIdentityObservation struct {
+	// The current version of the origin access identity.
+	ETag *string `json:"eTag,omitempty"`
+
+	// The Amazon S3 canonical user ID for the origin access identity, used
+	// when giving the origin access identity read permission to an object in
+	// Amazon S3.
+	S3CanonicalUserID *string `json:"s3CanonicalUserId,omitempty"`
+}
+
+// A CloudFrontOriginAccessIdentitySpec defines the desired state of a
+// CloudFrontOriginAccessIdentity.
+type CloudFrontOriginAccessIdentitySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudFrontOriginAccessIdentityParameters `json:"forProvider"`
+}
+
+// A CloudFrontOriginAccessIdentityStatus represents the observed state of a
+// CloudFrontOriginAccessIdentity.
+type CloudFrontOriginAccessIdentityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudFrontOriginAccessIdentityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudFrontOriginAccessIdentity is a managed resource that represents a
+// CloudFront origin access identity, which lets a Distribution read the
+// objects of a private S3 bucket.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CloudFrontOriginAccessIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudFrontOriginAccessIdentitySpec   `json:"spec"`
+	Status CloudFrontOriginAccessIdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudFrontOriginAccessIdentityList contains a list of
+// CloudFrontOriginAccessIdentities
+type CloudFrontOriginAccessIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudFrontOriginAccessIdentity `json:"items"`
+}
+
+// CloudFrontOriginAccessIdentity type metadata.
+var (
+	CloudFrontOriginAccessIdentityKind             = "CloudFrontOriginAccessIdentity"
+	CloudFrontOriginAccessIdentityGroupKind        = schema.GroupKind{Group: Group, Kind: CloudFrontOriginAccessIdentityKind}.String()
+	CloudFrontOriginAccessIdentityKindAPIVersion   = CloudFrontOriginAccessIdentityKind + "." + GroupVersion.String()
+	CloudFrontOriginAccessIdentityGroupVersionKind = GroupVersion.WithKind(CloudFrontOriginAccessIdentityKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudFrontOriginAccessIdentity{}, &CloudFrontOriginAccessIdentityList{})
+}
@@ -16,5 +16,135 @@ limitations under the License.
 
 package v1alpha1
 
-// CustomDistributionParameters includes the custom fields of Stage.
-type CustomDistributionParameters struct{}
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomDistributionParameters includes the custom fields of Distribution.
+type CustomDistributionParameters struct {
+	// DefaultCacheBehaviorReferences are used to set the IDs of the default
+	// cache behavior of the DistributionConfig.
+	// +optional
+	DefaultCacheBehaviorReferences *CustomCacheBehaviorParameters `json:"defaultCacheBehaviorReferences,omitempty"`
+
+	// CacheBehaviorReferences are used to set the IDs of the cache behaviors
+	// of the DistributionConfig with the same path pattern.
+	// +optional
+	CacheBehaviorReferences []CustomCacheBehaviorParameters `json:"cacheBehaviorReferences,omitempty"`
+
+	// OriginReferences are used to set the IDs of the origins of the
+	// DistributionConfig with the same ID.
+	// +optional
+	OriginReferences []CustomOriginParameters `json:"originReferences,omitempty"`
+}
+
+// CustomCacheBehaviorParameters includes the references of a cache behavior.
+type CustomCacheBehaviorParameters struct {
+	// PathPattern of the cache behavior the references belong to. It is
+	// ignored for the default cache behavior.
+	// +optional
+	PathPattern *string `json:"pathPattern,omitempty"`
+
+	// CachePolicyIDRef is a reference to a CachePolicy used to set the
+	// CachePolicyID of the cache behavior.
+	// +optional
+	CachePolicyIDRef *xpv1.Reference `json:"cachePolicyIDRef,omitempty"`
+
+	// CachePolicyIDSelector selects a reference to a CachePolicy used to set
+	// the CachePolicyID of the cache behavior.
+	// +optional
+	CachePolicyIDSelector *xpv1.Selector `json:"cachePolicyIDSelector,omitempty"`
+
+	// OriginRequestPolicyIDRef is a reference to an OriginRequestPolicy used
+	// to set the OriginRequestPolicyID of the cache behavior.
+	// +optional
+	OriginRequestPolicyIDRef *xpv1.Reference `json:"originRequestPolicyIDRef,omitempty"`
+
+	// OriginRequestPolicyIDSelector selects a reference to an
+	// OriginRequestPolicy used to set the OriginRequestPolicyID of the cache
+	// behavior.
+	// +optional
+	OriginRequestPolicyIDSelector *xpv1.Selector `json:"originRequestPolicyIDSelector,omitempty"`
+
+	// TrustedKeyGroupRefs are references to KeyGroups used to set the items
+	// of the TrustedKeyGroups of the cache behavior.
+	// +optional
+	TrustedKeyGroupRefs []xpv1.Reference `json:"trustedKeyGroupRefs,omitempty"`
+
+	// TrustedKeyGroupSelector selects references to KeyGroups used to set the
+	// items of the TrustedKeyGroups of the cache behavior.
+	// +optional
+	TrustedKeyGroupSelector *xpv1.Selector `json:"trustedKeyGroupSelector,omitempty"`
+
+	// FunctionAssociations are used to set the ARNs of the function
+	// associations of the cache behavior with the same event type.
+	// +optional
+	FunctionAssociations []CustomFunctionAssociationParameters `json:"functionAssociations,omitempty"`
+
+	// LambdaFunctionAssociations are used to set the ARNs of the Lambda
+	// function associations of the cache behavior with the same event type.
+	// +optional
+	LambdaFunctionAssociations []CustomLambdaFunctionAssociationParameters `json:"lambdaFunctionAssociations,omitempty"`
+}
+
+// CustomFunctionAssociationParameters includes the references of a function
+// association.
+type CustomFunctionAssociationParameters struct {
+	// EventType of the function association the references belong to. The
+	// association is added to the cache behavior if it does not exist yet.
+	// +kubebuilder:validation:Enum=viewer-request;viewer-response
+	EventType string `json:"eventType"`
+
+	// FunctionARNRef is a reference to a CloudFront Function used to set the
+	// FunctionARN of the function association.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionARNRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a CloudFront Function used
+	// to set the FunctionARN of the function association.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionARNSelector,omitempty"`
+}
+
+// CustomLambdaFunctionAssociationParameters includes the references of a
+// Lambda function association.
+type CustomLambdaFunctionAssociationParameters struct {
+	// EventType of the Lambda function association the references belong to.
+	// The association is added to the cache behavior if it does not exist yet.
+	// +kubebuilder:validation:Enum=viewer-request;viewer-response;origin-request;origin-response
+	EventType string `json:"eventType"`
+
+	// LambdaFunctionARNRef is a reference to a lambda Function used to set
+	// the LambdaFunctionARN of the association together with the
+	// LambdaFunctionVersion.
+	// +optional
+	LambdaFunctionARNRef *xpv1.Reference `json:"lambdaFunctionARNRef,omitempty"`
+
+	// LambdaFunctionARNSelector selects a reference to a lambda Function used
+	// to set the LambdaFunctionARN of the association together with the
+	// LambdaFunctionVersion.
+	// +optional
+	LambdaFunctionARNSelector *xpv1.Selector `json:"lambdaFunctionARNSelector,omitempty"`
+
+	// LambdaFunctionVersion is the published version of the referenced lambda
+	// Function that is appended to its ARN as qualifier. Lambda@Edge does not
+	// accept unqualified ARNs and the $LATEST version.
+	// +optional
+	LambdaFunctionVersion *string `json:"lambdaFunctionVersion,omitempty"`
+}
+
+// CustomOriginParameters includes the references of an origin.
+type CustomOriginParameters struct {
+	// ID of the origin the references belong to.
+	ID string `json:"id"`
+
+	// OriginAccessIdentityRef is a reference to a
+	// CloudFrontOriginAccessIdentity used to set the OriginAccessIDentity of
+	// the S3OriginConfig of the origin.
+	// +optional
+	OriginAccessIdentityRef *xpv1.Reference `json:"originAccessIdentityRef,omitempty"`
+
+	// OriginAccessIdentitySelector selects a reference to a
+	// CloudFrontOriginAccessIdentity used to set the OriginAccessIDentity of
+	// the S3OriginConfig of the origin.
+	// +optional
+	OriginAccessIdentitySelector *xpv1.Selector `json:"originAccessIdentitySelector,omitempty"`
+}
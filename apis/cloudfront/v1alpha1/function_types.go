@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FunctionParameters define the desired state of a CloudFront Function. The
+// external name of the resource is the name of the function.
+type FunctionParameters struct {
+	// Region is which region the Function will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// A comment to describe the function.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// The function's runtime environment.
+	// +optional
+	// +kubebuilder:validation:Enum=cloudfront-js-1.0
+	// +kubebuilder:default:=cloudfront-js-1.0
+	Runtime string `json:"runtime,omitempty"`
+
+	// Code is the source of the JavaScript code of the function.
+	Code FunctionCode `json:"code"`
+
+	// Publish specifies whether the function is published to the LIVE stage
+	// after every change of its DEVELOPMENT stage. Only the LIVE stage of a
+	// function can be associated with a Distribution. Defaults to true.
+	// +optional
+	Publish *bool `json:"publish,omitempty"`
+
+	// TestEventObject is an event object in JSON format that the function
+	// is tested with after every change of its DEVELOPMENT stage. The result
+	// is reported in status.atProvider.testResult and the function is not
+	// published if the test fails.
+	// +optional
+	TestEventObject *string `json:"testEventObject,omitempty"`
+}
+
+// FunctionCode is the source of the code of a CloudFront Function. Exactly
+// one of its fields should be set.
+type FunctionCode struct {
+	// Inline is the JavaScript code of the function.
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef is a reference to a key of a ConfigMap whose value is
+	// the JavaScript code of the function.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key whose value is selected.
+	Key string `json:"key"`
+}
+
+// FunctionObservation is the observed state of a Function.
+type FunctionObservation struct {
+	// The ARN of the function.
+	FunctionARN *string `json:"functionARN,omitempty"`
+
+	// The status of the function, one of UNPUBLISHED, UNASSOCIATED or
+	// DEPLOYED.
+	Status *string `json:"status,omitempty"`
+
+	// The current version of the DEVELOPMENT stage of the function.
+	ETag *string `json:"eTag,omitempty"`
+
+	// The date and time when the function was created.
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+
+	// The date and time when the function was most recently modified.
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+
+	// The result of the most recent test of the function.
+	TestResult *FunctionTestResult `json:"testResult,omitempty"`
+}
+
+// FunctionTestResult is the result of testing a Function with the
+// TestEventObject.
+type FunctionTestResult struct {
+	// The amount of time that the function took to run as a percentage of
+	// the maximum allowed time.
+	ComputeUtilization *string `json:"computeUtilization,omitempty"`
+
+	// If the function returned an error, it is contained here.
+	FunctionErrorMessage *string `json:"functionErrorMessage,omitempty"`
+
+	// Logs that the function wrote while it ran.
+	FunctionExecutionLogs []string `json:"functionExecutionLogs,omitempty"`
+
+	// The event object returned by the function.
+	FunctionOutput *string `json:"functionOutput,omitempty"`
+}
+
+// A FunctionSpec defines the desired state of a Function.
+type FunctionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionParameters `json:"forProvider"`
+}
+
+// A FunctionStatus represents the observed state of a Function.
+type FunctionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Function is a managed resource that represents a CloudFront Function.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionList contains a list of Functions
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Function `json:"items"`
+}
+
+// Function type metadata.
+var (
+	FunctionKind             = "Function"
+	FunctionGroupKind        = schema.GroupKind{Group: Group, Kind: FunctionKind}.String()
+	FunctionKindAPIVersion   = FunctionKind + "." + GroupVersion.String()
+	FunctionGroupVersionKind = GroupVersion.WithKind(FunctionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Function{}, &FunctionList{})
+}
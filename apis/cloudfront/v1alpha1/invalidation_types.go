@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AnnotationKeyInvalidationTrigger is the annotation of an Invalidation
+// whose changes issue a new invalidation of the same paths.
+const AnnotationKeyInvalidationTrigger = "cloudfront.aws.crossplane.io/invalidation-trigger"
+
+// InvalidationParameters define the desired state of a CloudFront
+// Invalidation. The external name of the resource is the ID of the latest
+// invalidation. A new invalidation is issued whenever the paths or the
+// cloudfront.aws.crossplane.io/invalidation-trigger annotation change.
+type InvalidationParameters struct {
+	// Region is which region the Invalidation will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the distribution whose objects are invalidated.
+	// +immutable
+	// +optional
+	DistributionID *string `json:"distributionId,omitempty"`
+
+	// DistributionIDRef is a reference to a Distribution used to set the
+	// DistributionID.
+	// +optional
+	DistributionIDRef *xpv1.Reference `json:"distributionIdRef,omitempty"`
+
+	// DistributionIDSelector selects a reference to a Distribution used to
+	// set the DistributionID.
+	// +optional
+	DistributionIDSelector *xpv1.Selector `json:"distributionIdSelector,omitempty"`
+
+	// The paths of the objects to invalidate, such as /images/*.
+	// +kubebuilder:validation:MinItems=1
+	Paths []string `json:"paths"`
+}
+
+// InvalidationObservation is the observed state of an Invalidation.
+type InvalidationObservation struct {
+	// The unique value that identifies the latest invalidation request.
+	CallerReference *string `json:"callerReference,omitempty"`
+
+	// The date and time the latest invalidation request was first made.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// The status of the latest invalidation request, either InProgress or
+	// Completed.
+	Status *string `json:"status,omitempty"`
+}
+
+// An InvalidationSpec defines the desired state of an Invalidation.
+type InvalidationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InvalidationParameters `json:"forProvider"`
+}
+
+// An InvalidationStatus represents the observed state of an Invalidation.
+type InvalidationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InvalidationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Invalidation is a managed resource that represents the invalidations of
+// the objects of a CloudFront distribution. Invalidations cannot be
+// cancelled, so deleting the resource leaves them in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Invalidation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InvalidationSpec   `json:"spec"`
+	Status InvalidationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InvalidationList contains a list of Invalidations
+type InvalidationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Invalidation `json:"items"`
+}
+
+// Invalidation type metadata.
+var (
+	InvalidationKind             = "Invalidation"
+	InvalidationGroupKind        = schema.GroupKind{Group: Group, Kind: InvalidationKind}.String()
+	InvalidationKindAPIVersion   = InvalidationKind + "." + GroupVersion.String()
+	InvalidationGroupVersionKind = GroupVersion.WithKind(InvalidationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Invalidation{}, &InvalidationList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyGroupParameters define the desired state of a CloudFront KeyGroup. The
+// external name of the resource is the ID of the key group.
+type KeyGroupParameters struct {
+	// Region is which region the KeyGroup will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// A name to identify the key group.
+	Name string `json:"name"`
+
+	// A comment to describe the key group.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// A list of the identifiers of the public keys in the key group.
+	// +optional
+	Items []string `json:"items,omitempty"`
+
+	// ItemRefs are references to PublicKeys used to set the Items.
+	// +optional
+	ItemRefs []xpv1.Reference `json:"itemRefs,omitempty"`
+
+	// ItemSelector selects references to PublicKeys used to set the Items.
+	// +optional
+	ItemSelector *xpv1.Selector `json:"itemSelector,omitempty"`
+}
+
+// KeyGroupObservation is the observed state of a KeyGroup.
+type KeyGroupObservation struct {
+	// The current version of the key group.
+	ETag *string `json:"eTag,omitempty"`
+
+	// The date and time when the key group was last modified.
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+}
+
+// A KeyGroupSpec defines the desired state of a KeyGroup.
+type KeyGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyGroupParameters `json:"forProvider"`
+}
+
+// A KeyGroupStatus represents the observed state of a KeyGroup.
+type KeyGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyGroup is a managed resource that represents a CloudFront key group,
+// whose public keys verify signed URLs and signed cookies of the cache
+// behaviors that trust it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyGroupSpec   `json:"spec"`
+	Status KeyGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyGroupList contains a list of KeyGroups
+type KeyGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyGroup `json:"items"`
+}
+
+// KeyGroup type metadata.
+var (
+	KeyGroupKind             = "KeyGroup"
+	KeyGroupGroupKind        = schema.GroupKind{Group: Group, Kind: KeyGroupKind}.String()
+	KeyGroupKindAPIVersion   = KeyGroupKind + "." + GroupVersion.String()
+	KeyGroupGroupVersionKind = GroupVersion.WithKind(KeyGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KeyGroup{}, &KeyGroupList{})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OriginRequestPolicyParameters define the desired state of a CloudFront
+// OriginRequestPolicy. The external name of the resource is the ID of the
+// origin request policy.
+type OriginRequestPolicyParameters struct {
+	// Region is which region the OriginRequestPolicy will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// A unique name to identify the origin request policy.
+	Name string `json:"name"`
+
+	// A comment to describe the origin request policy.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// CookiesConfig determines which cookies from viewer requests are
+	// included in requests that CloudFront sends to the origin.
+	CookiesConfig OriginRequestCookies `json:"cookiesConfig"`
+
+	// HeadersConfig determines which HTTP headers from viewer requests are
+	// included in requests that CloudFront sends to the origin.
+	HeadersConfig OriginRequestHeaders `json:"headersConfig"`
+
+	// QueryStringsConfig determines which URL query strings from viewer
+	// requests are included in requests that CloudFront sends to the origin.
+	QueryStringsConfig OriginRequestQueryStrings `json:"queryStringsConfig"`
+}
+
+// OriginRequestCookies determines which cookies are included in origin
+// requests.
+type OriginRequestCookies struct {
+	// CookieBehavior determines whether any cookies in viewer requests are
+	// included in origin requests.
+	// +kubebuilder:validation:Enum=none;whitelist;all
+	CookieBehavior string `json:"cookieBehavior"`
+
+	// Cookies is the list of cookie names the behavior applies to.
+	// +optional
+	Cookies []string `json:"cookies,omitempty"`
+}
+
+// OriginRequestHeaders determines which headers are included in origin
+// requests.
+type OriginRequestHeaders struct {
+	// HeaderBehavior determines whether any HTTP headers are included in
+	// origin requests.
+	// +kubebuilder:validation:Enum=none;whitelist;allViewer;allViewerAndWhitelistCloudFront
+	HeaderBehavior string `json:"headerBehavior"`
+
+	// Headers is the list of header names the behavior applies to.
+	// +optional
+	Headers []string `json:"headers,omitempty"`
+}
+
+// OriginRequestQueryStrings determines which query strings are included in
+// origin requests.
+type OriginRequestQueryStrings struct {
+	// QueryStringBehavior determines whether any URL query strings in viewer
+	// requests are included in origin requests.
+	// +kubebuilder:validation:Enum=none;whitelist;all
+	QueryStringBehavior string `json:"queryStringBehavior"`
+
+	// QueryStrings is the list of query string names the behavior applies
+	// to.
+	// +optional
+	QueryStrings []string `json:"queryStrings,omitempty"`
+}
+
+// OriginRequestPolicyObservation is the observed state of an
+// OriginRequestPolicy.
+type OriginRequestPolicyObservation struct {
+	// The current version of the origin request policy.
+	ETag *string `json:"eTag,omitempty"`
+
+	// The date and time when the origin request policy was last modified.
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+}
+
+// An OriginRequestPolicySpec defines the desired state of an
+// OriginRequestPolicy.
+type OriginRequestPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OriginRequestPolicyParameters `json:"forProvider"`
+}
+
+// An OriginRequestPolicyStatus represents the observed state of an
+// OriginRequestPolicy.
+type OriginRequestPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OriginRequestPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OriginRequestPolicy is a managed resource that represents a CloudFront
+// origin request policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OriginRequestPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OriginRequestPolicySpec   `json:"spec"`
+	Status OriginRequestPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OriginRequestPolicyList contains a list of OriginRequestPolicies
+type OriginRequestPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OriginRequestPolicy `json:"items"`
+}
+
+// OriginRequestPolicy type metadata.
+var (
+	OriginRequestPolicyKind             = "OriginRequestPolicy"
+	OriginRequestPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: OriginRequestPolicyKind}.String()
+	OriginRequestPolicyKindAPIVersion   = OriginRequestPolicyKind + "." + GroupVersion.String()
+	OriginRequestPolicyGroupVersionKind = GroupVersion.WithKind(OriginRequestPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OriginRequestPolicy{}, &OriginRequestPolicyList{})
+}
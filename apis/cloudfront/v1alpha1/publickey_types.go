@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PublicKeyParameters define the desired state of a CloudFront PublicKey. The
+// external name of the resource is the ID of the public key.
+type PublicKeyParameters struct {
+	// Region is which region the PublicKey will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// A name to help identify the public key.
+	// +immutable
+	Name string `json:"name"`
+
+	// The public key that you can use with signed URLs and signed cookies,
+	// or with field-level encryption, in PEM format.
+	// +immutable
+	EncodedKey string `json:"encodedKey"`
+
+	// A comment to describe the public key.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// PublicKeyObservation is the observed state of a PublicKey.
+type PublicKeyObservation struct {
+	// The current version of the public key.
+	ETag *string `json:"eTag,omitempty"`
+
+	// The date and time when the public key was uploaded.
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+}
+
+// A PublicKeySpec defines the desired state of a PublicKey.
+type PublicKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PublicKeyParameters `json:"forProvider"`
+}
+
+// A PublicKeyStatus represents the observed state of a PublicKey.
+type PublicKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PublicKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublicKey is a managed resource that represents a CloudFront public key
+// used to verify signed URLs and signed cookies.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PublicKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicKeySpec   `json:"spec"`
+	Status PublicKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublicKeyList contains a list of PublicKeys
+type PublicKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublicKey `json:"items"`
+}
+
+// PublicKey type metadata.
+var (
+	PublicKeyKind             = "PublicKey"
+	PublicKeyGroupKind        = schema.GroupKind{Group: Group, Kind: PublicKeyKind}.String()
+	PublicKeyKindAPIVersion   = PublicKeyKind + "." + GroupVersion.String()
+	PublicKeyGroupVersionKind = GroupVersion.WithKind(PublicKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PublicKey{}, &PublicKeyList{})
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// OriginAccessIdentityPath returns the path of a
+// CloudFrontOriginAccessIdentity used by the S3 origins of a Distribution.
+func OriginAccessIdentityPath() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		id := meta.GetExternalName(mg)
+		if id == "" {
+			return ""
+		}
+		return "origin-access-identity/cloudfront/" + id
+	}
+}
+
+// FunctionARN returns the status.atProvider.functionARN of a Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.FunctionARN)
+	}
+}
+
+const (
+	errNoCacheBehaviorFmt = "no cache behavior of the distribution config has the path pattern %q"
+	errNoOriginFmt        = "no origin of the distribution config has the ID %q"
+)
+
+// ResolveReferences of this Distribution
+func (mg *Distribution) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, mg)
+
+	cfg := mg.Spec.ForProvider.DistributionConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if refs := mg.Spec.ForProvider.DefaultCacheBehaviorReferences; refs != nil {
+		if cfg.DefaultCacheBehavior == nil {
+			cfg.DefaultCacheBehavior = &DefaultCacheBehavior{}
+		}
+		b := cfg.DefaultCacheBehavior
+		path := "spec.forProvider.defaultCacheBehaviorReferences"
+		if err := refs.resolve(ctx, r, path, &b.CachePolicyID, &b.OriginRequestPolicyID, &b.TrustedKeyGroups); err != nil {
+			return err
+		}
+		if err := refs.resolveAssociations(ctx, r, path, &b.FunctionAssociations, &b.LambdaFunctionAssociations); err != nil {
+			return err
+		}
+	}
+
+	for i := range mg.Spec.ForProvider.CacheBehaviorReferences {
+		refs := &mg.Spec.ForProvider.CacheBehaviorReferences[i]
+		path := fmt.Sprintf("spec.forProvider.cacheBehaviorReferences[%d]", i)
+		b := findCacheBehavior(cfg.CacheBehaviors, reference.FromPtrValue(refs.PathPattern))
+		if b == nil {
+			return errors.Wrap(errors.Errorf(errNoCacheBehaviorFmt, reference.FromPtrValue(refs.PathPattern)), path)
+		}
+		if err := refs.resolve(ctx, r, path, &b.CachePolicyID, &b.OriginRequestPolicyID, &b.TrustedKeyGroups); err != nil {
+			return err
+		}
+		if err := refs.resolveAssociations(ctx, r, path, &b.FunctionAssociations, &b.LambdaFunctionAssociations); err != nil {
+			return err
+		}
+	}
+
+	for i := range mg.Spec.ForProvider.OriginReferences {
+		refs := &mg.Spec.ForProvider.OriginReferences[i]
+		path := fmt.Sprintf("spec.forProvider.originReferences[%d]", i)
+		o := findOrigin(cfg.Origins, refs.ID)
+		if o == nil {
+			return errors.Wrap(errors.Errorf(errNoOriginFmt, refs.ID), path)
+		}
+		if o.S3OriginConfig == nil {
+			o.S3OriginConfig = &S3OriginConfig{}
+		}
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(o.S3OriginConfig.OriginAccessIDentity),
+			Reference:    refs.OriginAccessIdentityRef,
+			Selector:     refs.OriginAccessIdentitySelector,
+			To:           reference.To{Managed: &CloudFrontOriginAccessIdentity{}, List: &CloudFrontOriginAccessIdentityList{}},
+			Extract:      OriginAccessIdentityPath(),
+		})
+		if err != nil {
+			return errors.Wrap(err, path+".originAccessIdentity")
+		}
+		o.S3OriginConfig.OriginAccessIDentity = reference.ToPtrValue(rsp.ResolvedValue)
+		refs.OriginAccessIdentityRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// resolve sets the IDs of a cache behavior from its references.
+func (p *CustomCacheBehaviorParameters) resolve(ctx context.Context, r *reference.APIResolver, path string, cachePolicyID, originRequestPolicyID **string, keyGroups **TrustedKeyGroups) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*cachePolicyID),
+		Reference:    p.CachePolicyIDRef,
+		Selector:     p.CachePolicyIDSelector,
+		To:           reference.To{Managed: &CachePolicy{}, List: &CachePolicyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".cachePolicyID")
+	}
+	*cachePolicyID = reference.ToPtrValue(rsp.ResolvedValue)
+	p.CachePolicyIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*originRequestPolicyID),
+		Reference:    p.OriginRequestPolicyIDRef,
+		Selector:     p.OriginRequestPolicyIDSelector,
+		To:           reference.To{Managed: &OriginRequestPolicy{}, List: &OriginRequestPolicyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".originRequestPolicyID")
+	}
+	*originRequestPolicyID = reference.ToPtrValue(rsp.ResolvedValue)
+	p.OriginRequestPolicyIDRef = rsp.ResolvedReference
+
+	if len(p.TrustedKeyGroupRefs) == 0 && p.TrustedKeyGroupSelector == nil {
+		return nil
+	}
+	if *keyGroups == nil {
+		*keyGroups = &TrustedKeyGroups{}
+	}
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues((*keyGroups).Items),
+		References:    p.TrustedKeyGroupRefs,
+		Selector:      p.TrustedKeyGroupSelector,
+		To:            reference.To{Managed: &KeyGroup{}, List: &KeyGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".trustedKeyGroups")
+	}
+	(*keyGroups).Items = reference.ToPtrValues(mrsp.ResolvedValues)
+	p.TrustedKeyGroupRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// findCacheBehavior returns the cache behavior with the given path pattern.
+func findCacheBehavior(bs *CacheBehaviors, pattern string) *CacheBehavior {
+	if bs == nil {
+		return nil
+	}
+	for _, b := range bs.Items {
+		if b != nil && reference.FromPtrValue(b.PathPattern) == pattern {
+			return b
+		}
+	}
+	return nil
+}
+
+// findOrigin returns the origin with the given ID.
+func findOrigin(os *Origins, id string) *Origin {
+	if os == nil {
+		return nil
+	}
+	for _, o := range os.Items {
+		if o != nil && reference.FromPtrValue(o.ID) == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// resolveAssociations sets the ARNs of the function and Lambda function
+// associations of a cache behavior from their references. Associations that
+// do not exist yet are added for the referenced event types.
+func (p *CustomCacheBehaviorParameters) resolveAssociations(ctx context.Context, r *reference.APIResolver, path string, fa **FunctionAssociations, la **LambdaFunctionAssociations) error {
+	for i := range p.FunctionAssociations {
+		refs := &p.FunctionAssociations[i]
+		if refs.FunctionARNRef == nil && refs.FunctionARNSelector == nil {
+			continue
+		}
+		if *fa == nil {
+			*fa = &FunctionAssociations{}
+		}
+		a := findFunctionAssociation(*fa, refs.EventType)
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.FunctionARN),
+			Reference:    refs.FunctionARNRef,
+			Selector:     refs.FunctionARNSelector,
+			To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+			Extract:      FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s.functionAssociations[%d].functionARN", path, i))
+		}
+		a.FunctionARN = reference.ToPtrValue(rsp.ResolvedValue)
+		refs.FunctionARNRef = rsp.ResolvedReference
+	}
+
+	for i := range p.LambdaFunctionAssociations {
+		refs := &p.LambdaFunctionAssociations[i]
+		if refs.LambdaFunctionARNRef == nil && refs.LambdaFunctionARNSelector == nil {
+			continue
+		}
+		if *la == nil {
+			*la = &LambdaFunctionAssociations{}
+		}
+		a := findLambdaFunctionAssociation(*la, refs.EventType)
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: unqualifiedLambdaARN(reference.FromPtrValue(a.LambdaFunctionARN)),
+			Reference:    refs.LambdaFunctionARNRef,
+			Selector:     refs.LambdaFunctionARNSelector,
+			To:           reference.To{Managed: &lambdav1alpha1.Function{}, List: &lambdav1alpha1.FunctionList{}},
+			Extract:      lambdav1alpha1.FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s.lambdaFunctionAssociations[%d].lambdaFunctionARN", path, i))
+		}
+		// Lambda@Edge only accepts ARNs of published function versions.
+		arn := unqualifiedLambdaARN(rsp.ResolvedValue)
+		if arn != "" && reference.FromPtrValue(refs.LambdaFunctionVersion) != "" {
+			arn += ":" + reference.FromPtrValue(refs.LambdaFunctionVersion)
+		}
+		a.LambdaFunctionARN = reference.ToPtrValue(arn)
+		refs.LambdaFunctionARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// findFunctionAssociation returns the function association with the given
+// event type and adds it if it does not exist yet.
+func findFunctionAssociation(fa *FunctionAssociations, eventType string) *FunctionAssociation {
+	for _, a := range fa.Items {
+		if a != nil && reference.FromPtrValue(a.EventType) == eventType {
+			return a
+		}
+	}
+	a := &FunctionAssociation{EventType: reference.ToPtrValue(eventType)}
+	fa.Items = append(fa.Items, a)
+	q := int64(len(fa.Items))
+	fa.Quantity = &q
+	return a
+}
+
+// findLambdaFunctionAssociation returns the Lambda function association with
+// the given event type and adds it if it does not exist yet.
+func findLambdaFunctionAssociation(la *LambdaFunctionAssociations, eventType string) *LambdaFunctionAssociation {
+	for _, a := range la.Items {
+		if a != nil && reference.FromPtrValue(a.EventType) == eventType {
+			return a
+		}
+	}
+	a := &LambdaFunctionAssociation{EventType: reference.ToPtrValue(eventType)}
+	la.Items = append(la.Items, a)
+	q := int64(len(la.Items))
+	la.Quantity = &q
+	return a
+}
+
+// unqualifiedLambdaARN strips the version or alias qualifier of a lambda
+// function ARN, i.e. arn:aws:lambda:us-east-1:123456789012:function:name:1.
+func unqualifiedLambdaARN(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) <= 7 {
+		return arn
+	}
+	return strings.Join(parts[:7], ":")
+}
+
+// ResolveReferences of this KeyGroup
+func (mg *KeyGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.items
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Items,
+		References:    mg.Spec.ForProvider.ItemRefs,
+		Selector:      mg.Spec.ForProvider.ItemSelector,
+		To:            reference.To{Managed: &PublicKey{}, List: &PublicKeyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.items")
+	}
+	mg.Spec.ForProvider.Items = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ItemRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Invalidation
+func (mg *Invalidation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.distributionId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DistributionID),
+		Reference:    mg.Spec.ForProvider.DistributionIDRef,
+		Selector:     mg.Spec.ForProvider.DistributionIDSelector,
+		To:           reference.To{Managed: &Distribution{}, List: &DistributionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.distributionId")
+	}
+	mg.Spec.ForProvider.DistributionID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DistributionIDRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -73,6 +73,19 @@ const (
 	Format_URLEncoded Format = "URLEncoded"
 )
 
+type FunctionRuntime string
+
+const (
+	FunctionRuntime_cloudfront_js_1_0 FunctionRuntime = "cloudfront-js-1.0"
+)
+
+type FunctionStage string
+
+const (
+	FunctionStage_DEVELOPMENT FunctionStage = "DEVELOPMENT"
+	FunctionStage_LIVE        FunctionStage = "LIVE"
+)
+
 type GeoRestrictionType string
 
 const (
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -221,6 +222,11 @@ func (in *CacheBehavior) DeepCopyInto(out *CacheBehavior) {
 		*out = new(ForwardedValues)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FunctionAssociations != nil {
+		in, out := &in.FunctionAssociations, &out.FunctionAssociations
+		*out = new(FunctionAssociations)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LambdaFunctionAssociations != nil {
 		in, out := &in.LambdaFunctionAssociations, &out.LambdaFunctionAssociations
 		*out = new(LambdaFunctionAssociations)
@@ -320,17 +326,95 @@ func (in *CacheBehaviors) DeepCopy() *CacheBehaviors {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CachePolicy) DeepCopyInto(out *CachePolicy) {
+func (in *CacheKeyCookies) DeepCopyInto(out *CacheKeyCookies) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKeyCookies.
+func (in *CacheKeyCookies) DeepCopy() *CacheKeyCookies {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKeyCookies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKeyHeaders) DeepCopyInto(out *CacheKeyHeaders) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKeyHeaders.
+func (in *CacheKeyHeaders) DeepCopy() *CacheKeyHeaders {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKeyHeaders)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKeyParameters) DeepCopyInto(out *CacheKeyParameters) {
+	*out = *in
+	if in.EnableAcceptEncodingBrotli != nil {
+		in, out := &in.EnableAcceptEncodingBrotli, &out.EnableAcceptEncodingBrotli
+		*out = new(bool)
 		**out = **in
 	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
+	in.CookiesConfig.DeepCopyInto(&out.CookiesConfig)
+	in.HeadersConfig.DeepCopyInto(&out.HeadersConfig)
+	in.QueryStringsConfig.DeepCopyInto(&out.QueryStringsConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKeyParameters.
+func (in *CacheKeyParameters) DeepCopy() *CacheKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKeyQueryStrings) DeepCopyInto(out *CacheKeyQueryStrings) {
+	*out = *in
+	if in.QueryStrings != nil {
+		in, out := &in.QueryStrings, &out.QueryStrings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKeyQueryStrings.
+func (in *CacheKeyQueryStrings) DeepCopy() *CacheKeyQueryStrings {
+	if in == nil {
+		return nil
 	}
+	out := new(CacheKeyQueryStrings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicy) DeepCopyInto(out *CachePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicy.
@@ -343,6 +427,14 @@ func (in *CachePolicy) DeepCopy() *CachePolicy {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CachePolicyConfig) DeepCopyInto(out *CachePolicyConfig) {
 	*out = *in
@@ -426,20 +518,14 @@ func (in *CachePolicyHeadersConfig) DeepCopy() *CachePolicyHeadersConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CachePolicyList) DeepCopyInto(out *CachePolicyList) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
-		*out = new(string)
-		**out = **in
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CachePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -453,104 +539,105 @@ func (in *CachePolicyList) DeepCopy() *CachePolicyList {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CachedMethods) DeepCopyInto(out *CachedMethods) {
+func (in *CachePolicyObservation) DeepCopyInto(out *CachePolicyObservation) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
 		**out = **in
 	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachedMethods.
-func (in *CachedMethods) DeepCopy() *CachedMethods {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyObservation.
+func (in *CachePolicyObservation) DeepCopy() *CachePolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(CachedMethods)
+	out := new(CachePolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentTypeProfile) DeepCopyInto(out *ContentTypeProfile) {
+func (in *CachePolicyParameters) DeepCopyInto(out *CachePolicyParameters) {
 	*out = *in
-	if in.ContentType != nil {
-		in, out := &in.ContentType, &out.ContentType
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.ProfileID != nil {
-		in, out := &in.ProfileID, &out.ProfileID
-		*out = new(string)
+	if in.DefaultTTL != nil {
+		in, out := &in.DefaultTTL, &out.DefaultTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(int64)
 		**out = **in
 	}
+	in.ParametersInCacheKeyAndForwardedToOrigin.DeepCopyInto(&out.ParametersInCacheKeyAndForwardedToOrigin)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentTypeProfile.
-func (in *ContentTypeProfile) DeepCopy() *ContentTypeProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyParameters.
+func (in *CachePolicyParameters) DeepCopy() *CachePolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentTypeProfile)
+	out := new(CachePolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentTypeProfileConfig) DeepCopyInto(out *ContentTypeProfileConfig) {
+func (in *CachePolicySpec) DeepCopyInto(out *CachePolicySpec) {
 	*out = *in
-	if in.ForwardWhenContentTypeIsUnknown != nil {
-		in, out := &in.ForwardWhenContentTypeIsUnknown, &out.ForwardWhenContentTypeIsUnknown
-		*out = new(bool)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentTypeProfileConfig.
-func (in *ContentTypeProfileConfig) DeepCopy() *ContentTypeProfileConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicySpec.
+func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentTypeProfileConfig)
+	out := new(CachePolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentTypeProfiles) DeepCopyInto(out *ContentTypeProfiles) {
+func (in *CachePolicyStatus) DeepCopyInto(out *CachePolicyStatus) {
 	*out = *in
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentTypeProfiles.
-func (in *ContentTypeProfiles) DeepCopy() *ContentTypeProfiles {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyStatus.
+func (in *CachePolicyStatus) DeepCopy() *CachePolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentTypeProfiles)
+	out := new(CachePolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CookieNames) DeepCopyInto(out *CookieNames) {
+func (in *CachedMethods) DeepCopyInto(out *CachedMethods) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
@@ -570,133 +657,240 @@ func (in *CookieNames) DeepCopyInto(out *CookieNames) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookieNames.
-func (in *CookieNames) DeepCopy() *CookieNames {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachedMethods.
+func (in *CachedMethods) DeepCopy() *CachedMethods {
 	if in == nil {
 		return nil
 	}
-	out := new(CookieNames)
+	out := new(CachedMethods)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CookiePreference) DeepCopyInto(out *CookiePreference) {
+func (in *CloudFrontOriginAccessIdentity) DeepCopyInto(out *CloudFrontOriginAccessIdentity) {
 	*out = *in
-	if in.Forward != nil {
-		in, out := &in.Forward, &out.Forward
-		*out = new(string)
-		**out = **in
-	}
-	if in.WhitelistedNames != nil {
-		in, out := &in.WhitelistedNames, &out.WhitelistedNames
-		*out = new(CookieNames)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookiePreference.
-func (in *CookiePreference) DeepCopy() *CookiePreference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontOriginAccessIdentity.
+func (in *CloudFrontOriginAccessIdentity) DeepCopy() *CloudFrontOriginAccessIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(CookiePreference)
+	out := new(CloudFrontOriginAccessIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudFrontOriginAccessIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomDistributionParameters) DeepCopyInto(out *CustomDistributionParameters) {
+func (in *CloudFrontOriginAccessIdentityList) DeepCopyInto(out *CloudFrontOriginAccessIdentityList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudFrontOriginAccessIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDistributionParameters.
-func (in *CustomDistributionParameters) DeepCopy() *CustomDistributionParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontOriginAccessIdentityList.
+func (in *CloudFrontOriginAccessIdentityList) DeepCopy() *CloudFrontOriginAccessIdentityList {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomDistributionParameters)
+	out := new(CloudFrontOriginAccessIdentityList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudFrontOriginAccessIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomErrorResponse) DeepCopyInto(out *CustomErrorResponse) {
+func (in *CloudFrontOriginAccessIdentityObservation) DeepCopyInto(out *CloudFrontOriginAccessIdentityObservation) {
 	*out = *in
-	if in.ErrorCachingMinTTL != nil {
-		in, out := &in.ErrorCachingMinTTL, &out.ErrorCachingMinTTL
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ErrorCode != nil {
-		in, out := &in.ErrorCode, &out.ErrorCode
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ResponseCode != nil {
-		in, out := &in.ResponseCode, &out.ResponseCode
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResponsePagePath != nil {
-		in, out := &in.ResponsePagePath, &out.ResponsePagePath
+	if in.S3CanonicalUserID != nil {
+		in, out := &in.S3CanonicalUserID, &out.S3CanonicalUserID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponse.
-func (in *CustomErrorResponse) DeepCopy() *CustomErrorResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontOriginAccessIdentityObservation.
+func (in *CloudFrontOriginAccessIdentityObservation) DeepCopy() *CloudFrontOriginAccessIdentityObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomErrorResponse)
+	out := new(CloudFrontOriginAccessIdentityObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomErrorResponses) DeepCopyInto(out *CustomErrorResponses) {
+func (in *CloudFrontOriginAccessIdentityParameters) DeepCopyInto(out *CloudFrontOriginAccessIdentityParameters) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]*CustomErrorResponse, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(CustomErrorResponse)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontOriginAccessIdentityParameters.
+func (in *CloudFrontOriginAccessIdentityParameters) DeepCopy() *CloudFrontOriginAccessIdentityParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudFrontOriginAccessIdentityParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudFrontOriginAccessIdentitySpec) DeepCopyInto(out *CloudFrontOriginAccessIdentitySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontOriginAccessIdentitySpec.
+func (in *CloudFrontOriginAccessIdentitySpec) DeepCopy() *CloudFrontOriginAccessIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudFrontOriginAccessIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudFrontOriginAccessIdentityStatus) DeepCopyInto(out *CloudFrontOriginAccessIdentityStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontOriginAccessIdentityStatus.
+func (in *CloudFrontOriginAccessIdentityStatus) DeepCopy() *CloudFrontOriginAccessIdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudFrontOriginAccessIdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentTypeProfile) DeepCopyInto(out *ContentTypeProfile) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProfileID != nil {
+		in, out := &in.ProfileID, &out.ProfileID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentTypeProfile.
+func (in *ContentTypeProfile) DeepCopy() *ContentTypeProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentTypeProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentTypeProfileConfig) DeepCopyInto(out *ContentTypeProfileConfig) {
+	*out = *in
+	if in.ForwardWhenContentTypeIsUnknown != nil {
+		in, out := &in.ForwardWhenContentTypeIsUnknown, &out.ForwardWhenContentTypeIsUnknown
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentTypeProfileConfig.
+func (in *ContentTypeProfileConfig) DeepCopy() *ContentTypeProfileConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentTypeProfileConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentTypeProfiles) DeepCopyInto(out *ContentTypeProfiles) {
+	*out = *in
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
 		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponses.
-func (in *CustomErrorResponses) DeepCopy() *CustomErrorResponses {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentTypeProfiles.
+func (in *ContentTypeProfiles) DeepCopy() *ContentTypeProfiles {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomErrorResponses)
+	out := new(ContentTypeProfiles)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomHeaders) DeepCopyInto(out *CustomHeaders) {
+func (in *CookieNames) DeepCopyInto(out *CookieNames) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]*OriginCustomHeader, len(*in))
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(OriginCustomHeader)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
@@ -707,130 +901,435 @@ func (in *CustomHeaders) DeepCopyInto(out *CustomHeaders) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHeaders.
-func (in *CustomHeaders) DeepCopy() *CustomHeaders {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookieNames.
+func (in *CookieNames) DeepCopy() *CookieNames {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomHeaders)
+	out := new(CookieNames)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomOriginConfig) DeepCopyInto(out *CustomOriginConfig) {
+func (in *CookiePreference) DeepCopyInto(out *CookiePreference) {
 	*out = *in
-	if in.HTTPPort != nil {
-		in, out := &in.HTTPPort, &out.HTTPPort
-		*out = new(int64)
-		**out = **in
-	}
-	if in.HTTPSPort != nil {
-		in, out := &in.HTTPSPort, &out.HTTPSPort
-		*out = new(int64)
-		**out = **in
-	}
-	if in.OriginKeepaliveTimeout != nil {
-		in, out := &in.OriginKeepaliveTimeout, &out.OriginKeepaliveTimeout
-		*out = new(int64)
-		**out = **in
-	}
-	if in.OriginProtocolPolicy != nil {
-		in, out := &in.OriginProtocolPolicy, &out.OriginProtocolPolicy
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
 		*out = new(string)
 		**out = **in
 	}
-	if in.OriginReadTimeout != nil {
-		in, out := &in.OriginReadTimeout, &out.OriginReadTimeout
-		*out = new(int64)
-		**out = **in
-	}
-	if in.OriginSSLProtocols != nil {
-		in, out := &in.OriginSSLProtocols, &out.OriginSSLProtocols
-		*out = new(OriginSSLProtocols)
+	if in.WhitelistedNames != nil {
+		in, out := &in.WhitelistedNames, &out.WhitelistedNames
+		*out = new(CookieNames)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOriginConfig.
-func (in *CustomOriginConfig) DeepCopy() *CustomOriginConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookiePreference.
+func (in *CookiePreference) DeepCopy() *CookiePreference {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomOriginConfig)
+	out := new(CookiePreference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DefaultCacheBehavior) DeepCopyInto(out *DefaultCacheBehavior) {
+func (in *CustomCacheBehaviorParameters) DeepCopyInto(out *CustomCacheBehaviorParameters) {
 	*out = *in
-	if in.AllowedMethods != nil {
-		in, out := &in.AllowedMethods, &out.AllowedMethods
-		*out = new(AllowedMethods)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CachePolicyID != nil {
-		in, out := &in.CachePolicyID, &out.CachePolicyID
+	if in.PathPattern != nil {
+		in, out := &in.PathPattern, &out.PathPattern
 		*out = new(string)
 		**out = **in
 	}
-	if in.Compress != nil {
-		in, out := &in.Compress, &out.Compress
-		*out = new(bool)
+	if in.CachePolicyIDRef != nil {
+		in, out := &in.CachePolicyIDRef, &out.CachePolicyIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.DefaultTTL != nil {
-		in, out := &in.DefaultTTL, &out.DefaultTTL
-		*out = new(int64)
-		**out = **in
+	if in.CachePolicyIDSelector != nil {
+		in, out := &in.CachePolicyIDSelector, &out.CachePolicyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.FieldLevelEncryptionID != nil {
-		in, out := &in.FieldLevelEncryptionID, &out.FieldLevelEncryptionID
-		*out = new(string)
+	if in.OriginRequestPolicyIDRef != nil {
+		in, out := &in.OriginRequestPolicyIDRef, &out.OriginRequestPolicyIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.ForwardedValues != nil {
-		in, out := &in.ForwardedValues, &out.ForwardedValues
-		*out = new(ForwardedValues)
+	if in.OriginRequestPolicyIDSelector != nil {
+		in, out := &in.OriginRequestPolicyIDSelector, &out.OriginRequestPolicyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedKeyGroupRefs != nil {
+		in, out := &in.TrustedKeyGroupRefs, &out.TrustedKeyGroupRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedKeyGroupSelector != nil {
+		in, out := &in.TrustedKeyGroupSelector, &out.TrustedKeyGroupSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FunctionAssociations != nil {
+		in, out := &in.FunctionAssociations, &out.FunctionAssociations
+		*out = make([]CustomFunctionAssociationParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LambdaFunctionAssociations != nil {
 		in, out := &in.LambdaFunctionAssociations, &out.LambdaFunctionAssociations
-		*out = new(LambdaFunctionAssociations)
+		*out = make([]CustomLambdaFunctionAssociationParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCacheBehaviorParameters.
+func (in *CustomCacheBehaviorParameters) DeepCopy() *CustomCacheBehaviorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomCacheBehaviorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDistributionParameters) DeepCopyInto(out *CustomDistributionParameters) {
+	*out = *in
+	if in.DefaultCacheBehaviorReferences != nil {
+		in, out := &in.DefaultCacheBehaviorReferences, &out.DefaultCacheBehaviorReferences
+		*out = new(CustomCacheBehaviorParameters)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxTTL != nil {
-		in, out := &in.MaxTTL, &out.MaxTTL
+	if in.CacheBehaviorReferences != nil {
+		in, out := &in.CacheBehaviorReferences, &out.CacheBehaviorReferences
+		*out = make([]CustomCacheBehaviorParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OriginReferences != nil {
+		in, out := &in.OriginReferences, &out.OriginReferences
+		*out = make([]CustomOriginParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDistributionParameters.
+func (in *CustomDistributionParameters) DeepCopy() *CustomDistributionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDistributionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponse) DeepCopyInto(out *CustomErrorResponse) {
+	*out = *in
+	if in.ErrorCachingMinTTL != nil {
+		in, out := &in.ErrorCachingMinTTL, &out.ErrorCachingMinTTL
 		*out = new(int64)
 		**out = **in
 	}
-	if in.MinTTL != nil {
-		in, out := &in.MinTTL, &out.MinTTL
+	if in.ErrorCode != nil {
+		in, out := &in.ErrorCode, &out.ErrorCode
 		*out = new(int64)
 		**out = **in
 	}
-	if in.OriginRequestPolicyID != nil {
-		in, out := &in.OriginRequestPolicyID, &out.OriginRequestPolicyID
+	if in.ResponseCode != nil {
+		in, out := &in.ResponseCode, &out.ResponseCode
 		*out = new(string)
 		**out = **in
 	}
-	if in.RealtimeLogConfigARN != nil {
-		in, out := &in.RealtimeLogConfigARN, &out.RealtimeLogConfigARN
+	if in.ResponsePagePath != nil {
+		in, out := &in.ResponsePagePath, &out.ResponsePagePath
 		*out = new(string)
 		**out = **in
 	}
-	if in.SmoothStreaming != nil {
-		in, out := &in.SmoothStreaming, &out.SmoothStreaming
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TargetOriginID != nil {
-		in, out := &in.TargetOriginID, &out.TargetOriginID
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponse.
+func (in *CustomErrorResponse) DeepCopy() *CustomErrorResponse {
+	if in == nil {
+		return nil
 	}
-	if in.TrustedKeyGroups != nil {
+	out := new(CustomErrorResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponses) DeepCopyInto(out *CustomErrorResponses) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*CustomErrorResponse, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CustomErrorResponse)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponses.
+func (in *CustomErrorResponses) DeepCopy() *CustomErrorResponses {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomErrorResponses)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomFunctionAssociationParameters) DeepCopyInto(out *CustomFunctionAssociationParameters) {
+	*out = *in
+	if in.FunctionARNRef != nil {
+		in, out := &in.FunctionARNRef, &out.FunctionARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionARNSelector != nil {
+		in, out := &in.FunctionARNSelector, &out.FunctionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFunctionAssociationParameters.
+func (in *CustomFunctionAssociationParameters) DeepCopy() *CustomFunctionAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomFunctionAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomHeaders) DeepCopyInto(out *CustomHeaders) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*OriginCustomHeader, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OriginCustomHeader)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHeaders.
+func (in *CustomHeaders) DeepCopy() *CustomHeaders {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomHeaders)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomLambdaFunctionAssociationParameters) DeepCopyInto(out *CustomLambdaFunctionAssociationParameters) {
+	*out = *in
+	if in.LambdaFunctionARNRef != nil {
+		in, out := &in.LambdaFunctionARNRef, &out.LambdaFunctionARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LambdaFunctionARNSelector != nil {
+		in, out := &in.LambdaFunctionARNSelector, &out.LambdaFunctionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LambdaFunctionVersion != nil {
+		in, out := &in.LambdaFunctionVersion, &out.LambdaFunctionVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLambdaFunctionAssociationParameters.
+func (in *CustomLambdaFunctionAssociationParameters) DeepCopy() *CustomLambdaFunctionAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomLambdaFunctionAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOriginConfig) DeepCopyInto(out *CustomOriginConfig) {
+	*out = *in
+	if in.HTTPPort != nil {
+		in, out := &in.HTTPPort, &out.HTTPPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HTTPSPort != nil {
+		in, out := &in.HTTPSPort, &out.HTTPSPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OriginKeepaliveTimeout != nil {
+		in, out := &in.OriginKeepaliveTimeout, &out.OriginKeepaliveTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OriginProtocolPolicy != nil {
+		in, out := &in.OriginProtocolPolicy, &out.OriginProtocolPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.OriginReadTimeout != nil {
+		in, out := &in.OriginReadTimeout, &out.OriginReadTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OriginSSLProtocols != nil {
+		in, out := &in.OriginSSLProtocols, &out.OriginSSLProtocols
+		*out = new(OriginSSLProtocols)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOriginConfig.
+func (in *CustomOriginConfig) DeepCopy() *CustomOriginConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOriginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOriginParameters) DeepCopyInto(out *CustomOriginParameters) {
+	*out = *in
+	if in.OriginAccessIdentityRef != nil {
+		in, out := &in.OriginAccessIdentityRef, &out.OriginAccessIdentityRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OriginAccessIdentitySelector != nil {
+		in, out := &in.OriginAccessIdentitySelector, &out.OriginAccessIdentitySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOriginParameters.
+func (in *CustomOriginParameters) DeepCopy() *CustomOriginParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOriginParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultCacheBehavior) DeepCopyInto(out *DefaultCacheBehavior) {
+	*out = *in
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = new(AllowedMethods)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CachePolicyID != nil {
+		in, out := &in.CachePolicyID, &out.CachePolicyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Compress != nil {
+		in, out := &in.Compress, &out.Compress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultTTL != nil {
+		in, out := &in.DefaultTTL, &out.DefaultTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FieldLevelEncryptionID != nil {
+		in, out := &in.FieldLevelEncryptionID, &out.FieldLevelEncryptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForwardedValues != nil {
+		in, out := &in.ForwardedValues, &out.ForwardedValues
+		*out = new(ForwardedValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionAssociations != nil {
+		in, out := &in.FunctionAssociations, &out.FunctionAssociations
+		*out = new(FunctionAssociations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LambdaFunctionAssociations != nil {
+		in, out := &in.LambdaFunctionAssociations, &out.LambdaFunctionAssociations
+		*out = new(LambdaFunctionAssociations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinTTL != nil {
+		in, out := &in.MinTTL, &out.MinTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OriginRequestPolicyID != nil {
+		in, out := &in.OriginRequestPolicyID, &out.OriginRequestPolicyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RealtimeLogConfigARN != nil {
+		in, out := &in.RealtimeLogConfigARN, &out.RealtimeLogConfigARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SmoothStreaming != nil {
+		in, out := &in.SmoothStreaming, &out.SmoothStreaming
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetOriginID != nil {
+		in, out := &in.TargetOriginID, &out.TargetOriginID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustedKeyGroups != nil {
 		in, out := &in.TrustedKeyGroups, &out.TrustedKeyGroups
 		*out = new(TrustedKeyGroups)
 		(*in).DeepCopyInto(*out)
@@ -840,53 +1339,392 @@ func (in *DefaultCacheBehavior) DeepCopyInto(out *DefaultCacheBehavior) {
 		*out = new(TrustedSigners)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ViewerProtocolPolicy != nil {
-		in, out := &in.ViewerProtocolPolicy, &out.ViewerProtocolPolicy
-		*out = new(string)
-		**out = **in
-	}
+	if in.ViewerProtocolPolicy != nil {
+		in, out := &in.ViewerProtocolPolicy, &out.ViewerProtocolPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCacheBehavior.
+func (in *DefaultCacheBehavior) DeepCopy() *DefaultCacheBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultCacheBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Distribution) DeepCopyInto(out *Distribution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Distribution.
+func (in *Distribution) DeepCopy() *Distribution {
+	if in == nil {
+		return nil
+	}
+	out := new(Distribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Distribution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
+	*out = *in
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = new(Aliases)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheBehaviors != nil {
+		in, out := &in.CacheBehaviors, &out.CacheBehaviors
+		*out = new(CacheBehaviors)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomErrorResponses != nil {
+		in, out := &in.CustomErrorResponses, &out.CustomErrorResponses
+		*out = new(CustomErrorResponses)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultCacheBehavior != nil {
+		in, out := &in.DefaultCacheBehavior, &out.DefaultCacheBehavior
+		*out = new(DefaultCacheBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultRootObject != nil {
+		in, out := &in.DefaultRootObject, &out.DefaultRootObject
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HTTPVersion != nil {
+		in, out := &in.HTTPVersion, &out.HTTPVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsIPV6Enabled != nil {
+		in, out := &in.IsIPV6Enabled, &out.IsIPV6Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OriginGroups != nil {
+		in, out := &in.OriginGroups, &out.OriginGroups
+		*out = new(OriginGroups)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Origins != nil {
+		in, out := &in.Origins, &out.Origins
+		*out = new(Origins)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PriceClass != nil {
+		in, out := &in.PriceClass, &out.PriceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = new(Restrictions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ViewerCertificate != nil {
+		in, out := &in.ViewerCertificate, &out.ViewerCertificate
+		*out = new(ViewerCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebACLID != nil {
+		in, out := &in.WebACLID, &out.WebACLID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionConfig.
+func (in *DistributionConfig) DeepCopy() *DistributionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionConfigWithTags) DeepCopyInto(out *DistributionConfigWithTags) {
+	*out = *in
+	if in.DistributionConfig != nil {
+		in, out := &in.DistributionConfig, &out.DistributionConfig
+		*out = new(DistributionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionConfigWithTags.
+func (in *DistributionConfigWithTags) DeepCopy() *DistributionConfigWithTags {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionConfigWithTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionIDList) DeepCopyInto(out *DistributionIDList) {
+	*out = *in
+	if in.IsTruncated != nil {
+		in, out := &in.IsTruncated, &out.IsTruncated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Marker != nil {
+		in, out := &in.Marker, &out.Marker
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NextMarker != nil {
+		in, out := &in.NextMarker, &out.NextMarker
+		*out = new(string)
+		**out = **in
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionIDList.
+func (in *DistributionIDList) DeepCopy() *DistributionIDList {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionIDList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionList) DeepCopyInto(out *DistributionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Distribution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionList.
+func (in *DistributionList) DeepCopy() *DistributionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DistributionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionList_SDK) DeepCopyInto(out *DistributionList_SDK) {
+	*out = *in
+	if in.IsTruncated != nil {
+		in, out := &in.IsTruncated, &out.IsTruncated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*DistributionSummary, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DistributionSummary)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Marker != nil {
+		in, out := &in.Marker, &out.Marker
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NextMarker != nil {
+		in, out := &in.NextMarker, &out.NextMarker
+		*out = new(string)
+		**out = **in
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionList_SDK.
+func (in *DistributionList_SDK) DeepCopy() *DistributionList_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionObservation) DeepCopyInto(out *DistributionObservation) {
+	*out = *in
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(Distribution_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionObservation.
+func (in *DistributionObservation) DeepCopy() *DistributionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
+	*out = *in
+	if in.DistributionConfig != nil {
+		in, out := &in.DistributionConfig, &out.DistributionConfig
+		*out = new(DistributionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CustomDistributionParameters.DeepCopyInto(&out.CustomDistributionParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCacheBehavior.
-func (in *DefaultCacheBehavior) DeepCopy() *DefaultCacheBehavior {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
+func (in *DistributionParameters) DeepCopy() *DistributionParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DefaultCacheBehavior)
+	out := new(DistributionParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Distribution) DeepCopyInto(out *Distribution) {
+func (in *DistributionSpec) DeepCopyInto(out *DistributionSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Distribution.
-func (in *Distribution) DeepCopy() *Distribution {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionSpec.
+func (in *DistributionSpec) DeepCopy() *DistributionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Distribution)
+	out := new(DistributionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Distribution) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionStatus) DeepCopyInto(out *DistributionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionStatus.
+func (in *DistributionStatus) DeepCopy() *DistributionStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DistributionStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
+func (in *DistributionSummary) DeepCopyInto(out *DistributionSummary) {
 	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AliasICPRecordals != nil {
+		in, out := &in.AliasICPRecordals, &out.AliasICPRecordals
+		*out = make([]*AliasICPRecordal, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AliasICPRecordal)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Aliases != nil {
 		in, out := &in.Aliases, &out.Aliases
 		*out = new(Aliases)
@@ -912,8 +1750,8 @@ func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
 		*out = new(DefaultCacheBehavior)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DefaultRootObject != nil {
-		in, out := &in.DefaultRootObject, &out.DefaultRootObject
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
 		*out = new(string)
 		**out = **in
 	}
@@ -927,15 +1765,19 @@ func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
 	if in.IsIPV6Enabled != nil {
 		in, out := &in.IsIPV6Enabled, &out.IsIPV6Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(LoggingConfig)
-		(*in).DeepCopyInto(*out)
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
 	}
 	if in.OriginGroups != nil {
 		in, out := &in.OriginGroups, &out.OriginGroups
@@ -957,6 +1799,11 @@ func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
 		*out = new(Restrictions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
 	if in.ViewerCertificate != nil {
 		in, out := &in.ViewerCertificate, &out.ViewerCertificate
 		*out = new(ViewerCertificate)
@@ -969,400 +1816,319 @@ func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionConfig.
-func (in *DistributionConfig) DeepCopy() *DistributionConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(DistributionConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionConfigWithTags) DeepCopyInto(out *DistributionConfigWithTags) {
-	*out = *in
-	if in.DistributionConfig != nil {
-		in, out := &in.DistributionConfig, &out.DistributionConfig
-		*out = new(DistributionConfig)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionConfigWithTags.
-func (in *DistributionConfigWithTags) DeepCopy() *DistributionConfigWithTags {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionSummary.
+func (in *DistributionSummary) DeepCopy() *DistributionSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionConfigWithTags)
+	out := new(DistributionSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionIDList) DeepCopyInto(out *DistributionIDList) {
+func (in *Distribution_SDK) DeepCopyInto(out *Distribution_SDK) {
 	*out = *in
-	if in.IsTruncated != nil {
-		in, out := &in.IsTruncated, &out.IsTruncated
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Marker != nil {
-		in, out := &in.Marker, &out.Marker
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionIDList.
-func (in *DistributionIDList) DeepCopy() *DistributionIDList {
-	if in == nil {
-		return nil
-	}
-	out := new(DistributionIDList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionList) DeepCopyInto(out *DistributionList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Distribution, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionList.
-func (in *DistributionList) DeepCopy() *DistributionList {
-	if in == nil {
-		return nil
-	}
-	out := new(DistributionList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DistributionList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.ActiveTrustedKeyGroups != nil {
+		in, out := &in.ActiveTrustedKeyGroups, &out.ActiveTrustedKeyGroups
+		*out = new(ActiveTrustedKeyGroups)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionList_SDK) DeepCopyInto(out *DistributionList_SDK) {
-	*out = *in
-	if in.IsTruncated != nil {
-		in, out := &in.IsTruncated, &out.IsTruncated
-		*out = new(bool)
-		**out = **in
+	if in.ActiveTrustedSigners != nil {
+		in, out := &in.ActiveTrustedSigners, &out.ActiveTrustedSigners
+		*out = new(ActiveTrustedSigners)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]*DistributionSummary, len(*in))
+	if in.AliasICPRecordals != nil {
+		in, out := &in.AliasICPRecordals, &out.AliasICPRecordals
+		*out = make([]*AliasICPRecordal, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DistributionSummary)
+				*out = new(AliasICPRecordal)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Marker != nil {
-		in, out := &in.Marker, &out.Marker
-		*out = new(string)
-		**out = **in
+	if in.DistributionConfig != nil {
+		in, out := &in.DistributionConfig, &out.DistributionConfig
+		*out = new(DistributionConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
+		*out = new(string)
 		**out = **in
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
+	if in.InProgressInvalidationBatches != nil {
+		in, out := &in.InProgressInvalidationBatches, &out.InProgressInvalidationBatches
 		*out = new(int64)
 		**out = **in
 	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionList_SDK.
-func (in *DistributionList_SDK) DeepCopy() *DistributionList_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Distribution_SDK.
+func (in *Distribution_SDK) DeepCopy() *Distribution_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionList_SDK)
+	out := new(Distribution_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionObservation) DeepCopyInto(out *DistributionObservation) {
+func (in *EncryptionEntities) DeepCopyInto(out *EncryptionEntities) {
 	*out = *in
-	if in.Distribution != nil {
-		in, out := &in.Distribution, &out.Distribution
-		*out = new(Distribution_SDK)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ETag != nil {
-		in, out := &in.ETag, &out.ETag
-		*out = new(string)
-		**out = **in
-	}
-	if in.Location != nil {
-		in, out := &in.Location, &out.Location
-		*out = new(string)
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionObservation.
-func (in *DistributionObservation) DeepCopy() *DistributionObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionEntities.
+func (in *EncryptionEntities) DeepCopy() *EncryptionEntities {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionObservation)
+	out := new(EncryptionEntities)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
+func (in *EncryptionEntity) DeepCopyInto(out *EncryptionEntity) {
 	*out = *in
-	if in.DistributionConfig != nil {
-		in, out := &in.DistributionConfig, &out.DistributionConfig
-		*out = new(DistributionConfig)
-		(*in).DeepCopyInto(*out)
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeyID != nil {
+		in, out := &in.PublicKeyID, &out.PublicKeyID
+		*out = new(string)
+		**out = **in
 	}
-	out.CustomDistributionParameters = in.CustomDistributionParameters
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
-func (in *DistributionParameters) DeepCopy() *DistributionParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionEntity.
+func (in *EncryptionEntity) DeepCopy() *EncryptionEntity {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionParameters)
+	out := new(EncryptionEntity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionSpec) DeepCopyInto(out *DistributionSpec) {
+func (in *EndPoint) DeepCopyInto(out *EndPoint) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.StreamType != nil {
+		in, out := &in.StreamType, &out.StreamType
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionSpec.
-func (in *DistributionSpec) DeepCopy() *DistributionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndPoint.
+func (in *EndPoint) DeepCopy() *EndPoint {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionSpec)
+	out := new(EndPoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionStatus) DeepCopyInto(out *DistributionStatus) {
+func (in *FieldLevelEncryption) DeepCopyInto(out *FieldLevelEncryption) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionStatus.
-func (in *DistributionStatus) DeepCopy() *DistributionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryption.
+func (in *FieldLevelEncryption) DeepCopy() *FieldLevelEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionStatus)
+	out := new(FieldLevelEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DistributionSummary) DeepCopyInto(out *DistributionSummary) {
+func (in *FieldLevelEncryptionConfig) DeepCopyInto(out *FieldLevelEncryptionConfig) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
+	if in.CallerReference != nil {
+		in, out := &in.CallerReference, &out.CallerReference
 		*out = new(string)
 		**out = **in
 	}
-	if in.AliasICPRecordals != nil {
-		in, out := &in.AliasICPRecordals, &out.AliasICPRecordals
-		*out = make([]*AliasICPRecordal, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(AliasICPRecordal)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Aliases != nil {
-		in, out := &in.Aliases, &out.Aliases
-		*out = new(Aliases)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CacheBehaviors != nil {
-		in, out := &in.CacheBehaviors, &out.CacheBehaviors
-		*out = new(CacheBehaviors)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Comment != nil {
 		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.CustomErrorResponses != nil {
-		in, out := &in.CustomErrorResponses, &out.CustomErrorResponses
-		*out = new(CustomErrorResponses)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionConfig.
+func (in *FieldLevelEncryptionConfig) DeepCopy() *FieldLevelEncryptionConfig {
+	if in == nil {
+		return nil
 	}
-	if in.DefaultCacheBehavior != nil {
-		in, out := &in.DefaultCacheBehavior, &out.DefaultCacheBehavior
-		*out = new(DefaultCacheBehavior)
-		(*in).DeepCopyInto(*out)
+	out := new(FieldLevelEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldLevelEncryptionList) DeepCopyInto(out *FieldLevelEncryptionList) {
+	*out = *in
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
 	}
-	if in.DomainName != nil {
-		in, out := &in.DomainName, &out.DomainName
+	if in.NextMarker != nil {
+		in, out := &in.NextMarker, &out.NextMarker
 		*out = new(string)
 		**out = **in
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
 		**out = **in
 	}
-	if in.HTTPVersion != nil {
-		in, out := &in.HTTPVersion, &out.HTTPVersion
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionList.
+func (in *FieldLevelEncryptionList) DeepCopy() *FieldLevelEncryptionList {
+	if in == nil {
+		return nil
 	}
+	out := new(FieldLevelEncryptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldLevelEncryptionProfile) DeepCopyInto(out *FieldLevelEncryptionProfile) {
+	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsIPV6Enabled != nil {
-		in, out := &in.IsIPV6Enabled, &out.IsIPV6Enabled
-		*out = new(bool)
-		**out = **in
-	}
 	if in.LastModifiedTime != nil {
 		in, out := &in.LastModifiedTime, &out.LastModifiedTime
 		*out = (*in).DeepCopy()
 	}
-	if in.OriginGroups != nil {
-		in, out := &in.OriginGroups, &out.OriginGroups
-		*out = new(OriginGroups)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Origins != nil {
-		in, out := &in.Origins, &out.Origins
-		*out = new(Origins)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfile.
+func (in *FieldLevelEncryptionProfile) DeepCopy() *FieldLevelEncryptionProfile {
+	if in == nil {
+		return nil
 	}
-	if in.PriceClass != nil {
-		in, out := &in.PriceClass, &out.PriceClass
+	out := new(FieldLevelEncryptionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldLevelEncryptionProfileConfig) DeepCopyInto(out *FieldLevelEncryptionProfileConfig) {
+	*out = *in
+	if in.CallerReference != nil {
+		in, out := &in.CallerReference, &out.CallerReference
 		*out = new(string)
 		**out = **in
 	}
-	if in.Restrictions != nil {
-		in, out := &in.Restrictions, &out.Restrictions
-		*out = new(Restrictions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.ViewerCertificate != nil {
-		in, out := &in.ViewerCertificate, &out.ViewerCertificate
-		*out = new(ViewerCertificate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.WebACLID != nil {
-		in, out := &in.WebACLID, &out.WebACLID
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionSummary.
-func (in *DistributionSummary) DeepCopy() *DistributionSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfileConfig.
+func (in *FieldLevelEncryptionProfileConfig) DeepCopy() *FieldLevelEncryptionProfileConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(DistributionSummary)
+	out := new(FieldLevelEncryptionProfileConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Distribution_SDK) DeepCopyInto(out *Distribution_SDK) {
+func (in *FieldLevelEncryptionProfileList) DeepCopyInto(out *FieldLevelEncryptionProfileList) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
 		**out = **in
 	}
-	if in.ActiveTrustedKeyGroups != nil {
-		in, out := &in.ActiveTrustedKeyGroups, &out.ActiveTrustedKeyGroups
-		*out = new(ActiveTrustedKeyGroups)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ActiveTrustedSigners != nil {
-		in, out := &in.ActiveTrustedSigners, &out.ActiveTrustedSigners
-		*out = new(ActiveTrustedSigners)
-		(*in).DeepCopyInto(*out)
+	if in.NextMarker != nil {
+		in, out := &in.NextMarker, &out.NextMarker
+		*out = new(string)
+		**out = **in
 	}
-	if in.AliasICPRecordals != nil {
-		in, out := &in.AliasICPRecordals, &out.AliasICPRecordals
-		*out = make([]*AliasICPRecordal, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(AliasICPRecordal)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
 	}
-	if in.DistributionConfig != nil {
-		in, out := &in.DistributionConfig, &out.DistributionConfig
-		*out = new(DistributionConfig)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfileList.
+func (in *FieldLevelEncryptionProfileList) DeepCopy() *FieldLevelEncryptionProfileList {
+	if in == nil {
+		return nil
 	}
-	if in.DomainName != nil {
-		in, out := &in.DomainName, &out.DomainName
+	out := new(FieldLevelEncryptionProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldLevelEncryptionProfileSummary) DeepCopyInto(out *FieldLevelEncryptionProfileSummary) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
@@ -1371,158 +2137,176 @@ func (in *Distribution_SDK) DeepCopyInto(out *Distribution_SDK) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.InProgressInvalidationBatches != nil {
-		in, out := &in.InProgressInvalidationBatches, &out.InProgressInvalidationBatches
-		*out = new(int64)
-		**out = **in
-	}
 	if in.LastModifiedTime != nil {
 		in, out := &in.LastModifiedTime, &out.LastModifiedTime
 		*out = (*in).DeepCopy()
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Distribution_SDK.
-func (in *Distribution_SDK) DeepCopy() *Distribution_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfileSummary.
+func (in *FieldLevelEncryptionProfileSummary) DeepCopy() *FieldLevelEncryptionProfileSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(Distribution_SDK)
+	out := new(FieldLevelEncryptionProfileSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EncryptionEntities) DeepCopyInto(out *EncryptionEntities) {
+func (in *FieldLevelEncryptionSummary) DeepCopyInto(out *FieldLevelEncryptionSummary) {
 	*out = *in
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
 		**out = **in
 	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionEntities.
-func (in *EncryptionEntities) DeepCopy() *EncryptionEntities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionSummary.
+func (in *FieldLevelEncryptionSummary) DeepCopy() *FieldLevelEncryptionSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(EncryptionEntities)
+	out := new(FieldLevelEncryptionSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EncryptionEntity) DeepCopyInto(out *EncryptionEntity) {
+func (in *FieldPatterns) DeepCopyInto(out *FieldPatterns) {
 	*out = *in
-	if in.ProviderID != nil {
-		in, out := &in.ProviderID, &out.ProviderID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicKeyID != nil {
-		in, out := &in.PublicKeyID, &out.PublicKeyID
-		*out = new(string)
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionEntity.
-func (in *EncryptionEntity) DeepCopy() *EncryptionEntity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldPatterns.
+func (in *FieldPatterns) DeepCopy() *FieldPatterns {
 	if in == nil {
 		return nil
 	}
-	out := new(EncryptionEntity)
+	out := new(FieldPatterns)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndPoint) DeepCopyInto(out *EndPoint) {
+func (in *ForwardedValues) DeepCopyInto(out *ForwardedValues) {
 	*out = *in
-	if in.StreamType != nil {
-		in, out := &in.StreamType, &out.StreamType
-		*out = new(string)
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = new(CookiePreference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = new(Headers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryString != nil {
+		in, out := &in.QueryString, &out.QueryString
+		*out = new(bool)
 		**out = **in
 	}
+	if in.QueryStringCacheKeys != nil {
+		in, out := &in.QueryStringCacheKeys, &out.QueryStringCacheKeys
+		*out = new(QueryStringCacheKeys)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndPoint.
-func (in *EndPoint) DeepCopy() *EndPoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardedValues.
+func (in *ForwardedValues) DeepCopy() *ForwardedValues {
 	if in == nil {
 		return nil
 	}
-	out := new(EndPoint)
+	out := new(ForwardedValues)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryption) DeepCopyInto(out *FieldLevelEncryption) {
+func (in *Function) DeepCopyInto(out *Function) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryption.
-func (in *FieldLevelEncryption) DeepCopy() *FieldLevelEncryption {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
+func (in *Function) DeepCopy() *Function {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryption)
+	out := new(Function)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Function) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionConfig) DeepCopyInto(out *FieldLevelEncryptionConfig) {
+func (in *FunctionAssociation) DeepCopyInto(out *FunctionAssociation) {
 	*out = *in
-	if in.CallerReference != nil {
-		in, out := &in.CallerReference, &out.CallerReference
+	if in.EventType != nil {
+		in, out := &in.EventType, &out.EventType
 		*out = new(string)
 		**out = **in
 	}
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
+	if in.FunctionARN != nil {
+		in, out := &in.FunctionARN, &out.FunctionARN
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionConfig.
-func (in *FieldLevelEncryptionConfig) DeepCopy() *FieldLevelEncryptionConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionAssociation.
+func (in *FunctionAssociation) DeepCopy() *FunctionAssociation {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionConfig)
+	out := new(FunctionAssociation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionList) DeepCopyInto(out *FieldLevelEncryptionList) {
+func (in *FunctionAssociations) DeepCopyInto(out *FunctionAssociations) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
-		*out = new(string)
-		**out = **in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*FunctionAssociation, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FunctionAssociation)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	if in.Quantity != nil {
 		in, out := &in.Quantity, &out.Quantity
@@ -1531,214 +2315,280 @@ func (in *FieldLevelEncryptionList) DeepCopyInto(out *FieldLevelEncryptionList)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionList.
-func (in *FieldLevelEncryptionList) DeepCopy() *FieldLevelEncryptionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionAssociations.
+func (in *FunctionAssociations) DeepCopy() *FunctionAssociations {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionList)
+	out := new(FunctionAssociations)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionProfile) DeepCopyInto(out *FieldLevelEncryptionProfile) {
+func (in *FunctionCode) DeepCopyInto(out *FunctionCode) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
 		*out = new(string)
 		**out = **in
 	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfile.
-func (in *FieldLevelEncryptionProfile) DeepCopy() *FieldLevelEncryptionProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionCode.
+func (in *FunctionCode) DeepCopy() *FunctionCode {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionProfile)
+	out := new(FunctionCode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionProfileConfig) DeepCopyInto(out *FieldLevelEncryptionProfileConfig) {
+func (in *FunctionConfig) DeepCopyInto(out *FunctionConfig) {
 	*out = *in
-	if in.CallerReference != nil {
-		in, out := &in.CallerReference, &out.CallerReference
-		*out = new(string)
-		**out = **in
-	}
 	if in.Comment != nil {
 		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionConfig.
+func (in *FunctionConfig) DeepCopy() *FunctionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionList) DeepCopyInto(out *FunctionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Function, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionList.
+func (in *FunctionList) DeepCopy() *FunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FunctionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionMetadata) DeepCopyInto(out *FunctionMetadata) {
+	*out = *in
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FunctionARN != nil {
+		in, out := &in.FunctionARN, &out.FunctionARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfileConfig.
-func (in *FieldLevelEncryptionProfileConfig) DeepCopy() *FieldLevelEncryptionProfileConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionMetadata.
+func (in *FunctionMetadata) DeepCopy() *FunctionMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionProfileConfig)
+	out := new(FunctionMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionProfileList) DeepCopyInto(out *FieldLevelEncryptionProfileList) {
+func (in *FunctionObservation) DeepCopyInto(out *FunctionObservation) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
+	if in.FunctionARN != nil {
+		in, out := &in.FunctionARN, &out.FunctionARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
 		**out = **in
 	}
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TestResult != nil {
+		in, out := &in.TestResult, &out.TestResult
+		*out = new(FunctionTestResult)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfileList.
-func (in *FieldLevelEncryptionProfileList) DeepCopy() *FieldLevelEncryptionProfileList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionObservation.
+func (in *FunctionObservation) DeepCopy() *FunctionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionProfileList)
+	out := new(FunctionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionProfileSummary) DeepCopyInto(out *FieldLevelEncryptionProfileSummary) {
+func (in *FunctionParameters) DeepCopyInto(out *FunctionParameters) {
 	*out = *in
 	if in.Comment != nil {
 		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
+	in.Code.DeepCopyInto(&out.Code)
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = new(bool)
 		**out = **in
 	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.TestEventObject != nil {
+		in, out := &in.TestEventObject, &out.TestEventObject
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionProfileSummary.
-func (in *FieldLevelEncryptionProfileSummary) DeepCopy() *FieldLevelEncryptionProfileSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionParameters.
+func (in *FunctionParameters) DeepCopy() *FunctionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionSpec) DeepCopyInto(out *FunctionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionSpec.
+func (in *FunctionSpec) DeepCopy() *FunctionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionProfileSummary)
+	out := new(FunctionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldLevelEncryptionSummary) DeepCopyInto(out *FieldLevelEncryptionSummary) {
+func (in *FunctionStatus) DeepCopyInto(out *FunctionStatus) {
 	*out = *in
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
-		*out = new(string)
-		**out = **in
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLevelEncryptionSummary.
-func (in *FieldLevelEncryptionSummary) DeepCopy() *FieldLevelEncryptionSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionStatus.
+func (in *FunctionStatus) DeepCopy() *FunctionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldLevelEncryptionSummary)
+	out := new(FunctionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FieldPatterns) DeepCopyInto(out *FieldPatterns) {
+func (in *FunctionSummary) DeepCopyInto(out *FunctionSummary) {
 	*out = *in
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldPatterns.
-func (in *FieldPatterns) DeepCopy() *FieldPatterns {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionSummary.
+func (in *FunctionSummary) DeepCopy() *FunctionSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(FieldPatterns)
+	out := new(FunctionSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ForwardedValues) DeepCopyInto(out *ForwardedValues) {
+func (in *FunctionTestResult) DeepCopyInto(out *FunctionTestResult) {
 	*out = *in
-	if in.Cookies != nil {
-		in, out := &in.Cookies, &out.Cookies
-		*out = new(CookiePreference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = new(Headers)
-		(*in).DeepCopyInto(*out)
+	if in.ComputeUtilization != nil {
+		in, out := &in.ComputeUtilization, &out.ComputeUtilization
+		*out = new(string)
+		**out = **in
 	}
-	if in.QueryString != nil {
-		in, out := &in.QueryString, &out.QueryString
-		*out = new(bool)
+	if in.FunctionErrorMessage != nil {
+		in, out := &in.FunctionErrorMessage, &out.FunctionErrorMessage
+		*out = new(string)
 		**out = **in
 	}
-	if in.QueryStringCacheKeys != nil {
-		in, out := &in.QueryStringCacheKeys, &out.QueryStringCacheKeys
-		*out = new(QueryStringCacheKeys)
-		(*in).DeepCopyInto(*out)
+	if in.FunctionExecutionLogs != nil {
+		in, out := &in.FunctionExecutionLogs, &out.FunctionExecutionLogs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FunctionOutput != nil {
+		in, out := &in.FunctionOutput, &out.FunctionOutput
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardedValues.
-func (in *ForwardedValues) DeepCopy() *ForwardedValues {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionTestResult.
+func (in *FunctionTestResult) DeepCopy() *FunctionTestResult {
 	if in == nil {
 		return nil
 	}
-	out := new(ForwardedValues)
+	out := new(FunctionTestResult)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1813,20 +2663,10 @@ func (in *Headers) DeepCopy() *Headers {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Invalidation) DeepCopyInto(out *Invalidation) {
 	*out = *in
-	if in.CreateTime != nil {
-		in, out := &in.CreateTime, &out.CreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Invalidation.
@@ -1839,6 +2679,14 @@ func (in *Invalidation) DeepCopy() *Invalidation {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Invalidation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InvalidationBatch) DeepCopyInto(out *InvalidationBatch) {
 	*out = *in
@@ -1862,39 +2710,129 @@ func (in *InvalidationBatch) DeepCopy() *InvalidationBatch {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InvalidationList) DeepCopyInto(out *InvalidationList) {
 	*out = *in
-	if in.IsTruncated != nil {
-		in, out := &in.IsTruncated, &out.IsTruncated
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Invalidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Marker != nil {
-		in, out := &in.Marker, &out.Marker
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList.
+func (in *InvalidationList) DeepCopy() *InvalidationList {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InvalidationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationObservation) DeepCopyInto(out *InvalidationObservation) {
+	*out = *in
+	if in.CallerReference != nil {
+		in, out := &in.CallerReference, &out.CallerReference
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationObservation.
+func (in *InvalidationObservation) DeepCopy() *InvalidationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationParameters) DeepCopyInto(out *InvalidationParameters) {
+	*out = *in
+	if in.DistributionID != nil {
+		in, out := &in.DistributionID, &out.DistributionID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.DistributionIDRef != nil {
+		in, out := &in.DistributionIDRef, &out.DistributionIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
+	if in.DistributionIDSelector != nil {
+		in, out := &in.DistributionIDSelector, &out.DistributionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList.
-func (in *InvalidationList) DeepCopy() *InvalidationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationParameters.
+func (in *InvalidationParameters) DeepCopy() *InvalidationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InvalidationList)
+	out := new(InvalidationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationSpec) DeepCopyInto(out *InvalidationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSpec.
+func (in *InvalidationSpec) DeepCopy() *InvalidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationStatus) DeepCopyInto(out *InvalidationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationStatus.
+func (in *InvalidationStatus) DeepCopy() *InvalidationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1911,53 +2849,137 @@ func (in *InvalidationSummary) DeepCopyInto(out *InvalidationSummary) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSummary.
+func (in *InvalidationSummary) DeepCopy() *InvalidationSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KGKeyPairIDs) DeepCopyInto(out *KGKeyPairIDs) {
+	*out = *in
+	if in.KeyGroupID != nil {
+		in, out := &in.KeyGroupID, &out.KeyGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPairIDs != nil {
+		in, out := &in.KeyPairIDs, &out.KeyPairIDs
+		*out = new(KeyPairIDs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KGKeyPairIDs.
+func (in *KGKeyPairIDs) DeepCopy() *KGKeyPairIDs {
+	if in == nil {
+		return nil
+	}
+	out := new(KGKeyPairIDs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup.
+func (in *KeyGroup) DeepCopy() *KeyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSummary.
-func (in *InvalidationSummary) DeepCopy() *InvalidationSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupConfig.
+func (in *KeyGroupConfig) DeepCopy() *KeyGroupConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InvalidationSummary)
+	out := new(KeyGroupConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KGKeyPairIDs) DeepCopyInto(out *KGKeyPairIDs) {
+func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
 	*out = *in
-	if in.KeyGroupID != nil {
-		in, out := &in.KeyGroupID, &out.KeyGroupID
-		*out = new(string)
-		**out = **in
-	}
-	if in.KeyPairIDs != nil {
-		in, out := &in.KeyPairIDs, &out.KeyPairIDs
-		*out = new(KeyPairIDs)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KGKeyPairIDs.
-func (in *KGKeyPairIDs) DeepCopy() *KGKeyPairIDs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
+func (in *KeyGroupList) DeepCopy() *KeyGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(KGKeyPairIDs)
+	out := new(KeyGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
+func (in *KeyGroupObservation) DeepCopyInto(out *KeyGroupObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
 		*out = new(string)
 		**out = **in
 	}
@@ -1967,67 +2989,81 @@ func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup.
-func (in *KeyGroup) DeepCopy() *KeyGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupObservation.
+func (in *KeyGroupObservation) DeepCopy() *KeyGroupObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroup)
+	out := new(KeyGroupObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
+func (in *KeyGroupParameters) DeepCopyInto(out *KeyGroupParameters) {
 	*out = *in
 	if in.Comment != nil {
 		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ItemRefs != nil {
+		in, out := &in.ItemRefs, &out.ItemRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ItemSelector != nil {
+		in, out := &in.ItemSelector, &out.ItemSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupConfig.
-func (in *KeyGroupConfig) DeepCopy() *KeyGroupConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupParameters.
+func (in *KeyGroupParameters) DeepCopy() *KeyGroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroupConfig)
+	out := new(KeyGroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
+func (in *KeyGroupSpec) DeepCopyInto(out *KeyGroupSpec) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
-		*out = new(string)
-		**out = **in
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupSpec.
+func (in *KeyGroupSpec) DeepCopy() *KeyGroupSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(KeyGroupSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
-func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupStatus) DeepCopyInto(out *KeyGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupStatus.
+func (in *KeyGroupStatus) DeepCopy() *KeyGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroupList)
+	out := new(KeyGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2440,92 +3476,256 @@ func (in *OriginGroupFailoverCriteria) DeepCopy() *OriginGroupFailoverCriteria {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginGroupMember) DeepCopyInto(out *OriginGroupMember) {
+func (in *OriginGroupMember) DeepCopyInto(out *OriginGroupMember) {
+	*out = *in
+	if in.OriginID != nil {
+		in, out := &in.OriginID, &out.OriginID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginGroupMember.
+func (in *OriginGroupMember) DeepCopy() *OriginGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginGroupMembers) DeepCopyInto(out *OriginGroupMembers) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*OriginGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OriginGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginGroupMembers.
+func (in *OriginGroupMembers) DeepCopy() *OriginGroupMembers {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginGroupMembers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginGroups) DeepCopyInto(out *OriginGroups) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*OriginGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OriginGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginGroups.
+func (in *OriginGroups) DeepCopy() *OriginGroups {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginGroups)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestCookies) DeepCopyInto(out *OriginRequestCookies) {
+	*out = *in
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestCookies.
+func (in *OriginRequestCookies) DeepCopy() *OriginRequestCookies {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestCookies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestHeaders) DeepCopyInto(out *OriginRequestHeaders) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestHeaders.
+func (in *OriginRequestHeaders) DeepCopy() *OriginRequestHeaders {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestHeaders)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicy) DeepCopyInto(out *OriginRequestPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicy.
+func (in *OriginRequestPolicy) DeepCopy() *OriginRequestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OriginRequestPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyConfig) DeepCopyInto(out *OriginRequestPolicyConfig) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyConfig.
+func (in *OriginRequestPolicyConfig) DeepCopy() *OriginRequestPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginRequestPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginRequestPolicyCookiesConfig) DeepCopyInto(out *OriginRequestPolicyCookiesConfig) {
 	*out = *in
-	if in.OriginID != nil {
-		in, out := &in.OriginID, &out.OriginID
-		*out = new(string)
-		**out = **in
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = new(CookieNames)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginGroupMember.
-func (in *OriginGroupMember) DeepCopy() *OriginGroupMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyCookiesConfig.
+func (in *OriginRequestPolicyCookiesConfig) DeepCopy() *OriginRequestPolicyCookiesConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginGroupMember)
+	out := new(OriginRequestPolicyCookiesConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginGroupMembers) DeepCopyInto(out *OriginGroupMembers) {
+func (in *OriginRequestPolicyHeadersConfig) DeepCopyInto(out *OriginRequestPolicyHeadersConfig) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]*OriginGroupMember, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(OriginGroupMember)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = new(Headers)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginGroupMembers.
-func (in *OriginGroupMembers) DeepCopy() *OriginGroupMembers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyHeadersConfig.
+func (in *OriginRequestPolicyHeadersConfig) DeepCopy() *OriginRequestPolicyHeadersConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginGroupMembers)
+	out := new(OriginRequestPolicyHeadersConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginGroups) DeepCopyInto(out *OriginGroups) {
+func (in *OriginRequestPolicyList) DeepCopyInto(out *OriginRequestPolicyList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]*OriginGroup, len(*in))
+		*out = make([]OriginRequestPolicy, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(OriginGroup)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginGroups.
-func (in *OriginGroups) DeepCopy() *OriginGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyList.
+func (in *OriginRequestPolicyList) DeepCopy() *OriginRequestPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginGroups)
+	out := new(OriginRequestPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OriginRequestPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicy) DeepCopyInto(out *OriginRequestPolicy) {
+func (in *OriginRequestPolicyObservation) DeepCopyInto(out *OriginRequestPolicyObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
 		*out = new(string)
 		**out = **in
 	}
@@ -2535,107 +3735,89 @@ func (in *OriginRequestPolicy) DeepCopyInto(out *OriginRequestPolicy) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicy.
-func (in *OriginRequestPolicy) DeepCopy() *OriginRequestPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyObservation.
+func (in *OriginRequestPolicyObservation) DeepCopy() *OriginRequestPolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicy)
+	out := new(OriginRequestPolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyConfig) DeepCopyInto(out *OriginRequestPolicyConfig) {
+func (in *OriginRequestPolicyParameters) DeepCopyInto(out *OriginRequestPolicyParameters) {
 	*out = *in
 	if in.Comment != nil {
 		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
+	in.CookiesConfig.DeepCopyInto(&out.CookiesConfig)
+	in.HeadersConfig.DeepCopyInto(&out.HeadersConfig)
+	in.QueryStringsConfig.DeepCopyInto(&out.QueryStringsConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyConfig.
-func (in *OriginRequestPolicyConfig) DeepCopy() *OriginRequestPolicyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyParameters.
+func (in *OriginRequestPolicyParameters) DeepCopy() *OriginRequestPolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyConfig)
+	out := new(OriginRequestPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyCookiesConfig) DeepCopyInto(out *OriginRequestPolicyCookiesConfig) {
+func (in *OriginRequestPolicySpec) DeepCopyInto(out *OriginRequestPolicySpec) {
 	*out = *in
-	if in.Cookies != nil {
-		in, out := &in.Cookies, &out.Cookies
-		*out = new(CookieNames)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyCookiesConfig.
-func (in *OriginRequestPolicyCookiesConfig) DeepCopy() *OriginRequestPolicyCookiesConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicySpec.
+func (in *OriginRequestPolicySpec) DeepCopy() *OriginRequestPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyCookiesConfig)
+	out := new(OriginRequestPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyHeadersConfig) DeepCopyInto(out *OriginRequestPolicyHeadersConfig) {
+func (in *OriginRequestPolicyStatus) DeepCopyInto(out *OriginRequestPolicyStatus) {
 	*out = *in
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = new(Headers)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyHeadersConfig.
-func (in *OriginRequestPolicyHeadersConfig) DeepCopy() *OriginRequestPolicyHeadersConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyStatus.
+func (in *OriginRequestPolicyStatus) DeepCopy() *OriginRequestPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyHeadersConfig)
+	out := new(OriginRequestPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OriginRequestPolicyList) DeepCopyInto(out *OriginRequestPolicyList) {
+func (in *OriginRequestQueryStrings) DeepCopyInto(out *OriginRequestQueryStrings) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
-		*out = new(string)
-		**out = **in
-	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
+	if in.QueryStrings != nil {
+		in, out := &in.QueryStrings, &out.QueryStrings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestPolicyList.
-func (in *OriginRequestPolicyList) DeepCopy() *OriginRequestPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginRequestQueryStrings.
+func (in *OriginRequestQueryStrings) DeepCopy() *OriginRequestQueryStrings {
 	if in == nil {
 		return nil
 	}
-	out := new(OriginRequestPolicyList)
+	out := new(OriginRequestQueryStrings)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2770,15 +3952,10 @@ func (in *Paths) DeepCopy() *Paths {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKey) DeepCopyInto(out *PublicKey) {
 	*out = *in
-	if in.CreatedTime != nil {
-		in, out := &in.CreatedTime, &out.CreatedTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKey.
@@ -2791,6 +3968,14 @@ func (in *PublicKey) DeepCopy() *PublicKey {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyConfig) DeepCopyInto(out *PublicKeyConfig) {
 	*out = *in
@@ -2829,29 +4014,109 @@ func (in *PublicKeyConfig) DeepCopy() *PublicKeyConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyList) DeepCopyInto(out *PublicKeyList) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
+func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyObservation) DeepCopyInto(out *PublicKeyObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyObservation.
+func (in *PublicKeyObservation) DeepCopy() *PublicKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyParameters) DeepCopyInto(out *PublicKeyParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
-func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyParameters.
+func (in *PublicKeyParameters) DeepCopy() *PublicKeyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicKeyList)
+	out := new(PublicKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeySpec) DeepCopyInto(out *PublicKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeySpec.
+func (in *PublicKeySpec) DeepCopy() *PublicKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyStatus) DeepCopyInto(out *PublicKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyStatus.
+func (in *PublicKeyStatus) DeepCopy() *PublicKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3420,6 +4685,36 @@ func (in *StreamingLoggingConfig) DeepCopy() *StreamingLoggingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestResult) DeepCopyInto(out *TestResult) {
+	*out = *in
+	if in.ComputeUtilization != nil {
+		in, out := &in.ComputeUtilization, &out.ComputeUtilization
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionErrorMessage != nil {
+		in, out := &in.FunctionErrorMessage, &out.FunctionErrorMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionOutput != nil {
+		in, out := &in.FunctionOutput, &out.FunctionOutput
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestResult.
+func (in *TestResult) DeepCopy() *TestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustedKeyGroups) DeepCopyInto(out *TrustedKeyGroups) {
 	*out = *in
@@ -113,6 +113,10 @@ type CacheBehavior struct {
 	// A complex type that specifies how CloudFront handles query strings, cookies,
 	// and HTTP headers.
 	ForwardedValues *ForwardedValues `json:"forwardedValues,omitempty"`
+	// A list of CloudFront functions that are associated with a cache behavior
+	// in a CloudFront distribution. CloudFront functions must be published to the
+	// LIVE stage to associate them with a cache behavior.
+	FunctionAssociations *FunctionAssociations `json:"functionAssociations,omitempty"`
 	// A complex type that specifies a list of Lambda functions associations for
 	// a cache behavior.
 	//
@@ -155,12 +159,6 @@ type CacheBehaviors struct {
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
-type CachePolicy struct {
-	ID *string `json:"id,omitempty"`
-
-	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
-}
-
 type CachePolicyConfig struct {
 	Comment *string `json:"comment,omitempty"`
 
@@ -183,14 +181,6 @@ type CachePolicyHeadersConfig struct {
 	Headers *Headers `json:"headers,omitempty"`
 }
 
-type CachePolicyList struct {
-	MaxItems *int64 `json:"maxItems,omitempty"`
-
-	NextMarker *string `json:"nextMarker,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
 type CachedMethods struct {
 	Items []*string `json:"items,omitempty"`
 
@@ -300,6 +290,10 @@ type DefaultCacheBehavior struct {
 	// A complex type that specifies how CloudFront handles query strings, cookies,
 	// and HTTP headers.
 	ForwardedValues *ForwardedValues `json:"forwardedValues,omitempty"`
+	// A list of CloudFront functions that are associated with a cache behavior
+	// in a CloudFront distribution. CloudFront functions must be published to the
+	// LIVE stage to associate them with a cache behavior.
+	FunctionAssociations *FunctionAssociations `json:"functionAssociations,omitempty"`
 	// A complex type that specifies a list of Lambda functions associations for
 	// a cache behavior.
 	//
@@ -684,44 +678,52 @@ type ForwardedValues struct {
 	QueryStringCacheKeys *QueryStringCacheKeys `json:"queryStringCacheKeys,omitempty"`
 }
 
-type GeoRestriction struct {
-	Items []*string `json:"items,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
+type FunctionAssociation struct {
+	EventType *string `json:"eventType,omitempty"`
 
-	RestrictionType *string `json:"restrictionType,omitempty"`
+	FunctionARN *string `json:"functionARN,omitempty"`
 }
 
-type Headers struct {
-	Items []*string `json:"items,omitempty"`
+type FunctionAssociations struct {
+	Items []*FunctionAssociation `json:"items,omitempty"`
 
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
-type Invalidation struct {
-	CreateTime *metav1.Time `json:"createTime,omitempty"`
+type FunctionConfig struct {
+	Comment *string `json:"comment,omitempty"`
+}
 
-	ID *string `json:"id,omitempty"`
+type FunctionMetadata struct {
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
 
-	Status *string `json:"status,omitempty"`
+	FunctionARN *string `json:"functionARN,omitempty"`
+
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
 }
 
-type InvalidationBatch struct {
-	CallerReference *string `json:"callerReference,omitempty"`
+type FunctionSummary struct {
+	Status *string `json:"status,omitempty"`
 }
 
-type InvalidationList struct {
-	IsTruncated *bool `json:"isTruncated,omitempty"`
+type GeoRestriction struct {
+	Items []*string `json:"items,omitempty"`
 
-	Marker *string `json:"marker,omitempty"`
+	Quantity *int64 `json:"quantity,omitempty"`
 
-	MaxItems *int64 `json:"maxItems,omitempty"`
+	RestrictionType *string `json:"restrictionType,omitempty"`
+}
 
-	NextMarker *string `json:"nextMarker,omitempty"`
+type Headers struct {
+	Items []*string `json:"items,omitempty"`
 
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
+type InvalidationBatch struct {
+	CallerReference *string `json:"callerReference,omitempty"`
+}
+
 type InvalidationSummary struct {
 	CreateTime *metav1.Time `json:"createTime,omitempty"`
 
@@ -736,26 +738,12 @@ type KGKeyPairIDs struct {
 	KeyPairIDs *KeyPairIDs `json:"keyPairIDs,omitempty"`
 }
 
-type KeyGroup struct {
-	ID *string `json:"id,omitempty"`
-
-	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
-}
-
 type KeyGroupConfig struct {
 	Comment *string `json:"comment,omitempty"`
 
 	Name *string `json:"name,omitempty"`
 }
 
-type KeyGroupList struct {
-	MaxItems *int64 `json:"maxItems,omitempty"`
-
-	NextMarker *string `json:"nextMarker,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
 type KeyPairIDs struct {
 	Items []*string `json:"items,omitempty"`
 
@@ -894,12 +882,6 @@ type OriginGroups struct {
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
-type OriginRequestPolicy struct {
-	ID *string `json:"id,omitempty"`
-
-	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
-}
-
 type OriginRequestPolicyConfig struct {
 	Comment *string `json:"comment,omitempty"`
 
@@ -916,14 +898,6 @@ type OriginRequestPolicyHeadersConfig struct {
 	Headers *Headers `json:"headers,omitempty"`
 }
 
-type OriginRequestPolicyList struct {
-	MaxItems *int64 `json:"maxItems,omitempty"`
-
-	NextMarker *string `json:"nextMarker,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
 type OriginSSLProtocols struct {
 	Items []*string `json:"items,omitempty"`
 
@@ -950,12 +924,6 @@ type Paths struct {
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
-type PublicKey struct {
-	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
-
-	ID *string `json:"id,omitempty"`
-}
-
 type PublicKeyConfig struct {
 	CallerReference *string `json:"callerReference,omitempty"`
 
@@ -966,14 +934,6 @@ type PublicKeyConfig struct {
 	Name *string `json:"name,omitempty"`
 }
 
-type PublicKeyList struct {
-	MaxItems *int64 `json:"maxItems,omitempty"`
-
-	NextMarker *string `json:"nextMarker,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
 type PublicKeySummary struct {
 	Comment *string `json:"comment,omitempty"`
 
@@ -1134,6 +1094,14 @@ type StreamingLoggingConfig struct {
 	Prefix *string `json:"prefix,omitempty"`
 }
 
+type TestResult struct {
+	ComputeUtilization *string `json:"computeUtilization,omitempty"`
+
+	FunctionErrorMessage *string `json:"functionErrorMessage,omitempty"`
+
+	FunctionOutput *string `json:"functionOutput,omitempty"`
+}
+
 type TrustedKeyGroups struct {
 	Enabled *bool `json:"enabled,omitempty"`
 
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LabelKeyBackupSchedule is the label of the Backups that are created by a
+// BackupSchedule. Its value is the name of the BackupSchedule.
+const LabelKeyBackupSchedule = "dynamodb.aws.crossplane.io/backup-schedule"
+
+// BackupScheduleParameters define the desired schedule of the on-demand
+// backups of a table.
+type BackupScheduleParameters struct {
+	// Region is which region the Backups will be created.
+	Region string `json:"region"`
+
+	// TableName is the name of the Table whose backups will be taken.
+	// +optional
+	TableName string `json:"tableName,omitempty"`
+
+	// TableNameRef points to the Table resource whose Name will be used to fill
+	// TableName field.
+	// +optional
+	TableNameRef *xpv1.Reference `json:"tableNameRef,omitempty"`
+
+	// TableNameSelector selects a Table resource.
+	// +optional
+	TableNameSelector *xpv1.Selector `json:"tableNameSelector,omitempty"`
+
+	// Schedule is a cron expression with the five fields minute, hour, day
+	// of month, month and day of week, evaluated in UTC. A Backup is created
+	// each time it matches, e.g. "0 3 * * *" creates one every day at 03:00.
+	Schedule string `json:"schedule"`
+
+	// StartingDeadlineSeconds is the deadline in seconds for creating a
+	// Backup after its schedule time. The Backup is skipped if it cannot be
+	// created in time, e.g. because the controller was not running. There is
+	// no deadline when omitted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// Retention deletes the Backups created by this schedule once they are
+	// no longer needed. All Backups are kept when omitted.
+	// +optional
+	Retention *BackupRetention `json:"retention,omitempty"`
+}
+
+// BackupRetention limits the Backups that are kept. A Backup is deleted when
+// it exceeds any of the given limits.
+type BackupRetention struct {
+	// MaxCount is the number of the most recent Backups to keep.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxCount *int64 `json:"maxCount,omitempty"`
+
+	// MaxAge is the age after which Backups are deleted, e.g. "720h".
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// BackupScheduleObservation is the observed state of a BackupSchedule.
+type BackupScheduleObservation struct {
+	// LastScheduleTime is the last schedule time a Backup was created or
+	// skipped for.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the time the next Backup will be created.
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// Backups are the names of the Backups created by this schedule that are
+	// kept, most recent first.
+	Backups []string `json:"backups,omitempty"`
+}
+
+// A BackupScheduleSpec defines the desired state of a BackupSchedule.
+type BackupScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupScheduleParameters `json:"forProvider"`
+}
+
+// A BackupScheduleStatus represents the observed state of a BackupSchedule.
+type BackupScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupSchedule is a managed resource that creates Backups of a table on
+// a cron schedule. The Backups inherit its provider config and deletion
+// policy, and are deleted together with it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TABLE",type="string",JSONPath=".spec.forProvider.tableName"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.forProvider.schedule"
+// +kubebuilder:printcolumn:name="LAST-SCHEDULE",type="date",JSONPath=".status.atProvider.lastScheduleTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupScheduleSpec   `json:"spec"`
+	Status BackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupScheduleList contains a list of BackupSchedules
+type BackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSchedule `json:"items"`
+}
+
+// BackupSchedule type metadata.
+var (
+	BackupScheduleKind             = "BackupSchedule"
+	BackupScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: BackupScheduleKind}.String()
+	BackupScheduleKindAPIVersion   = BackupScheduleKind + "." + GroupVersion.String()
+	BackupScheduleGroupVersionKind = GroupVersion.WithKind(BackupScheduleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BackupSchedule{}, &BackupScheduleList{})
+}
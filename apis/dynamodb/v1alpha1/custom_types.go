@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
 
 // CustomBackupParameters are custom parameters for Backup.
 type CustomBackupParameters struct {
@@ -31,8 +35,179 @@ type CustomBackupParameters struct {
 	TableNameSelector *xpv1.Selector `json:"tableNameSelector,omitempty"`
 }
 
+// AnnotationKeyRegisteredScalableTargets records the scalable targets that
+// were registered for the auto scaling of a Table as a JSON list, so that
+// they can be deregistered once auto scaling is removed from its spec.
+const AnnotationKeyRegisteredScalableTargets = "dynamodb.aws.crossplane.io/registered-scalable-targets"
+
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// TimeToLive configures the expiry of the items of the table. Time to
+	// live is not managed when omitted.
+	// +optional
+	TimeToLive *TimeToLive `json:"timeToLive,omitempty"`
+
+	// PointInTimeRecoveryEnabled enables or disables continuous backups of
+	// the table. Point-in-time recovery is not managed when omitted.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+
+	// ContributorInsightsEnabled enables or disables CloudWatch Contributor
+	// Insights for the table. Contributor insights are not managed when
+	// omitted.
+	// +optional
+	ContributorInsightsEnabled *bool `json:"contributorInsightsEnabled,omitempty"`
+
+	// AutoScaling configures Application Auto Scaling of the provisioned
+	// capacity of the table and its global secondary indexes. Once set, the
+	// scalable targets of the table and its indexes that are not listed here
+	// are deregistered. When removed, the scalable targets that were
+	// registered for it are deregistered. The provisioned throughput of the
+	// table is not checked for drift while auto scaling is configured.
+	// +optional
+	AutoScaling *TableAutoScaling `json:"autoScaling,omitempty"`
+
+	// KinesisStreamingDestination streams the item-level changes of the table
+	// to a Kinesis data stream. The destination is not managed when omitted.
+	// +optional
+	KinesisStreamingDestination *KinesisStreamingDestination `json:"kinesisStreamingDestination,omitempty"`
+
+	// RestoreFrom creates the table by restoring a backup or another table
+	// as of a point in time instead of creating an empty table. The
+	// attribute definitions and key schema have to match the ones of the
+	// source.
+	// +immutable
+	// +optional
+	RestoreFrom *TableRestoreSource `json:"restoreFrom,omitempty"`
+}
+
+// TableRestoreSource is the source a table is restored from. Exactly one of
+// BackupARN and PointInTime has to be given.
+type TableRestoreSource struct {
+	// BackupARN is the ARN of the backup to restore.
+	// +optional
+	BackupARN *string `json:"backupARN,omitempty"`
+
+	// BackupARNRef points to the Backup resource whose ARN will be used to
+	// fill BackupARN field.
+	// +optional
+	BackupARNRef *xpv1.Reference `json:"backupARNRef,omitempty"`
+
+	// BackupARNSelector selects a Backup resource.
+	// +optional
+	BackupARNSelector *xpv1.Selector `json:"backupARNSelector,omitempty"`
+
+	// PointInTime restores another table as of a point in time. The source
+	// table has to have point-in-time recovery enabled.
+	// +optional
+	PointInTime *PointInTimeRestore `json:"pointInTime,omitempty"`
+}
+
+// PointInTimeRestore restores a table as of a point in time.
+type PointInTimeRestore struct {
+	// SourceTableName is the name of the table to restore.
+	// +optional
+	SourceTableName *string `json:"sourceTableName,omitempty"`
+
+	// SourceTableNameRef points to the Table resource whose Name will be used
+	// to fill SourceTableName field.
+	// +optional
+	SourceTableNameRef *xpv1.Reference `json:"sourceTableNameRef,omitempty"`
+
+	// SourceTableNameSelector selects a Table resource.
+	// +optional
+	SourceTableNameSelector *xpv1.Selector `json:"sourceTableNameSelector,omitempty"`
+
+	// RestoreDateTime is the point in time to restore the table to. The
+	// latest restorable time is used when omitted.
+	// +optional
+	RestoreDateTime *metav1.Time `json:"restoreDateTime,omitempty"`
+}
+
+// KinesisStreamingDestination is a Kinesis data stream that receives the
+// item-level changes of a table.
+type KinesisStreamingDestination struct {
+	// StreamARN is the ARN of the Kinesis data stream.
+	StreamARN string `json:"streamARN"`
+
+	// Enabled indicates whether the changes are streamed to the data stream.
+	Enabled bool `json:"enabled"`
+}
+
+// TimeToLive configures the expiry of the items of a table.
+type TimeToLive struct {
+	// AttributeName is the name of the attribute that stores the expiry time
+	// of the items.
+	AttributeName string `json:"attributeName"`
+
+	// Enabled indicates whether expired items are deleted.
+	Enabled bool `json:"enabled"`
+}
+
+// TableAutoScaling configures Application Auto Scaling of a table and its
+// global secondary indexes.
+type TableAutoScaling struct {
+	// ReadCapacity scales the read capacity units of the table.
+	// +optional
+	ReadCapacity *CapacityAutoScaling `json:"readCapacity,omitempty"`
+
+	// WriteCapacity scales the write capacity units of the table.
+	// +optional
+	WriteCapacity *CapacityAutoScaling `json:"writeCapacity,omitempty"`
+
+	// GlobalSecondaryIndexes scales the capacity of the global secondary
+	// indexes of the table.
+	// +optional
+	GlobalSecondaryIndexes []IndexAutoScaling `json:"globalSecondaryIndexes,omitempty"`
+}
+
+// IndexAutoScaling configures Application Auto Scaling of a global secondary
+// index.
+type IndexAutoScaling struct {
+	// IndexName is the name of the global secondary index.
+	IndexName string `json:"indexName"`
+
+	// ReadCapacity scales the read capacity units of the index.
+	// +optional
+	ReadCapacity *CapacityAutoScaling `json:"readCapacity,omitempty"`
+
+	// WriteCapacity scales the write capacity units of the index.
+	// +optional
+	WriteCapacity *CapacityAutoScaling `json:"writeCapacity,omitempty"`
+}
+
+// CapacityAutoScaling is a scalable target with a target tracking scaling
+// policy on the utilization of the capacity.
+type CapacityAutoScaling struct {
+	// MinCapacity is the lower bound of the provisioned capacity units.
+	// +kubebuilder:validation:Minimum=1
+	MinCapacity int64 `json:"minCapacity"`
+
+	// MaxCapacity is the upper bound of the provisioned capacity units.
+	// +kubebuilder:validation:Minimum=1
+	MaxCapacity int64 `json:"maxCapacity"`
+
+	// TargetUtilizationPercent is the ratio of consumed to provisioned
+	// capacity the scaling policy aims for.
+	// +kubebuilder:validation:Minimum=20
+	// +kubebuilder:validation:Maximum=90
+	TargetUtilizationPercent int64 `json:"targetUtilizationPercent"`
+
+	// ScaleInCooldown is the number of seconds after a scale-in activity
+	// before another scale-in activity can start.
+	// +optional
+	ScaleInCooldown *int64 `json:"scaleInCooldown,omitempty"`
+
+	// ScaleOutCooldown is the number of seconds after a scale-out activity
+	// before another scale-out activity can start.
+	// +optional
+	ScaleOutCooldown *int64 `json:"scaleOutCooldown,omitempty"`
+
+	// DisableScaleIn prevents the scaling policy from decreasing the
+	// capacity.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
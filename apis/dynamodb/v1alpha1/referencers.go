@@ -44,3 +44,66 @@ func (mg *Backup) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.TableNameRef = rsp.ResolvedReference
 	return nil
 }
+
+// ResolveReferences of this BackupSchedule
+func (mg *BackupSchedule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.tableName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.TableName,
+		Reference:    mg.Spec.ForProvider.TableNameRef,
+		Selector:     mg.Spec.ForProvider.TableNameSelector,
+		To:           reference.To{Managed: &Table{}, List: &TableList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.tableName")
+	}
+	mg.Spec.ForProvider.TableName = rsp.ResolvedValue
+	mg.Spec.ForProvider.TableNameRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Table
+func (mg *Table) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	src := mg.Spec.ForProvider.RestoreFrom
+	if src == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.restoreFrom.backupARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(src.BackupARN),
+		Reference:    src.BackupARNRef,
+		Selector:     src.BackupARNSelector,
+		To:           reference.To{Managed: &Backup{}, List: &BackupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.restoreFrom.backupARN")
+	}
+	src.BackupARN = reference.ToPtrValue(rsp.ResolvedValue)
+	src.BackupARNRef = rsp.ResolvedReference
+
+	if src.PointInTime == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.restoreFrom.pointInTime.sourceTableName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(src.PointInTime.SourceTableName),
+		Reference:    src.PointInTime.SourceTableNameRef,
+		Selector:     src.PointInTime.SourceTableNameSelector,
+		To:           reference.To{Managed: &Table{}, List: &TableList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.restoreFrom.pointInTime.sourceTableName")
+	}
+	src.PointInTime.SourceTableName = reference.ToPtrValue(rsp.ResolvedValue)
+	src.PointInTime.SourceTableNameRef = rsp.ResolvedReference
+	return nil
+}
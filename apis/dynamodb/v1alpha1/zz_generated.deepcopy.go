@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -335,6 +336,187 @@ func (in *BackupParameters) DeepCopy() *BackupParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSchedule) DeepCopyInto(out *BackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSchedule.
+func (in *BackupSchedule) DeepCopy() *BackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleList) DeepCopyInto(out *BackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleList.
+func (in *BackupScheduleList) DeepCopy() *BackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleObservation) DeepCopyInto(out *BackupScheduleObservation) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleObservation.
+func (in *BackupScheduleObservation) DeepCopy() *BackupScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleParameters) DeepCopyInto(out *BackupScheduleParameters) {
+	*out = *in
+	if in.TableNameRef != nil {
+		in, out := &in.TableNameRef, &out.TableNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TableNameSelector != nil {
+		in, out := &in.TableNameSelector, &out.TableNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleParameters.
+func (in *BackupScheduleParameters) DeepCopy() *BackupScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleSpec) DeepCopyInto(out *BackupScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleSpec.
+func (in *BackupScheduleSpec) DeepCopy() *BackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleStatus) DeepCopyInto(out *BackupScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleStatus.
+func (in *BackupScheduleStatus) DeepCopy() *BackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -496,6 +678,36 @@ func (in *BillingModeSummary) DeepCopy() *BillingModeSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityAutoScaling) DeepCopyInto(out *CapacityAutoScaling) {
+	*out = *in
+	if in.ScaleInCooldown != nil {
+		in, out := &in.ScaleInCooldown, &out.ScaleInCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleOutCooldown != nil {
+		in, out := &in.ScaleOutCooldown, &out.ScaleOutCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DisableScaleIn != nil {
+		in, out := &in.DisableScaleIn, &out.DisableScaleIn
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityAutoScaling.
+func (in *CapacityAutoScaling) DeepCopy() *CapacityAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionCheck) DeepCopyInto(out *ConditionCheck) {
 	*out = *in
@@ -706,6 +918,36 @@ func (in *CustomGlobalTableParameters) DeepCopy() *CustomGlobalTableParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTableParameters) DeepCopyInto(out *CustomTableParameters) {
 	*out = *in
+	if in.TimeToLive != nil {
+		in, out := &in.TimeToLive, &out.TimeToLive
+		*out = new(TimeToLive)
+		**out = **in
+	}
+	if in.PointInTimeRecoveryEnabled != nil {
+		in, out := &in.PointInTimeRecoveryEnabled, &out.PointInTimeRecoveryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContributorInsightsEnabled != nil {
+		in, out := &in.ContributorInsightsEnabled, &out.ContributorInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoScaling != nil {
+		in, out := &in.AutoScaling, &out.AutoScaling
+		*out = new(TableAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KinesisStreamingDestination != nil {
+		in, out := &in.KinesisStreamingDestination, &out.KinesisStreamingDestination
+		*out = new(KinesisStreamingDestination)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(TableRestoreSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableParameters.
@@ -1326,6 +1568,31 @@ func (in *GlobalTable_SDK) DeepCopy() *GlobalTable_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexAutoScaling) DeepCopyInto(out *IndexAutoScaling) {
+	*out = *in
+	if in.ReadCapacity != nil {
+		in, out := &in.ReadCapacity, &out.ReadCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteCapacity != nil {
+		in, out := &in.WriteCapacity, &out.WriteCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexAutoScaling.
+func (in *IndexAutoScaling) DeepCopy() *IndexAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeySchemaElement) DeepCopyInto(out *KeySchemaElement) {
 	*out = *in
@@ -1376,6 +1643,21 @@ func (in *KinesisDataStreamDestination) DeepCopy() *KinesisDataStreamDestination
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KinesisStreamingDestination) DeepCopyInto(out *KinesisStreamingDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisStreamingDestination.
+func (in *KinesisStreamingDestination) DeepCopy() *KinesisStreamingDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(KinesisStreamingDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalSecondaryIndex) DeepCopyInto(out *LocalSecondaryIndex) {
 	*out = *in
@@ -1522,6 +1804,40 @@ func (in *PointInTimeRecoveryDescription) DeepCopy() *PointInTimeRecoveryDescrip
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PointInTimeRestore) DeepCopyInto(out *PointInTimeRestore) {
+	*out = *in
+	if in.SourceTableName != nil {
+		in, out := &in.SourceTableName, &out.SourceTableName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceTableNameRef != nil {
+		in, out := &in.SourceTableNameRef, &out.SourceTableNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceTableNameSelector != nil {
+		in, out := &in.SourceTableNameSelector, &out.SourceTableNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreDateTime != nil {
+		in, out := &in.RestoreDateTime, &out.RestoreDateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointInTimeRestore.
+func (in *PointInTimeRestore) DeepCopy() *PointInTimeRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(PointInTimeRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Projection) DeepCopyInto(out *Projection) {
 	*out = *in
@@ -2323,6 +2639,38 @@ func (in *Table) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableAutoScaling) DeepCopyInto(out *TableAutoScaling) {
+	*out = *in
+	if in.ReadCapacity != nil {
+		in, out := &in.ReadCapacity, &out.ReadCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteCapacity != nil {
+		in, out := &in.WriteCapacity, &out.WriteCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalSecondaryIndexes != nil {
+		in, out := &in.GlobalSecondaryIndexes, &out.GlobalSecondaryIndexes
+		*out = make([]IndexAutoScaling, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableAutoScaling.
+func (in *TableAutoScaling) DeepCopy() *TableAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(TableAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableAutoScalingDescription) DeepCopyInto(out *TableAutoScalingDescription) {
 	*out = *in
@@ -2702,7 +3050,7 @@ func (in *TableParameters) DeepCopyInto(out *TableParameters) {
 			}
 		}
 	}
-	out.CustomTableParameters = in.CustomTableParameters
+	in.CustomTableParameters.DeepCopyInto(&out.CustomTableParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
@@ -2715,6 +3063,41 @@ func (in *TableParameters) DeepCopy() *TableParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableRestoreSource) DeepCopyInto(out *TableRestoreSource) {
+	*out = *in
+	if in.BackupARN != nil {
+		in, out := &in.BackupARN, &out.BackupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupARNRef != nil {
+		in, out := &in.BackupARNRef, &out.BackupARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BackupARNSelector != nil {
+		in, out := &in.BackupARNSelector, &out.BackupARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PointInTime != nil {
+		in, out := &in.PointInTime, &out.PointInTime
+		*out = new(PointInTimeRestore)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableRestoreSource.
+func (in *TableRestoreSource) DeepCopy() *TableRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TableRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableSpec) DeepCopyInto(out *TableSpec) {
 	*out = *in
@@ -2774,6 +3157,21 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeToLive) DeepCopyInto(out *TimeToLive) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeToLive.
+func (in *TimeToLive) DeepCopy() *TimeToLive {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeToLive)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeToLiveDescription) DeepCopyInto(out *TimeToLiveDescription) {
 	*out = *in
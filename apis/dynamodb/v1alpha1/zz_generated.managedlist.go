@@ -29,6 +29,15 @@ func (l *BackupList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this BackupScheduleList.
+func (l *BackupScheduleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this GlobalTableList.
 func (l *GlobalTableList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
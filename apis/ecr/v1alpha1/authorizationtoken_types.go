@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AuthorizationTokenParameters define the desired state of an ECR
+// authorization token.
+type AuthorizationTokenParameters struct {
+	// Region is the region of the registry.
+	Region string `json:"region"`
+
+	// RegistryID is the AWS account ID of the registry the token is for.
+	// Defaults to the registry of the account of the provider.
+	// +optional
+	RegistryID *string `json:"registryId,omitempty"`
+
+	// RepositoryURI is the URI of a repository of the registry. The registry
+	// host of the docker config is taken from it. Defaults to the proxy
+	// endpoint of the token.
+	// +optional
+	RepositoryURI *string `json:"repositoryUri,omitempty"`
+
+	// RepositoryURIRef references a Repository to retrieve its URI.
+	// +optional
+	RepositoryURIRef *xpv1.Reference `json:"repositoryUriRef,omitempty"`
+
+	// RepositoryURISelector selects a reference to a Repository to retrieve
+	// its URI.
+	// +optional
+	RepositoryURISelector *xpv1.Selector `json:"repositoryUriSelector,omitempty"`
+
+	// RefreshBefore is how long before its expiry the token is refreshed.
+	// Tokens are valid for 12 hours. Defaults to 6 hours.
+	// +optional
+	RefreshBefore *metav1.Duration `json:"refreshBefore,omitempty"`
+}
+
+// An AuthorizationTokenSpec defines the desired state of an
+// AuthorizationToken.
+type AuthorizationTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthorizationTokenParameters `json:"forProvider"`
+}
+
+// AuthorizationTokenObservation keeps the state for the external resource
+type AuthorizationTokenObservation struct {
+	// Registry is the registry host the token is published for.
+	Registry string `json:"registry,omitempty"`
+
+	// ProxyEndpoint is the registry URL the token can be used with.
+	ProxyEndpoint string `json:"proxyEndpoint,omitempty"`
+
+	// ExpiresAt is the time the token expires at.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// An AuthorizationTokenStatus represents the observed state of an
+// AuthorizationToken.
+type AuthorizationTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthorizationTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AuthorizationToken is a managed resource that publishes an authorization
+// token of an Elastic Container Registry as a kubernetes.io/dockerconfigjson
+// connection secret, so that it can be used as an image pull secret. The token
+// is refreshed before it expires.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGISTRY",type="string",JSONPath=".status.atProvider.registry"
+// +kubebuilder:printcolumn:name="EXPIRES",type="string",JSONPath=".status.atProvider.expiresAt"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AuthorizationToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthorizationTokenSpec   `json:"spec"`
+	Status AuthorizationTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthorizationTokenList contains a list of AuthorizationTokens
+type AuthorizationTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthorizationToken `json:"items"`
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LifecyclePolicyParameters define the desired state of an AWS Elastic
+// Container Repository lifecycle policy
+type LifecyclePolicyParameters struct {
+	// Region is the region you'd like your LifecyclePolicy to be created in.
+	Region string `json:"region"`
+
+	// Rules of the lifecycle policy. Either rules or rawPolicy must be
+	// specified.
+	// +optional
+	Rules []LifecyclePolicyRule `json:"rules,omitempty"`
+
+	// RawPolicy is the JSON lifecycle policy text. Either rules or rawPolicy
+	// must be specified.
+	// +optional
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
+	// The AWS account ID associated with the registry that contains the repository.
+	// If you do not specify a registry, the default registry is assumed.
+	// +optional
+	// +immutable
+	RegistryID *string `json:"registryId,omitempty"`
+
+	// The name of the repository to receive the policy.
+	//
+	// One of RepositoryName, RepositoryNameRef, or RepositoryNameSelector is required.
+	// +optional
+	// +immutable
+	RepositoryName *string `json:"repositoryName,omitempty"`
+
+	// A referencer to retrieve the name of a repository
+	// One of RepositoryName, RepositoryNameRef, or RepositoryNameSelector is required.
+	// +optional
+	// +immutable
+	RepositoryNameRef *xpv1.Reference `json:"repositoryNameRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the name of a repository
+	// One of RepositoryName, RepositoryNameRef, or RepositoryNameSelector is required.
+	// +optional
+	// +immutable
+	RepositoryNameSelector *xpv1.Selector `json:"repositoryNameSelector,omitempty"`
+}
+
+// LifecyclePolicyRule is a rule of a lifecycle policy. Rules are evaluated in
+// the order of their priority.
+type LifecyclePolicyRule struct {
+	// RulePriority sets the order in which rules are evaluated, lowest to
+	// highest. Priorities must be unique within the policy.
+	// +kubebuilder:validation:Minimum=1
+	RulePriority int64 `json:"rulePriority"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Selection selects the images the rule applies to.
+	Selection LifecyclePolicySelection `json:"selection"`
+
+	// Action applied to the selected images.
+	Action LifecyclePolicyAction `json:"action"`
+}
+
+// LifecyclePolicySelection selects the images a lifecycle policy rule
+// applies to.
+type LifecyclePolicySelection struct {
+	// TagStatus determines whether the rule applies to tagged, untagged or
+	// any images.
+	// +kubebuilder:validation:Enum=tagged;untagged;any
+	TagStatus string `json:"tagStatus"`
+
+	// TagPrefixList is the list of image tag prefixes the rule applies to.
+	// It is required when TagStatus is tagged.
+	// +optional
+	TagPrefixList []string `json:"tagPrefixList,omitempty"`
+
+	// CountType is imageCountMoreThan to limit the number of images, or
+	// sinceImagePushed to limit the age of images.
+	// +kubebuilder:validation:Enum=imageCountMoreThan;sinceImagePushed
+	CountType string `json:"countType"`
+
+	// CountUnit is the unit of CountNumber. It is required when CountType is
+	// sinceImagePushed.
+	// +optional
+	// +kubebuilder:validation:Enum=days
+	CountUnit *string `json:"countUnit,omitempty"`
+
+	// CountNumber is the maximum number of images, or their maximum age in
+	// CountUnit.
+	// +kubebuilder:validation:Minimum=1
+	CountNumber int64 `json:"countNumber"`
+}
+
+// LifecyclePolicyAction is the action of a lifecycle policy rule.
+type LifecyclePolicyAction struct {
+	// Type of the action.
+	// +kubebuilder:validation:Enum=expire
+	Type string `json:"type"`
+}
+
+// A LifecyclePolicySpec defines the desired state of a LifecyclePolicy.
+type LifecyclePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LifecyclePolicyParameters `json:"forProvider"`
+}
+
+// LifecyclePolicyObservation keeps the state for the external resource
+type LifecyclePolicyObservation struct {
+	// The JSON lifecycle policy text associated with the repository.
+	PolicyText string `json:"policyText,omitempty"`
+
+	// The time the lifecycle policy was last evaluated.
+	LastEvaluatedAt *metav1.Time `json:"lastEvaluatedAt,omitempty"`
+}
+
+// A LifecyclePolicyStatus represents the observed state of a LifecyclePolicy.
+type LifecyclePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LifecyclePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LifecyclePolicy is a managed resource that represents an Elastic Container Repository Lifecycle Policy
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repositoryName"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LifecyclePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LifecyclePolicySpec   `json:"spec"`
+	Status LifecyclePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LifecyclePolicyList contains a list of LifecyclePolicies
+type LifecyclePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LifecyclePolicy `json:"items"`
+}
@@ -24,12 +24,38 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 )
 
+// ResolveReferences of this Repository
+func (mg *Repository) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.EncryptionConfiguration == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.encryptionConfiguration.kmsKey
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EncryptionConfiguration.KMSKey),
+		Reference:    mg.Spec.ForProvider.EncryptionConfiguration.KMSKeyRef,
+		Selector:     mg.Spec.ForProvider.EncryptionConfiguration.KMSKeySelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.encryptionConfiguration.kmsKey")
+	}
+	mg.Spec.ForProvider.EncryptionConfiguration.KMSKey = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EncryptionConfiguration.KMSKeyRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this RepositoryPolicy
 func (mg *RepositoryPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -66,6 +92,77 @@ func (mg *RepositoryPolicy) ResolveReferences(ctx context.Context, c client.Read
 	return nil
 }
 
+// ResolveReferences of this LifecyclePolicy
+func (mg *LifecyclePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.repositoryName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RepositoryName),
+		Reference:    mg.Spec.ForProvider.RepositoryNameRef,
+		Selector:     mg.Spec.ForProvider.RepositoryNameSelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repositoryName")
+	}
+	mg.Spec.ForProvider.RepositoryName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RepositoryNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RegistryPolicy
+func (mg *RegistryPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.Policy == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+	for i := range mg.Spec.ForProvider.Policy.Statements {
+		statement := mg.Spec.ForProvider.Policy.Statements[i]
+		if err := ResolvePrincipal(ctx, r, statement.Principal, i); err != nil {
+			return err
+		}
+		if err := ResolvePrincipal(ctx, r, statement.NotPrincipal, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RepositoryURI returns the status.atProvider.repositoryUri of a Repository.
+func RepositoryURI() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Repository)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.RepositoryURI
+	}
+}
+
+// ResolveReferences of this AuthorizationToken
+func (mg *AuthorizationToken) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.repositoryUri
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RepositoryURI),
+		Reference:    mg.Spec.ForProvider.RepositoryURIRef,
+		Selector:     mg.Spec.ForProvider.RepositoryURISelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      RepositoryURI(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repositoryUri")
+	}
+	mg.Spec.ForProvider.RepositoryURI = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RepositoryURIRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolvePrincipal resolves all the IAMUser and IAMRole references in a RepositoryPrincipal
 func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *RepositoryPrincipal, statementIndex int) error {
 	if principal == nil {
@@ -56,7 +56,43 @@ var (
 	RepositoryPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryPolicyKind)
 )
 
+// LifecyclePolicy type metadata.
+var (
+	LifecyclePolicyKind             = reflect.TypeOf(LifecyclePolicy{}).Name()
+	LifecyclePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: LifecyclePolicyKind}.String()
+	LifecyclePolicyKindAPIVersion   = LifecyclePolicyKind + "." + SchemeGroupVersion.String()
+	LifecyclePolicyGroupVersionKind = SchemeGroupVersion.WithKind(LifecyclePolicyKind)
+)
+
+// RegistryReplicationConfiguration type metadata.
+var (
+	RegistryReplicationConfigurationKind             = reflect.TypeOf(RegistryReplicationConfiguration{}).Name()
+	RegistryReplicationConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: RegistryReplicationConfigurationKind}.String()
+	RegistryReplicationConfigurationKindAPIVersion   = RegistryReplicationConfigurationKind + "." + SchemeGroupVersion.String()
+	RegistryReplicationConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(RegistryReplicationConfigurationKind)
+)
+
+// RegistryPolicy type metadata.
+var (
+	RegistryPolicyKind             = reflect.TypeOf(RegistryPolicy{}).Name()
+	RegistryPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: RegistryPolicyKind}.String()
+	RegistryPolicyKindAPIVersion   = RegistryPolicyKind + "." + SchemeGroupVersion.String()
+	RegistryPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RegistryPolicyKind)
+)
+
+// AuthorizationToken type metadata.
+var (
+	AuthorizationTokenKind             = reflect.TypeOf(AuthorizationToken{}).Name()
+	AuthorizationTokenGroupKind        = schema.GroupKind{Group: Group, Kind: AuthorizationTokenKind}.String()
+	AuthorizationTokenKindAPIVersion   = AuthorizationTokenKind + "." + SchemeGroupVersion.String()
+	AuthorizationTokenGroupVersionKind = SchemeGroupVersion.WithKind(AuthorizationTokenKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
 	SchemeBuilder.Register(&RepositoryPolicy{}, &RepositoryPolicyList{})
+	SchemeBuilder.Register(&LifecyclePolicy{}, &LifecyclePolicyList{})
+	SchemeBuilder.Register(&RegistryReplicationConfiguration{}, &RegistryReplicationConfigurationList{})
+	SchemeBuilder.Register(&RegistryPolicy{}, &RegistryPolicyList{})
+	SchemeBuilder.Register(&AuthorizationToken{}, &AuthorizationTokenList{})
 }
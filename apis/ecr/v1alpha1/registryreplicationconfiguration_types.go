@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RegistryReplicationConfigurationParameters define the desired replication
+// configuration of the registry of the account in a region.
+type RegistryReplicationConfigurationParameters struct {
+	// Region is the region of the registry that is replicated.
+	Region string `json:"region"`
+
+	// Rules of the replication configuration. Each rule replicates the
+	// contents of the registry to its destinations. The registry is not
+	// replicated if there are no rules.
+	// +optional
+	Rules []ReplicationRule `json:"rules,omitempty"`
+}
+
+// ReplicationRule is a rule of a replication configuration.
+type ReplicationRule struct {
+	// Destinations the registry contents are replicated to.
+	// +kubebuilder:validation:MinItems=1
+	Destinations []ReplicationDestination `json:"destinations"`
+}
+
+// ReplicationDestination is a destination of a replication rule.
+type ReplicationDestination struct {
+	// Region to replicate to.
+	Region string `json:"region"`
+
+	// RegistryID is the account ID of the registry to replicate to. The
+	// registry must have a RegistryPolicy that allows the replication when
+	// it belongs to another account.
+	RegistryID string `json:"registryId"`
+}
+
+// A RegistryReplicationConfigurationSpec defines the desired state of a
+// RegistryReplicationConfiguration.
+type RegistryReplicationConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RegistryReplicationConfigurationParameters `json:"forProvider"`
+}
+
+// RegistryReplicationConfigurationObservation keeps the state for the
+// external resource
+type RegistryReplicationConfigurationObservation struct {
+	// The ID of the registry.
+	RegistryID string `json:"registryId,omitempty"`
+}
+
+// A RegistryReplicationConfigurationStatus represents the observed state of a
+// RegistryReplicationConfiguration.
+type RegistryReplicationConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RegistryReplicationConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegistryReplicationConfiguration is a managed resource that represents
+// the replication configuration of an Elastic Container Registry. There is
+// one replication configuration per registry and region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGISTRY",type="string",JSONPath=".status.atProvider.registryId"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RegistryReplicationConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryReplicationConfigurationSpec   `json:"spec"`
+	Status RegistryReplicationConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegistryReplicationConfigurationList contains a list of
+// RegistryReplicationConfigurations
+type RegistryReplicationConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryReplicationConfiguration `json:"items"`
+}
@@ -44,6 +44,34 @@ type RepositoryParameters struct {
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// The encryption configuration for the repository. This determines how the
+	// contents of your repository are encrypted at rest. If this parameter is
+	// omitted, AES256 encryption with Amazon S3-managed keys is used.
+	// +optional
+	// +immutable
+	EncryptionConfiguration *EncryptionConfiguration `json:"encryptionConfiguration,omitempty"`
+}
+
+// EncryptionConfiguration is the encryption configuration of a repository.
+type EncryptionConfiguration struct {
+	// The encryption type to use. If KMS is used, the contents of the
+	// repository are encrypted with a customer master key stored in AWS KMS.
+	// +kubebuilder:validation:Enum=AES256;KMS
+	EncryptionType string `json:"encryptionType"`
+
+	// The ARN, ID or alias of the KMS key to use when EncryptionType is KMS.
+	// If it is omitted, the AWS managed KMS key for Amazon ECR is used.
+	// +optional
+	KMSKey *string `json:"kmsKey,omitempty"`
+
+	// KMSKeyRef is a reference to a KMS Key used to set KMSKey.
+	// +optional
+	KMSKeyRef *xpv1.Reference `json:"kmsKeyRef,omitempty"`
+
+	// KMSKeySelector selects a reference to a KMS Key used to set KMSKey.
+	// +optional
+	KMSKeySelector *xpv1.Selector `json:"kmsKeySelector,omitempty"`
 }
 
 // Tag defines a tag
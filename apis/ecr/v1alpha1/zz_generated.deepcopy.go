@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -75,6 +76,158 @@ func (in *AWSPrincipal) DeepCopy() *AWSPrincipal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationToken) DeepCopyInto(out *AuthorizationToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationToken.
+func (in *AuthorizationToken) DeepCopy() *AuthorizationToken {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTokenList) DeepCopyInto(out *AuthorizationTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthorizationToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTokenList.
+func (in *AuthorizationTokenList) DeepCopy() *AuthorizationTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTokenObservation) DeepCopyInto(out *AuthorizationTokenObservation) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTokenObservation.
+func (in *AuthorizationTokenObservation) DeepCopy() *AuthorizationTokenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTokenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTokenParameters) DeepCopyInto(out *AuthorizationTokenParameters) {
+	*out = *in
+	if in.RegistryID != nil {
+		in, out := &in.RegistryID, &out.RegistryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryURI != nil {
+		in, out := &in.RepositoryURI, &out.RepositoryURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryURIRef != nil {
+		in, out := &in.RepositoryURIRef, &out.RepositoryURIRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RepositoryURISelector != nil {
+		in, out := &in.RepositoryURISelector, &out.RepositoryURISelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefreshBefore != nil {
+		in, out := &in.RefreshBefore, &out.RefreshBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTokenParameters.
+func (in *AuthorizationTokenParameters) DeepCopy() *AuthorizationTokenParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTokenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTokenSpec) DeepCopyInto(out *AuthorizationTokenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTokenSpec.
+func (in *AuthorizationTokenSpec) DeepCopy() *AuthorizationTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTokenStatus) DeepCopyInto(out *AuthorizationTokenStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTokenStatus.
+func (in *AuthorizationTokenStatus) DeepCopy() *AuthorizationTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -136,6 +289,36 @@ func (in *ConditionPair) DeepCopy() *ConditionPair {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfiguration) DeepCopyInto(out *EncryptionConfiguration) {
+	*out = *in
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KMSKeySelector != nil {
+		in, out := &in.KMSKeySelector, &out.KMSKeySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfiguration.
+func (in *EncryptionConfiguration) DeepCopy() *EncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageScanningConfiguration) DeepCopyInto(out *ImageScanningConfiguration) {
 	*out = *in
@@ -152,7 +335,7 @@ func (in *ImageScanningConfiguration) DeepCopy() *ImageScanningConfiguration {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Repository) DeepCopyInto(out *Repository) {
+func (in *LifecyclePolicy) DeepCopyInto(out *LifecyclePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -160,18 +343,18 @@ func (in *Repository) DeepCopyInto(out *Repository) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
-func (in *Repository) DeepCopy() *Repository {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicy.
+func (in *LifecyclePolicy) DeepCopy() *LifecyclePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Repository)
+	out := new(LifecyclePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Repository) DeepCopyObject() runtime.Object {
+func (in *LifecyclePolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -179,31 +362,46 @@ func (in *Repository) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
+func (in *LifecyclePolicyAction) DeepCopyInto(out *LifecyclePolicyAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyAction.
+func (in *LifecyclePolicyAction) DeepCopy() *LifecyclePolicyAction {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyList) DeepCopyInto(out *LifecyclePolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Repository, len(*in))
+		*out = make([]LifecyclePolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryList.
-func (in *RepositoryList) DeepCopy() *RepositoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyList.
+func (in *LifecyclePolicyList) DeepCopy() *LifecyclePolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryList)
+	out := new(LifecyclePolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RepositoryList) DeepCopyObject() runtime.Object {
+func (in *LifecyclePolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -211,42 +409,551 @@ func (in *RepositoryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryObservation) DeepCopyInto(out *RepositoryObservation) {
+func (in *LifecyclePolicyObservation) DeepCopyInto(out *LifecyclePolicyObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
+	if in.LastEvaluatedAt != nil {
+		in, out := &in.LastEvaluatedAt, &out.LastEvaluatedAt
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryObservation.
-func (in *RepositoryObservation) DeepCopy() *RepositoryObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyObservation.
+func (in *LifecyclePolicyObservation) DeepCopy() *LifecyclePolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryObservation)
+	out := new(LifecyclePolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
+func (in *LifecyclePolicyParameters) DeepCopyInto(out *LifecyclePolicyParameters) {
 	*out = *in
-	if in.ImageScanningConfiguration != nil {
-		in, out := &in.ImageScanningConfiguration, &out.ImageScanningConfiguration
-		*out = new(ImageScanningConfiguration)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]LifecyclePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RawPolicy != nil {
+		in, out := &in.RawPolicy, &out.RawPolicy
+		*out = new(string)
 		**out = **in
 	}
-	if in.ImageTagMutability != nil {
-		in, out := &in.ImageTagMutability, &out.ImageTagMutability
+	if in.RegistryID != nil {
+		in, out := &in.RegistryID, &out.RegistryID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
+	if in.RepositoryName != nil {
+		in, out := &in.RepositoryName, &out.RepositoryName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryNameRef != nil {
+		in, out := &in.RepositoryNameRef, &out.RepositoryNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RepositoryNameSelector != nil {
+		in, out := &in.RepositoryNameSelector, &out.RepositoryNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyParameters.
+func (in *LifecyclePolicyParameters) DeepCopy() *LifecyclePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyRule) DeepCopyInto(out *LifecyclePolicyRule) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.Selection.DeepCopyInto(&out.Selection)
+	out.Action = in.Action
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyRule.
+func (in *LifecyclePolicyRule) DeepCopy() *LifecyclePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicySelection) DeepCopyInto(out *LifecyclePolicySelection) {
+	*out = *in
+	if in.TagPrefixList != nil {
+		in, out := &in.TagPrefixList, &out.TagPrefixList
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CountUnit != nil {
+		in, out := &in.CountUnit, &out.CountUnit
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicySelection.
+func (in *LifecyclePolicySelection) DeepCopy() *LifecyclePolicySelection {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicySelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicySpec) DeepCopyInto(out *LifecyclePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicySpec.
+func (in *LifecyclePolicySpec) DeepCopy() *LifecyclePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyStatus) DeepCopyInto(out *LifecyclePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyStatus.
+func (in *LifecyclePolicyStatus) DeepCopy() *LifecyclePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPolicy) DeepCopyInto(out *RegistryPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPolicy.
+func (in *RegistryPolicy) DeepCopy() *RegistryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPolicyList) DeepCopyInto(out *RegistryPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RegistryPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPolicyList.
+func (in *RegistryPolicyList) DeepCopy() *RegistryPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPolicyObservation) DeepCopyInto(out *RegistryPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPolicyObservation.
+func (in *RegistryPolicyObservation) DeepCopy() *RegistryPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPolicyParameters) DeepCopyInto(out *RegistryPolicyParameters) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(RepositoryPolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawPolicy != nil {
+		in, out := &in.RawPolicy, &out.RawPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPolicyParameters.
+func (in *RegistryPolicyParameters) DeepCopy() *RegistryPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPolicySpec) DeepCopyInto(out *RegistryPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPolicySpec.
+func (in *RegistryPolicySpec) DeepCopy() *RegistryPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPolicyStatus) DeepCopyInto(out *RegistryPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPolicyStatus.
+func (in *RegistryPolicyStatus) DeepCopy() *RegistryPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryReplicationConfiguration) DeepCopyInto(out *RegistryReplicationConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryReplicationConfiguration.
+func (in *RegistryReplicationConfiguration) DeepCopy() *RegistryReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryReplicationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryReplicationConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryReplicationConfigurationList) DeepCopyInto(out *RegistryReplicationConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RegistryReplicationConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryReplicationConfigurationList.
+func (in *RegistryReplicationConfigurationList) DeepCopy() *RegistryReplicationConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryReplicationConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryReplicationConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryReplicationConfigurationObservation) DeepCopyInto(out *RegistryReplicationConfigurationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryReplicationConfigurationObservation.
+func (in *RegistryReplicationConfigurationObservation) DeepCopy() *RegistryReplicationConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryReplicationConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryReplicationConfigurationParameters) DeepCopyInto(out *RegistryReplicationConfigurationParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ReplicationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryReplicationConfigurationParameters.
+func (in *RegistryReplicationConfigurationParameters) DeepCopy() *RegistryReplicationConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryReplicationConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryReplicationConfigurationSpec) DeepCopyInto(out *RegistryReplicationConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryReplicationConfigurationSpec.
+func (in *RegistryReplicationConfigurationSpec) DeepCopy() *RegistryReplicationConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryReplicationConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryReplicationConfigurationStatus) DeepCopyInto(out *RegistryReplicationConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryReplicationConfigurationStatus.
+func (in *RegistryReplicationConfigurationStatus) DeepCopy() *RegistryReplicationConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryReplicationConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestination) DeepCopyInto(out *ReplicationDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestination.
+func (in *ReplicationDestination) DeepCopy() *ReplicationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRule) DeepCopyInto(out *ReplicationRule) {
+	*out = *in
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]ReplicationDestination, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRule.
+func (in *ReplicationRule) DeepCopy() *ReplicationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Repository) DeepCopyInto(out *Repository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
+func (in *Repository) DeepCopy() *Repository {
+	if in == nil {
+		return nil
+	}
+	out := new(Repository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Repository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Repository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryList.
+func (in *RepositoryList) DeepCopy() *RepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryObservation) DeepCopyInto(out *RepositoryObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryObservation.
+func (in *RepositoryObservation) DeepCopy() *RepositoryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
+	*out = *in
+	if in.ImageScanningConfiguration != nil {
+		in, out := &in.ImageScanningConfiguration, &out.ImageScanningConfiguration
+		*out = new(ImageScanningConfiguration)
+		**out = **in
+	}
+	if in.ImageTagMutability != nil {
+		in, out := &in.ImageTagMutability, &out.ImageTagMutability
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.EncryptionConfiguration != nil {
+		in, out := &in.EncryptionConfiguration, &out.EncryptionConfiguration
+		*out = new(EncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryParameters.
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AccessPointParameters define the desired state of an EFS AccessPoint. The
+// external name of the resource is the ID of the access point.
+type AccessPointParameters struct {
+	// Region is which region the AccessPoint will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the file system that the access point provides access to.
+	// +immutable
+	// +optional
+	FileSystemID *string `json:"fileSystemId,omitempty"`
+
+	// FileSystemIDRef is a reference to a FileSystem used to set the
+	// FileSystemID.
+	// +optional
+	FileSystemIDRef *xpv1.Reference `json:"fileSystemIdRef,omitempty"`
+
+	// FileSystemIDSelector selects a reference to a FileSystem used to set
+	// the FileSystemID.
+	// +optional
+	FileSystemIDSelector *xpv1.Selector `json:"fileSystemIdSelector,omitempty"`
+
+	// The operating system user and group applied to all file system requests
+	// made using the access point.
+	// +immutable
+	// +optional
+	PosixUser *PosixUser `json:"posixUser,omitempty"`
+
+	// Specifies the directory on the file system that the access point
+	// exposes as the root directory to NFS clients using the access point.
+	// +immutable
+	// +optional
+	RootDirectory *RootDirectory `json:"rootDirectory,omitempty"`
+
+	// Tags to be associated with the access point.
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// PosixUser is the full POSIX identity, including the user ID, group ID, and
+// secondary group IDs, that is used for all file operations by NFS clients
+// using the access point.
+type PosixUser struct {
+	// The POSIX user ID used for all file system operations using this
+	// access point.
+	UID int64 `json:"uid"`
+
+	// The POSIX group ID used for all file system operations using this
+	// access point.
+	GID int64 `json:"gid"`
+
+	// Secondary POSIX group IDs used for all file system operations using
+	// this access point.
+	// +optional
+	SecondaryGIDs []int64 `json:"secondaryGids,omitempty"`
+}
+
+// RootDirectory specifies the directory on the file system that the access
+// point provides access to.
+type RootDirectory struct {
+	// Specifies the path on the EFS file system to expose as the root
+	// directory to NFS clients using the access point. A path can have up to
+	// four subdirectories.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// CreationInfo is used to create the directory of Path with the given
+	// owner and permissions if it does not exist. The access point cannot be
+	// mounted if the directory does not exist and CreationInfo is omitted.
+	// +optional
+	CreationInfo *CreationInfo `json:"creationInfo,omitempty"`
+}
+
+// CreationInfo defines the owner and permissions of the root directory of an
+// access point when it is created.
+type CreationInfo struct {
+	// The POSIX user ID to apply to the root directory.
+	OwnerUID int64 `json:"ownerUid"`
+
+	// The POSIX group ID to apply to the root directory.
+	OwnerGID int64 `json:"ownerGid"`
+
+	// The POSIX permissions to apply to the root directory, in the format
+	// of an octal number representing the mode bits, e.g. "0755".
+	// +kubebuilder:validation:Pattern=`^[0-7]{3,4}$`
+	Permissions string `json:"permissions"`
+}
+
+// AccessPointObservation is the observed state of an AccessPoint.
+type AccessPointObservation struct {
+	// The unique Amazon Resource Name (ARN) associated with the access point.
+	AccessPointARN *string `json:"accessPointArn,omitempty"`
+
+	// Identifies the lifecycle phase of the access point.
+	LifeCycleState *string `json:"lifeCycleState,omitempty"`
+
+	// Identified the AWS account that owns the access point resource.
+	OwnerID *string `json:"ownerId,omitempty"`
+}
+
+// An AccessPointSpec defines the desired state of an AccessPoint.
+type AccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPointParameters `json:"forProvider"`
+}
+
+// An AccessPointStatus represents the observed state of an AccessPoint.
+type AccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPoint is a managed resource that represents an EFS access point.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointSpec   `json:"spec"`
+	Status AccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointList contains a list of AccessPoints
+type AccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPoint `json:"items"`
+}
+
+// AccessPoint type metadata.
+var (
+	AccessPointKind             = "AccessPoint"
+	AccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: AccessPointKind}.String()
+	AccessPointKindAPIVersion   = AccessPointKind + "." + GroupVersion.String()
+	AccessPointGroupVersionKind = GroupVersion.WithKind(AccessPointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccessPoint{}, &AccessPointList{})
+}
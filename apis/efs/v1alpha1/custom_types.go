@@ -47,4 +47,29 @@ type CustomFileSystemParameters struct {
 	// to set the KMSKeyID.
 	// +optional
 	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// LifecyclePolicies are used by EFS lifecycle management to transition
+	// files to the Infrequent Access (IA) storage class. The lifecycle
+	// configuration is left as it is when none is given.
+	// +optional
+	LifecyclePolicies []LifecyclePolicy `json:"lifecyclePolicies,omitempty"`
+
+	// BackupPolicyEnabled turns automatic backups of the file system with AWS
+	// Backup on or off. The backup policy is left as it is when omitted.
+	// +optional
+	BackupPolicyEnabled *bool `json:"backupPolicyEnabled,omitempty"`
+
+	// Policy is the JSON formatted resource-based policy of the file system.
+	// The policy is left as it is when omitted.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+}
+
+// LifecyclePolicy describes a policy used by EFS lifecycle management to
+// transition files to the Infrequent Access (IA) storage class.
+type LifecyclePolicy struct {
+	// Describes the period of time that a file is not accessed, after which
+	// it transitions to the IA storage class.
+	// +kubebuilder:validation:Enum=AFTER_7_DAYS;AFTER_14_DAYS;AFTER_30_DAYS;AFTER_60_DAYS;AFTER_90_DAYS
+	TransitionToIA string `json:"transitionToIA"`
 }
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MountTargetParameters define the desired state of an EFS MountTarget. The
+// external name of the resource is the ID of the mount target.
+type MountTargetParameters struct {
+	// Region is which region the MountTarget will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the file system for which to create the mount target.
+	// +immutable
+	// +optional
+	FileSystemID *string `json:"fileSystemId,omitempty"`
+
+	// FileSystemIDRef is a reference to a FileSystem used to set the
+	// FileSystemID.
+	// +optional
+	FileSystemIDRef *xpv1.Reference `json:"fileSystemIdRef,omitempty"`
+
+	// FileSystemIDSelector selects a reference to a FileSystem used to set
+	// the FileSystemID.
+	// +optional
+	FileSystemIDSelector *xpv1.Selector `json:"fileSystemIdSelector,omitempty"`
+
+	// The ID of the subnet to add the mount target in.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef is a reference to a Subnet used to set the SubnetID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet used to set the
+	// SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Valid IPv4 address within the address range of the specified subnet.
+	// An address is assigned automatically if it is omitted.
+	// +immutable
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// Up to five VPC security group IDs, of the form sg-xxxxxxxx. These must
+	// be for the same VPC as subnet specified. The default security group of
+	// the VPC is used if none is given.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// SecurityGroupsRefs are references to SecurityGroups used to set the
+	// SecurityGroups.
+	// +optional
+	SecurityGroupsRefs []xpv1.Reference `json:"securityGroupsRefs,omitempty"`
+
+	// SecurityGroupsSelector selects references to SecurityGroups used to set
+	// the SecurityGroups.
+	// +optional
+	SecurityGroupsSelector *xpv1.Selector `json:"securityGroupsSelector,omitempty"`
+}
+
+// MountTargetObservation is the observed state of a MountTarget.
+type MountTargetObservation struct {
+	// The unique and consistent identifier of the Availability Zone that the
+	// mount target resides in.
+	AvailabilityZoneID *string `json:"availabilityZoneId,omitempty"`
+
+	// The name of the Availability Zone in which the mount target is located.
+	AvailabilityZoneName *string `json:"availabilityZoneName,omitempty"`
+
+	// Address at which the file system can be mounted by using the mount
+	// target.
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// Lifecycle state of the mount target.
+	LifeCycleState *string `json:"lifeCycleState,omitempty"`
+
+	// The ID of the network interface that Amazon EFS created when it created
+	// the mount target.
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// AWS account ID that owns the resource.
+	OwnerID *string `json:"ownerId,omitempty"`
+
+	// The virtual private cloud (VPC) ID that the mount target is configured
+	// in.
+	VPCID *string `json:"vpcId,omitempty"`
+}
+
+// A MountTargetSpec defines the desired state of a MountTarget.
+type MountTargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MountTargetParameters `json:"forProvider"`
+}
+
+// A MountTargetStatus represents the observed state of a MountTarget.
+type MountTargetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MountTargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MountTarget is a managed resource that represents an EFS mount target.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MountTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MountTargetSpec   `json:"spec"`
+	Status MountTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MountTargetList contains a list of MountTargets
+type MountTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MountTarget `json:"items"`
+}
+
+// MountTarget type metadata.
+var (
+	MountTargetKind             = "MountTarget"
+	MountTargetGroupKind        = schema.GroupKind{Group: Group, Kind: MountTargetKind}.String()
+	MountTargetKindAPIVersion   = MountTargetKind + "." + GroupVersion.String()
+	MountTargetGroupVersionKind = GroupVersion.WithKind(MountTargetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MountTarget{}, &MountTargetList{})
+}
@@ -26,6 +26,7 @@ const (
 	LifeCycleState_updating  LifeCycleState = "updating"
 	LifeCycleState_deleting  LifeCycleState = "deleting"
 	LifeCycleState_deleted   LifeCycleState = "deleted"
+	LifeCycleState_error     LifeCycleState = "error"
 )
 
 type PerformanceMode string
@@ -29,6 +29,15 @@ type FileSystemParameters struct {
 	// Region is which region the FileSystem will be created.
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
+	// Used to create a file system that uses One Zone storage classes. It specifies
+	// the AWS Availability Zone in which to create the file system. Use the format
+	// us-east-1a to specify the Availability Zone. For more information about One
+	// Zone storage classes, see Using EFS storage classes (https://docs.aws.amazon.com/efs/latest/ug/storage-classes.html)
+	// in the Amazon EFS User Guide.
+	//
+	// One Zone storage classes are not available in all Availability Zones in AWS
+	// Regions where Amazon EFS is available.
+	AvailabilityZoneName *string `json:"availabilityZoneName,omitempty"`
 	// A Boolean value that, if true, creates an encrypted file system. When creating
 	// an encrypted file system, you have the option of specifying CreateFileSystemRequest$KmsKeyId
 	// for an existing AWS Key Management Service (AWS KMS) customer master key
@@ -36,9 +45,9 @@ type FileSystemParameters struct {
 	// is used to protect the encrypted file system.
 	Encrypted *bool `json:"encrypted,omitempty"`
 	// The ID of the AWS KMS CMK to be used to protect the encrypted file system.
-	// This parameter is only required if you want to use a nondefault CMK. If this
-	// parameter is not specified, the default CMK for Amazon EFS is used. This
-	// ID can be in one of the following formats:
+	// This parameter is only required if you want to use a non-default CMK. If
+	// this parameter is not specified, the default CMK for Amazon EFS is used.
+	// This ID can be in one of the following formats:
 	//
 	//    * Key ID - A unique identifier of the key, for example 1234abcd-12ab-34cd-56ef-1234567890ab.
 	//
@@ -60,19 +69,23 @@ type FileSystemParameters struct {
 	// can scale to higher levels of aggregate throughput and operations per second
 	// with a tradeoff of slightly higher latencies for most file operations. The
 	// performance mode can't be changed after the file system has been created.
+	//
+	// The maxIO mode is not supported on file systems using One Zone storage classes.
 	PerformanceMode *string `json:"performanceMode,omitempty"`
 	// A value that specifies to create one or more tags associated with the file
 	// system. Each tag is a user-defined key-value pair. Name your file system
 	// on creation by including a "Key":"Name","Value":"{value}" key-value pair.
 	Tags []*Tag `json:"tags,omitempty"`
-	// The throughput mode for the file system to be created. There are two throughput
-	// modes to choose from for your file system: bursting and provisioned. If you
-	// set ThroughputMode to provisioned, you must also set a value for ProvisionedThroughPutInMibps.
-	// You can decrease your file system's throughput in Provisioned Throughput
-	// mode or change between the throughput modes as long as it’s been more than
-	// 24 hours since the last decrease or throughput mode change. For more, see
-	// Specifying Throughput with Provisioned Mode (https://docs.aws.amazon.com/efs/latest/ug/performance.html#provisioned-throughput)
+	// Specifies the throughput mode for the file system, either bursting or provisioned.
+	// If you set ThroughputMode to provisioned, you must also set a value for ProvisionedThroughputInMibps.
+	// After you create the file system, you can decrease your file system's throughput
+	// in Provisioned Throughput mode or change between the throughput modes, as
+	// long as it’s been more than 24 hours since the last decrease or throughput
+	// mode change. For more information, see Specifying throughput with provisioned
+	// mode (https://docs.aws.amazon.com/efs/latest/ug/performance.html#provisioned-throughput)
 	// in the Amazon EFS User Guide.
+	//
+	// Default is bursting.
 	ThroughputMode             *string `json:"throughputMode,omitempty"`
 	CustomFileSystemParameters `json:",inline"`
 }
@@ -85,6 +98,11 @@ type FileSystemSpec struct {
 
 // FileSystemObservation defines the observed state of FileSystem
 type FileSystemObservation struct {
+	// The unique and consistent identifier of the Availability Zone in which the
+	// file system's One Zone storage classes exist. For example, use1-az1 is an
+	// Availability Zone ID for the us-east-1 AWS Region, and it has the same location
+	// in every AWS account.
+	AvailabilityZoneID *string `json:"availabilityZoneID,omitempty"`
 	// The time that the file system was created, in seconds (since 1970-01-01T00:00:00Z).
 	CreationTime *metav1.Time `json:"creationTime,omitempty"`
 	// The opaque string specified in the request.
@@ -25,6 +25,33 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPoint) DeepCopyInto(out *AccessPoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPoint.
+func (in *AccessPoint) DeepCopy() *AccessPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessPointDescription) DeepCopyInto(out *AccessPointDescription) {
 	*out = *in
@@ -66,6 +93,168 @@ func (in *AccessPointDescription) DeepCopy() *AccessPointDescription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointList) DeepCopyInto(out *AccessPointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointList.
+func (in *AccessPointList) DeepCopy() *AccessPointList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointObservation) DeepCopyInto(out *AccessPointObservation) {
+	*out = *in
+	if in.AccessPointARN != nil {
+		in, out := &in.AccessPointARN, &out.AccessPointARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.LifeCycleState != nil {
+		in, out := &in.LifeCycleState, &out.LifeCycleState
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointObservation.
+func (in *AccessPointObservation) DeepCopy() *AccessPointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointParameters) DeepCopyInto(out *AccessPointParameters) {
+	*out = *in
+	if in.FileSystemID != nil {
+		in, out := &in.FileSystemID, &out.FileSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FileSystemIDRef != nil {
+		in, out := &in.FileSystemIDRef, &out.FileSystemIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FileSystemIDSelector != nil {
+		in, out := &in.FileSystemIDSelector, &out.FileSystemIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PosixUser != nil {
+		in, out := &in.PosixUser, &out.PosixUser
+		*out = new(PosixUser)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootDirectory != nil {
+		in, out := &in.RootDirectory, &out.RootDirectory
+		*out = new(RootDirectory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointParameters.
+func (in *AccessPointParameters) DeepCopy() *AccessPointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointSpec) DeepCopyInto(out *AccessPointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointSpec.
+func (in *AccessPointSpec) DeepCopy() *AccessPointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointStatus) DeepCopyInto(out *AccessPointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointStatus.
+func (in *AccessPointStatus) DeepCopy() *AccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreationInfo) DeepCopyInto(out *CreationInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreationInfo.
+func (in *CreationInfo) DeepCopy() *CreationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CreationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomFileSystemParameters) DeepCopyInto(out *CustomFileSystemParameters) {
 	*out = *in
@@ -84,6 +273,21 @@ func (in *CustomFileSystemParameters) DeepCopyInto(out *CustomFileSystemParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LifecyclePolicies != nil {
+		in, out := &in.LifecyclePolicies, &out.LifecyclePolicies
+		*out = make([]LifecyclePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackupPolicyEnabled != nil {
+		in, out := &in.BackupPolicyEnabled, &out.BackupPolicyEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFileSystemParameters.
@@ -158,6 +362,11 @@ func (in *FileSystemList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileSystemObservation) DeepCopyInto(out *FileSystemObservation) {
 	*out = *in
+	if in.AvailabilityZoneID != nil {
+		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
+		*out = new(string)
+		**out = **in
+	}
 	if in.CreationTime != nil {
 		in, out := &in.CreationTime, &out.CreationTime
 		*out = (*in).DeepCopy()
@@ -217,6 +426,11 @@ func (in *FileSystemObservation) DeepCopy() *FileSystemObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileSystemParameters) DeepCopyInto(out *FileSystemParameters) {
 	*out = *in
+	if in.AvailabilityZoneName != nil {
+		in, out := &in.AvailabilityZoneName, &out.AvailabilityZoneName
+		*out = new(string)
+		**out = **in
+	}
 	if in.Encrypted != nil {
 		in, out := &in.Encrypted, &out.Encrypted
 		*out = new(bool)
@@ -329,6 +543,274 @@ func (in *FileSystemStatus) DeepCopy() *FileSystemStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicy) DeepCopyInto(out *LifecyclePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicy.
+func (in *LifecyclePolicy) DeepCopy() *LifecyclePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountTarget) DeepCopyInto(out *MountTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountTarget.
+func (in *MountTarget) DeepCopy() *MountTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MountTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MountTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountTargetList) DeepCopyInto(out *MountTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MountTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountTargetList.
+func (in *MountTargetList) DeepCopy() *MountTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(MountTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MountTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountTargetObservation) DeepCopyInto(out *MountTargetObservation) {
+	*out = *in
+	if in.AvailabilityZoneID != nil {
+		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZoneName != nil {
+		in, out := &in.AvailabilityZoneName, &out.AvailabilityZoneName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.LifeCycleState != nil {
+		in, out := &in.LifeCycleState, &out.LifeCycleState
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountTargetObservation.
+func (in *MountTargetObservation) DeepCopy() *MountTargetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MountTargetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountTargetParameters) DeepCopyInto(out *MountTargetParameters) {
+	*out = *in
+	if in.FileSystemID != nil {
+		in, out := &in.FileSystemID, &out.FileSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FileSystemIDRef != nil {
+		in, out := &in.FileSystemIDRef, &out.FileSystemIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FileSystemIDSelector != nil {
+		in, out := &in.FileSystemIDSelector, &out.FileSystemIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupsRefs != nil {
+		in, out := &in.SecurityGroupsRefs, &out.SecurityGroupsRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupsSelector != nil {
+		in, out := &in.SecurityGroupsSelector, &out.SecurityGroupsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountTargetParameters.
+func (in *MountTargetParameters) DeepCopy() *MountTargetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MountTargetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountTargetSpec) DeepCopyInto(out *MountTargetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountTargetSpec.
+func (in *MountTargetSpec) DeepCopy() *MountTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MountTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountTargetStatus) DeepCopyInto(out *MountTargetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountTargetStatus.
+func (in *MountTargetStatus) DeepCopy() *MountTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MountTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PosixUser) DeepCopyInto(out *PosixUser) {
+	*out = *in
+	if in.SecondaryGIDs != nil {
+		in, out := &in.SecondaryGIDs, &out.SecondaryGIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PosixUser.
+func (in *PosixUser) DeepCopy() *PosixUser {
+	if in == nil {
+		return nil
+	}
+	out := new(PosixUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RootDirectory) DeepCopyInto(out *RootDirectory) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationInfo != nil {
+		in, out := &in.CreationInfo, &out.CreationInfo
+		*out = new(CreationInfo)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootDirectory.
+func (in *RootDirectory) DeepCopy() *RootDirectory {
+	if in == nil {
+		return nil
+	}
+	out := new(RootDirectory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AddonStatusType is a type of Addon status.
+type AddonStatusType string
+
+// Types of Addon status.
+const (
+	AddonStatusCreating     AddonStatusType = "CREATING"
+	AddonStatusActive       AddonStatusType = "ACTIVE"
+	AddonStatusCreateFailed AddonStatusType = "CREATE_FAILED"
+	AddonStatusUpdating     AddonStatusType = "UPDATING"
+	AddonStatusDeleting     AddonStatusType = "DELETING"
+	AddonStatusDeleteFailed AddonStatusType = "DELETE_FAILED"
+	AddonStatusDegraded     AddonStatusType = "DEGRADED"
+)
+
+// AddonParameters define the desired state of an AWS Elastic Kubernetes
+// Service Addon. The name of the addon, e.g. vpc-cni, coredns, kube-proxy or
+// aws-ebs-csi-driver, is the external name of the resource.
+type AddonParameters struct {
+	// Region is the region you'd like the Addon to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the Amazon EKS cluster to install the addon to.
+	//
+	// ClusterName is a required field
+	// +immutable
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The version of the addon. The version must match one of the versions
+	// returned by DescribeAddonVersions. The default version for the cluster
+	// is installed if omitted.
+	// +optional
+	AddonVersion *string `json:"addonVersion,omitempty"`
+
+	// The Amazon Resource Name (ARN) of an existing IAM role to bind to the
+	// addon's service account. The role must be assigned the IAM permissions
+	// required by the addon.
+	// +optional
+	ServiceAccountRoleARN *string `json:"serviceAccountRoleArn,omitempty"`
+
+	// ServiceAccountRoleARNRef is a reference to an IAMRole used to set
+	// the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNRef *xpv1.Reference `json:"serviceAccountRoleArnRef,omitempty"`
+
+	// ServiceAccountRoleARNSelector selects references to IAMRole used
+	// to set the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNSelector *xpv1.Selector `json:"serviceAccountRoleArnSelector,omitempty"`
+
+	// How to resolve conflicts with existing configuration of the addon in
+	// the cluster when it is created or updated.
+	// +kubebuilder:validation:Enum=OVERWRITE;NONE
+	// +optional
+	ResolveConflicts *string `json:"resolveConflicts,omitempty"`
+
+	// The metadata to apply to the addon to assist with categorization and
+	// organization.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AddonIssue is an issue related to an addon.
+type AddonIssue struct {
+	// A code that describes the type of issue.
+	Code string `json:"code,omitempty"`
+
+	// A message that provides details about the issue and what might cause it.
+	Message string `json:"message,omitempty"`
+
+	// The resource IDs of the issue.
+	ResourceIDs []string `json:"resourceIds,omitempty"`
+}
+
+// AddonObservation is the observed state of an Addon.
+type AddonObservation struct {
+	// The Amazon Resource Name (ARN) of the addon.
+	AddonARN string `json:"addonArn,omitempty"`
+
+	// The Unix epoch timestamp in seconds for when the addon was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The Unix epoch timestamp in seconds for when the addon was last
+	// modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// The issues that affect the health of the addon.
+	HealthIssues []AddonIssue `json:"healthIssues,omitempty"`
+
+	// The current status of the addon.
+	Status AddonStatusType `json:"status,omitempty"`
+}
+
+// An AddonSpec defines the desired state of an EKS Addon.
+type AddonSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AddonParameters `json:"forProvider"`
+}
+
+// An AddonStatus represents the observed state of an EKS Addon.
+type AddonStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AddonObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Addon is a managed resource that represents an AWS Elastic Kubernetes
+// Service Addon.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.addonVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Addon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AddonSpec   `json:"spec"`
+	Status AddonStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AddonList contains a list of Addon items
+type AddonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Addon `json:"items"`
+}
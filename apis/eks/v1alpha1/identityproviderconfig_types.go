@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IdentityProviderConfigStatusType is a type of IdentityProviderConfig
+// status.
+type IdentityProviderConfigStatusType string
+
+// Types of IdentityProviderConfig status.
+const (
+	IdentityProviderConfigStatusCreating IdentityProviderConfigStatusType = "CREATING"
+	IdentityProviderConfigStatusDeleting IdentityProviderConfigStatusType = "DELETING"
+	IdentityProviderConfigStatusActive   IdentityProviderConfigStatusType = "ACTIVE"
+)
+
+// OIDCIdentityProviderConfig is the configuration of an OpenID Connect
+// identity provider.
+type OIDCIdentityProviderConfig struct {
+	// This is also known as audience. The ID for the client application that
+	// makes authentication requests to the OpenID identity provider.
+	ClientID string `json:"clientId"`
+
+	// The URL of the OpenID identity provider that allows the API server to
+	// discover public signing keys for verifying tokens. The URL must begin
+	// with https://.
+	IssuerURL string `json:"issuerUrl"`
+
+	// The JWT claim that the provider uses to return your groups.
+	// +optional
+	GroupsClaim *string `json:"groupsClaim,omitempty"`
+
+	// The prefix that is prepended to group claims to prevent clashes with
+	// existing names (such as system: groups).
+	// +optional
+	GroupsPrefix *string `json:"groupsPrefix,omitempty"`
+
+	// The key value pairs that describe required claims in the identity token.
+	// If set, each claim is verified to be present in the token with a
+	// matching value.
+	// +optional
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
+
+	// The JSON Web Token (JWT) claim to use as the username. The default is
+	// sub.
+	// +optional
+	UsernameClaim *string `json:"usernameClaim,omitempty"`
+
+	// The prefix that is prepended to username claims to prevent clashes with
+	// existing names.
+	// +optional
+	UsernamePrefix *string `json:"usernamePrefix,omitempty"`
+}
+
+// IdentityProviderConfigParameters define the desired state of an AWS
+// Elastic Kubernetes Service IdentityProviderConfig. Only the tags can be
+// updated after the identity provider config is associated.
+type IdentityProviderConfigParameters struct {
+	// Region is the region you'd like the IdentityProviderConfig to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the Amazon EKS cluster to associate the identity provider
+	// config with.
+	//
+	// ClusterName is a required field
+	// +immutable
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// OIDC is the configuration of the OpenID Connect identity provider.
+	// +immutable
+	OIDC OIDCIdentityProviderConfig `json:"oidc"`
+
+	// The metadata to apply to the identity provider config to assist with
+	// categorization and organization.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// IdentityProviderConfigObservation is the observed state of an
+// IdentityProviderConfig.
+type IdentityProviderConfigObservation struct {
+	// The Amazon Resource Name (ARN) of the identity provider config.
+	IdentityProviderConfigARN string `json:"identityProviderConfigArn,omitempty"`
+
+	// The current status of the identity provider config.
+	Status IdentityProviderConfigStatusType `json:"status,omitempty"`
+}
+
+// An IdentityProviderConfigSpec defines the desired state of an EKS
+// IdentityProviderConfig.
+type IdentityProviderConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IdentityProviderConfigParameters `json:"forProvider"`
+}
+
+// An IdentityProviderConfigStatus represents the observed state of an EKS
+// IdentityProviderConfig.
+type IdentityProviderConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IdentityProviderConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IdentityProviderConfig is a managed resource that represents an OpenID
+// Connect identity provider associated with an AWS Elastic Kubernetes Service
+// Cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IdentityProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentityProviderConfigSpec   `json:"spec"`
+	Status IdentityProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityProviderConfigList contains a list of IdentityProviderConfig items
+type IdentityProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityProviderConfig `json:"items"`
+}
@@ -256,6 +256,30 @@ type ClusterObservation struct {
 
 	// The current status of the cluster.
 	Status ClusterStatusType `json:"status,omitempty"`
+
+	// VersionUpdate is the progress of the most recent Kubernetes version
+	// update of the cluster.
+	// +optional
+	VersionUpdate *ClusterUpdate `json:"versionUpdate,omitempty"`
+}
+
+// ClusterUpdate is the observed state of an update of a cluster.
+type ClusterUpdate struct {
+	// ID of the update.
+	ID string `json:"id"`
+
+	// The type of the update.
+	Type string `json:"type,omitempty"`
+
+	// The current status of the update. One of InProgress, Failed,
+	// Cancelled or Successful.
+	Status string `json:"status,omitempty"`
+
+	// The Unix epoch timestamp in seconds for when the update was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// Any errors associated with a Failed update.
+	Errors []string `json:"errors,omitempty"`
 }
 
 // Identity is the identity information for a cluster.
@@ -41,6 +41,26 @@ type IAMAccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// RotationPolicy configures the periodic replacement of the access key.
+	// The access key is not rotated if omitted.
+	// +optional
+	RotationPolicy *AccessKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+// AccessKeyRotationPolicy configures how often an access key is replaced and
+// how long a replaced access key remains usable.
+type AccessKeyRotationPolicy struct {
+	// RotationPeriod is the age at which the access key is replaced by a new
+	// one, e.g. 720h. The connection secret is updated with the new access
+	// key.
+	RotationPeriod metav1.Duration `json:"rotationPeriod"`
+
+	// GracePeriod is how long a replaced access key stays active so that its
+	// consumers can pick up the new one. The replaced access key is then
+	// deactivated, and deleted after another grace period. Defaults to 24h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
 }
 
 // An IAMAccessKeySpec defines the desired state of an IAM Access Key.
@@ -49,9 +69,36 @@ type IAMAccessKeySpec struct {
 	ForProvider       IAMAccessKeyParameters `json:"forProvider"`
 }
 
+// AnnotationKeyRetiredAccessKeys records the access keys that were replaced by
+// a rotation of an IAMAccessKey as a JSON list of RetiredAccessKeys. It is
+// persisted together with the new access key ID, so that a replaced access key
+// is never lost track of.
+const AnnotationKeyRetiredAccessKeys = "identity.aws.crossplane.io/retired-access-keys"
+
+// RetiredAccessKey is an access key that was replaced by a rotation.
+type RetiredAccessKey struct {
+	// AccessKeyID is the ID of the replaced access key.
+	AccessKeyID string `json:"accessKeyId"`
+
+	// RotatedAt is the time at which the access key was replaced.
+	RotatedAt metav1.Time `json:"rotatedAt"`
+}
+
+// IAMAccessKeyObservation keeps the state for the external resource
+type IAMAccessKeyObservation struct {
+	// CreateDate is the time at which the current access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// RetiredAccessKeys are the access keys that were replaced by a rotation
+	// and have not been deleted yet. They are tracked by the
+	// identity.aws.crossplane.io/retired-access-keys annotation.
+	RetiredAccessKeys []RetiredAccessKey `json:"retiredAccessKeys,omitempty"`
+}
+
 // IAMAccessKeyStatus represents the observed state of an IAM Access Key.
 type IAMAccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IAMAccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -27,6 +27,12 @@ type IAMGroupParameters struct {
 	// The path for the group name.
 	// +optional
 	Path *string `json:"path,omitempty"`
+
+	// InlinePolicies are the policy documents embedded in the group, keyed by
+	// policy name. When set, inline policies of the group that are not listed
+	// here are deleted.
+	// +optional
+	InlinePolicies map[string]string `json:"inlinePolicies,omitempty"`
 }
 
 // An IAMGroupSpec defines the desired state of an IAM Group.
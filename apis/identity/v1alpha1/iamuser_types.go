@@ -36,6 +36,12 @@ type IAMUserParameters struct {
 	// A list of tags that you want to attach to the newly created user.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// InlinePolicies are the policy documents embedded in the user, keyed by
+	// policy name. When set, inline policies of the user that are not listed
+	// here are deleted.
+	// +optional
+	InlinePolicies map[string]string `json:"inlinePolicies,omitempty"`
 }
 
 // An IAMUserSpec defines the desired state of an IAM User.
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IAMUserLoginProfileParameters define the desired state of an AWS IAM User
+// login profile.
+type IAMUserLoginProfileParameters struct {
+	// UserName presents the name of the IAMUser.
+	// +immutable
+	UserName string `json:"userName,omitempty"`
+
+	// UserNameRef references to an IAMUser to retrieve its userName
+	// +optional
+	UserNameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UserNameSelector selects a reference to an IAMUser to retrieve its userName
+	// +optional
+	UserNameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// PasswordResetRequired specifies whether the user must set a new
+	// password when they sign in for the first time.
+	// +optional
+	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
+
+	// PasswordLength is the length of the generated initial password.
+	// Defaults to 20.
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=128
+	// +immutable
+	// +optional
+	PasswordLength *int `json:"passwordLength,omitempty"`
+
+	// PGPKey is a base64 encoded PGP public key that the initial password is
+	// encrypted with before it is written to the connection secret as
+	// encryptedPassword, along with the keyFingerprint. The password is
+	// written in plain text if omitted.
+	// +immutable
+	// +optional
+	PGPKey *string `json:"pgpKey,omitempty"`
+}
+
+// An IAMUserLoginProfileSpec defines the desired state of an
+// IAMUserLoginProfile.
+type IAMUserLoginProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IAMUserLoginProfileParameters `json:"forProvider"`
+}
+
+// IAMUserLoginProfileObservation keeps the state for the external resource
+type IAMUserLoginProfileObservation struct {
+	// CreateDate is the time at which the login profile was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// An IAMUserLoginProfileStatus represents the observed state of an
+// IAMUserLoginProfile.
+type IAMUserLoginProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IAMUserLoginProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IAMUserLoginProfile is a managed resource that represents the console
+// password of an AWS IAM User. The generated initial password is written to
+// the connection secret.
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IAMUserLoginProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IAMUserLoginProfileSpec   `json:"spec"`
+	Status IAMUserLoginProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IAMUserLoginProfileList contains a list of IAMUserLoginProfiles
+type IAMUserLoginProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IAMUserLoginProfile `json:"items"`
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// InstanceProfileParameters define the desired state of an AWS IAM instance
+// profile.
+type InstanceProfileParameters struct {
+
+	// Path is the path to the instance profile.
+	// Default: /
+	// +immutable
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// RoleName is the name of the IAM role that is passed to the EC2
+	// instances launched with the instance profile. An instance profile can
+	// contain only one role.
+	// +optional
+	RoleName *string `json:"roleName,omitempty"`
+
+	// RoleNameRef references an IAMRole to retrieve its Name
+	// +optional
+	RoleNameRef *xpv1.Reference `json:"roleNameRef,omitempty"`
+
+	// RoleNameSelector selects a reference to an IAMRole to retrieve its Name
+	// +optional
+	RoleNameSelector *xpv1.Selector `json:"roleNameSelector,omitempty"`
+}
+
+// An InstanceProfileSpec defines the desired state of an InstanceProfile.
+type InstanceProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceProfileParameters `json:"forProvider,omitempty"`
+}
+
+// InstanceProfileObservation keeps the state for the external resource
+type InstanceProfileObservation struct {
+	// ARN is the Amazon Resource Name (ARN) specifying the instance profile.
+	ARN string `json:"arn,omitempty"`
+
+	// InstanceProfileID is the stable and unique string identifying the
+	// instance profile.
+	InstanceProfileID string `json:"instanceProfileId,omitempty"`
+
+	// RoleARN is the ARN of the role that is contained in the instance
+	// profile.
+	RoleARN string `json:"roleArn,omitempty"`
+}
+
+// An InstanceProfileStatus represents the observed state of an
+// InstanceProfile.
+type InstanceProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceProfile is a managed resource that represents an AWS IAM
+// instance profile, a container for an IAM role that is passed to EC2
+// instances.
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="ROLENAME",type="string",JSONPath=".spec.forProvider.roleName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type InstanceProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceProfileSpec   `json:"spec"`
+	Status InstanceProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceProfileList contains a list of InstanceProfiles
+type InstanceProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceProfile `json:"items"`
+}
@@ -48,6 +48,17 @@ func IAMUserARN() reference.ExtractValueFn {
 	}
 }
 
+// InstanceProfileARN returns the status.atProvider.ARN of an InstanceProfile.
+func InstanceProfileARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*InstanceProfile)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
 // ResolveReferences of this IAMUserPolicyAttachment
 func (mg *IAMUserPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -173,3 +184,24 @@ func (mg *IAMAccessKey) ResolveReferences(ctx context.Context, c client.Reader)
 
 	return nil
 }
+
+// ResolveReferences of this IAMUserLoginProfile
+func (mg *IAMUserLoginProfile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.userName
+	user, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.UserName,
+		Reference:    mg.Spec.ForProvider.UserNameRef,
+		Selector:     mg.Spec.ForProvider.UserNameSelector,
+		To:           reference.To{Managed: &IAMUser{}, List: &IAMUserList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.userName")
+	}
+	mg.Spec.ForProvider.UserName = user.ResolvedValue
+	mg.Spec.ForProvider.UserNameRef = user.ResolvedReference
+
+	return nil
+}
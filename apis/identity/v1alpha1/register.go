@@ -88,6 +88,14 @@ var (
 	IAMGroupPolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(IAMGroupPolicyAttachmentKind)
 )
 
+// InstanceProfile type metadata.
+var (
+	InstanceProfileKind             = reflect.TypeOf(InstanceProfile{}).Name()
+	InstanceProfileGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceProfileKind}.String()
+	InstanceProfileKindAPIVersion   = InstanceProfileKind + "." + SchemeGroupVersion.String()
+	InstanceProfileGroupVersionKind = SchemeGroupVersion.WithKind(InstanceProfileKind)
+)
+
 // IAMAccessKey type metadata.
 var (
 	IAMAccessKeyKind             = reflect.TypeOf(IAMAccessKey{}).Name()
@@ -104,6 +112,14 @@ var (
 	OpenIDConnectProviderGroupVersionKind = SchemeGroupVersion.WithKind(OpenIDConnectProviderKind)
 )
 
+// IAMUserLoginProfile type metadata.
+var (
+	IAMUserLoginProfileKind             = reflect.TypeOf(IAMUserLoginProfile{}).Name()
+	IAMUserLoginProfileGroupKind        = schema.GroupKind{Group: Group, Kind: IAMUserLoginProfileKind}.String()
+	IAMUserLoginProfileKindAPIVersion   = IAMUserLoginProfileKind + "." + SchemeGroupVersion.String()
+	IAMUserLoginProfileGroupVersionKind = SchemeGroupVersion.WithKind(IAMUserLoginProfileKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&IAMUser{}, &IAMUserList{})
 	SchemeBuilder.Register(&IAMPolicy{}, &IAMPolicyList{})
@@ -113,4 +129,6 @@ func init() {
 	SchemeBuilder.Register(&IAMGroupPolicyAttachment{}, &IAMGroupPolicyAttachmentList{})
 	SchemeBuilder.Register(&IAMAccessKey{}, &IAMAccessKeyList{})
 	SchemeBuilder.Register(&OpenIDConnectProvider{}, &OpenIDConnectProviderList{})
+	SchemeBuilder.Register(&IAMUserLoginProfile{}, &IAMUserLoginProfileList{})
+	SchemeBuilder.Register(&InstanceProfile{}, &InstanceProfileList{})
 }
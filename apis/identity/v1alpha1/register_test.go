@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestAddToScheme(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %s", err)
+	}
+
+	for _, gvk := range []schema.GroupVersionKind{
+		IAMUserGroupVersionKind,
+		IAMPolicyGroupVersionKind,
+		IAMUserPolicyAttachmentGroupVersionKind,
+		IAMGroupGroupVersionKind,
+		IAMGroupUserMembershipGroupVersionKind,
+		IAMGroupPolicyAttachmentGroupVersionKind,
+		IAMAccessKeyGroupVersionKind,
+		OpenIDConnectProviderGroupVersionKind,
+		IAMUserLoginProfileGroupVersionKind,
+		InstanceProfileGroupVersionKind,
+	} {
+		if !s.Recognizes(gvk) {
+			t.Errorf("Recognizes(%s): want true, got false", gvk)
+		}
+		list := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+		if !s.Recognizes(list) {
+			t.Errorf("Recognizes(%s): want true, got false", list)
+		}
+	}
+}
@@ -22,9 +22,31 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyRotationPolicy) DeepCopyInto(out *AccessKeyRotationPolicy) {
+	*out = *in
+	out.RotationPeriod = in.RotationPeriod
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyRotationPolicy.
+func (in *AccessKeyRotationPolicy) DeepCopy() *AccessKeyRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMAccessKey) DeepCopyInto(out *IAMAccessKey) {
 	*out = *in
@@ -84,6 +106,32 @@ func (in *IAMAccessKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeyObservation) DeepCopyInto(out *IAMAccessKeyObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.RetiredAccessKeys != nil {
+		in, out := &in.RetiredAccessKeys, &out.RetiredAccessKeys
+		*out = make([]RetiredAccessKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyObservation.
+func (in *IAMAccessKeyObservation) DeepCopy() *IAMAccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMAccessKeyParameters) DeepCopyInto(out *IAMAccessKeyParameters) {
 	*out = *in
@@ -97,6 +145,11 @@ func (in *IAMAccessKeyParameters) DeepCopyInto(out *IAMAccessKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RotationPolicy != nil {
+		in, out := &in.RotationPolicy, &out.RotationPolicy
+		*out = new(AccessKeyRotationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyParameters.
@@ -130,6 +183,7 @@ func (in *IAMAccessKeySpec) DeepCopy() *IAMAccessKeySpec {
 func (in *IAMAccessKeyStatus) DeepCopyInto(out *IAMAccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyStatus.
@@ -224,6 +278,13 @@ func (in *IAMGroupParameters) DeepCopyInto(out *IAMGroupParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMGroupParameters.
@@ -748,6 +809,158 @@ func (in *IAMUserList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMUserLoginProfile) DeepCopyInto(out *IAMUserLoginProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserLoginProfile.
+func (in *IAMUserLoginProfile) DeepCopy() *IAMUserLoginProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMUserLoginProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMUserLoginProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMUserLoginProfileList) DeepCopyInto(out *IAMUserLoginProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IAMUserLoginProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserLoginProfileList.
+func (in *IAMUserLoginProfileList) DeepCopy() *IAMUserLoginProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMUserLoginProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMUserLoginProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMUserLoginProfileObservation) DeepCopyInto(out *IAMUserLoginProfileObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserLoginProfileObservation.
+func (in *IAMUserLoginProfileObservation) DeepCopy() *IAMUserLoginProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMUserLoginProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMUserLoginProfileParameters) DeepCopyInto(out *IAMUserLoginProfileParameters) {
+	*out = *in
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordResetRequired != nil {
+		in, out := &in.PasswordResetRequired, &out.PasswordResetRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PasswordLength != nil {
+		in, out := &in.PasswordLength, &out.PasswordLength
+		*out = new(int)
+		**out = **in
+	}
+	if in.PGPKey != nil {
+		in, out := &in.PGPKey, &out.PGPKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserLoginProfileParameters.
+func (in *IAMUserLoginProfileParameters) DeepCopy() *IAMUserLoginProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMUserLoginProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMUserLoginProfileSpec) DeepCopyInto(out *IAMUserLoginProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserLoginProfileSpec.
+func (in *IAMUserLoginProfileSpec) DeepCopy() *IAMUserLoginProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMUserLoginProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMUserLoginProfileStatus) DeepCopyInto(out *IAMUserLoginProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserLoginProfileStatus.
+func (in *IAMUserLoginProfileStatus) DeepCopy() *IAMUserLoginProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMUserLoginProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMUserObservation) DeepCopyInto(out *IAMUserObservation) {
 	*out = *in
@@ -781,6 +994,13 @@ func (in *IAMUserParameters) DeepCopyInto(out *IAMUserParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserParameters.
@@ -970,6 +1190,149 @@ func (in *IAMUserStatus) DeepCopy() *IAMUserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfile) DeepCopyInto(out *InstanceProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfile.
+func (in *InstanceProfile) DeepCopy() *InstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfileList) DeepCopyInto(out *InstanceProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfileList.
+func (in *InstanceProfileList) DeepCopy() *InstanceProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfileObservation) DeepCopyInto(out *InstanceProfileObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfileObservation.
+func (in *InstanceProfileObservation) DeepCopy() *InstanceProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfileParameters) DeepCopyInto(out *InstanceProfileParameters) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleName != nil {
+		in, out := &in.RoleName, &out.RoleName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleNameRef != nil {
+		in, out := &in.RoleNameRef, &out.RoleNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RoleNameSelector != nil {
+		in, out := &in.RoleNameSelector, &out.RoleNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfileParameters.
+func (in *InstanceProfileParameters) DeepCopy() *InstanceProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfileSpec) DeepCopyInto(out *InstanceProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfileSpec.
+func (in *InstanceProfileSpec) DeepCopy() *InstanceProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfileStatus) DeepCopyInto(out *InstanceProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfileStatus.
+func (in *InstanceProfileStatus) DeepCopy() *InstanceProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenIDConnectProvider) DeepCopyInto(out *OpenIDConnectProvider) {
 	*out = *in
@@ -1107,6 +1470,22 @@ func (in *OpenIDConnectProviderStatus) DeepCopy() *OpenIDConnectProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetiredAccessKey) DeepCopyInto(out *RetiredAccessKey) {
+	*out = *in
+	in.RotatedAt.DeepCopyInto(&out.RotatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetiredAccessKey.
+func (in *RetiredAccessKey) DeepCopy() *RetiredAccessKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RetiredAccessKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
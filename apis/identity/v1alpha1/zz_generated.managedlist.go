@@ -74,6 +74,15 @@ func (l *IAMUserList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this IAMUserLoginProfileList.
+func (l *IAMUserLoginProfileList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this IAMUserPolicyAttachmentList.
 func (l *IAMUserPolicyAttachmentList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -83,6 +92,15 @@ func (l *IAMUserPolicyAttachmentList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this InstanceProfileList.
+func (l *InstanceProfileList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OpenIDConnectProviderList.
 func (l *OpenIDConnectProviderList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
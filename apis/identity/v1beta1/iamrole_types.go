@@ -80,6 +80,12 @@ type IAMRoleParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// InlinePolicies are the policy documents embedded in the role, keyed by
+	// policy name. When set, inline policies of the role that are not listed
+	// here are deleted.
+	// +optional
+	InlinePolicies map[string]string `json:"inlinePolicies,omitempty"`
 }
 
 // An IAMRoleSpec defines the desired state of an IAMRole.
@@ -26,6 +26,7 @@ import (
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -109,3 +110,14 @@ func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// FunctionARN returns the status.atProvider.functionARN of a Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.FunctionARN)
+	}
+}
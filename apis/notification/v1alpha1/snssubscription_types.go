@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -74,6 +75,19 @@ type SNSSubscriptionParameters struct {
 	//  analysis or reprocessing.
 	// +optional
 	RedrivePolicy *string `json:"redrivePolicy,omitempty"`
+
+	// ConfirmationTokenSecretRef references a secret key holding the token
+	// that was sent to the endpoint of a subscription that is pending
+	// confirmation, such as the token of an HTTP/S or email subscription. The
+	// subscription is confirmed with it.
+	// +optional
+	ConfirmationTokenSecretRef *xpv1.SecretKeySelector `json:"confirmationTokenSecretRef,omitempty"`
+
+	// AuthenticateOnUnsubscribe disallows unauthenticated unsubscribes of
+	// the subscription when it is confirmed. Only the topic owner and the
+	// subscription owner can then unsubscribe the endpoint.
+	// +optional
+	AuthenticateOnUnsubscribe *bool `json:"authenticateOnUnsubscribe,omitempty"`
 }
 
 // SNSSubscriptionSpec defined the desired state of a AWS SNS Topic
@@ -92,6 +106,38 @@ const (
 	ConfirmationSuccessful ConfirmationStatus = "Confirmed"
 )
 
+// TypeConfirmed indicates whether the endpoint of a SNSSubscription has
+// confirmed the subscription.
+const TypeConfirmed xpv1.ConditionType = "Confirmed"
+
+// Reasons a SNSSubscription is or is not confirmed.
+const (
+	ReasonConfirmed           xpv1.ConditionReason = "Confirmed"
+	ReasonPendingConfirmation xpv1.ConditionReason = "PendingConfirmation"
+)
+
+// Confirmed returns a condition that indicates the SNSSubscription has been
+// confirmed.
+func Confirmed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConfirmed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConfirmed,
+	}
+}
+
+// PendingConfirmation returns a condition that indicates the SNSSubscription
+// has not been confirmed by its endpoint yet.
+func PendingConfirmation() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConfirmed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPendingConfirmation,
+	}
+}
+
 // SNSSubscriptionObservation represents the observed state of a AWS SNS Topic
 type SNSSubscriptionObservation struct {
 
@@ -124,6 +170,7 @@ type SNSSubscriptionStatus struct {
 // +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".spec.forProvider.endpoint"
 // +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="CONFIRMED",type="string",JSONPath=".status.conditions[?(@.type=='Confirmed')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -54,6 +54,18 @@ type SNSTopicParameters struct {
 	// +immutable
 	Name string `json:"name"`
 
+	// FifoTopic creates a FIFO topic. The name of a FIFO topic must end
+	// with the .fifo suffix.
+	// +immutable
+	// +optional
+	FifoTopic *bool `json:"fifoTopic,omitempty"`
+
+	// ContentBasedDeduplication enables content-based deduplication for a
+	// FIFO topic. Messages are deduplicated using a SHA-256 hash of their
+	// body instead of an explicit deduplication ID.
+	// +optional
+	ContentBasedDeduplication *bool `json:"contentBasedDeduplication,omitempty"`
+
 	// The display name to use for a topic with SNS subscriptions.
 	// +optional
 	DisplayName *string `json:"displayName,omitempty"`
@@ -80,7 +92,6 @@ type SNSTopicParameters struct {
 	// SNS Topic. For more information about tagging,
 	// see Tagging SNS Topics (https://docs.aws.amazon.com/sns/latest/dg/sns-tags.html)
 	// in the SNS User Guide.
-	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
 }
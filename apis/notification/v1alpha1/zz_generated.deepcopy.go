@@ -147,6 +147,16 @@ func (in *SNSSubscriptionParameters) DeepCopyInto(out *SNSSubscriptionParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConfirmationTokenSecretRef != nil {
+		in, out := &in.ConfirmationTokenSecretRef, &out.ConfirmationTokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.AuthenticateOnUnsubscribe != nil {
+		in, out := &in.AuthenticateOnUnsubscribe, &out.AuthenticateOnUnsubscribe
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSSubscriptionParameters.
@@ -290,6 +300,16 @@ func (in *SNSTopicObservation) DeepCopy() *SNSTopicObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNSTopicParameters) DeepCopyInto(out *SNSTopicParameters) {
 	*out = *in
+	if in.FifoTopic != nil {
+		in, out := &in.FifoTopic, &out.FifoTopic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContentBasedDeduplication != nil {
+		in, out := &in.ContentBasedDeduplication, &out.ContentBasedDeduplication
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DisplayName != nil {
 		in, out := &in.DisplayName, &out.DisplayName
 		*out = new(string)
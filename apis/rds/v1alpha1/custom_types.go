@@ -105,6 +105,19 @@ type CustomDBClusterParameters struct {
 	// +immutable
 	// +optional
 	SkipFinalSnapshot bool `json:"skipFinalSnapshot,omitempty"`
+
+	// ApplyImmediately specifies whether the modifications of the DB cluster
+	// are applied as soon as possible, regardless of the
+	// PreferredMaintenanceWindow setting. Otherwise they are applied during
+	// the next maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// AllowMajorVersionUpgrade indicates that major version upgrades are
+	// allowed. It must be set when EngineVersion is changed to a version
+	// with a different major version than the current one.
+	// +optional
+	AllowMajorVersionUpgrade *bool `json:"allowMajorVersionUpgrade,omitempty"`
 }
 
 // CustomGlobalClusterParameters are custom parameters for a GlobalCluster
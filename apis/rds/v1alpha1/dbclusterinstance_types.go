@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DBClusterInstanceParameters define the desired state of an instance of an
+// Aurora DB cluster. The DB instance identifier is the external name of the
+// resource.
+type DBClusterInstanceParameters struct {
+	// Region is which region the DBClusterInstance will be created.
+	// +immutable
+	Region string `json:"region"`
+
+	// The identifier of the DB cluster that the instance will belong to.
+	// +immutable
+	// +optional
+	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
+
+	// DBClusterIdentifierRef is a reference to a DBCluster used to set
+	// DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *xpv1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster used to
+	// set DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *xpv1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
+	// The compute and memory capacity of the DB instance, for example,
+	// db.r5.large. Not all DB instance classes are available in all AWS
+	// Regions, or for all database engines.
+	DBInstanceClass string `json:"dbInstanceClass"`
+
+	// The name of the database engine of the instance. It must match the
+	// engine of the DB cluster, which is used if omitted.
+	// +immutable
+	// +optional
+	Engine *string `json:"engine,omitempty"`
+
+	// A value that specifies the order in which an Aurora Replica is promoted
+	// to the primary instance after a failure of the existing primary
+	// instance.
+	//
+	// Default: 1
+	//
+	// Valid Values: 0 - 15
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=15
+	// +optional
+	PromotionTier *int64 `json:"promotionTier,omitempty"`
+
+	// The Availability Zone (AZ) where the instance will be created. A random
+	// Availability Zone of the DB cluster is chosen if omitted.
+	// +immutable
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// The name of the DB parameter group to associate with this DB instance.
+	// The default DB parameter group of the engine is used if omitted.
+	// +optional
+	DBParameterGroupName *string `json:"dbParameterGroupName,omitempty"`
+
+	// A value that indicates whether the DB instance is publicly accessible.
+	// +optional
+	PubliclyAccessible *bool `json:"publiclyAccessible,omitempty"`
+
+	// A value that indicates whether minor engine upgrades are applied
+	// automatically to the DB instance during the maintenance window.
+	// +optional
+	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
+
+	// The time range each week during which system maintenance can occur, in
+	// Universal Coordinated Time (UTC).
+	//
+	// Format: ddd:hh24:mi-ddd:hh24:mi
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// The identifier of the CA certificate for this DB instance.
+	// +optional
+	CACertificateIdentifier *string `json:"caCertificateIdentifier,omitempty"`
+
+	// The interval, in seconds, between points when Enhanced Monitoring
+	// metrics are collected for the DB instance. To disable collecting
+	// Enhanced Monitoring metrics, specify 0.
+	//
+	// Valid Values: 0, 1, 5, 10, 15, 30, 60
+	// +optional
+	MonitoringInterval *int64 `json:"monitoringInterval,omitempty"`
+
+	// The ARN for the IAM role that permits RDS to send enhanced monitoring
+	// metrics to Amazon CloudWatch Logs.
+	// +optional
+	MonitoringRoleARN *string `json:"monitoringRoleArn,omitempty"`
+
+	// A value that indicates whether to enable Performance Insights for the
+	// DB instance.
+	// +optional
+	EnablePerformanceInsights *bool `json:"enablePerformanceInsights,omitempty"`
+
+	// ApplyImmediately specifies whether the modifications of the DB
+	// instance are applied as soon as possible, regardless of the
+	// PreferredMaintenanceWindow setting. Otherwise they are applied during
+	// the next maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// Tags to assign to the DB instance.
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// DBClusterInstanceObservation is the observed state of a DBClusterInstance.
+type DBClusterInstanceObservation struct {
+	// The Amazon Resource Name (ARN) for the DB instance.
+	DBInstanceARN string `json:"dbInstanceArn,omitempty"`
+
+	// The current state of the DB instance.
+	DBInstanceStatus string `json:"dbInstanceStatus,omitempty"`
+
+	// The AWS Region-unique, immutable identifier for the DB instance.
+	DBIResourceID string `json:"dbiResourceId,omitempty"`
+
+	// The DNS address of the DB instance.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// The port that the DB instance listens on.
+	Port int64 `json:"port,omitempty"`
+
+	// The Availability Zone the DB instance is located in.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// The version of the database engine of the DB instance.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// The time the DB instance was created.
+	InstanceCreateTime *metav1.Time `json:"instanceCreateTime,omitempty"`
+}
+
+// A DBClusterInstanceSpec defines the desired state of a DBClusterInstance.
+type DBClusterInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBClusterInstanceParameters `json:"forProvider"`
+}
+
+// A DBClusterInstanceStatus represents the observed state of a
+// DBClusterInstance.
+type DBClusterInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBClusterInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBClusterInstance is a managed resource that represents a DB instance of
+// an Aurora DB cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.dbClusterIdentifier"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.forProvider.dbInstanceClass"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.dbInstanceStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBClusterInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterInstanceSpec   `json:"spec"`
+	Status DBClusterInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterInstanceList contains a list of DBClusterInstances
+type DBClusterInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBClusterInstance `json:"items"`
+}
+
+// DBClusterInstance type metadata.
+var (
+	DBClusterInstanceKind             = "DBClusterInstance"
+	DBClusterInstanceGroupKind        = schema.GroupKind{Group: Group, Kind: DBClusterInstanceKind}.String()
+	DBClusterInstanceKindAPIVersion   = DBClusterInstanceKind + "." + GroupVersion.String()
+	DBClusterInstanceGroupVersionKind = GroupVersion.WithKind(DBClusterInstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DBClusterInstance{}, &DBClusterInstanceList{})
+}
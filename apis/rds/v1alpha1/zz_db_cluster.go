@@ -107,7 +107,7 @@ type DBClusterParameters struct {
 	//
 	// Aurora PostgreSQL
 	//
-	// Possible values are postgresql and upgrade.
+	// Possible value is postgresql.
 	EnableCloudwatchLogsExports []*string `json:"enableCloudwatchLogsExports,omitempty"`
 	// A value that indicates whether to enable write operations to be forwarded
 	// from this cluster to the primary cluster in an Aurora global database. The
@@ -272,8 +272,8 @@ type DBClusterParameters struct {
 	// backups are enabled using the BackupRetentionPeriod parameter.
 	//
 	// The default is a 30-minute window selected at random from an 8-hour block
-	// of time for each AWS Region. To see the time blocks available, see Adjusting
-	// the Preferred DB Cluster Maintenance Window (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/USER_UpgradeDBInstance.Maintenance.html#AdjustingTheMaintenanceWindow.Aurora)
+	// of time for each AWS Region. To view the time blocks available, see Backup
+	// window (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Aurora.Managing.Backups.html#Aurora.Managing.Backups.BackupWindow)
 	// in the Amazon Aurora User Guide.
 	//
 	// Constraints:
@@ -47,6 +47,24 @@ const (
 	AuthScheme_SECRETS AuthScheme = "SECRETS"
 )
 
+type DBProxyEndpointStatus string
+
+const (
+	DBProxyEndpointStatus_available                    DBProxyEndpointStatus = "available"
+	DBProxyEndpointStatus_modifying                    DBProxyEndpointStatus = "modifying"
+	DBProxyEndpointStatus_incompatible_network         DBProxyEndpointStatus = "incompatible-network"
+	DBProxyEndpointStatus_insufficient_resource_limits DBProxyEndpointStatus = "insufficient-resource-limits"
+	DBProxyEndpointStatus_creating                     DBProxyEndpointStatus = "creating"
+	DBProxyEndpointStatus_deleting                     DBProxyEndpointStatus = "deleting"
+)
+
+type DBProxyEndpointTargetRole string
+
+const (
+	DBProxyEndpointTargetRole_READ_WRITE DBProxyEndpointTargetRole = "READ_WRITE"
+	DBProxyEndpointTargetRole_READ_ONLY  DBProxyEndpointTargetRole = "READ_ONLY"
+)
+
 type DBProxyStatus string
 
 const (
@@ -68,6 +86,14 @@ const (
 	EngineFamily_POSTGRESQL EngineFamily = "POSTGRESQL"
 )
 
+type FailoverStatus string
+
+const (
+	FailoverStatus_pending      FailoverStatus = "pending"
+	FailoverStatus_failing_over FailoverStatus = "failing-over"
+	FailoverStatus_cancelling   FailoverStatus = "cancelling"
+)
+
 type IAMAuthMode string
 
 const (
@@ -96,10 +122,19 @@ const (
 type TargetHealthReason string
 
 const (
-	TargetHealthReason_UNREACHABLE            TargetHealthReason = "UNREACHABLE"
-	TargetHealthReason_CONNECTION_FAILED      TargetHealthReason = "CONNECTION_FAILED"
-	TargetHealthReason_AUTH_FAILURE           TargetHealthReason = "AUTH_FAILURE"
-	TargetHealthReason_PENDING_PROXY_CAPACITY TargetHealthReason = "PENDING_PROXY_CAPACITY"
+	TargetHealthReason_UNREACHABLE               TargetHealthReason = "UNREACHABLE"
+	TargetHealthReason_CONNECTION_FAILED         TargetHealthReason = "CONNECTION_FAILED"
+	TargetHealthReason_AUTH_FAILURE              TargetHealthReason = "AUTH_FAILURE"
+	TargetHealthReason_PENDING_PROXY_CAPACITY    TargetHealthReason = "PENDING_PROXY_CAPACITY"
+	TargetHealthReason_INVALID_REPLICATION_STATE TargetHealthReason = "INVALID_REPLICATION_STATE"
+)
+
+type TargetRole string
+
+const (
+	TargetRole_READ_WRITE TargetRole = "READ_WRITE"
+	TargetRole_READ_ONLY  TargetRole = "READ_ONLY"
+	TargetRole_UNKNOWN    TargetRole = "UNKNOWN"
 )
 
 type TargetState string
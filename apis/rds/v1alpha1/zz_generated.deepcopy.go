@@ -400,6 +400,16 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowMajorVersionUpgrade != nil {
+		in, out := &in.AllowMajorVersionUpgrade, &out.AllowMajorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBClusterParameters.
@@ -563,6 +573,219 @@ func (in *DBClusterEndpoint) DeepCopy() *DBClusterEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstance) DeepCopyInto(out *DBClusterInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstance.
+func (in *DBClusterInstance) DeepCopy() *DBClusterInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstanceList) DeepCopyInto(out *DBClusterInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBClusterInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstanceList.
+func (in *DBClusterInstanceList) DeepCopy() *DBClusterInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstanceObservation) DeepCopyInto(out *DBClusterInstanceObservation) {
+	*out = *in
+	if in.InstanceCreateTime != nil {
+		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstanceObservation.
+func (in *DBClusterInstanceObservation) DeepCopy() *DBClusterInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstanceParameters) DeepCopyInto(out *DBClusterInstanceParameters) {
+	*out = *in
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterIdentifierRef != nil {
+		in, out := &in.DBClusterIdentifierRef, &out.DBClusterIdentifierRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DBClusterIdentifierSelector != nil {
+		in, out := &in.DBClusterIdentifierSelector, &out.DBClusterIdentifierSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.PromotionTier != nil {
+		in, out := &in.PromotionTier, &out.PromotionTier
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroupName != nil {
+		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PubliclyAccessible != nil {
+		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.CACertificateIdentifier != nil {
+		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitoringInterval != nil {
+		in, out := &in.MonitoringInterval, &out.MonitoringInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MonitoringRoleARN != nil {
+		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnablePerformanceInsights != nil {
+		in, out := &in.EnablePerformanceInsights, &out.EnablePerformanceInsights
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstanceParameters.
+func (in *DBClusterInstanceParameters) DeepCopy() *DBClusterInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstanceSpec) DeepCopyInto(out *DBClusterInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstanceSpec.
+func (in *DBClusterInstanceSpec) DeepCopy() *DBClusterInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstanceStatus) DeepCopyInto(out *DBClusterInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstanceStatus.
+func (in *DBClusterInstanceStatus) DeepCopy() *DBClusterInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBClusterList) DeepCopyInto(out *DBClusterList) {
 	*out = *in
@@ -1207,6 +1430,11 @@ func (in *DBClusterSnapshot) DeepCopyInto(out *DBClusterSnapshot) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
 	if in.EngineVersion != nil {
 		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
@@ -2528,6 +2756,11 @@ func (in *DBProxy) DeepCopyInto(out *DBProxy) {
 		in, out := &in.UpdatedDate, &out.UpdatedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
 	if in.VPCSecurityGroupIDs != nil {
 		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]*string, len(*in))
@@ -2562,6 +2795,77 @@ func (in *DBProxy) DeepCopy() *DBProxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpoint) DeepCopyInto(out *DBProxyEndpoint) {
+	*out = *in
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.DBProxyEndpointARN != nil {
+		in, out := &in.DBProxyEndpointARN, &out.DBProxyEndpointARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBProxyEndpointName != nil {
+		in, out := &in.DBProxyEndpointName, &out.DBProxyEndpointName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint.
+func (in *DBProxyEndpoint) DeepCopy() *DBProxyEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBProxyTarget) DeepCopyInto(out *DBProxyTarget) {
 	*out = *in
@@ -3277,6 +3581,36 @@ func (in *ExportTask) DeepCopy() *ExportTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverState) DeepCopyInto(out *FailoverState) {
+	*out = *in
+	if in.FromDBClusterARN != nil {
+		in, out := &in.FromDBClusterARN, &out.FromDBClusterARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.ToDBClusterARN != nil {
+		in, out := &in.ToDBClusterARN, &out.ToDBClusterARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverState.
+func (in *FailoverState) DeepCopy() *FailoverState {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -3411,6 +3745,11 @@ func (in *GlobalClusterMember) DeepCopy() *GlobalClusterMember {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalClusterObservation) DeepCopyInto(out *GlobalClusterObservation) {
 	*out = *in
+	if in.FailoverState != nil {
+		in, out := &in.FailoverState, &out.FailoverState
+		*out = new(FailoverState)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GlobalClusterARN != nil {
 		in, out := &in.GlobalClusterARN, &out.GlobalClusterARN
 		*out = new(string)
@@ -3557,6 +3896,11 @@ func (in *GlobalCluster_SDK) DeepCopyInto(out *GlobalCluster_SDK) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailoverState != nil {
+		in, out := &in.FailoverState, &out.FailoverState
+		*out = new(FailoverState)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GlobalClusterARN != nil {
 		in, out := &in.GlobalClusterARN, &out.GlobalClusterARN
 		*out = new(string)
@@ -4947,6 +5291,16 @@ func (in *UpgradeTarget) DeepCopyInto(out *UpgradeTarget) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SupportsGlobalDatabases != nil {
+		in, out := &in.SupportsGlobalDatabases, &out.SupportsGlobalDatabases
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsParallelQuery != nil {
+		in, out := &in.SupportsParallelQuery, &out.SupportsParallelQuery
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeTarget.
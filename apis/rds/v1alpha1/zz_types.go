@@ -178,6 +178,8 @@ type DBClusterSnapshot struct {
 
 	Engine *string `json:"engine,omitempty"`
 
+	EngineMode *string `json:"engineMode,omitempty"`
+
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
 	IAMDatabaseAuthenticationEnabled *bool `json:"iamDatabaseAuthenticationEnabled,omitempty"`
@@ -555,6 +557,28 @@ type DBProxy struct {
 
 	UpdatedDate *metav1.Time `json:"updatedDate,omitempty"`
 
+	VPCID *string `json:"vpcID,omitempty"`
+
+	VPCSecurityGroupIDs []*string `json:"vpcSecurityGroupIDs,omitempty"`
+
+	VPCSubnetIDs []*string `json:"vpcSubnetIDs,omitempty"`
+}
+
+type DBProxyEndpoint struct {
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+
+	DBProxyEndpointARN *string `json:"dbProxyEndpointARN,omitempty"`
+
+	DBProxyEndpointName *string `json:"dbProxyEndpointName,omitempty"`
+
+	DBProxyName *string `json:"dbProxyName,omitempty"`
+
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	IsDefault *bool `json:"isDefault,omitempty"`
+
+	VPCID *string `json:"vpcID,omitempty"`
+
 	VPCSecurityGroupIDs []*string `json:"vpcSecurityGroupIDs,omitempty"`
 
 	VPCSubnetIDs []*string `json:"vpcSubnetIDs,omitempty"`
@@ -775,6 +799,14 @@ type ExportTask struct {
 	WarningMessage *string `json:"warningMessage,omitempty"`
 }
 
+type FailoverState struct {
+	FromDBClusterARN *string `json:"fromDBClusterARN,omitempty"`
+
+	Status *string `json:"status,omitempty"`
+
+	ToDBClusterARN *string `json:"toDBClusterARN,omitempty"`
+}
+
 type Filter struct {
 	Name *string `json:"name,omitempty"`
 
@@ -799,6 +831,10 @@ type GlobalCluster_SDK struct {
 	Engine *string `json:"engine,omitempty"`
 
 	EngineVersion *string `json:"engineVersion,omitempty"`
+	// Contains the state of scheduled or in-process failover operations on an Aurora
+	// global database (GlobalCluster). This Data type is empty unless a failover
+	// operation is scheduled or is currently underway on the Aurora global database.
+	FailoverState *FailoverState `json:"failoverState,omitempty"`
 
 	GlobalClusterARN *string `json:"globalClusterARN,omitempty"`
 
@@ -1211,6 +1247,10 @@ type UpgradeTarget struct {
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
 	IsMajorVersionUpgrade *bool `json:"isMajorVersionUpgrade,omitempty"`
+
+	SupportsGlobalDatabases *bool `json:"supportsGlobalDatabases,omitempty"`
+
+	SupportsParallelQuery *bool `json:"supportsParallelQuery,omitempty"`
 }
 
 type UserAuthConfig struct {
@@ -89,6 +89,24 @@ type HostedZoneParameters struct {
 	// +immutable
 	// +optional
 	VPC *VPC `json:"vpc,omitempty"`
+
+	// (Private hosted zones only) VPCAssociations are the Amazon VPCs that are
+	// associated with this hosted zone in addition to VPC. VPCs that are
+	// associated with the hosted zone but listed neither here nor in VPC are
+	// disassociated from it.
+	// +optional
+	VPCAssociations []VPCAssociation `json:"vpcAssociations,omitempty"`
+
+	// (Public hosted zones only) DNSSEC configures DNSSEC signing of the hosted
+	// zone. DNSSEC signing is left untouched if omitted.
+	// +optional
+	DNSSEC *DNSSEC `json:"dnssec,omitempty"`
+
+	// QueryLogging configures logging of the DNS queries that Route53
+	// receives for the hosted zone. Query logging is left untouched if
+	// omitted.
+	// +optional
+	QueryLogging *QueryLogging `json:"queryLogging,omitempty"`
 }
 
 // Config represents the configuration of a Hosted Zone.
@@ -123,6 +141,75 @@ type VPC struct {
 	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
 }
 
+// VPCAssociation is an additional VPC associated with a private hosted zone.
+type VPCAssociation struct {
+	VPC `json:",inline"`
+
+	// ProviderConfigReference specifies the ProviderConfig of the AWS account
+	// that owns the VPC if it is not the account of the hosted zone. The
+	// account of the hosted zone then authorizes the association, which is
+	// performed using the credentials of the referenced ProviderConfig.
+	// +optional
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+}
+
+// DNSSEC configures DNSSEC signing of a hosted zone.
+type DNSSEC struct {
+	// SigningEnabled specifies whether the hosted zone is signed. Signing
+	// requires at least one active key-signing key. Defaults to true.
+	// +optional
+	SigningEnabled *bool `json:"signingEnabled,omitempty"`
+
+	// KeySigningKeys of the hosted zone. Key-signing keys that exist for the
+	// hosted zone but are not listed here are deactivated and deleted.
+	KeySigningKeys []KeySigningKey `json:"keySigningKeys"`
+}
+
+// KeySigningKey is a key-signing key (KSK) of a hosted zone, backed by an
+// asymmetric customer managed KMS key in us-east-1.
+type KeySigningKey struct {
+	// Name of the key-signing key. It must be unique within the hosted zone
+	// and may contain only letters, numbers and underscores.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]{3,128}$`
+	Name string `json:"name"`
+
+	// KeyManagementServiceARN is the ARN of the customer managed KMS key that
+	// backs the key-signing key. The key must use the ECC_NIST_P256 key spec
+	// and the SIGN_VERIFY key usage.
+	// +immutable
+	// +optional
+	KeyManagementServiceARN *string `json:"keyManagementServiceArn,omitempty"`
+
+	// KeyManagementServiceARNRef references a KMS Key to retrieve its ARN.
+	// +optional
+	KeyManagementServiceARNRef *xpv1.Reference `json:"keyManagementServiceArnRef,omitempty"`
+
+	// KeyManagementServiceARNSelector selects a reference to a KMS Key to
+	// retrieve its ARN.
+	// +optional
+	KeyManagementServiceARNSelector *xpv1.Selector `json:"keyManagementServiceArnSelector,omitempty"`
+
+	// Status of the key-signing key. Only an active key-signing key is used to
+	// sign the hosted zone. Defaults to ACTIVE.
+	// +kubebuilder:validation:Enum=ACTIVE;INACTIVE
+	// +optional
+	Status *string `json:"status,omitempty"`
+}
+
+// QueryLogging configures query logging of a hosted zone.
+type QueryLogging struct {
+	// Enabled specifies whether queries are logged. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CloudWatchLogsLogGroupARN is the ARN of the CloudWatch Logs log group
+	// that Route53 publishes query logs to. The log group must be in us-east-1
+	// and its resource policy must allow Route53 to write to it. Required
+	// unless query logging is disabled.
+	// +optional
+	CloudWatchLogsLogGroupARN string `json:"cloudWatchLogsLogGroupArn,omitempty"`
+}
+
 // HostedZoneObservation keeps the state for the external resource.
 type HostedZoneObservation struct {
 	// DelegationSet describes the name servers for this hosted zone.
@@ -134,6 +221,55 @@ type HostedZoneObservation struct {
 	// A complex type that contains information about the VPCs that are associated
 	// with the specified hosted zone.
 	VPCs []VPCObservation `json:"vpcs,omitempty"`
+
+	// DNSSEC contains the DNSSEC status of the hosted zone.
+	DNSSEC *DNSSECObservation `json:"dnssec,omitempty"`
+
+	// QueryLogging is the query logging configuration of the hosted zone.
+	QueryLogging *QueryLoggingObservation `json:"queryLogging,omitempty"`
+}
+
+// QueryLoggingObservation is the observed query logging configuration of a
+// hosted zone.
+type QueryLoggingObservation struct {
+	// ID of the query logging configuration.
+	ID string `json:"id,omitempty"`
+
+	// CloudWatchLogsLogGroupARN is the ARN of the log group that query logs
+	// are published to.
+	CloudWatchLogsLogGroupARN string `json:"cloudWatchLogsLogGroupArn,omitempty"`
+}
+
+// DNSSECObservation is the DNSSEC status of a hosted zone.
+type DNSSECObservation struct {
+	// ServeSignature indicates whether the hosted zone is signed, e.g.
+	// SIGNING or NOT_SIGNING.
+	ServeSignature string `json:"serveSignature,omitempty"`
+
+	// StatusMessage explains the status of DNSSEC signing, if any.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// KeySigningKeys of the hosted zone.
+	KeySigningKeys []KeySigningKeyObservation `json:"keySigningKeys,omitempty"`
+}
+
+// KeySigningKeyObservation is the observed state of a key-signing key.
+type KeySigningKeyObservation struct {
+	// Name of the key-signing key.
+	Name string `json:"name,omitempty"`
+
+	// Status of the key-signing key, e.g. ACTIVE, INACTIVE or ACTION_NEEDED.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage explains the status of the key-signing key, if any.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// KeyTag is the key tag of the key-signing key.
+	KeyTag int64 `json:"keyTag,omitempty"`
+
+	// DSRecord is the delegation signer (DS) record that must be published in
+	// the parent zone to establish a chain of trust.
+	DSRecord string `json:"dsRecord,omitempty"`
 }
 
 // HostedZoneResponse stores the Hosted Zone received in the response output
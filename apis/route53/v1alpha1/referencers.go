@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,6 +26,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 )
 
 // ResolveReferences of this Zone
@@ -48,27 +50,62 @@ func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Rea
 	return nil
 }
 
-// ResolveReferences of a VPC provided for a HostedZone
+// ResolveReferences of the VPCs and KMS keys provided for a HostedZone
 func (mg *HostedZone) ResolveReferences(ctx context.Context, c client.Reader) error {
-	if mg.Spec.ForProvider.VPC == nil {
-		return nil
-	}
 	r := reference.NewAPIResolver(c, mg)
 
 	// Resolve spec.forProvider.vpc.vpcId
-	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPC.VPCID),
-		Reference:    mg.Spec.ForProvider.VPC.VPCIDRef,
-		Selector:     mg.Spec.ForProvider.VPC.VPCIDSelector,
-		To:           reference.To{Managed: &v1beta1.VPC{}, List: &v1beta1.VPCList{}},
-		Extract:      reference.ExternalName(),
-	})
-	if err != nil {
-		return errors.Wrap(err, "spec.forProvider.vpc.vpcId")
+	if mg.Spec.ForProvider.VPC != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPC.VPCID),
+			Reference:    mg.Spec.ForProvider.VPC.VPCIDRef,
+			Selector:     mg.Spec.ForProvider.VPC.VPCIDSelector,
+			To:           reference.To{Managed: &v1beta1.VPC{}, List: &v1beta1.VPCList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.vpc.vpcId")
+		}
+
+		mg.Spec.ForProvider.VPC.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.VPC.VPCIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.vpcAssociations[].vpcId
+	for i := range mg.Spec.ForProvider.VPCAssociations {
+		a := &mg.Spec.ForProvider.VPCAssociations[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.VPCID),
+			Reference:    a.VPCIDRef,
+			Selector:     a.VPCIDSelector,
+			To:           reference.To{Managed: &v1beta1.VPC{}, List: &v1beta1.VPCList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.vpcAssociations[%d].vpcId", i))
+		}
+		a.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+		a.VPCIDRef = rsp.ResolvedReference
 	}
 
-	mg.Spec.ForProvider.VPC.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.VPC.VPCIDRef = rsp.ResolvedReference
+	// Resolve spec.forProvider.dnssec.keySigningKeys[].keyManagementServiceArn
+	if mg.Spec.ForProvider.DNSSEC != nil {
+		for i := range mg.Spec.ForProvider.DNSSEC.KeySigningKeys {
+			k := &mg.Spec.ForProvider.DNSSEC.KeySigningKeys[i]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(k.KeyManagementServiceARN),
+				Reference:    k.KeyManagementServiceARNRef,
+				Selector:     k.KeyManagementServiceARNSelector,
+				To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
+				Extract:      kms.KeyARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.dnssec.keySigningKeys[%d].keyManagementServiceArn", i))
+			}
+			k.KeyManagementServiceARN = reference.ToPtrValue(rsp.ResolvedValue)
+			k.KeyManagementServiceARNRef = rsp.ResolvedReference
+		}
+	}
 
 	return nil
 }
@@ -253,6 +253,13 @@ type ResourceRecordSetParameters struct {
 	// +optional
 	MultiValueAnswer *bool `json:"multiValueAnswer,omitempty"`
 
+	// Ownership determines whether this resource may take over a resource
+	// record set that it did not create. By default the record set is written
+	// with an UPSERT action, which silently overwrites any record set with the
+	// same name and type.
+	// +optional
+	Ownership *Ownership `json:"ownership,omitempty"`
+
 	// Latency-based resource record sets only: The Amazon EC2 Region where you
 	// created the resource that this resource record set refers to. The resource
 	// typically is an AWS resource, such as an EC2 instance or an ELB load balancer,
@@ -762,6 +769,62 @@ type GeoLocation struct {
 	SubdivisionCode *string `json:"subdivisionCode,omitempty"`
 }
 
+// OwnershipPolicy is the policy used to claim a resource record set.
+type OwnershipPolicy string
+
+// Ownership policies of a ResourceRecordSet.
+const (
+	// OwnershipPolicyUpsert writes the resource record set with an UPSERT
+	// action regardless of who created it.
+	OwnershipPolicyUpsert OwnershipPolicy = "Upsert"
+
+	// OwnershipPolicyCreateOnly writes the resource record set with a CREATE
+	// action, which Route53 rejects if the record set already exists. A record
+	// set that exists but was not created by this resource is reported as a
+	// conflict unless the resource is annotated as its owner.
+	OwnershipPolicyCreateOnly OwnershipPolicy = "CreateOnly"
+
+	// OwnershipPolicyTXTRegistry records the owner of the resource record set
+	// in a companion TXT record, similar to the registry of external-dns. A
+	// record set without a matching registry record is reported as a conflict.
+	OwnershipPolicyTXTRegistry OwnershipPolicy = "TXTRegistry"
+)
+
+const (
+	// AnnotationKeyOwned marks a ResourceRecordSet using the CreateOnly
+	// ownership policy as the owner of its resource record set when set to
+	// "true". It is set to AnnotationValueOwnedPending before the record set
+	// is created, to "true" once Route53 accepted the creation, and removed
+	// if Route53 rejected it. It may be set to "true" manually to adopt an
+	// existing record set.
+	AnnotationKeyOwned = "route53.aws.crossplane.io/owned"
+
+	// AnnotationValueOwnedPending is the value of AnnotationKeyOwned while
+	// the creation of the resource record set is not confirmed. A record set
+	// is not claimed if its creation cannot be confirmed, e.g. because the
+	// provider restarted while the creation was in flight.
+	AnnotationValueOwnedPending = "pending"
+)
+
+// Ownership configures how a ResourceRecordSet claims the resource record set
+// it manages.
+type Ownership struct {
+	// Policy used to claim the resource record set.
+	// +kubebuilder:validation:Enum=Upsert;CreateOnly;TXTRegistry
+	Policy OwnershipPolicy `json:"policy"`
+
+	// OwnerID is written to the TXT registry record and must match for this
+	// resource to manage the record set. Defaults to "default". Only used by
+	// the TXTRegistry policy.
+	// +optional
+	OwnerID *string `json:"ownerId,omitempty"`
+
+	// TXTPrefix is prepended to the name of the TXT registry record. Only used
+	// by the TXTRegistry policy.
+	// +optional
+	TXTPrefix *string `json:"txtPrefix,omitempty"`
+}
+
 // ResourceRecord holds the DNS value to be used for the record.
 type ResourceRecord struct {
 	// The current or new DNS record value, not to exceed 4,000 characters. In the
@@ -65,6 +65,53 @@ func (in *Config) DeepCopy() *Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSEC) DeepCopyInto(out *DNSSEC) {
+	*out = *in
+	if in.SigningEnabled != nil {
+		in, out := &in.SigningEnabled, &out.SigningEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KeySigningKeys != nil {
+		in, out := &in.KeySigningKeys, &out.KeySigningKeys
+		*out = make([]KeySigningKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSEC.
+func (in *DNSSEC) DeepCopy() *DNSSEC {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSEC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECObservation) DeepCopyInto(out *DNSSECObservation) {
+	*out = *in
+	if in.KeySigningKeys != nil {
+		in, out := &in.KeySigningKeys, &out.KeySigningKeys
+		*out = make([]KeySigningKeyObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECObservation.
+func (in *DNSSECObservation) DeepCopy() *DNSSECObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DelegationSet) DeepCopyInto(out *DelegationSet) {
 	*out = *in
@@ -184,6 +231,16 @@ func (in *HostedZoneObservation) DeepCopyInto(out *HostedZoneObservation) {
 		*out = make([]VPCObservation, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSSEC != nil {
+		in, out := &in.DNSSEC, &out.DNSSEC
+		*out = new(DNSSECObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryLogging != nil {
+		in, out := &in.QueryLogging, &out.QueryLogging
+		*out = new(QueryLoggingObservation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedZoneObservation.
@@ -214,6 +271,23 @@ func (in *HostedZoneParameters) DeepCopyInto(out *HostedZoneParameters) {
 		*out = new(VPC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPCAssociations != nil {
+		in, out := &in.VPCAssociations, &out.VPCAssociations
+		*out = make([]VPCAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSSEC != nil {
+		in, out := &in.DNSSEC, &out.DNSSEC
+		*out = new(DNSSEC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryLogging != nil {
+		in, out := &in.QueryLogging, &out.QueryLogging
+		*out = new(QueryLogging)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedZoneParameters.
@@ -276,6 +350,56 @@ func (in *HostedZoneStatus) DeepCopy() *HostedZoneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeySigningKey) DeepCopyInto(out *KeySigningKey) {
+	*out = *in
+	if in.KeyManagementServiceARN != nil {
+		in, out := &in.KeyManagementServiceARN, &out.KeyManagementServiceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyManagementServiceARNRef != nil {
+		in, out := &in.KeyManagementServiceARNRef, &out.KeyManagementServiceARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyManagementServiceARNSelector != nil {
+		in, out := &in.KeyManagementServiceARNSelector, &out.KeyManagementServiceARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeySigningKey.
+func (in *KeySigningKey) DeepCopy() *KeySigningKey {
+	if in == nil {
+		return nil
+	}
+	out := new(KeySigningKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeySigningKeyObservation) DeepCopyInto(out *KeySigningKeyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeySigningKeyObservation.
+func (in *KeySigningKeyObservation) DeepCopy() *KeySigningKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeySigningKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinkedService) DeepCopyInto(out *LinkedService) {
 	*out = *in
@@ -291,6 +415,66 @@ func (in *LinkedService) DeepCopy() *LinkedService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ownership) DeepCopyInto(out *Ownership) {
+	*out = *in
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TXTPrefix != nil {
+		in, out := &in.TXTPrefix, &out.TXTPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ownership.
+func (in *Ownership) DeepCopy() *Ownership {
+	if in == nil {
+		return nil
+	}
+	out := new(Ownership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryLogging) DeepCopyInto(out *QueryLogging) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLogging.
+func (in *QueryLogging) DeepCopy() *QueryLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryLoggingObservation) DeepCopyInto(out *QueryLoggingObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLoggingObservation.
+func (in *QueryLoggingObservation) DeepCopy() *QueryLoggingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryLoggingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRecord) DeepCopyInto(out *ResourceRecord) {
 	*out = *in
@@ -388,6 +572,11 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Ownership != nil {
+		in, out := &in.Ownership, &out.Ownership
+		*out = new(Ownership)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourceRecords != nil {
 		in, out := &in.ResourceRecords, &out.ResourceRecords
 		*out = make([]ResourceRecord, len(*in))
@@ -508,6 +697,27 @@ func (in *VPC) DeepCopy() *VPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCAssociation) DeepCopyInto(out *VPCAssociation) {
+	*out = *in
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.ProviderConfigReference != nil {
+		in, out := &in.ProviderConfigReference, &out.ProviderConfigReference
+		*out = new(v1.Reference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAssociation.
+func (in *VPCAssociation) DeepCopy() *VPCAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCObservation) DeepCopyInto(out *VPCObservation) {
 	*out = *in
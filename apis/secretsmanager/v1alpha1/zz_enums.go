@@ -21,11 +21,12 @@ package v1alpha1
 type FilterNameStringType string
 
 const (
-	FilterNameStringType_description FilterNameStringType = "description"
-	FilterNameStringType_name        FilterNameStringType = "name"
-	FilterNameStringType_tag_key     FilterNameStringType = "tag-key"
-	FilterNameStringType_tag_value   FilterNameStringType = "tag-value"
-	FilterNameStringType_all         FilterNameStringType = "all"
+	FilterNameStringType_description    FilterNameStringType = "description"
+	FilterNameStringType_name           FilterNameStringType = "name"
+	FilterNameStringType_tag_key        FilterNameStringType = "tag-key"
+	FilterNameStringType_tag_value      FilterNameStringType = "tag-value"
+	FilterNameStringType_primary_region FilterNameStringType = "primary-region"
+	FilterNameStringType_all            FilterNameStringType = "all"
 )
 
 type SortOrderType string
@@ -34,3 +35,11 @@ const (
 	SortOrderType_asc  SortOrderType = "asc"
 	SortOrderType_desc SortOrderType = "desc"
 )
+
+type StatusType string
+
+const (
+	StatusType_InSync     StatusType = "InSync"
+	StatusType_Failed     StatusType = "Failed"
+	StatusType_InProgress StatusType = "InProgress"
+)
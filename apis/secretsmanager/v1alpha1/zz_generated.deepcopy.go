@@ -101,6 +101,70 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaRegionType) DeepCopyInto(out *ReplicaRegionType) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaRegionType.
+func (in *ReplicaRegionType) DeepCopy() *ReplicaRegionType {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaRegionType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationStatusType) DeepCopyInto(out *ReplicationStatusType) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastAccessedDate != nil {
+		in, out := &in.LastAccessedDate, &out.LastAccessedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationStatusType.
+func (in *ReplicationStatusType) DeepCopy() *ReplicationStatusType {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationStatusType)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RotationRulesType) DeepCopyInto(out *RotationRulesType) {
 	*out = *in
@@ -228,6 +292,11 @@ func (in *SecretListEntry) DeepCopyInto(out *SecretListEntry) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrimaryRegion != nil {
+		in, out := &in.PrimaryRegion, &out.PrimaryRegion
+		*out = new(string)
+		**out = **in
+	}
 	if in.RotationEnabled != nil {
 		in, out := &in.RotationEnabled, &out.RotationEnabled
 		*out = new(bool)
@@ -295,6 +364,17 @@ func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplicationStatus != nil {
+		in, out := &in.ReplicationStatus, &out.ReplicationStatus
+		*out = make([]*ReplicationStatusType, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicationStatusType)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretObservation.
@@ -310,11 +390,27 @@ func (in *SecretObservation) DeepCopy() *SecretObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretParameters) DeepCopyInto(out *SecretParameters) {
 	*out = *in
+	if in.AddReplicaRegions != nil {
+		in, out := &in.AddReplicaRegions, &out.AddReplicaRegions
+		*out = make([]*ReplicaRegionType, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaRegionType)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
+	if in.ForceOverwriteReplicaSecret != nil {
+		in, out := &in.ForceOverwriteReplicaSecret, &out.ForceOverwriteReplicaSecret
+		*out = new(bool)
+		**out = **in
+	}
 	if in.KMSKeyID != nil {
 		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
@@ -29,8 +29,14 @@ type SecretParameters struct {
 	// Region is which region the Secret will be created.
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
+	// (Optional) Add a list of regions to replicate secrets. Secrets Manager replicates
+	// the KMSKeyID objects to the list of regions specified in the parameter.
+	AddReplicaRegions []*ReplicaRegionType `json:"addReplicaRegions,omitempty"`
 	// (Optional) Specifies a user-provided description of the secret.
 	Description *string `json:"description,omitempty"`
+	// (Optional) If set, the replication overwrites a secret with the same name
+	// in the destination region.
+	ForceOverwriteReplicaSecret *bool `json:"forceOverwriteReplicaSecret,omitempty"`
 	// (Optional) Specifies the ARN, Key ID, or alias of the AWS KMS customer master
 	// key (CMK) to be used to encrypt the SecretString or SecretBinary values in
 	// the versions stored in this secret.
@@ -114,6 +120,8 @@ type SecretObservation struct {
 	// then users with access to the old secret don't automatically get access to
 	// the new secret because the ARNs are different.
 	ARN *string `json:"arn,omitempty"`
+	// Describes a list of replication status objects as InProgress, Failed or InSync.
+	ReplicationStatus []*ReplicationStatusType `json:"replicationStatus,omitempty"`
 }
 
 // SecretStatus defines the observed state of Secret.
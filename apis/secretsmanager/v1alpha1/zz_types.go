@@ -33,6 +33,24 @@ type Filter struct {
 	Values []*string `json:"values,omitempty"`
 }
 
+type ReplicaRegionType struct {
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+
+	Region *string `json:"region,omitempty"`
+}
+
+type ReplicationStatusType struct {
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+
+	LastAccessedDate *metav1.Time `json:"lastAccessedDate,omitempty"`
+
+	Region *string `json:"region,omitempty"`
+
+	Status *string `json:"status,omitempty"`
+
+	StatusMessage *string `json:"statusMessage,omitempty"`
+}
+
 type RotationRulesType struct {
 	AutomaticallyAfterDays *int64 `json:"automaticallyAfterDays,omitempty"`
 }
@@ -58,6 +76,8 @@ type SecretListEntry struct {
 
 	OwningService *string `json:"owningService,omitempty"`
 
+	PrimaryRegion *string `json:"primaryRegion,omitempty"`
+
 	RotationEnabled *bool `json:"rotationEnabled,omitempty"`
 
 	RotationLambdaARN *string `json:"rotationLambdaARN,omitempty"`
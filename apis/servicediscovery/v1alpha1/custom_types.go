@@ -2,9 +2,6 @@ package v1alpha1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
-// CustomServiceParameters are custom parameters for Services.
-type CustomServiceParameters struct{}
-
 // CustomPrivateDNSNamespaceParameters are custom parameters for PrivateDNSNamespaces.
 type CustomPrivateDNSNamespaceParameters struct {
 
@@ -45,6 +42,11 @@ func (in *HTTPNamespace) SetDescription(d *string) {
 	in.Spec.ForProvider.Description = d
 }
 
+// GetTags returns the tags.
+func (in *HTTPNamespace) GetTags() []*Tag {
+	return in.Spec.ForProvider.Tags
+}
+
 // GetOperationID returns the last operation id.
 func (in *PrivateDNSNamespace) GetOperationID() *string {
 	return in.Status.AtProvider.OperationID
@@ -65,6 +67,11 @@ func (in *PrivateDNSNamespace) SetDescription(d *string) {
 	in.Spec.ForProvider.Description = d
 }
 
+// GetTags returns the tags.
+func (in *PrivateDNSNamespace) GetTags() []*Tag {
+	return in.Spec.ForProvider.Tags
+}
+
 // GetOperationID returns the last operation id.
 func (in *PublicDNSNamespace) GetOperationID() *string {
 	return in.Status.AtProvider.OperationID
@@ -84,3 +91,8 @@ func (in *PublicDNSNamespace) GetDescription() *string {
 func (in *PublicDNSNamespace) SetDescription(d *string) {
 	in.Spec.ForProvider.Description = d
 }
+
+// GetTags returns the tags.
+func (in *PublicDNSNamespace) GetTags() []*Tag {
+	return in.Spec.ForProvider.Tags
+}
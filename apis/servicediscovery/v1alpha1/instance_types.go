@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// InstanceParameters define the desired state of a Cloud Map Instance. The
+// external name of the resource is the ID of the instance. When the
+// instance is registered from a Kubernetes Service, one instance per ready
+// endpoint address is registered and their IDs are prefixed with the
+// external name.
+type InstanceParameters struct {
+	// Region is which region the Instance will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the service that you want to use for settings for the
+	// instance.
+	// +immutable
+	// +optional
+	ServiceID *string `json:"serviceId,omitempty"`
+
+	// ServiceIDRef is a reference to a Service used to set the ServiceID.
+	// +optional
+	ServiceIDRef *xpv1.Reference `json:"serviceIdRef,omitempty"`
+
+	// ServiceIDSelector selects a reference to a Service used to set the
+	// ServiceID.
+	// +optional
+	ServiceIDSelector *xpv1.Selector `json:"serviceIdSelector,omitempty"`
+
+	// The attributes of the instance, e.g. AWS_INSTANCE_IPV4 and
+	// AWS_INSTANCE_PORT. See RegisterInstance (https://docs.aws.amazon.com/cloud-map/latest/api/API_RegisterInstance.html)
+	// for the supported keys.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// KubernetesServiceRef registers the ready endpoint addresses of a
+	// Kubernetes Service as instances. AWS_INSTANCE_IPV4 and
+	// AWS_INSTANCE_PORT are set from the endpoints and added to the given
+	// attributes. The provider needs permission to read the Endpoints of the
+	// Kubernetes Service.
+	// +optional
+	KubernetesServiceRef *KubernetesServiceReference `json:"kubernetesServiceRef,omitempty"`
+}
+
+// KubernetesServiceReference refers to a Kubernetes Service whose endpoints
+// are registered as instances.
+type KubernetesServiceReference struct {
+	// Name of the Kubernetes Service.
+	Name string `json:"name"`
+
+	// Namespace of the Kubernetes Service.
+	Namespace string `json:"namespace"`
+
+	// Port is the name of the endpoint port that is registered. The first
+	// port is used if it is omitted.
+	// +optional
+	Port *string `json:"port,omitempty"`
+}
+
+// InstanceObservation is the observed state of an Instance.
+type InstanceObservation struct {
+	// The IDs of the instances that are registered for this resource.
+	InstanceIDs []string `json:"instanceIds,omitempty"`
+}
+
+// An InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// An InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents an instance registered
+// to an AWS Cloud Map Service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instances
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
+
+// Instance type metadata.
+var (
+	InstanceKind             = "Instance"
+	InstanceGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceKind}.String()
+	InstanceKindAPIVersion   = InstanceKind + "." + GroupVersion.String()
+	InstanceGroupVersionKind = GroupVersion.WithKind(InstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+}
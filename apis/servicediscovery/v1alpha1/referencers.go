@@ -30,3 +30,62 @@ func (mg *PrivateDNSNamespace) ResolveReferences(ctx context.Context, c client.R
 	mg.Spec.ForProvider.VPCRef = rsp.ResolvedReference
 	return nil
 }
+
+// ResolveReferences of this Service.
+func (mg *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.namespaceId
+	req := reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NamespaceID),
+		Extract:      reference.ExternalName(),
+	}
+	switch {
+	case mg.Spec.ForProvider.PrivateDNSNamespaceIDRef != nil || mg.Spec.ForProvider.PrivateDNSNamespaceIDSelector != nil:
+		req.Reference = mg.Spec.ForProvider.PrivateDNSNamespaceIDRef
+		req.Selector = mg.Spec.ForProvider.PrivateDNSNamespaceIDSelector
+		req.To = reference.To{Managed: &PrivateDNSNamespace{}, List: &PrivateDNSNamespaceList{}}
+	case mg.Spec.ForProvider.PublicDNSNamespaceIDRef != nil || mg.Spec.ForProvider.PublicDNSNamespaceIDSelector != nil:
+		req.Reference = mg.Spec.ForProvider.PublicDNSNamespaceIDRef
+		req.Selector = mg.Spec.ForProvider.PublicDNSNamespaceIDSelector
+		req.To = reference.To{Managed: &PublicDNSNamespace{}, List: &PublicDNSNamespaceList{}}
+	default:
+		req.Reference = mg.Spec.ForProvider.HTTPNamespaceIDRef
+		req.Selector = mg.Spec.ForProvider.HTTPNamespaceIDSelector
+		req.To = reference.To{Managed: &HTTPNamespace{}, List: &HTTPNamespaceList{}}
+	}
+	rsp, err := r.Resolve(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceId")
+	}
+	mg.Spec.ForProvider.NamespaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	switch req.To.Managed.(type) {
+	case *PrivateDNSNamespace:
+		mg.Spec.ForProvider.PrivateDNSNamespaceIDRef = rsp.ResolvedReference
+	case *PublicDNSNamespace:
+		mg.Spec.ForProvider.PublicDNSNamespaceIDRef = rsp.ResolvedReference
+	default:
+		mg.Spec.ForProvider.HTTPNamespaceIDRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this Instance.
+func (mg *Instance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.serviceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceID),
+		Reference:    mg.Spec.ForProvider.ServiceIDRef,
+		Selector:     mg.Spec.ForProvider.ServiceIDSelector,
+		To:           reference.To{Managed: &Service{}, List: &ServiceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceId")
+	}
+	mg.Spec.ForProvider.ServiceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceIDRef = rsp.ResolvedReference
+	return nil
+}
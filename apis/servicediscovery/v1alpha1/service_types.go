@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServiceParameters define the desired state of a Cloud Map Service. The ID
+// of the service that AWS assigns on creation is the external name of the
+// resource.
+type ServiceParameters struct {
+	// Region is which region the Service will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name that you want to assign to the service.
+	// +immutable
+	Name string `json:"name"`
+
+	// The ID of the namespace that you want to use to create the service.
+	// One of namespaceId or one of the namespace references has to be
+	// supplied.
+	// +immutable
+	// +optional
+	NamespaceID *string `json:"namespaceId,omitempty"`
+
+	// HTTPNamespaceIDRef is a reference to an HTTPNamespace used to set the
+	// NamespaceID.
+	// +optional
+	HTTPNamespaceIDRef *xpv1.Reference `json:"httpNamespaceIdRef,omitempty"`
+
+	// HTTPNamespaceIDSelector selects a reference to an HTTPNamespace used to
+	// set the NamespaceID.
+	// +optional
+	HTTPNamespaceIDSelector *xpv1.Selector `json:"httpNamespaceIdSelector,omitempty"`
+
+	// PrivateDNSNamespaceIDRef is a reference to a PrivateDNSNamespace used
+	// to set the NamespaceID.
+	// +optional
+	PrivateDNSNamespaceIDRef *xpv1.Reference `json:"privateDnsNamespaceIdRef,omitempty"`
+
+	// PrivateDNSNamespaceIDSelector selects a reference to a
+	// PrivateDNSNamespace used to set the NamespaceID.
+	// +optional
+	PrivateDNSNamespaceIDSelector *xpv1.Selector `json:"privateDnsNamespaceIdSelector,omitempty"`
+
+	// PublicDNSNamespaceIDRef is a reference to a PublicDNSNamespace used to
+	// set the NamespaceID.
+	// +optional
+	PublicDNSNamespaceIDRef *xpv1.Reference `json:"publicDnsNamespaceIdRef,omitempty"`
+
+	// PublicDNSNamespaceIDSelector selects a reference to a
+	// PublicDNSNamespace used to set the NamespaceID.
+	// +optional
+	PublicDNSNamespaceIDSelector *xpv1.Selector `json:"publicDnsNamespaceIdSelector,omitempty"`
+
+	// A description for the service.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The DNS records that you want Cloud Map to create when you register an
+	// instance. Only the TTL of the records can be changed after creation.
+	// +optional
+	DNSConfig *DNSConfig `json:"dnsConfig,omitempty"`
+
+	// The settings for an optional Route 53 health check. It can only be
+	// used with public DNS namespaces.
+	// +optional
+	HealthCheckConfig *HealthCheckConfig `json:"healthCheckConfig,omitempty"`
+
+	// The settings for an optional custom health check.
+	// +immutable
+	// +optional
+	HealthCheckCustomConfig *HealthCheckCustomConfig `json:"healthCheckCustomConfig,omitempty"`
+
+	// The tags to add to the service.
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// DNSConfig contains the DNS records that Cloud Map creates for the instances
+// of a service.
+type DNSConfig struct {
+	// The routing policy that you want to apply to all Route 53 DNS records
+	// that Cloud Map creates when you register an instance.
+	// +kubebuilder:validation:Enum=MULTIVALUE;WEIGHTED
+	// +immutable
+	// +optional
+	RoutingPolicy *string `json:"routingPolicy,omitempty"`
+
+	// The records that Cloud Map creates for every instance.
+	DNSRecords []DNSRecord `json:"dnsRecords"`
+}
+
+// DNSRecord is a record that Cloud Map creates for every instance.
+type DNSRecord struct {
+	// The type of the record.
+	// +kubebuilder:validation:Enum=SRV;A;AAAA;CNAME
+	Type string `json:"type"`
+
+	// The amount of time, in seconds, that you want DNS resolvers to cache
+	// the settings for this record.
+	TTL int64 `json:"ttl"`
+}
+
+// HealthCheckConfig contains the settings of a Route 53 health check.
+type HealthCheckConfig struct {
+	// The type of health check that you want to create.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+	Type string `json:"type"`
+
+	// The path that you want Route 53 to request when performing health
+	// checks.
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+
+	// The number of consecutive health checks that an endpoint must pass or
+	// fail to change its health status.
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+}
+
+// HealthCheckCustomConfig contains the settings of a custom health check.
+type HealthCheckCustomConfig struct {
+	// The number of 30-second intervals that Cloud Map waits after an
+	// UpdateInstanceCustomHealthStatus request before it changes the health
+	// status of a service instance.
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+}
+
+// ServiceObservation is the observed state of a Service.
+type ServiceObservation struct {
+	// The Amazon Resource Name (ARN) of the service.
+	ARN string `json:"arn,omitempty"`
+
+	// The date and time that the service was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// The number of instances that are currently associated with the
+	// service.
+	InstanceCount int64 `json:"instanceCount,omitempty"`
+
+	// The ID of the last update operation of the service.
+	OperationID *string `json:"operationID,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents an AWS Cloud Map Service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Services
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
+
+// Service type metadata.
+var (
+	ServiceKind             = "Service"
+	ServiceGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceKind}.String()
+	ServiceKindAPIVersion   = ServiceKind + "." + GroupVersion.String()
+	ServiceGroupVersionKind = GroupVersion.WithKind(ServiceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Service{}, &ServiceList{})
+}
@@ -129,3 +129,17 @@ type ServiceFilterName string
 const (
 	ServiceFilterName_NAMESPACE_ID ServiceFilterName = "NAMESPACE_ID"
 )
+
+type ServiceType string
+
+const (
+	ServiceType_HTTP     ServiceType = "HTTP"
+	ServiceType_DNS_HTTP ServiceType = "DNS_HTTP"
+	ServiceType_DNS      ServiceType = "DNS"
+)
+
+type ServiceTypeOption string
+
+const (
+	ServiceTypeOption_HTTP ServiceTypeOption = "HTTP"
+)
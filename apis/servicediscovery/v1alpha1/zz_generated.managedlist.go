@@ -29,6 +29,15 @@ func (l *HTTPNamespaceList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this InstanceList.
+func (l *InstanceList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this PrivateDNSNamespaceList.
 func (l *PrivateDNSNamespaceList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -46,3 +55,12 @@ func (l *PublicDNSNamespaceList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this ServiceList.
+func (l *ServiceList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -55,10 +55,6 @@ type OperationSummary struct {
 	ID *string `json:"id,omitempty"`
 }
 
-type Service struct {
-	Description *string `json:"description,omitempty"`
-}
-
 type ServiceChange struct {
 	Description *string `json:"description,omitempty"`
 }
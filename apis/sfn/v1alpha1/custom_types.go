@@ -16,16 +16,37 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
 
 // CustomActivityParameters includes custom additional fields for ActivityParameters.
 type CustomActivityParameters struct{}
 
 // CustomStateMachineParameters includes custom additional fields for StateMachineParameters.
 type CustomStateMachineParameters struct {
+	// Definition is the Amazon States Language definition of the state
+	// machine as a JSON string. See Amazon States Language (https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html).
+	// Either Definition or DefinitionObject has to be given.
+	// +optional
+	Definition *string `json:"definition,omitempty"`
+
+	// DefinitionObject is the Amazon States Language definition of the
+	// state machine given as a structured object instead of a string.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	DefinitionObject *runtime.RawExtension `json:"definitionObject,omitempty"`
+
+	// DefinitionSubstitutions are the values that replace the ${key}
+	// placeholders in the definition, e.g. the ARN of a Function in the
+	// Resource of a Task state.
+	// +optional
+	DefinitionSubstitutions []DefinitionSubstitution `json:"definitionSubstitutions,omitempty"`
+
 	// RoleARN is the ARN for the IAMRole.
 	// It has to be given directly or resolved using RoleARNRef or RoleARNSelector.
-	// +immutable
 	// +optional
 	RoleARN *string `json:"roleArn,omitempty"`
 
@@ -46,3 +67,34 @@ type CustomStateMachineParameters struct {
 	// +kubebuilder:validation:Enum=STANDARD;EXPRESS
 	Type StateMachineType `json:"type,omitempty"`
 }
+
+// DefinitionSubstitution is a value that replaces the ${key} placeholder in the
+// definition of a StateMachine.
+type DefinitionSubstitution struct {
+	// Key is the name of the placeholder without the ${} delimiters.
+	Key string `json:"key"`
+
+	// Value replaces the placeholder. It has to be given directly or resolved
+	// using one of the references.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// FunctionARNRef is a reference to a lambda Function whose ARN is used
+	// as the value.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionArnRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a lambda Function whose ARN
+	// is used as the value.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionArnSelector,omitempty"`
+
+	// RoleARNRef is a reference to an IAMRole whose ARN is used as the value.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole whose ARN is used as
+	// the value.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 )
 
 // ResolveReferences of this StateMachine
@@ -44,5 +45,37 @@ func (mg *StateMachine) ResolveReferences(ctx context.Context, c client.Reader)
 	}
 	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.definitionSubstitutions[*].value
+	for i := range mg.Spec.ForProvider.DefinitionSubstitutions {
+		s := &mg.Spec.ForProvider.DefinitionSubstitutions[i]
+		if s.FunctionARNRef != nil || s.FunctionARNSelector != nil {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(s.Value),
+				Reference:    s.FunctionARNRef,
+				Selector:     s.FunctionARNSelector,
+				To:           reference.To{Managed: &lambdav1alpha1.Function{}, List: &lambdav1alpha1.FunctionList{}},
+				Extract:      lambdav1alpha1.FunctionARN(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.definitionSubstitutions[%d].value", i)
+			}
+			s.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			s.FunctionARNRef = rsp.ResolvedReference
+			continue
+		}
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(s.Value),
+			Reference:    s.RoleARNRef,
+			Selector:     s.RoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+			Extract:      iamv1beta1.IAMRoleARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.definitionSubstitutions[%d].value", i)
+		}
+		s.Value = reference.ToPtrValue(rsp.ResolvedValue)
+		s.RoleARNRef = rsp.ResolvedReference
+	}
 	return nil
 }
@@ -22,7 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -261,6 +261,23 @@ func (in *CustomActivityParameters) DeepCopy() *CustomActivityParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomStateMachineParameters) DeepCopyInto(out *CustomStateMachineParameters) {
 	*out = *in
+	if in.Definition != nil {
+		in, out := &in.Definition, &out.Definition
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefinitionObject != nil {
+		in, out := &in.DefinitionObject, &out.DefinitionObject
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefinitionSubstitutions != nil {
+		in, out := &in.DefinitionSubstitutions, &out.DefinitionSubstitutions
+		*out = make([]DefinitionSubstitution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RoleARN != nil {
 		in, out := &in.RoleARN, &out.RoleARN
 		*out = new(string)
@@ -288,6 +305,46 @@ func (in *CustomStateMachineParameters) DeepCopy() *CustomStateMachineParameters
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefinitionSubstitution) DeepCopyInto(out *DefinitionSubstitution) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionARNRef != nil {
+		in, out := &in.FunctionARNRef, &out.FunctionARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionARNSelector != nil {
+		in, out := &in.FunctionARNSelector, &out.FunctionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefinitionSubstitution.
+func (in *DefinitionSubstitution) DeepCopy() *DefinitionSubstitution {
+	if in == nil {
+		return nil
+	}
+	out := new(DefinitionSubstitution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionListItem) DeepCopyInto(out *ExecutionListItem) {
 	*out = *in
@@ -621,11 +678,6 @@ func (in *StateMachineObservation) DeepCopy() *StateMachineObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StateMachineParameters) DeepCopyInto(out *StateMachineParameters) {
 	*out = *in
-	if in.Definition != nil {
-		in, out := &in.Definition, &out.Definition
-		*out = new(string)
-		**out = **in
-	}
 	if in.LoggingConfiguration != nil {
 		in, out := &in.LoggingConfiguration, &out.LoggingConfiguration
 		*out = new(LoggingConfiguration)
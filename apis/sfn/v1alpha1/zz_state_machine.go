@@ -29,10 +29,6 @@ type StateMachineParameters struct {
 	// Region is which region the StateMachine will be created.
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
-	// The Amazon States Language definition of the state machine. See Amazon States
-	// Language (https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html).
-	// +kubebuilder:validation:Required
-	Definition *string `json:"definition"`
 	// Defines what execution history events are logged and where they are logged.
 	//
 	// By default, the level is set to OFF. For more information see Log Levels
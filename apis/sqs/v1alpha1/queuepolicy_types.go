@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// QueuePolicyParameters define the desired state of the access policy of an
+// AWS SQS Queue.
+type QueuePolicyParameters struct {
+	// Region is the region of the Queue.
+	Region string `json:"region"`
+
+	// QueueURL is the URL of the Queue the policy is attached to.
+	// +immutable
+	// +optional
+	QueueURL *string `json:"queueUrl,omitempty"`
+
+	// QueueURLRef references a Queue to retrieve its URL.
+	// +immutable
+	// +optional
+	QueueURLRef *xpv1.Reference `json:"queueUrlRef,omitempty"`
+
+	// QueueURLSelector selects a reference to a Queue to retrieve its URL.
+	// +immutable
+	// +optional
+	QueueURLSelector *xpv1.Selector `json:"queueUrlSelector,omitempty"`
+
+	// Policy is a well defined type which can be parsed into a JSON queue
+	// policy. Statements without a resource apply to the Queue.
+	// +optional
+	Policy *QueuePolicyBody `json:"policy,omitempty"`
+
+	// RawPolicy is the stringified version of a JSON queue policy. It
+	// takes precedence over Policy and SNSTopicARNs.
+	// +optional
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
+	// SNSTopicARNs are the ARNs of SNS topics that are allowed to send
+	// messages to the Queue. A statement that grants them sqs:SendMessage is
+	// added to the policy, so that they can be subscribed to by the Queue.
+	// +optional
+	SNSTopicARNs []string `json:"snsTopicArns,omitempty"`
+
+	// SNSTopicARNRefs reference SNSTopics to retrieve their ARNs.
+	// +optional
+	SNSTopicARNRefs []xpv1.Reference `json:"snsTopicArnRefs,omitempty"`
+
+	// SNSTopicARNSelector selects references to SNSTopics to retrieve their
+	// ARNs.
+	// +optional
+	SNSTopicARNSelector *xpv1.Selector `json:"snsTopicArnSelector,omitempty"`
+}
+
+// QueuePolicyBody represents a queue policy in the manifest.
+type QueuePolicyBody struct {
+	// Version is the current IAM policy version
+	// +kubebuilder:validation:Enum="2012-10-17";"2008-10-17"
+	// +kubebuilder:default:="2012-10-17"
+	Version string `json:"version"`
+
+	// ID is the policy's optional identifier
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Statements is the list of statement this policy applies.
+	// +optional
+	Statements []QueuePolicyStatement `json:"statements,omitempty"`
+}
+
+// QueuePolicyStatement defines an individual statement within the
+// QueuePolicyBody.
+type QueuePolicyStatement struct {
+	// Optional identifier for this statement, must be unique within the
+	// policy if provided.
+	// +optional
+	SID *string `json:"sid,omitempty"`
+
+	// The effect is required and specifies whether the statement results
+	// in an allow or an explicit deny.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Principal specifies the principal that is allowed or denied access to
+	// the Queue.
+	// +optional
+	Principal *QueuePrincipal `json:"principal,omitempty"`
+
+	// NotPrincipal specifies the principals that are not included in this
+	// statement.
+	// +optional
+	NotPrincipal *QueuePrincipal `json:"notPrincipal,omitempty"`
+
+	// Action lists the actions that are allowed or denied by this
+	// statement, such as sqs:SendMessage.
+	// +optional
+	Action []string `json:"action,omitempty"`
+
+	// NotAction lists the actions the statement applies to all but.
+	// +optional
+	NotAction []string `json:"notAction,omitempty"`
+
+	// Resource lists the ARNs the statement applies to. Defaults to the ARN
+	// of the Queue.
+	// +optional
+	Resource []string `json:"resource,omitempty"`
+
+	// Condition specifies where conditions for the policy are in effect.
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazonsqs.html#amazonsqs-policy-keys
+	// +optional
+	Condition []Condition `json:"condition,omitempty"`
+}
+
+// QueuePrincipal defines the principals affected by a QueuePolicyStatement.
+type QueuePrincipal struct {
+	// AllowAnon indicates if the statement applies to all anonymous users.
+	// Principal: "*"
+	// +optional
+	AllowAnon *bool `json:"allowAnon,omitempty"`
+
+	// AWSPrincipals are the ARNs of the AWS accounts, IAM users and roles
+	// affected by the statement. Plain account IDs are converted to the ARN
+	// of their root user.
+	// +optional
+	AWSPrincipals []string `json:"awsPrincipals,omitempty"`
+
+	// Service defines the services affected by the statement, such as
+	// sns.amazonaws.com.
+	// +optional
+	Service []string `json:"service,omitempty"`
+}
+
+// Condition represents a set of condition pairs for a queue policy.
+type Condition struct {
+	// OperatorKey matches the condition key and value in the policy against
+	// values in the request context, such as ArnEquals.
+	OperatorKey string `json:"operatorKey"`
+
+	// Conditions represents each of the key/value pairs for the operator key
+	Conditions []ConditionPair `json:"conditions"`
+}
+
+// ConditionPair represents one condition inside of the set of conditions for
+// a queue policy.
+type ConditionPair struct {
+	// ConditionKey is the key condition being applied to the parent condition
+	ConditionKey string `json:"key"`
+
+	// ConditionStringValue is the expected string value of the key from the parent condition
+	// +optional
+	ConditionStringValue *string `json:"stringValue,omitempty"`
+
+	// ConditionBooleanValue is the expected boolean value of the key from the parent condition
+	// +optional
+	ConditionBooleanValue *bool `json:"booleanValue,omitempty"`
+
+	// ConditionNumericValue is the expected numeric value of the key from the parent condition
+	// +optional
+	ConditionNumericValue *int64 `json:"numericValue,omitempty"`
+
+	// ConditionListValue is the list value of the key from the parent condition
+	// +optional
+	ConditionListValue []string `json:"listValue,omitempty"`
+}
+
+// A QueuePolicySpec defines the desired state of a QueuePolicy.
+type QueuePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       QueuePolicyParameters `json:"forProvider"`
+}
+
+// QueuePolicyObservation keeps the state for the external resource
+type QueuePolicyObservation struct {
+	// PolicyText is the JSON policy attached to the Queue.
+	PolicyText string `json:"policyText,omitempty"`
+
+	// QueueARN is the ARN of the Queue.
+	QueueARN string `json:"queueArn,omitempty"`
+}
+
+// A QueuePolicyStatus represents the observed state of a QueuePolicy.
+type QueuePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          QueuePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A QueuePolicy is a managed resource that represents the access policy of an
+// AWS SQS Queue. The policy attribute of the Queue should be left empty when
+// it is managed by a QueuePolicy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="QUEUE",type="string",JSONPath=".status.atProvider.queueArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type QueuePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QueuePolicySpec   `json:"spec"`
+	Status QueuePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueuePolicyList contains a list of QueuePolicies
+type QueuePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QueuePolicy `json:"items"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	snsv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// ResolveReferences of this QueuePolicy
+func (mg *QueuePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.queueUrl
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.QueueURL),
+		Reference:    mg.Spec.ForProvider.QueueURLRef,
+		Selector:     mg.Spec.ForProvider.QueueURLSelector,
+		To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+		Extract:      sqsv1beta1.QueueURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.queueUrl")
+	}
+	mg.Spec.ForProvider.QueueURL = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.QueueURLRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.snsTopicArns
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SNSTopicARNs,
+		References:    mg.Spec.ForProvider.SNSTopicARNRefs,
+		Selector:      mg.Spec.ForProvider.SNSTopicARNSelector,
+		To:            reference.To{Managed: &snsv1alpha1.SNSTopic{}, List: &snsv1alpha1.SNSTopicList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.snsTopicArns")
+	}
+	mg.Spec.ForProvider.SNSTopicARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SNSTopicARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,355 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ConditionPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionPair) DeepCopyInto(out *ConditionPair) {
+	*out = *in
+	if in.ConditionStringValue != nil {
+		in, out := &in.ConditionStringValue, &out.ConditionStringValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConditionBooleanValue != nil {
+		in, out := &in.ConditionBooleanValue, &out.ConditionBooleanValue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConditionNumericValue != nil {
+		in, out := &in.ConditionNumericValue, &out.ConditionNumericValue
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConditionListValue != nil {
+		in, out := &in.ConditionListValue, &out.ConditionListValue
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionPair.
+func (in *ConditionPair) DeepCopy() *ConditionPair {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicy) DeepCopyInto(out *QueuePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicy.
+func (in *QueuePolicy) DeepCopy() *QueuePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueuePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyBody) DeepCopyInto(out *QueuePolicyBody) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]QueuePolicyStatement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyBody.
+func (in *QueuePolicyBody) DeepCopy() *QueuePolicyBody {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyList) DeepCopyInto(out *QueuePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueuePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyList.
+func (in *QueuePolicyList) DeepCopy() *QueuePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueuePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyObservation) DeepCopyInto(out *QueuePolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyObservation.
+func (in *QueuePolicyObservation) DeepCopy() *QueuePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyParameters) DeepCopyInto(out *QueuePolicyParameters) {
+	*out = *in
+	if in.QueueURL != nil {
+		in, out := &in.QueueURL, &out.QueueURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueURLRef != nil {
+		in, out := &in.QueueURLRef, &out.QueueURLRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.QueueURLSelector != nil {
+		in, out := &in.QueueURLSelector, &out.QueueURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(QueuePolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawPolicy != nil {
+		in, out := &in.RawPolicy, &out.RawPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.SNSTopicARNs != nil {
+		in, out := &in.SNSTopicARNs, &out.SNSTopicARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SNSTopicARNRefs != nil {
+		in, out := &in.SNSTopicARNRefs, &out.SNSTopicARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SNSTopicARNSelector != nil {
+		in, out := &in.SNSTopicARNSelector, &out.SNSTopicARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyParameters.
+func (in *QueuePolicyParameters) DeepCopy() *QueuePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicySpec) DeepCopyInto(out *QueuePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicySpec.
+func (in *QueuePolicySpec) DeepCopy() *QueuePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyStatement) DeepCopyInto(out *QueuePolicyStatement) {
+	*out = *in
+	if in.SID != nil {
+		in, out := &in.SID, &out.SID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
+		*out = new(QueuePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotPrincipal != nil {
+		in, out := &in.NotPrincipal, &out.NotPrincipal
+		*out = new(QueuePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotAction != nil {
+		in, out := &in.NotAction, &out.NotAction
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyStatement.
+func (in *QueuePolicyStatement) DeepCopy() *QueuePolicyStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyStatus) DeepCopyInto(out *QueuePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyStatus.
+func (in *QueuePolicyStatus) DeepCopy() *QueuePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePrincipal) DeepCopyInto(out *QueuePrincipal) {
+	*out = *in
+	if in.AllowAnon != nil {
+		in, out := &in.AllowAnon, &out.AllowAnon
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AWSPrincipals != nil {
+		in, out := &in.AWSPrincipals, &out.AWSPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePrincipal.
+func (in *QueuePrincipal) DeepCopy() *QueuePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
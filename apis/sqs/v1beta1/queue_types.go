@@ -42,6 +42,8 @@ const (
 	AttributeContentBasedDeduplication             string = "ContentBasedDeduplication"
 	AttributeKmsMasterKeyID                        string = "KmsMasterKeyId"
 	AttributeKmsDataKeyReusePeriodSeconds          string = "KmsDataKeyReusePeriodSeconds"
+	AttributeRedriveAllowPolicy                    string = "RedriveAllowPolicy"
+	AttributeSqsManagedSseEnabled                  string = "SqsManagedSseEnabled"
 )
 
 // RedrivePolicy includes the parameters for the dead-letter queue functionality of the source queue.
@@ -63,6 +65,32 @@ type RedrivePolicy struct {
 	MaxReceiveCount int64 `json:"maxReceiveCount"`
 }
 
+// RedriveAllowPolicy defines which source queues can use a queue as their
+// dead-letter queue.
+type RedriveAllowPolicy struct {
+	// RedrivePermission defines which source queues can specify the queue
+	// as their dead-letter queue. allowAll allows all source queues in the
+	// account and region, denyAll allows none, and byQueue allows the
+	// source queues given by their ARN.
+	// +kubebuilder:validation:Enum=allowAll;denyAll;byQueue
+	RedrivePermission string `json:"redrivePermission"`
+
+	// SourceQueueARNs are the ARNs of the source queues that can specify
+	// the queue as their dead-letter queue when the redrive permission is
+	// byQueue. At most 10 source queues can be given.
+	// +optional
+	SourceQueueARNs []string `json:"sourceQueueArns,omitempty"`
+
+	// SourceQueueARNRefs reference Queues to retrieve their ARNs.
+	// +optional
+	SourceQueueARNRefs []xpv1.Reference `json:"sourceQueueArnRefs,omitempty"`
+
+	// SourceQueueARNSelector selects references to Queues to retrieve their
+	// ARNs.
+	// +optional
+	SourceQueueARNSelector *xpv1.Selector `json:"sourceQueueArnSelector,omitempty"`
+}
+
 // QueueParameters define the desired state of an AWS Queue
 type QueueParameters struct {
 	// Region is the region you'd like your Queue to be created in.
@@ -89,6 +117,7 @@ type QueueParameters struct {
 	// The queue's policy. A valid AWS policy. For more information
 	// about policy structure, see Overview of AWS IAM Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/PoliciesOverview.html)
 	// in the Amazon IAM User Guide.
+	// Leave it empty when the policy is managed by a QueuePolicy.
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
@@ -106,6 +135,11 @@ type QueueParameters struct {
 	// +optional
 	RedrivePolicy *RedrivePolicy `json:"redrivePolicy,omitempty"`
 
+	// RedriveAllowPolicy defines which source queues can use this queue as
+	// their dead-letter queue.
+	// +optional
+	RedriveAllowPolicy *RedriveAllowPolicy `json:"redriveAllowPolicy,omitempty"`
+
 	// VisibilityTimeout - The visibility timeout for the queue, in seconds.
 	// Valid values: an integer from 0 to 43,200 (12 hours). Default: 30. For
 	// more information about the visibility timeout, see Visibility Timeout
@@ -136,6 +170,11 @@ type QueueParameters struct {
 	// +optional
 	KMSDataKeyReusePeriodSeconds *int64 `json:"kmsDataKeyReusePeriodSeconds,omitempty"`
 
+	// SQSManagedSSEEnabled enables server-side encryption with SQS-owned
+	// encryption keys. It cannot be used together with a KMSMasterKeyID.
+	// +optional
+	SQSManagedSSEEnabled *bool `json:"sqsManagedSseEnabled,omitempty"`
+
 	// FIFOQueue - Designates a queue as FIFO. Valid values: true, false. If
 	//	you don't specify the FifoQueue attribute, Amazon SQS creates a standard
 	//	queue. You can provide this attribute only during queue creation. You
@@ -22,6 +22,17 @@ func QueueARN() reference.ExtractValueFn {
 	}
 }
 
+// QueueURL returns URL of the Queue resource.
+func QueueURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Queue)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.URL
+	}
+}
+
 // ResolveReferences of this Queue
 func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -41,5 +52,21 @@ func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN = aws.String(rsp.ResolvedValue)
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef = rsp.ResolvedReference
 	}
+
+	if mg.Spec.ForProvider.RedriveAllowPolicy != nil {
+		// Resolve spec.forProvider.redriveAllowPolicy.sourceQueueArns
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNs,
+			References:    mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNRefs,
+			Selector:      mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNSelector,
+			To:            reference.To{Managed: &Queue{}, List: &QueueList{}},
+			Extract:       QueueARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.redriveAllowPolicy.sourceQueueArns")
+		}
+		mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNRefs = mrsp.ResolvedReferences
+	}
 	return nil
 }
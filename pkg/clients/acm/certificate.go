@@ -22,6 +22,8 @@ type Client interface {
 	AddTagsToCertificateRequest(*acm.AddTagsToCertificateInput) acm.AddTagsToCertificateRequest
 	RenewCertificateRequest(*acm.RenewCertificateInput) acm.RenewCertificateRequest
 	RemoveTagsFromCertificateRequest(*acm.RemoveTagsFromCertificateInput) acm.RemoveTagsFromCertificateRequest
+	ImportCertificateRequest(*acm.ImportCertificateInput) acm.ImportCertificateRequest
+	ExportCertificateRequest(*acm.ExportCertificateInput) acm.ExportCertificateRequest
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -75,13 +77,34 @@ func GenerateCreateCertificateInput(name string, p *v1alpha1.CertificateParamete
 // GenerateCertificateStatus is used to produce CertificateExternalStatus from acm.certificateStatus
 func GenerateCertificateStatus(certificate acm.CertificateDetail) v1alpha1.CertificateExternalStatus {
 	return v1alpha1.CertificateExternalStatus{
-		CertificateARN:     aws.StringValue(certificate.CertificateArn),
-		RenewalEligibility: certificate.RenewalEligibility,
-		Status:             certificate.Status,
-		Type:               certificate.Type,
+		CertificateARN:          aws.StringValue(certificate.CertificateArn),
+		RenewalEligibility:      certificate.RenewalEligibility,
+		Status:                  certificate.Status,
+		Type:                    certificate.Type,
+		DomainValidationRecords: GenerateDomainValidationRecords(certificate),
 	}
 }
 
+// GenerateDomainValidationRecords returns the DNS validation records of the
+// domains of the certificate. Domains that are not validated through DNS, or
+// whose record has not been generated by ACM yet, are skipped.
+func GenerateDomainValidationRecords(certificate acm.CertificateDetail) []v1alpha1.DomainValidationRecord {
+	var out []v1alpha1.DomainValidationRecord
+	for _, dv := range certificate.DomainValidationOptions {
+		if dv.ResourceRecord == nil {
+			continue
+		}
+		out = append(out, v1alpha1.DomainValidationRecord{
+			DomainName:       aws.StringValue(dv.DomainName),
+			ValidationStatus: dv.ValidationStatus,
+			Name:             aws.StringValue(dv.ResourceRecord.Name),
+			Type:             dv.ResourceRecord.Type,
+			Value:            aws.StringValue(dv.ResourceRecord.Value),
+		})
+	}
+	return out
+}
+
 // LateInitializeCertificate fills the empty fields in *v1beta1.CertificateParameters with
 // the values seen in iam.Certificate.
 func LateInitializeCertificate(in *v1alpha1.CertificateParameters, certificate *acm.CertificateDetail) { // nolint:gocyclo
@@ -125,7 +148,8 @@ func LateInitializeCertificate(in *v1alpha1.CertificateParameters, certificate *
 // IsCertificateUpToDate checks whether there is a change in any of the modifiable fields.
 func IsCertificateUpToDate(p v1alpha1.CertificateParameters, cd acm.CertificateDetail, tags []acm.Tag) bool { // nolint:gocyclo
 
-	if *p.CertificateTransparencyLoggingPreference != cd.Options.CertificateTransparencyLoggingPreference {
+	if p.CertificateTransparencyLoggingPreference != nil && cd.Options != nil &&
+		*p.CertificateTransparencyLoggingPreference != cd.Options.CertificateTransparencyLoggingPreference {
 		return false
 	}
 
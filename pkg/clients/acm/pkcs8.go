@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+	"github.com/youmark/pkcs8"
+)
+
+const (
+	errNoEncryptedKey = "no ENCRYPTED PRIVATE KEY PEM block found"
+	errDecryptKey     = "cannot decrypt private key"
+	errMarshalKey     = "cannot marshal decrypted private key"
+)
+
+// DecryptPrivateKey decrypts a PKCS #8 private key that is encrypted with
+// PBES2, which is how ACM exports private keys, and returns it as an
+// unencrypted PKCS #8 PEM block.
+func DecryptPrivateKey(encrypted, passphrase []byte) ([]byte, error) {
+	block, _ := pem.Decode(encrypted)
+	if block == nil || block.Type != "ENCRYPTED PRIVATE KEY" {
+		return nil, errors.New(errNoEncryptedKey)
+	}
+	key, _, err := pkcs8.ParsePrivateKey(block.Bytes, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecryptKey)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalKey)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+)
+
+// SecretKeyCertificateChain is the key of the certificate chain in Secrets of
+// type kubernetes.io/tls.
+const SecretKeyCertificateChain = "ca.crt"
+
+const (
+	errNoCertificate = "no CERTIFICATE PEM block found in " + corev1.TLSCertKey
+	errNoPrivateKey  = corev1.TLSPrivateKeyKey + " is empty"
+)
+
+// SplitCertificate returns the first certificate of the given PEM encoded
+// certificates, and the remaining ones as its chain. The fallback chain is
+// returned if there are no remaining certificates.
+func SplitCertificate(certs, fallbackChain []byte) (cert, chain []byte, err error) {
+	rest := certs
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		b := pem.EncodeToMemory(block)
+		if cert == nil {
+			cert = b
+			continue
+		}
+		chain = append(chain, b...)
+	}
+	if cert == nil {
+		return nil, nil, errors.New(errNoCertificate)
+	}
+	if len(chain) == 0 && len(fallbackChain) != 0 {
+		chain = fallbackChain
+	}
+	return cert, chain, nil
+}
+
+// GenerateImportCertificateInput returns the input of ImportCertificate for
+// the given Secret of type kubernetes.io/tls. The certificate with the given
+// ARN is re-imported if it is not empty; tags can only be given on the first
+// import.
+func GenerateImportCertificateInput(arn string, p *v1alpha1.CertificateParameters, s *corev1.Secret) (*acm.ImportCertificateInput, error) {
+	cert, chain, err := SplitCertificate(s.Data[corev1.TLSCertKey], s.Data[SecretKeyCertificateChain])
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil, errors.New(errNoPrivateKey)
+	}
+	in := &acm.ImportCertificateInput{
+		Certificate:      cert,
+		CertificateChain: chain,
+		PrivateKey:       s.Data[corev1.TLSPrivateKeyKey],
+	}
+	if arn != "" {
+		in.CertificateArn = aws.String(arn)
+		return in, nil
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, acm.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return in, nil
+}
+
+// IsSameCertificate returns whether the first certificate of the given PEM
+// encoded certificates has the given serial number, as reported by ACM in
+// the form "0a:1b:...".
+func IsSameCertificate(serial *string, certs []byte) bool {
+	if serial == nil {
+		return false
+	}
+	cert, _, err := SplitCertificate(certs, nil)
+	if err != nil {
+		return false
+	}
+	block, _ := pem.Decode(cert)
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	s, ok := new(big.Int).SetString(strings.ReplaceAll(*serial, ":", ""), 16)
+	return ok && s.Cmp(c.SerialNumber) == 0
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/youmark/pkcs8"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func testCertificate(t *testing.T, serial int64) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestSplitCertificate(t *testing.T) {
+	leaf := testCertificate(t, 1)
+	intermediate := testCertificate(t, 2)
+	root := testCertificate(t, 3)
+
+	type want struct {
+		cert  []byte
+		chain []byte
+		err   error
+	}
+
+	cases := map[string]struct {
+		certs    []byte
+		fallback []byte
+		want     want
+	}{
+		"FullChain": {
+			certs:    append(append([]byte{}, leaf...), intermediate...),
+			fallback: root,
+			want:     want{cert: leaf, chain: intermediate},
+		},
+		"FallbackChain": {
+			certs:    leaf,
+			fallback: root,
+			want:     want{cert: leaf, chain: root},
+		},
+		"NoCertificate": {
+			certs: []byte("garbage"),
+			want:  want{err: errors.New(errNoCertificate)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cert, chain, err := SplitCertificate(tc.certs, tc.fallback)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cert, cert); diff != "" {
+				t.Errorf("cert: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.chain, chain); diff != "" {
+				t.Errorf("chain: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSameCertificate(t *testing.T) {
+	cert := testCertificate(t, 0x0a1b2c)
+
+	cases := map[string]struct {
+		serial *string
+		want   bool
+	}{
+		"Same": {
+			serial: aws.String("0a:1b:2c"),
+			want:   true,
+		},
+		"Different": {
+			serial: aws.String("0a:1b:2d"),
+		},
+		"NoSerial": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSameCertificate(tc.serial, cert)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// encryptPrivateKey encrypts a PKCS #8 private key with PBES2 using
+// PBKDF2-HMAC-SHA256 and AES-256-CBC, the way ACM exports private keys.
+func encryptPrivateKey(t *testing.T, key interface{}, passphrase []byte) []byte {
+	t.Helper()
+	b, err := pkcs8.MarshalPrivateKey(key, passphrase, &pkcs8.Opts{
+		Cipher:  pkcs8.AES256CBC,
+		KDFOpts: pkcs8.PBKDF2Opts{SaltSize: 16, IterationCount: 2048, HMACHash: crypto.SHA256},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: b})
+}
+
+func TestDecryptPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	passphrase := []byte("secret")
+
+	type want struct {
+		key []byte
+		err error
+	}
+
+	cases := map[string]struct {
+		encrypted  []byte
+		passphrase []byte
+		want       want
+	}{
+		"Decrypted": {
+			encrypted:  encryptPrivateKey(t, key, passphrase),
+			passphrase: passphrase,
+			want:       want{key: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})},
+		},
+		"WrongPassphrase": {
+			encrypted:  encryptPrivateKey(t, key, passphrase),
+			passphrase: []byte("wrong"),
+			want:       want{err: errors.Wrap(errors.New("pkcs8: incorrect password"), errDecryptKey)},
+		},
+		"NotEncrypted": {
+			encrypted: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+			want:      want{err: errors.New(errNoEncryptedKey)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DecryptPrivateKey(tc.encrypted, tc.passphrase)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 )
@@ -35,8 +36,20 @@ type Client interface {
 	ListTagsRequest(*acmpca.ListTagsInput) acmpca.ListTagsRequest
 	UntagCertificateAuthorityRequest(*acmpca.UntagCertificateAuthorityInput) acmpca.UntagCertificateAuthorityRequest
 	TagCertificateAuthorityRequest(*acmpca.TagCertificateAuthorityInput) acmpca.TagCertificateAuthorityRequest
+	GetCertificateAuthorityCsrRequest(*acmpca.GetCertificateAuthorityCsrInput) acmpca.GetCertificateAuthorityCsrRequest
+	IssueCertificateRequest(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	GetCertificateRequest(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
+	ImportCertificateAuthorityCertificateRequest(*acmpca.ImportCertificateAuthorityCertificateInput) acmpca.ImportCertificateAuthorityCertificateRequest
 }
 
+const (
+	templateRootCACertificate        = "template/RootCACertificate/V1"
+	templateSubordinateCACertificate = "template/SubordinateCACertificate_PathLen0/V1"
+
+	defaultRootValidityInYears        = 10
+	defaultSubordinateValidityInYears = 5
+)
+
 // NewClient returns a new client using AWS credentials as JSON encoded data.
 func NewClient(conf *aws.Config) Client {
 	return acmpca.New(*conf)
@@ -185,13 +198,82 @@ func IsCertificateAuthorityUpToDate(p *v1alpha1.CertificateAuthority, cd acmpca.
 	return true
 }
 
+// IsCertificateIssuable returns true if the certificate of the certificate
+// authority can be issued by ACM Private CA, i.e. if it is a ROOT certificate
+// authority or a SUBORDINATE one with a parent.
+func IsCertificateIssuable(p v1alpha1.CertificateAuthorityParameters) bool {
+	return p.Type == acmpca.CertificateAuthorityTypeRoot || p.ParentCertificateAuthorityARN != nil
+}
+
+// IssuerARN returns the ARN of the certificate authority that issues the
+// certificate of the certificate authority with the given ARN.
+func IssuerARN(arn string, p v1alpha1.CertificateAuthorityParameters) *string {
+	if p.Type == acmpca.CertificateAuthorityTypeSubordinate {
+		return p.ParentCertificateAuthorityARN
+	}
+	return aws.String(arn)
+}
+
+// GenerateIssueCACertificateInput returns the input to issue the certificate
+// of the certificate authority with the given ARN from its CSR. ROOT
+// certificate authorities sign their own certificate, SUBORDINATE ones are
+// signed by their parent.
+func GenerateIssueCACertificateInput(arn string, p v1alpha1.CertificateAuthorityParameters, csr []byte, token string) *acmpca.IssueCertificateInput {
+	in := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: IssuerARN(arn, p),
+		Csr:                     csr,
+		IdempotencyToken:        aws.String(token),
+		SigningAlgorithm:        p.CertificateAuthorityConfiguration.SigningAlgorithm,
+		TemplateArn:             p.TemplateARN,
+		Validity:                GenerateValidity(p.Validity),
+	}
+	template, years := templateRootCACertificate, int64(defaultRootValidityInYears)
+	if p.Type == acmpca.CertificateAuthorityTypeSubordinate {
+		template, years = templateSubordinateCACertificate, defaultSubordinateValidityInYears
+	}
+	if in.TemplateArn == nil {
+		in.TemplateArn = aws.String(templateARN(arn, template))
+	}
+	if in.Validity == nil {
+		in.Validity = &acmpca.Validity{Type: acmpca.ValidityPeriodTypeYears, Value: aws.Int64(years)}
+	}
+	return in
+}
+
+// templateARN returns the ARN of the given ACM Private CA template in the
+// partition of the given ARN.
+func templateARN(arn, template string) string {
+	partition := "aws"
+	if parts := strings.SplitN(arn, ":", 3); len(parts) == 3 && parts[1] != "" {
+		partition = parts[1]
+	}
+	return "arn:" + partition + ":acm-pca:::" + template
+}
+
+// GenerateValidity from Validity
+func GenerateValidity(v *v1alpha1.Validity) *acmpca.Validity {
+	if v == nil {
+		return nil
+	}
+	return &acmpca.Validity{Type: v.Type, Value: aws.Int64(v.Value)}
+}
+
 // GenerateCertificateAuthorityExternalStatus is used to produce CertificateAuthorityExternalStatus from acmpca.certificateAuthorityStatus and v1alpha1.CertificateAuthority
 func GenerateCertificateAuthorityExternalStatus(certificateAuthority acmpca.CertificateAuthority) v1alpha1.CertificateAuthorityExternalStatus {
-	return v1alpha1.CertificateAuthorityExternalStatus{
+	o := v1alpha1.CertificateAuthorityExternalStatus{
 		CertificateAuthorityARN: aws.StringValue(certificateAuthority.Arn),
 		Serial:                  aws.StringValue(certificateAuthority.Serial),
 		Status:                  string(certificateAuthority.Status),
 	}
+	if certificateAuthority.NotBefore != nil {
+		t := metav1.NewTime(*certificateAuthority.NotBefore)
+		o.NotBefore = &t
+	}
+	if certificateAuthority.NotAfter != nil {
+		t := metav1.NewTime(*certificateAuthority.NotAfter)
+		o.NotAfter = &t
+	}
+	return o
 }
 
 // IsErrorNotFound returns true if the error code indicates that the item was not found
@@ -204,3 +286,12 @@ func IsErrorNotFound(err error) bool {
 
 	return false
 }
+
+// IsRequestInProgress returns true if the error code indicates that the
+// requested certificate or CSR is not ready yet.
+func IsRequestInProgress(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == acmpca.ErrCodeRequestInProgressException
+	}
+	return false
+}
@@ -348,3 +348,59 @@ func TestIsCertificateAuthorityUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateIssueCACertificateInput(t *testing.T) {
+	arn := "arn:aws-cn:acm-pca:cn-north-1:123456789012:certificate-authority/sub"
+	parent := "arn:aws-cn:acm-pca:cn-north-1:123456789012:certificate-authority/root"
+	csr := []byte("csr")
+	token := "token"
+
+	cases := map[string]struct {
+		p   v1alpha1.CertificateAuthorityParameters
+		out *acmpca.IssueCertificateInput
+	}{
+		"Root": {
+			p: v1alpha1.CertificateAuthorityParameters{
+				Type: acmpca.CertificateAuthorityTypeRoot,
+				CertificateAuthorityConfiguration: v1alpha1.CertificateAuthorityConfiguration{
+					SigningAlgorithm: acmpca.SigningAlgorithmSha256withrsa,
+				},
+			},
+			out: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: &arn,
+				Csr:                     csr,
+				IdempotencyToken:        &token,
+				SigningAlgorithm:        acmpca.SigningAlgorithmSha256withrsa,
+				TemplateArn:             aws.String("arn:aws-cn:acm-pca:::template/RootCACertificate/V1"),
+				Validity:                &acmpca.Validity{Type: acmpca.ValidityPeriodTypeYears, Value: aws.Int64(10)},
+			},
+		},
+		"Subordinate": {
+			p: v1alpha1.CertificateAuthorityParameters{
+				Type: acmpca.CertificateAuthorityTypeSubordinate,
+				CertificateAuthorityConfiguration: v1alpha1.CertificateAuthorityConfiguration{
+					SigningAlgorithm: acmpca.SigningAlgorithmSha256withrsa,
+				},
+				ParentCertificateAuthorityARN: &parent,
+				Validity:                      &v1alpha1.Validity{Type: acmpca.ValidityPeriodTypeDays, Value: 365},
+			},
+			out: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: &parent,
+				Csr:                     csr,
+				IdempotencyToken:        &token,
+				SigningAlgorithm:        acmpca.SigningAlgorithmSha256withrsa,
+				TemplateArn:             aws.String("arn:aws-cn:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"),
+				Validity:                &acmpca.Validity{Type: acmpca.ValidityPeriodTypeDays, Value: aws.Int64(365)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateIssueCACertificateInput(arn, tc.p, csr, token)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -36,6 +36,11 @@ type MockCertificateAuthorityClient struct {
 	MockListTagsRequest                     func(*acmpca.ListTagsInput) acmpca.ListTagsRequest
 	MockUntagCertificateAuthorityRequest    func(*acmpca.UntagCertificateAuthorityInput) acmpca.UntagCertificateAuthorityRequest
 	MockTagCertificateAuthorityRequest      func(*acmpca.TagCertificateAuthorityInput) acmpca.TagCertificateAuthorityRequest
+
+	MockGetCertificateAuthorityCsrRequest            func(*acmpca.GetCertificateAuthorityCsrInput) acmpca.GetCertificateAuthorityCsrRequest
+	MockIssueCertificateRequest                      func(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	MockGetCertificateRequest                        func(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
+	MockImportCertificateAuthorityCertificateRequest func(*acmpca.ImportCertificateAuthorityCertificateInput) acmpca.ImportCertificateAuthorityCertificateRequest
 }
 
 // CreateCertificateAuthorityRequest mocks CreateCertificateAuthorityRequest method
@@ -82,3 +87,23 @@ func (m *MockCertificateAuthorityClient) UpdateCertificateAuthorityRequest(input
 func (m *MockCertificateAuthorityClient) DeletePermissionRequest(input *acmpca.DeletePermissionInput) acmpca.DeletePermissionRequest {
 	return m.MockDeletePermissionRequest(input)
 }
+
+// GetCertificateAuthorityCsrRequest mocks GetCertificateAuthorityCsrRequest method
+func (m *MockCertificateAuthorityClient) GetCertificateAuthorityCsrRequest(input *acmpca.GetCertificateAuthorityCsrInput) acmpca.GetCertificateAuthorityCsrRequest {
+	return m.MockGetCertificateAuthorityCsrRequest(input)
+}
+
+// IssueCertificateRequest mocks IssueCertificateRequest method
+func (m *MockCertificateAuthorityClient) IssueCertificateRequest(input *acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest {
+	return m.MockIssueCertificateRequest(input)
+}
+
+// GetCertificateRequest mocks GetCertificateRequest method
+func (m *MockCertificateAuthorityClient) GetCertificateRequest(input *acmpca.GetCertificateInput) acmpca.GetCertificateRequest {
+	return m.MockGetCertificateRequest(input)
+}
+
+// ImportCertificateAuthorityCertificateRequest mocks ImportCertificateAuthorityCertificateRequest method
+func (m *MockCertificateAuthorityClient) ImportCertificateAuthorityCertificateRequest(input *acmpca.ImportCertificateAuthorityCertificateInput) acmpca.ImportCertificateAuthorityCertificateRequest {
+	return m.MockImportCertificateAuthorityCertificateRequest(input)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmpca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+)
+
+const (
+	errParsePrivateKey  = "cannot parse private key"
+	errGenerateKey      = "cannot generate private key"
+	errCreateCSR        = "cannot create certificate signing request"
+	errMarshalKey       = "cannot marshal private key"
+	errParseCertificate = "cannot parse certificate"
+
+	templateEndEntityCertificate = "template/EndEntityCertificate/V1"
+)
+
+// SecretKeyCertificateChain is the key of the certificate chain in the
+// connection secret of an IssuedCertificate.
+const SecretKeyCertificateChain = "ca.crt"
+
+// IssuedCertificateClient defines the operations on certificates issued by a
+// certificate authority.
+type IssuedCertificateClient interface {
+	IssueCertificateRequest(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	GetCertificateRequest(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
+	RevokeCertificateRequest(*acmpca.RevokeCertificateInput) acmpca.RevokeCertificateRequest
+}
+
+// NewIssuedCertificateClient returns a new client using AWS credentials as JSON encoded data.
+func NewIssuedCertificateClient(conf *aws.Config) IssuedCertificateClient {
+	return acmpca.New(*conf)
+}
+
+// GenerateCertificateSigningRequest returns the PEM encoded certificate
+// signing request of the given parameters. Unless the parameters contain a
+// CSR one is created for the given PEM encoded private key, or a newly
+// generated one if none is given, and returned along with the key.
+func GenerateCertificateSigningRequest(p v1alpha1.IssuedCertificateParameters, key []byte) (csr, pemKey []byte, err error) {
+	if p.CertificateSigningRequest != nil {
+		return []byte(aws.StringValue(p.CertificateSigningRequest)), nil, nil
+	}
+	var signer crypto.Signer
+	if len(key) != 0 {
+		signer, err = parsePrivateKey(key)
+	} else {
+		signer, err = generatePrivateKey(p.KeyAlgorithm)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: aws.StringValue(p.CommonName)},
+		DNSNames: p.DNSNames,
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, tmpl, signer)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errCreateCSR)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(signer)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errMarshalKey)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+func parsePrivateKey(key []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(key)
+	if block == nil {
+		return nil, errors.New(errParsePrivateKey)
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, errParsePrivateKey)
+	}
+	signer, ok := k.(crypto.Signer)
+	if !ok {
+		return nil, errors.New(errParsePrivateKey)
+	}
+	return signer, nil
+}
+
+func generatePrivateKey(alg acmpca.KeyAlgorithm) (crypto.Signer, error) {
+	var (
+		k   crypto.Signer
+		err error
+	)
+	switch alg { // nolint:exhaustive
+	case acmpca.KeyAlgorithmRsa4096:
+		k, err = rsa.GenerateKey(rand.Reader, 4096)
+	case acmpca.KeyAlgorithmEcPrime256v1:
+		k, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case acmpca.KeyAlgorithmEcSecp384r1:
+		k, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		k, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	return k, errors.Wrap(err, errGenerateKey)
+}
+
+// GenerateIssueCertificateInput returns the input to issue a certificate for
+// the given CSR.
+func GenerateIssueCertificateInput(p v1alpha1.IssuedCertificateParameters, csr []byte, token string) *acmpca.IssueCertificateInput {
+	in := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: p.CertificateAuthorityARN,
+		Csr:                     csr,
+		IdempotencyToken:        aws.String(token),
+		SigningAlgorithm:        p.SigningAlgorithm,
+		TemplateArn:             p.TemplateARN,
+		Validity:                GenerateValidity(&p.Validity),
+	}
+	if in.TemplateArn == nil {
+		in.TemplateArn = aws.String(templateARN(aws.StringValue(p.CertificateAuthorityARN), templateEndEntityCertificate))
+	}
+	return in
+}
+
+// ParseCertificate parses the given PEM encoded certificate.
+func ParseCertificate(cert string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(cert))
+	if block == nil {
+		return nil, errors.New(errParseCertificate)
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	return c, errors.Wrap(err, errParseCertificate)
+}
+
+// FormatSerial returns the serial of the given certificate as colon separated
+// hexadecimal bytes, the way ACM Private CA expects it.
+func FormatSerial(c *x509.Certificate) string {
+	b := c.SerialNumber.Bytes()
+	s := make([]string, len(b))
+	for i := range b {
+		s[i] = fmt.Sprintf("%02x", b[i])
+	}
+	return strings.Join(s, ":")
+}
+
+// RenewalTime returns the time the given certificate needs to be renewed at.
+func RenewalTime(p v1alpha1.IssuedCertificateParameters, c *x509.Certificate) time.Time {
+	renewBefore := c.NotAfter.Sub(c.NotBefore) / 3
+	if p.RenewBefore != nil {
+		renewBefore = p.RenewBefore.Duration
+	}
+	return c.NotAfter.Add(-renewBefore)
+}
+
+// GenerateIssuedCertificateObservation returns the observation of the given
+// certificate.
+func GenerateIssuedCertificateObservation(p v1alpha1.IssuedCertificateParameters, c *x509.Certificate) v1alpha1.IssuedCertificateObservation {
+	notBefore, notAfter, renewal := metav1.NewTime(c.NotBefore), metav1.NewTime(c.NotAfter), metav1.NewTime(RenewalTime(p, c))
+	return v1alpha1.IssuedCertificateObservation{
+		Serial:      FormatSerial(c),
+		NotBefore:   &notBefore,
+		NotAfter:    &notAfter,
+		RenewalTime: &renewal,
+	}
+}
+
+// GenerateIssuedCertificateConnectionDetails returns the certificate and its
+// chain in the format of a kubernetes.io/tls Secret.
+func GenerateIssuedCertificateConnectionDetails(out *acmpca.GetCertificateOutput) managed.ConnectionDetails {
+	cert := strings.TrimSpace(aws.StringValue(out.Certificate)) + "\n"
+	chain := strings.TrimSpace(aws.StringValue(out.CertificateChain))
+	if chain != "" {
+		chain += "\n"
+	}
+	return managed.ConnectionDetails{
+		corev1.TLSCertKey:         []byte(cert + chain),
+		SecretKeyCertificateChain: []byte(chain),
+	}
+}
+
+// IsCertificateNotFound returns true if the error code indicates that the
+// certificate was not found
+func IsCertificateNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == acmpca.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// IsRequestAlreadyProcessed returns true if the error code indicates that the
+// request, e.g. a revocation, was already processed.
+func IsRequestAlreadyProcessed(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == acmpca.ErrCodeRequestAlreadyProcessedException
+	}
+	return false
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigatewayv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetTags         = "cannot get tags"
+	errTagResource     = "cannot tag resource"
+	errUntagResource   = "cannot untag resource"
+	errGetRoutes       = "cannot get routes"
+	errGetIntegrations = "cannot get integrations"
+	errHashRevision    = "cannot compute revision of API"
+
+	responseParameterStatusCode = "overwrite:statuscode"
+	responseParameterHeaderFmt  = "%s:header.%s"
+)
+
+// ARN returns the ARN of the API Gateway resource with the supplied path in
+// the given region, e.g. /apis/a1b2c3 for an API.
+func ARN(region, path string) string {
+	partition := endpoints.AwsPartitionID
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		partition = p.ID()
+	}
+	return fmt.Sprintf("arn:%s:apigateway:%s::%s", partition, region, path)
+}
+
+// APIARN returns the ARN of the API with the given ID.
+func APIARN(region, apiID string) string {
+	return ARN(region, "/apis/"+apiID)
+}
+
+// StageARN returns the ARN of the stage with the given name.
+func StageARN(region, apiID, stageName string) string {
+	return ARN(region, fmt.Sprintf("/apis/%s/stages/%s", apiID, stageName))
+}
+
+// DomainNameARN returns the ARN of the given domain name.
+func DomainNameARN(region, domainName string) string {
+	return ARN(region, "/domainnames/"+domainName)
+}
+
+// VPCLinkARN returns the ARN of the VPC link with the given ID.
+func VPCLinkARN(region, vpcLinkID string) string {
+	return ARN(region, "/vpclinks/"+vpcLinkID)
+}
+
+// DiffTags returns the tags that should be added and the keys of the tags
+// that should be removed so that the current tags match the desired ones.
+func DiffTags(desired, current map[string]*string) (add map[string]*string, remove []*string) {
+	add = map[string]*string{}
+	for k, v := range desired {
+		if cv, ok := current[k]; !ok || aws.StringValue(cv) != aws.StringValue(v) {
+			add[k] = v
+		}
+	}
+	var keys []string
+	for k := range current {
+		if _, ok := desired[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return add, aws.StringSlice(keys)
+}
+
+// IsTagsUpToDate returns true if the current tags match the desired ones.
+func IsTagsUpToDate(desired, current map[string]*string) bool {
+	add, remove := DiffTags(desired, current)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// UpdateTags adds and removes tags of the resource with the given ARN so that
+// they match the desired tags.
+func UpdateTags(ctx context.Context, client apigatewayv2iface.ApiGatewayV2API, arn string, desired map[string]*string) error {
+	resp, err := client.GetTagsWithContext(ctx, &svcsdk.GetTagsInput{ResourceArn: aws.String(arn)})
+	if err != nil {
+		return awsclients.Wrap(err, errGetTags)
+	}
+	add, remove := DiffTags(desired, resp.Tags)
+	if len(remove) != 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceArn: aws.String(arn), TagKeys: remove}); err != nil {
+			return awsclients.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceArn: aws.String(arn), Tags: add}); err != nil {
+			return awsclients.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+// GenerateResponseParameters converts the response parameters of an
+// integration to the form the API expects, i.e. a map of status codes to maps
+// of transform operations such as overwrite:header.name.
+func GenerateResponseParameters(in svcapitypes.ResponseParameters) map[string]map[string]*string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]map[string]*string, len(in))
+	for code, p := range in {
+		m := map[string]*string{}
+		if p.OverwriteStatusCode != nil {
+			m[responseParameterStatusCode] = p.OverwriteStatusCode
+		}
+		for _, h := range p.HeaderEntries {
+			m[fmt.Sprintf(responseParameterHeaderFmt, h.Operation, h.Name)] = aws.String(h.Value)
+		}
+		out[code] = m
+	}
+	return out
+}
+
+// GenerateResponseParametersFromAPI converts the response parameters returned
+// by the API to their representation in the spec of an integration.
+func GenerateResponseParametersFromAPI(in map[string]map[string]*string) svcapitypes.ResponseParameters {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(svcapitypes.ResponseParameters, len(in))
+	for code, m := range in {
+		p := svcapitypes.ResponseParameter{}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if k == responseParameterStatusCode {
+				p.OverwriteStatusCode = m[k]
+				continue
+			}
+			op := strings.SplitN(k, ":header.", 2)
+			if len(op) != 2 {
+				continue
+			}
+			p.HeaderEntries = append(p.HeaderEntries, svcapitypes.HeaderEntry{Operation: op[0], Name: op[1], Value: aws.StringValue(m[k])})
+		}
+		out[code] = p
+	}
+	return out
+}
+
+// GetAPIRevision returns a hash of the routes and integrations of the API with
+// the given ID. It changes whenever a route or an integration of the API is
+// created, updated or deleted.
+func GetAPIRevision(ctx context.Context, client apigatewayv2iface.ApiGatewayV2API, apiID string) (string, error) {
+	rev := struct {
+		Routes       []*svcsdk.Route
+		Integrations []*svcsdk.Integration
+	}{}
+	rin := &svcsdk.GetRoutesInput{ApiId: aws.String(apiID)}
+	for {
+		resp, err := client.GetRoutesWithContext(ctx, rin)
+		if err != nil {
+			return "", awsclients.Wrap(err, errGetRoutes)
+		}
+		rev.Routes = append(rev.Routes, resp.Items...)
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		rin.NextToken = resp.NextToken
+	}
+	iin := &svcsdk.GetIntegrationsInput{ApiId: aws.String(apiID)}
+	for {
+		resp, err := client.GetIntegrationsWithContext(ctx, iin)
+		if err != nil {
+			return "", awsclients.Wrap(err, errGetIntegrations)
+		}
+		rev.Integrations = append(rev.Integrations, resp.Items...)
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		iin.NextToken = resp.NextToken
+	}
+	sort.Slice(rev.Routes, func(i, j int) bool {
+		return aws.StringValue(rev.Routes[i].RouteId) < aws.StringValue(rev.Routes[j].RouteId)
+	})
+	sort.Slice(rev.Integrations, func(i, j int) bool {
+		return aws.StringValue(rev.Integrations[i].IntegrationId) < aws.StringValue(rev.Integrations[j].IntegrationId)
+	})
+	b, err := json.Marshal(rev)
+	if err != nil {
+		return "", errors.Wrap(err, errHashRevision)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
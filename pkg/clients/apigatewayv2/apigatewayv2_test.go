@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigatewayv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+)
+
+func TestARN(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		path   string
+		want   string
+	}{
+		"Commercial": {
+			region: "us-east-1",
+			path:   "/apis/abc",
+			want:   "arn:aws:apigateway:us-east-1::/apis/abc",
+		},
+		"China": {
+			region: "cn-north-1",
+			path:   "/vpclinks/abc",
+			want:   "arn:aws-cn:apigateway:cn-north-1::/vpclinks/abc",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ARN(tc.region, tc.path)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    map[string]*string
+		remove []*string
+	}
+
+	cases := map[string]struct {
+		desired map[string]*string
+		current map[string]*string
+		want
+	}{
+		"Same": {
+			desired: map[string]*string{"k": aws.String("v")},
+			current: map[string]*string{"k": aws.String("v")},
+			want: want{
+				add:    map[string]*string{},
+				remove: []*string{},
+			},
+		},
+		"AddRemoveAndChange": {
+			desired: map[string]*string{"k": aws.String("new"), "add": aws.String("v")},
+			current: map[string]*string{"k": aws.String("old"), "b": aws.String("v"), "a": aws.String("v")},
+			want: want{
+				add:    map[string]*string{"k": aws.String("new"), "add": aws.String("v")},
+				remove: []*string{aws.String("a"), aws.String("b")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponseParameters(t *testing.T) {
+	params := svcapitypes.ResponseParameters{
+		"500": {
+			OverwriteStatusCode: aws.String("403"),
+			HeaderEntries: []svcapitypes.HeaderEntry{
+				{Operation: "append", Name: "a", Value: "1"},
+				{Operation: "remove", Name: "b", Value: "''"},
+			},
+		},
+	}
+	api := map[string]map[string]*string{
+		"500": {
+			"overwrite:statuscode": aws.String("403"),
+			"append:header.a":      aws.String("1"),
+			"remove:header.b":      aws.String("''"),
+		},
+	}
+
+	if diff := cmp.Diff(api, GenerateResponseParameters(params)); diff != "" {
+		t.Errorf("GenerateResponseParameters: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(params, GenerateResponseParametersFromAPI(api)); diff != "" {
+		t.Errorf("GenerateResponseParametersFromAPI: -want, +got:\n%s", diff)
+	}
+}
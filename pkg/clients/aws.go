@@ -39,6 +39,7 @@ import (
 	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
@@ -97,6 +98,48 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return useProviderConfig(ctx, c, mg, pc, region)
+}
+
+// UseProviderConfigReference produces a config that can be used to
+// authenticate to AWS using the referenced ProviderConfig instead of the one
+// of the supplied managed resource, e.g. to act on resources that belong to
+// another account. The usage of the referenced ProviderConfig is tracked
+// until the managed resource is deleted.
+func UseProviderConfigReference(ctx context.Context, c client.Client, mg resource.Managed, ref xpv1.Reference, region string) (*aws.Config, error) {
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced Provider")
+	}
+
+	if err := trackProviderConfigReference(ctx, c, mg, ref); err != nil {
+		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
+	}
+
+	return useProviderConfig(ctx, c, mg, pc, region)
+}
+
+// trackProviderConfigReference records that the supplied managed resource
+// uses the referenced ProviderConfig. The ProviderConfigUsageTracker names a
+// usage after the UID of the managed resource, which is taken by the usage of
+// its own ProviderConfig, so the usage is named after both here.
+func trackProviderConfigReference(ctx context.Context, c client.Client, mg resource.Managed, ref xpv1.Reference) error {
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	pcu := &v1beta1.ProviderConfigUsage{}
+	pcu.SetName(fmt.Sprintf("%s-%s", mg.GetUID(), ref.Name))
+	pcu.SetLabels(map[string]string{xpv1.LabelKeyProviderName: ref.Name})
+	pcu.SetOwnerReferences([]metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, gvk))})
+	pcu.SetProviderConfigReference(xpv1.Reference{Name: ref.Name})
+	pcu.SetResourceReference(xpv1.TypedReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       mg.GetName(),
+	})
+	err := resource.NewAPIUpdatingApplicator(c).Apply(ctx, pcu, resource.MustBeControllableBy(mg.GetUID()))
+	return resource.Ignore(resource.IsNotAllowed, err)
+}
+
+func useProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCachePolicyConfig returns the configuration of a cache policy with
+// the given parameters.
+func GenerateCachePolicyConfig(p v1alpha1.CachePolicyParameters) *svcsdk.CachePolicyConfig {
+	k := p.ParametersInCacheKeyAndForwardedToOrigin
+	return &svcsdk.CachePolicyConfig{
+		Name:       aws.String(p.Name),
+		Comment:    p.Comment,
+		DefaultTTL: p.DefaultTTL,
+		MaxTTL:     p.MaxTTL,
+		MinTTL:     aws.Int64(p.MinTTL),
+		ParametersInCacheKeyAndForwardedToOrigin: &svcsdk.ParametersInCacheKeyAndForwardedToOrigin{
+			EnableAcceptEncodingGzip:   aws.Bool(k.EnableAcceptEncodingGzip),
+			EnableAcceptEncodingBrotli: k.EnableAcceptEncodingBrotli,
+			CookiesConfig: &svcsdk.CachePolicyCookiesConfig{
+				CookieBehavior: aws.String(k.CookiesConfig.CookieBehavior),
+				Cookies:        &svcsdk.CookieNames{Quantity: quantity(k.CookiesConfig.Cookies), Items: itemsOrNil(k.CookiesConfig.Cookies)},
+			},
+			HeadersConfig: &svcsdk.CachePolicyHeadersConfig{
+				HeaderBehavior: aws.String(k.HeadersConfig.HeaderBehavior),
+				Headers:        &svcsdk.Headers{Quantity: quantity(k.HeadersConfig.Headers), Items: itemsOrNil(k.HeadersConfig.Headers)},
+			},
+			QueryStringsConfig: &svcsdk.CachePolicyQueryStringsConfig{
+				QueryStringBehavior: aws.String(k.QueryStringsConfig.QueryStringBehavior),
+				QueryStrings:        &svcsdk.QueryStringNames{Quantity: quantity(k.QueryStringsConfig.QueryStrings), Items: itemsOrNil(k.QueryStringsConfig.QueryStrings)},
+			},
+		},
+	}
+}
+
+// LateInitializeCachePolicy fills the empty fields of the given parameters
+// with the values of the observed cache policy configuration.
+func LateInitializeCachePolicy(p *v1alpha1.CachePolicyParameters, c *svcsdk.CachePolicyConfig) {
+	if c == nil {
+		return
+	}
+	p.Comment = awsclient.LateInitializeStringPtr(p.Comment, c.Comment)
+	p.DefaultTTL = awsclient.LateInitializeInt64Ptr(p.DefaultTTL, c.DefaultTTL)
+	p.MaxTTL = awsclient.LateInitializeInt64Ptr(p.MaxTTL, c.MaxTTL)
+	if k := c.ParametersInCacheKeyAndForwardedToOrigin; k != nil {
+		p.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingBrotli = awsclient.LateInitializeBoolPtr(
+			p.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingBrotli, k.EnableAcceptEncodingBrotli)
+	}
+}
+
+// IsCachePolicyUpToDate returns true if the observed cache policy
+// configuration matches the given parameters.
+func IsCachePolicyUpToDate(p v1alpha1.CachePolicyParameters, c *svcsdk.CachePolicyConfig) bool {
+	if c == nil {
+		return false
+	}
+	observed := p.DeepCopy()
+	observed.Name = aws.StringValue(c.Name)
+	observed.Comment = c.Comment
+	observed.DefaultTTL = c.DefaultTTL
+	observed.MaxTTL = c.MaxTTL
+	observed.MinTTL = aws.Int64Value(c.MinTTL)
+	observed.ParametersInCacheKeyAndForwardedToOrigin = v1alpha1.CacheKeyParameters{}
+	if k := c.ParametersInCacheKeyAndForwardedToOrigin; k != nil {
+		o := &observed.ParametersInCacheKeyAndForwardedToOrigin
+		o.EnableAcceptEncodingGzip = aws.BoolValue(k.EnableAcceptEncodingGzip)
+		o.EnableAcceptEncodingBrotli = k.EnableAcceptEncodingBrotli
+		if k.CookiesConfig != nil {
+			o.CookiesConfig.CookieBehavior = aws.StringValue(k.CookiesConfig.CookieBehavior)
+			if k.CookiesConfig.Cookies != nil {
+				o.CookiesConfig.Cookies = aws.StringValueSlice(k.CookiesConfig.Cookies.Items)
+			}
+		}
+		if k.HeadersConfig != nil {
+			o.HeadersConfig.HeaderBehavior = aws.StringValue(k.HeadersConfig.HeaderBehavior)
+			if k.HeadersConfig.Headers != nil {
+				o.HeadersConfig.Headers = aws.StringValueSlice(k.HeadersConfig.Headers.Items)
+			}
+		}
+		if k.QueryStringsConfig != nil {
+			o.QueryStringsConfig.QueryStringBehavior = aws.StringValue(k.QueryStringsConfig.QueryStringBehavior)
+			if k.QueryStringsConfig.QueryStrings != nil {
+				o.QueryStringsConfig.QueryStrings = aws.StringValueSlice(k.QueryStringsConfig.QueryStrings.Items)
+			}
+		}
+	}
+	return cmp.Equal(&p, observed, cmpopts.EquateEmpty(), sortStrings)
+}
+
+var sortStrings = cmpopts.SortSlices(func(a, b string) bool { return a < b })
@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+func cachePolicyParameters(m ...func(*v1alpha1.CachePolicyParameters)) v1alpha1.CachePolicyParameters {
+	p := v1alpha1.CachePolicyParameters{
+		Region:     "us-east-1",
+		Name:       "policy",
+		DefaultTTL: aws.Int64(86400),
+		MaxTTL:     aws.Int64(31536000),
+		MinTTL:     1,
+		ParametersInCacheKeyAndForwardedToOrigin: v1alpha1.CacheKeyParameters{
+			EnableAcceptEncodingGzip: true,
+			CookiesConfig:            v1alpha1.CacheKeyCookies{CookieBehavior: "none"},
+			HeadersConfig:            v1alpha1.CacheKeyHeaders{HeaderBehavior: "whitelist", Headers: []string{"Origin", "Host"}},
+			QueryStringsConfig:       v1alpha1.CacheKeyQueryStrings{QueryStringBehavior: "all"},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestIsCachePolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CachePolicyParameters
+		c    *svcsdk.CachePolicyConfig
+		want bool
+	}{
+		"UpToDate": {
+			p: cachePolicyParameters(),
+			c: GenerateCachePolicyConfig(cachePolicyParameters(func(p *v1alpha1.CachePolicyParameters) {
+				p.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers = []string{"Host", "Origin"}
+			})),
+			want: true,
+		},
+		"DifferentHeaders": {
+			p: cachePolicyParameters(),
+			c: GenerateCachePolicyConfig(cachePolicyParameters(func(p *v1alpha1.CachePolicyParameters) {
+				p.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers = []string{"Host"}
+			})),
+			want: false,
+		},
+		"DifferentTTL": {
+			p: cachePolicyParameters(),
+			c: GenerateCachePolicyConfig(cachePolicyParameters(func(p *v1alpha1.CachePolicyParameters) {
+				p.MinTTL = 0
+			})),
+			want: false,
+		},
+		"NotObserved": {
+			p:    cachePolicyParameters(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCachePolicyUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
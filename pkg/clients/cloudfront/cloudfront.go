@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+// Client is the external client used for the CloudFront resources that are
+// not generated.
+type Client interface {
+	CreateCachePolicyWithContext(context.Context, *svcsdk.CreateCachePolicyInput, ...request.Option) (*svcsdk.CreateCachePolicyOutput, error)
+	GetCachePolicyWithContext(context.Context, *svcsdk.GetCachePolicyInput, ...request.Option) (*svcsdk.GetCachePolicyOutput, error)
+	UpdateCachePolicyWithContext(context.Context, *svcsdk.UpdateCachePolicyInput, ...request.Option) (*svcsdk.UpdateCachePolicyOutput, error)
+	DeleteCachePolicyWithContext(context.Context, *svcsdk.DeleteCachePolicyInput, ...request.Option) (*svcsdk.DeleteCachePolicyOutput, error)
+
+	CreateOriginRequestPolicyWithContext(context.Context, *svcsdk.CreateOriginRequestPolicyInput, ...request.Option) (*svcsdk.CreateOriginRequestPolicyOutput, error)
+	GetOriginRequestPolicyWithContext(context.Context, *svcsdk.GetOriginRequestPolicyInput, ...request.Option) (*svcsdk.GetOriginRequestPolicyOutput, error)
+	UpdateOriginRequestPolicyWithContext(context.Context, *svcsdk.UpdateOriginRequestPolicyInput, ...request.Option) (*svcsdk.UpdateOriginRequestPolicyOutput, error)
+	DeleteOriginRequestPolicyWithContext(context.Context, *svcsdk.DeleteOriginRequestPolicyInput, ...request.Option) (*svcsdk.DeleteOriginRequestPolicyOutput, error)
+
+	CreateCloudFrontOriginAccessIdentityWithContext(context.Context, *svcsdk.CreateCloudFrontOriginAccessIdentityInput, ...request.Option) (*svcsdk.CreateCloudFrontOriginAccessIdentityOutput, error)
+	GetCloudFrontOriginAccessIdentityWithContext(context.Context, *svcsdk.GetCloudFrontOriginAccessIdentityInput, ...request.Option) (*svcsdk.GetCloudFrontOriginAccessIdentityOutput, error)
+	UpdateCloudFrontOriginAccessIdentityWithContext(context.Context, *svcsdk.UpdateCloudFrontOriginAccessIdentityInput, ...request.Option) (*svcsdk.UpdateCloudFrontOriginAccessIdentityOutput, error)
+	DeleteCloudFrontOriginAccessIdentityWithContext(context.Context, *svcsdk.DeleteCloudFrontOriginAccessIdentityInput, ...request.Option) (*svcsdk.DeleteCloudFrontOriginAccessIdentityOutput, error)
+
+	CreatePublicKeyWithContext(context.Context, *svcsdk.CreatePublicKeyInput, ...request.Option) (*svcsdk.CreatePublicKeyOutput, error)
+	GetPublicKeyWithContext(context.Context, *svcsdk.GetPublicKeyInput, ...request.Option) (*svcsdk.GetPublicKeyOutput, error)
+	UpdatePublicKeyWithContext(context.Context, *svcsdk.UpdatePublicKeyInput, ...request.Option) (*svcsdk.UpdatePublicKeyOutput, error)
+	DeletePublicKeyWithContext(context.Context, *svcsdk.DeletePublicKeyInput, ...request.Option) (*svcsdk.DeletePublicKeyOutput, error)
+
+	CreateKeyGroupWithContext(context.Context, *svcsdk.CreateKeyGroupInput, ...request.Option) (*svcsdk.CreateKeyGroupOutput, error)
+	GetKeyGroupWithContext(context.Context, *svcsdk.GetKeyGroupInput, ...request.Option) (*svcsdk.GetKeyGroupOutput, error)
+	UpdateKeyGroupWithContext(context.Context, *svcsdk.UpdateKeyGroupInput, ...request.Option) (*svcsdk.UpdateKeyGroupOutput, error)
+	DeleteKeyGroupWithContext(context.Context, *svcsdk.DeleteKeyGroupInput, ...request.Option) (*svcsdk.DeleteKeyGroupOutput, error)
+
+	CreateInvalidationWithContext(context.Context, *svcsdk.CreateInvalidationInput, ...request.Option) (*svcsdk.CreateInvalidationOutput, error)
+	GetInvalidationWithContext(context.Context, *svcsdk.GetInvalidationInput, ...request.Option) (*svcsdk.GetInvalidationOutput, error)
+
+	CreateFunctionWithContext(context.Context, *svcsdk.CreateFunctionInput, ...request.Option) (*svcsdk.CreateFunctionOutput, error)
+	DescribeFunctionWithContext(context.Context, *svcsdk.DescribeFunctionInput, ...request.Option) (*svcsdk.DescribeFunctionOutput, error)
+	GetFunctionWithContext(context.Context, *svcsdk.GetFunctionInput, ...request.Option) (*svcsdk.GetFunctionOutput, error)
+	UpdateFunctionWithContext(context.Context, *svcsdk.UpdateFunctionInput, ...request.Option) (*svcsdk.UpdateFunctionOutput, error)
+	TestFunctionWithContext(context.Context, *svcsdk.TestFunctionInput, ...request.Option) (*svcsdk.TestFunctionOutput, error)
+	PublishFunctionWithContext(context.Context, *svcsdk.PublishFunctionInput, ...request.Option) (*svcsdk.PublishFunctionOutput, error)
+	DeleteFunctionWithContext(context.Context, *svcsdk.DeleteFunctionInput, ...request.Option) (*svcsdk.DeleteFunctionOutput, error)
+}
+
+// NewClient returns a new Client with the provided session.
+func NewClient(sess *session.Session) Client {
+	return svcsdk.New(sess)
+}
+
+// IsNotFound returns true if the error is because the CloudFront resource
+// doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case svcsdk.ErrCodeNoSuchCachePolicy,
+		svcsdk.ErrCodeNoSuchOriginRequestPolicy,
+		svcsdk.ErrCodeNoSuchCloudFrontOriginAccessIdentity,
+		svcsdk.ErrCodeNoSuchPublicKey,
+		svcsdk.ErrCodeNoSuchResource,
+		svcsdk.ErrCodeNoSuchInvalidation,
+		svcsdk.ErrCodeNoSuchFunctionExists:
+		return true
+	}
+	return false
+}
+
+// quantity returns the number of items of a CloudFront list.
+func quantity(items []string) *int64 {
+	return aws.Int64(int64(len(items)))
+}
+
+// itemsOrNil returns the items of a CloudFront list, or nil if there are
+// none since CloudFront expects the list to be omitted then.
+func itemsOrNil(items []string) []*string {
+	if len(items) == 0 {
+		return nil
+	}
+	return aws.StringSlice(items)
+}
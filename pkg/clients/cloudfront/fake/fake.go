@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateCachePolicy                    func(*svcsdk.CreateCachePolicyInput) (*svcsdk.CreateCachePolicyOutput, error)
+	MockGetCachePolicy                       func(*svcsdk.GetCachePolicyInput) (*svcsdk.GetCachePolicyOutput, error)
+	MockUpdateCachePolicy                    func(*svcsdk.UpdateCachePolicyInput) (*svcsdk.UpdateCachePolicyOutput, error)
+	MockDeleteCachePolicy                    func(*svcsdk.DeleteCachePolicyInput) (*svcsdk.DeleteCachePolicyOutput, error)
+	MockCreateOriginRequestPolicy            func(*svcsdk.CreateOriginRequestPolicyInput) (*svcsdk.CreateOriginRequestPolicyOutput, error)
+	MockGetOriginRequestPolicy               func(*svcsdk.GetOriginRequestPolicyInput) (*svcsdk.GetOriginRequestPolicyOutput, error)
+	MockUpdateOriginRequestPolicy            func(*svcsdk.UpdateOriginRequestPolicyInput) (*svcsdk.UpdateOriginRequestPolicyOutput, error)
+	MockDeleteOriginRequestPolicy            func(*svcsdk.DeleteOriginRequestPolicyInput) (*svcsdk.DeleteOriginRequestPolicyOutput, error)
+	MockCreateCloudFrontOriginAccessIdentity func(*svcsdk.CreateCloudFrontOriginAccessIdentityInput) (*svcsdk.CreateCloudFrontOriginAccessIdentityOutput, error)
+	MockGetCloudFrontOriginAccessIdentity    func(*svcsdk.GetCloudFrontOriginAccessIdentityInput) (*svcsdk.GetCloudFrontOriginAccessIdentityOutput, error)
+	MockUpdateCloudFrontOriginAccessIdentity func(*svcsdk.UpdateCloudFrontOriginAccessIdentityInput) (*svcsdk.UpdateCloudFrontOriginAccessIdentityOutput, error)
+	MockDeleteCloudFrontOriginAccessIdentity func(*svcsdk.DeleteCloudFrontOriginAccessIdentityInput) (*svcsdk.DeleteCloudFrontOriginAccessIdentityOutput, error)
+	MockCreatePublicKey                      func(*svcsdk.CreatePublicKeyInput) (*svcsdk.CreatePublicKeyOutput, error)
+	MockGetPublicKey                         func(*svcsdk.GetPublicKeyInput) (*svcsdk.GetPublicKeyOutput, error)
+	MockUpdatePublicKey                      func(*svcsdk.UpdatePublicKeyInput) (*svcsdk.UpdatePublicKeyOutput, error)
+	MockDeletePublicKey                      func(*svcsdk.DeletePublicKeyInput) (*svcsdk.DeletePublicKeyOutput, error)
+	MockCreateKeyGroup                       func(*svcsdk.CreateKeyGroupInput) (*svcsdk.CreateKeyGroupOutput, error)
+	MockGetKeyGroup                          func(*svcsdk.GetKeyGroupInput) (*svcsdk.GetKeyGroupOutput, error)
+	MockUpdateKeyGroup                       func(*svcsdk.UpdateKeyGroupInput) (*svcsdk.UpdateKeyGroupOutput, error)
+	MockDeleteKeyGroup                       func(*svcsdk.DeleteKeyGroupInput) (*svcsdk.DeleteKeyGroupOutput, error)
+	MockCreateInvalidation                   func(*svcsdk.CreateInvalidationInput) (*svcsdk.CreateInvalidationOutput, error)
+	MockGetInvalidation                      func(*svcsdk.GetInvalidationInput) (*svcsdk.GetInvalidationOutput, error)
+	MockCreateFunction                       func(*svcsdk.CreateFunctionInput) (*svcsdk.CreateFunctionOutput, error)
+	MockDescribeFunction                     func(*svcsdk.DescribeFunctionInput) (*svcsdk.DescribeFunctionOutput, error)
+	MockGetFunction                          func(*svcsdk.GetFunctionInput) (*svcsdk.GetFunctionOutput, error)
+	MockUpdateFunction                       func(*svcsdk.UpdateFunctionInput) (*svcsdk.UpdateFunctionOutput, error)
+	MockTestFunction                         func(*svcsdk.TestFunctionInput) (*svcsdk.TestFunctionOutput, error)
+	MockPublishFunction                      func(*svcsdk.PublishFunctionInput) (*svcsdk.PublishFunctionOutput, error)
+	MockDeleteFunction                       func(*svcsdk.DeleteFunctionInput) (*svcsdk.DeleteFunctionOutput, error)
+}
+
+// CreateCachePolicyWithContext mocks CreateCachePolicyWithContext method
+func (m *MockClient) CreateCachePolicyWithContext(_ context.Context, input *svcsdk.CreateCachePolicyInput, _ ...request.Option) (*svcsdk.CreateCachePolicyOutput, error) {
+	return m.MockCreateCachePolicy(input)
+}
+
+// GetCachePolicyWithContext mocks GetCachePolicyWithContext method
+func (m *MockClient) GetCachePolicyWithContext(_ context.Context, input *svcsdk.GetCachePolicyInput, _ ...request.Option) (*svcsdk.GetCachePolicyOutput, error) {
+	return m.MockGetCachePolicy(input)
+}
+
+// UpdateCachePolicyWithContext mocks UpdateCachePolicyWithContext method
+func (m *MockClient) UpdateCachePolicyWithContext(_ context.Context, input *svcsdk.UpdateCachePolicyInput, _ ...request.Option) (*svcsdk.UpdateCachePolicyOutput, error) {
+	return m.MockUpdateCachePolicy(input)
+}
+
+// DeleteCachePolicyWithContext mocks DeleteCachePolicyWithContext method
+func (m *MockClient) DeleteCachePolicyWithContext(_ context.Context, input *svcsdk.DeleteCachePolicyInput, _ ...request.Option) (*svcsdk.DeleteCachePolicyOutput, error) {
+	return m.MockDeleteCachePolicy(input)
+}
+
+// CreateOriginRequestPolicyWithContext mocks CreateOriginRequestPolicyWithContext method
+func (m *MockClient) CreateOriginRequestPolicyWithContext(_ context.Context, input *svcsdk.CreateOriginRequestPolicyInput, _ ...request.Option) (*svcsdk.CreateOriginRequestPolicyOutput, error) {
+	return m.MockCreateOriginRequestPolicy(input)
+}
+
+// GetOriginRequestPolicyWithContext mocks GetOriginRequestPolicyWithContext method
+func (m *MockClient) GetOriginRequestPolicyWithContext(_ context.Context, input *svcsdk.GetOriginRequestPolicyInput, _ ...request.Option) (*svcsdk.GetOriginRequestPolicyOutput, error) {
+	return m.MockGetOriginRequestPolicy(input)
+}
+
+// UpdateOriginRequestPolicyWithContext mocks UpdateOriginRequestPolicyWithContext method
+func (m *MockClient) UpdateOriginRequestPolicyWithContext(_ context.Context, input *svcsdk.UpdateOriginRequestPolicyInput, _ ...request.Option) (*svcsdk.UpdateOriginRequestPolicyOutput, error) {
+	return m.MockUpdateOriginRequestPolicy(input)
+}
+
+// DeleteOriginRequestPolicyWithContext mocks DeleteOriginRequestPolicyWithContext method
+func (m *MockClient) DeleteOriginRequestPolicyWithContext(_ context.Context, input *svcsdk.DeleteOriginRequestPolicyInput, _ ...request.Option) (*svcsdk.DeleteOriginRequestPolicyOutput, error) {
+	return m.MockDeleteOriginRequestPolicy(input)
+}
+
+// CreateCloudFrontOriginAccessIdentityWithContext mocks CreateCloudFrontOriginAccessIdentityWithContext method
+func (m *MockClient) CreateCloudFrontOriginAccessIdentityWithContext(_ context.Context, input *svcsdk.CreateCloudFrontOriginAccessIdentityInput, _ ...request.Option) (*svcsdk.CreateCloudFrontOriginAccessIdentityOutput, error) {
+	return m.MockCreateCloudFrontOriginAccessIdentity(input)
+}
+
+// GetCloudFrontOriginAccessIdentityWithContext mocks GetCloudFrontOriginAccessIdentityWithContext method
+func (m *MockClient) GetCloudFrontOriginAccessIdentityWithContext(_ context.Context, input *svcsdk.GetCloudFrontOriginAccessIdentityInput, _ ...request.Option) (*svcsdk.GetCloudFrontOriginAccessIdentityOutput, error) {
+	return m.MockGetCloudFrontOriginAccessIdentity(input)
+}
+
+// UpdateCloudFrontOriginAccessIdentityWithContext mocks UpdateCloudFrontOriginAccessIdentityWithContext method
+func (m *MockClient) UpdateCloudFrontOriginAccessIdentityWithContext(_ context.Context, input *svcsdk.UpdateCloudFrontOriginAccessIdentityInput, _ ...request.Option) (*svcsdk.UpdateCloudFrontOriginAccessIdentityOutput, error) {
+	return m.MockUpdateCloudFrontOriginAccessIdentity(input)
+}
+
+// DeleteCloudFrontOriginAccessIdentityWithContext mocks DeleteCloudFrontOriginAccessIdentityWithContext method
+func (m *MockClient) DeleteCloudFrontOriginAccessIdentityWithContext(_ context.Context, input *svcsdk.DeleteCloudFrontOriginAccessIdentityInput, _ ...request.Option) (*svcsdk.DeleteCloudFrontOriginAccessIdentityOutput, error) {
+	return m.MockDeleteCloudFrontOriginAccessIdentity(input)
+}
+
+// CreatePublicKeyWithContext mocks CreatePublicKeyWithContext method
+func (m *MockClient) CreatePublicKeyWithContext(_ context.Context, input *svcsdk.CreatePublicKeyInput, _ ...request.Option) (*svcsdk.CreatePublicKeyOutput, error) {
+	return m.MockCreatePublicKey(input)
+}
+
+// GetPublicKeyWithContext mocks GetPublicKeyWithContext method
+func (m *MockClient) GetPublicKeyWithContext(_ context.Context, input *svcsdk.GetPublicKeyInput, _ ...request.Option) (*svcsdk.GetPublicKeyOutput, error) {
+	return m.MockGetPublicKey(input)
+}
+
+// UpdatePublicKeyWithContext mocks UpdatePublicKeyWithContext method
+func (m *MockClient) UpdatePublicKeyWithContext(_ context.Context, input *svcsdk.UpdatePublicKeyInput, _ ...request.Option) (*svcsdk.UpdatePublicKeyOutput, error) {
+	return m.MockUpdatePublicKey(input)
+}
+
+// DeletePublicKeyWithContext mocks DeletePublicKeyWithContext method
+func (m *MockClient) DeletePublicKeyWithContext(_ context.Context, input *svcsdk.DeletePublicKeyInput, _ ...request.Option) (*svcsdk.DeletePublicKeyOutput, error) {
+	return m.MockDeletePublicKey(input)
+}
+
+// CreateKeyGroupWithContext mocks CreateKeyGroupWithContext method
+func (m *MockClient) CreateKeyGroupWithContext(_ context.Context, input *svcsdk.CreateKeyGroupInput, _ ...request.Option) (*svcsdk.CreateKeyGroupOutput, error) {
+	return m.MockCreateKeyGroup(input)
+}
+
+// GetKeyGroupWithContext mocks GetKeyGroupWithContext method
+func (m *MockClient) GetKeyGroupWithContext(_ context.Context, input *svcsdk.GetKeyGroupInput, _ ...request.Option) (*svcsdk.GetKeyGroupOutput, error) {
+	return m.MockGetKeyGroup(input)
+}
+
+// UpdateKeyGroupWithContext mocks UpdateKeyGroupWithContext method
+func (m *MockClient) UpdateKeyGroupWithContext(_ context.Context, input *svcsdk.UpdateKeyGroupInput, _ ...request.Option) (*svcsdk.UpdateKeyGroupOutput, error) {
+	return m.MockUpdateKeyGroup(input)
+}
+
+// DeleteKeyGroupWithContext mocks DeleteKeyGroupWithContext method
+func (m *MockClient) DeleteKeyGroupWithContext(_ context.Context, input *svcsdk.DeleteKeyGroupInput, _ ...request.Option) (*svcsdk.DeleteKeyGroupOutput, error) {
+	return m.MockDeleteKeyGroup(input)
+}
+
+// CreateInvalidationWithContext mocks CreateInvalidationWithContext method
+func (m *MockClient) CreateInvalidationWithContext(_ context.Context, input *svcsdk.CreateInvalidationInput, _ ...request.Option) (*svcsdk.CreateInvalidationOutput, error) {
+	return m.MockCreateInvalidation(input)
+}
+
+// GetInvalidationWithContext mocks GetInvalidationWithContext method
+func (m *MockClient) GetInvalidationWithContext(_ context.Context, input *svcsdk.GetInvalidationInput, _ ...request.Option) (*svcsdk.GetInvalidationOutput, error) {
+	return m.MockGetInvalidation(input)
+}
+
+// CreateFunctionWithContext mocks CreateFunctionWithContext method
+func (m *MockClient) CreateFunctionWithContext(_ context.Context, input *svcsdk.CreateFunctionInput, _ ...request.Option) (*svcsdk.CreateFunctionOutput, error) {
+	return m.MockCreateFunction(input)
+}
+
+// DescribeFunctionWithContext mocks DescribeFunctionWithContext method
+func (m *MockClient) DescribeFunctionWithContext(_ context.Context, input *svcsdk.DescribeFunctionInput, _ ...request.Option) (*svcsdk.DescribeFunctionOutput, error) {
+	return m.MockDescribeFunction(input)
+}
+
+// GetFunctionWithContext mocks GetFunctionWithContext method
+func (m *MockClient) GetFunctionWithContext(_ context.Context, input *svcsdk.GetFunctionInput, _ ...request.Option) (*svcsdk.GetFunctionOutput, error) {
+	return m.MockGetFunction(input)
+}
+
+// UpdateFunctionWithContext mocks UpdateFunctionWithContext method
+func (m *MockClient) UpdateFunctionWithContext(_ context.Context, input *svcsdk.UpdateFunctionInput, _ ...request.Option) (*svcsdk.UpdateFunctionOutput, error) {
+	return m.MockUpdateFunction(input)
+}
+
+// TestFunctionWithContext mocks TestFunctionWithContext method
+func (m *MockClient) TestFunctionWithContext(_ context.Context, input *svcsdk.TestFunctionInput, _ ...request.Option) (*svcsdk.TestFunctionOutput, error) {
+	return m.MockTestFunction(input)
+}
+
+// PublishFunctionWithContext mocks PublishFunctionWithContext method
+func (m *MockClient) PublishFunctionWithContext(_ context.Context, input *svcsdk.PublishFunctionInput, _ ...request.Option) (*svcsdk.PublishFunctionOutput, error) {
+	return m.MockPublishFunction(input)
+}
+
+// DeleteFunctionWithContext mocks DeleteFunctionWithContext method
+func (m *MockClient) DeleteFunctionWithContext(_ context.Context, input *svcsdk.DeleteFunctionInput, _ ...request.Option) (*svcsdk.DeleteFunctionOutput, error) {
+	return m.MockDeleteFunction(input)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateFunctionConfig returns the configuration of a function with the
+// given parameters.
+func GenerateFunctionConfig(p v1alpha1.FunctionParameters) *svcsdk.FunctionConfig {
+	runtime := p.Runtime
+	if runtime == "" {
+		runtime = svcsdk.FunctionRuntimeCloudfrontJs10
+	}
+	return &svcsdk.FunctionConfig{
+		// The comment is required by the API even if it is empty.
+		Comment: aws.String(aws.StringValue(p.Comment)),
+		Runtime: aws.String(runtime),
+	}
+}
+
+// IsFunctionUpToDate returns true if the observed configuration and code of a
+// stage of a function match the given parameters and code.
+func IsFunctionUpToDate(p v1alpha1.FunctionParameters, code string, c *svcsdk.FunctionConfig, observedCode []byte) bool {
+	if c == nil {
+		return false
+	}
+	desired := GenerateFunctionConfig(p)
+	return aws.StringValue(desired.Comment) == aws.StringValue(c.Comment) &&
+		aws.StringValue(desired.Runtime) == aws.StringValue(c.Runtime) &&
+		code == string(observedCode)
+}
+
+// IsPublishEnabled returns true if the function should be published to the
+// LIVE stage after every change. This is the default.
+func IsPublishEnabled(p v1alpha1.FunctionParameters) bool {
+	return p.Publish == nil || aws.BoolValue(p.Publish)
+}
+
+// GenerateFunctionObservation returns the observation of the DEVELOPMENT
+// stage of a function.
+func GenerateFunctionObservation(o *svcsdk.DescribeFunctionOutput) v1alpha1.FunctionObservation {
+	obs := v1alpha1.FunctionObservation{ETag: o.ETag}
+	if o.FunctionSummary == nil {
+		return obs
+	}
+	obs.Status = o.FunctionSummary.Status
+	if m := o.FunctionSummary.FunctionMetadata; m != nil {
+		obs.FunctionARN = m.FunctionARN
+		obs.CreatedTime = awsclient.LateInitializeTimePtr(nil, m.CreatedTime)
+		obs.LastModifiedTime = awsclient.LateInitializeTimePtr(nil, m.LastModifiedTime)
+	}
+	return obs
+}
+
+// GenerateFunctionTestResult returns the result of a function test.
+func GenerateFunctionTestResult(r *svcsdk.TestResult) *v1alpha1.FunctionTestResult {
+	if r == nil {
+		return nil
+	}
+	return &v1alpha1.FunctionTestResult{
+		ComputeUtilization:    r.ComputeUtilization,
+		FunctionErrorMessage:  r.FunctionErrorMessage,
+		FunctionExecutionLogs: aws.StringValueSlice(r.FunctionExecutionLogs),
+		FunctionOutput:        r.FunctionOutput,
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+// InvalidationCallerReference returns the caller reference of the
+// invalidation the given Invalidation should have issued last. It changes
+// whenever the paths or the trigger annotation of the Invalidation change.
+func InvalidationCallerReference(cr *v1alpha1.Invalidation) string {
+	paths := append([]string{}, cr.Spec.ForProvider.Paths...)
+	sort.Strings(paths)
+	paths = append(paths, cr.GetAnnotations()[v1alpha1.AnnotationKeyInvalidationTrigger])
+	sum := sha256.Sum256([]byte(strings.Join(paths, "\n")))
+	return string(cr.UID) + "-" + hex.EncodeToString(sum[:])[:16]
+}
+
+// GenerateCreateInvalidationInput returns the input to issue an invalidation
+// of the paths of the given Invalidation.
+func GenerateCreateInvalidationInput(cr *v1alpha1.Invalidation) *svcsdk.CreateInvalidationInput {
+	return &svcsdk.CreateInvalidationInput{
+		DistributionId: cr.Spec.ForProvider.DistributionID,
+		InvalidationBatch: &svcsdk.InvalidationBatch{
+			CallerReference: aws.String(InvalidationCallerReference(cr)),
+			Paths: &svcsdk.Paths{
+				Quantity: quantity(cr.Spec.ForProvider.Paths),
+				Items:    aws.StringSlice(cr.Spec.ForProvider.Paths),
+			},
+		},
+	}
+}
+
+// GenerateInvalidationObservation returns the observation of the given
+// invalidation.
+func GenerateInvalidationObservation(i *svcsdk.Invalidation) v1alpha1.InvalidationObservation {
+	o := v1alpha1.InvalidationObservation{
+		Status: i.Status,
+	}
+	if i.InvalidationBatch != nil {
+		o.CallerReference = i.InvalidationBatch.CallerReference
+	}
+	if i.CreateTime != nil {
+		t := metav1.NewTime(*i.CreateTime)
+		o.CreateTime = &t
+	}
+	return o
+}
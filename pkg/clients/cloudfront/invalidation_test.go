@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+func invalidation(paths []string, trigger string) *v1alpha1.Invalidation {
+	cr := &v1alpha1.Invalidation{
+		ObjectMeta: metav1.ObjectMeta{UID: "uid"},
+		Spec: v1alpha1.InvalidationSpec{
+			ForProvider: v1alpha1.InvalidationParameters{Paths: paths},
+		},
+	}
+	if trigger != "" {
+		cr.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyInvalidationTrigger: trigger})
+	}
+	return cr
+}
+
+func TestInvalidationCallerReference(t *testing.T) {
+	base := InvalidationCallerReference(invalidation([]string{"/index.html", "/images/*"}, ""))
+
+	cases := map[string]struct {
+		cr   *v1alpha1.Invalidation
+		same bool
+	}{
+		"ReorderedPaths": {
+			cr:   invalidation([]string{"/images/*", "/index.html"}, ""),
+			same: true,
+		},
+		"ChangedPaths": {
+			cr:   invalidation([]string{"/index.html"}, ""),
+			same: false,
+		},
+		"ChangedTrigger": {
+			cr:   invalidation([]string{"/index.html", "/images/*"}, "2"),
+			same: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := InvalidationCallerReference(tc.cr) == base
+			if diff := cmp.Diff(tc.same, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GeneratePublicKeyConfig returns the configuration of a public key with the
+// given parameters.
+func GeneratePublicKeyConfig(p v1alpha1.PublicKeyParameters, callerReference string) *svcsdk.PublicKeyConfig {
+	return &svcsdk.PublicKeyConfig{
+		CallerReference: aws.String(callerReference),
+		Name:            aws.String(p.Name),
+		EncodedKey:      aws.String(p.EncodedKey),
+		Comment:         p.Comment,
+	}
+}
+
+// LateInitializePublicKey fills the empty fields of the given parameters with
+// the values of the observed public key configuration.
+func LateInitializePublicKey(p *v1alpha1.PublicKeyParameters, c *svcsdk.PublicKeyConfig) {
+	if c == nil {
+		return
+	}
+	p.Comment = awsclient.LateInitializeStringPtr(p.Comment, c.Comment)
+}
+
+// IsPublicKeyUpToDate returns true if the observed public key configuration
+// matches the given parameters. Only the comment of a public key can be
+// updated.
+func IsPublicKeyUpToDate(p v1alpha1.PublicKeyParameters, c *svcsdk.PublicKeyConfig) bool {
+	return c != nil && aws.StringValue(p.Comment) == aws.StringValue(c.Comment)
+}
+
+// GenerateKeyGroupConfig returns the configuration of a key group with the
+// given parameters.
+func GenerateKeyGroupConfig(p v1alpha1.KeyGroupParameters) *svcsdk.KeyGroupConfig {
+	return &svcsdk.KeyGroupConfig{
+		Name:    aws.String(p.Name),
+		Comment: p.Comment,
+		Items:   aws.StringSlice(p.Items),
+	}
+}
+
+// LateInitializeKeyGroup fills the empty fields of the given parameters with
+// the values of the observed key group configuration.
+func LateInitializeKeyGroup(p *v1alpha1.KeyGroupParameters, c *svcsdk.KeyGroupConfig) {
+	if c == nil {
+		return
+	}
+	p.Comment = awsclient.LateInitializeStringPtr(p.Comment, c.Comment)
+}
+
+// IsKeyGroupUpToDate returns true if the observed key group configuration
+// matches the given parameters.
+func IsKeyGroupUpToDate(p v1alpha1.KeyGroupParameters, c *svcsdk.KeyGroupConfig) bool {
+	if c == nil {
+		return false
+	}
+	return p.Name == aws.StringValue(c.Name) &&
+		aws.StringValue(p.Comment) == aws.StringValue(c.Comment) &&
+		cmp.Equal(p.Items, aws.StringValueSlice(c.Items), cmpopts.EquateEmpty(), sortStrings)
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+// GenerateOriginAccessIdentityConfig returns the configuration of an origin
+// access identity with the given parameters.
+func GenerateOriginAccessIdentityConfig(p v1alpha1.CloudFrontOriginAccessIdentityParameters, callerReference string) *svcsdk.OriginAccessIdentityConfig {
+	return &svcsdk.OriginAccessIdentityConfig{
+		CallerReference: aws.String(callerReference),
+		Comment:         aws.String(p.Comment),
+	}
+}
+
+// IsOriginAccessIdentityUpToDate returns true if the observed origin access
+// identity configuration matches the given parameters.
+func IsOriginAccessIdentityUpToDate(p v1alpha1.CloudFrontOriginAccessIdentityParameters, c *svcsdk.OriginAccessIdentityConfig) bool {
+	return c != nil && p.Comment == aws.StringValue(c.Comment)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateOriginRequestPolicyConfig returns the configuration of an origin
+// request policy with the given parameters.
+func GenerateOriginRequestPolicyConfig(p v1alpha1.OriginRequestPolicyParameters) *svcsdk.OriginRequestPolicyConfig {
+	return &svcsdk.OriginRequestPolicyConfig{
+		Name:    aws.String(p.Name),
+		Comment: p.Comment,
+		CookiesConfig: &svcsdk.OriginRequestPolicyCookiesConfig{
+			CookieBehavior: aws.String(p.CookiesConfig.CookieBehavior),
+			Cookies:        &svcsdk.CookieNames{Quantity: quantity(p.CookiesConfig.Cookies), Items: itemsOrNil(p.CookiesConfig.Cookies)},
+		},
+		HeadersConfig: &svcsdk.OriginRequestPolicyHeadersConfig{
+			HeaderBehavior: aws.String(p.HeadersConfig.HeaderBehavior),
+			Headers:        &svcsdk.Headers{Quantity: quantity(p.HeadersConfig.Headers), Items: itemsOrNil(p.HeadersConfig.Headers)},
+		},
+		QueryStringsConfig: &svcsdk.OriginRequestPolicyQueryStringsConfig{
+			QueryStringBehavior: aws.String(p.QueryStringsConfig.QueryStringBehavior),
+			QueryStrings:        &svcsdk.QueryStringNames{Quantity: quantity(p.QueryStringsConfig.QueryStrings), Items: itemsOrNil(p.QueryStringsConfig.QueryStrings)},
+		},
+	}
+}
+
+// LateInitializeOriginRequestPolicy fills the empty fields of the given
+// parameters with the values of the observed origin request policy
+// configuration.
+func LateInitializeOriginRequestPolicy(p *v1alpha1.OriginRequestPolicyParameters, c *svcsdk.OriginRequestPolicyConfig) {
+	if c == nil {
+		return
+	}
+	p.Comment = awsclient.LateInitializeStringPtr(p.Comment, c.Comment)
+}
+
+// IsOriginRequestPolicyUpToDate returns true if the observed origin request
+// policy configuration matches the given parameters.
+func IsOriginRequestPolicyUpToDate(p v1alpha1.OriginRequestPolicyParameters, c *svcsdk.OriginRequestPolicyConfig) bool {
+	if c == nil {
+		return false
+	}
+	observed := p.DeepCopy()
+	observed.Name = aws.StringValue(c.Name)
+	observed.Comment = c.Comment
+	observed.CookiesConfig = v1alpha1.OriginRequestCookies{}
+	if c.CookiesConfig != nil {
+		observed.CookiesConfig.CookieBehavior = aws.StringValue(c.CookiesConfig.CookieBehavior)
+		if c.CookiesConfig.Cookies != nil {
+			observed.CookiesConfig.Cookies = aws.StringValueSlice(c.CookiesConfig.Cookies.Items)
+		}
+	}
+	observed.HeadersConfig = v1alpha1.OriginRequestHeaders{}
+	if c.HeadersConfig != nil {
+		observed.HeadersConfig.HeaderBehavior = aws.StringValue(c.HeadersConfig.HeaderBehavior)
+		if c.HeadersConfig.Headers != nil {
+			observed.HeadersConfig.Headers = aws.StringValueSlice(c.HeadersConfig.Headers.Items)
+		}
+	}
+	observed.QueryStringsConfig = v1alpha1.OriginRequestQueryStrings{}
+	if c.QueryStringsConfig != nil {
+		observed.QueryStringsConfig.QueryStringBehavior = aws.StringValue(c.QueryStringsConfig.QueryStringBehavior)
+		if c.QueryStringsConfig.QueryStrings != nil {
+			observed.QueryStringsConfig.QueryStrings = aws.StringValueSlice(c.QueryStringsConfig.QueryStrings.Items)
+		}
+	}
+	return cmp.Equal(&p, observed, cmpopts.EquateEmpty(), sortStrings)
+}
@@ -0,0 +1,116 @@
+package ecr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// DefaultTokenRefreshBefore is how long before its expiry an
+	// authorization token is refreshed by default.
+	DefaultTokenRefreshBefore = 6 * time.Hour
+
+	errDecodeToken = "failed to decode authorization token"
+)
+
+// AuthorizationTokenClient is the external client used for AuthorizationToken
+// Custom Resource
+type AuthorizationTokenClient interface {
+	GetAuthorizationTokenRequest(input *ecr.GetAuthorizationTokenInput) ecr.GetAuthorizationTokenRequest
+}
+
+// GenerateGetAuthorizationTokenInput generates the GetAuthorizationTokenInput
+// from the AuthorizationTokenParameters
+func GenerateGetAuthorizationTokenInput(params *v1alpha1.AuthorizationTokenParameters) *ecr.GetAuthorizationTokenInput {
+	input := &ecr.GetAuthorizationTokenInput{}
+	if params.RegistryID != nil {
+		input.RegistryIds = []string{*params.RegistryID}
+	}
+	return input
+}
+
+// RegistryHost returns the registry host an authorization token is published
+// for. It is the host of the repository URI if given, and of the proxy
+// endpoint of the token otherwise.
+func RegistryHost(params *v1alpha1.AuthorizationTokenParameters, data ecr.AuthorizationData) string {
+	if params.RepositoryURI != nil {
+		return strings.SplitN(*params.RepositoryURI, "/", 2)[0]
+	}
+	return strings.TrimPrefix(awsclient.StringValue(data.ProxyEndpoint), "https://")
+}
+
+// TokenRefreshTime returns the time an authorization token that expires at the
+// given time has to be refreshed.
+func TokenRefreshTime(params *v1alpha1.AuthorizationTokenParameters, expiresAt time.Time) time.Time {
+	refreshBefore := DefaultTokenRefreshBefore
+	if params.RefreshBefore != nil {
+		refreshBefore = params.RefreshBefore.Duration
+	}
+	return expiresAt.Add(-refreshBefore)
+}
+
+// IsAuthorizationTokenUpToDate checks whether the published authorization
+// token is still fresh and for the desired registry.
+func IsAuthorizationTokenUpToDate(params *v1alpha1.AuthorizationTokenParameters, obs v1alpha1.AuthorizationTokenObservation, now time.Time) bool {
+	if obs.ExpiresAt == nil || !now.Before(TokenRefreshTime(params, obs.ExpiresAt.Time)) {
+		return false
+	}
+	return params.RepositoryURI == nil || RegistryHost(params, ecr.AuthorizationData{}) == obs.Registry
+}
+
+// GenerateAuthorizationTokenObservation returns the observation of the given
+// authorization data.
+func GenerateAuthorizationTokenObservation(params *v1alpha1.AuthorizationTokenParameters, data ecr.AuthorizationData) v1alpha1.AuthorizationTokenObservation {
+	obs := v1alpha1.AuthorizationTokenObservation{
+		Registry:      RegistryHost(params, data),
+		ProxyEndpoint: awsclient.StringValue(data.ProxyEndpoint),
+	}
+	if data.ExpiresAt != nil {
+		t := metav1.NewTime(*data.ExpiresAt)
+		obs.ExpiresAt = &t
+	}
+	return obs
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+// GenerateDockerConfigConnectionDetails returns the given authorization data
+// formatted as docker config JSON for the given registry host.
+func GenerateDockerConfigConnectionDetails(host string, data ecr.AuthorizationData) (managed.ConnectionDetails, error) {
+	token := awsclient.StringValue(data.AuthorizationToken)
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecodeToken)
+	}
+	creds := strings.SplitN(string(decoded), ":", 2)
+	if len(creds) != 2 {
+		return nil, errors.New(errDecodeToken)
+	}
+	b, err := json.Marshal(dockerConfig{Auths: map[string]dockerConfigAuth{
+		host: {Username: creds[0], Password: creds[1], Auth: token},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return managed.ConnectionDetails{corev1.DockerConfigJsonKey: b}, nil
+}
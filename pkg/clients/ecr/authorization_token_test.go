@@ -0,0 +1,63 @@
+package ecr
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGenerateDockerConfigConnectionDetails(t *testing.T) {
+	token := base64.StdEncoding.EncodeToString([]byte("AWS:secret"))
+	type args struct {
+		host string
+		data ecr.AuthorizationData
+	}
+	type want struct {
+		conn managed.ConnectionDetails
+		err  error
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidToken": {
+			args: args{
+				host: "123.dkr.ecr.us-east-1.amazonaws.com",
+				data: ecr.AuthorizationData{AuthorizationToken: aws.String(token)},
+			},
+			want: want{
+				conn: managed.ConnectionDetails{
+					corev1.DockerConfigJsonKey: []byte(`{"auths":{"123.dkr.ecr.us-east-1.amazonaws.com":{"username":"AWS","password":"secret","auth":"` + token + `"}}}`),
+				},
+			},
+		},
+		"MalformedToken": {
+			args: args{
+				data: ecr.AuthorizationData{AuthorizationToken: aws.String(base64.StdEncoding.EncodeToString([]byte("AWS")))},
+			},
+			want: want{
+				err: errors.New(errDecodeToken),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn, err := GenerateDockerConfigConnectionDetails(tc.args.host, tc.args.data)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
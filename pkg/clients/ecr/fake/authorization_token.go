@@ -0,0 +1,36 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AuthorizationTokenClient = (*MockAuthorizationTokenClient)(nil)
+
+// MockAuthorizationTokenClient is a type that implements all the methods for AuthorizationTokenClient interface
+type MockAuthorizationTokenClient struct {
+	MockGetAuthorizationToken func(*ecr.GetAuthorizationTokenInput) ecr.GetAuthorizationTokenRequest
+}
+
+// GetAuthorizationTokenRequest mocks GetAuthorizationTokenRequest method
+func (m *MockAuthorizationTokenClient) GetAuthorizationTokenRequest(input *ecr.GetAuthorizationTokenInput) ecr.GetAuthorizationTokenRequest {
+	return m.MockGetAuthorizationToken(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LifecyclePolicyClient = (*MockLifecyclePolicyClient)(nil)
+
+// MockLifecyclePolicyClient is a type that implements all the methods for LifecyclePolicyClient interface
+type MockLifecyclePolicyClient struct {
+	MockPut    func(*ecr.PutLifecyclePolicyInput) ecr.PutLifecyclePolicyRequest
+	MockDelete func(*ecr.DeleteLifecyclePolicyInput) ecr.DeleteLifecyclePolicyRequest
+	MockGet    func(*ecr.GetLifecyclePolicyInput) ecr.GetLifecyclePolicyRequest
+}
+
+// PutLifecyclePolicyRequest mocks PutLifecyclePolicyRequest method
+func (m *MockLifecyclePolicyClient) PutLifecyclePolicyRequest(input *ecr.PutLifecyclePolicyInput) ecr.PutLifecyclePolicyRequest {
+	return m.MockPut(input)
+}
+
+// DeleteLifecyclePolicyRequest mocks DeleteLifecyclePolicyRequest method
+func (m *MockLifecyclePolicyClient) DeleteLifecyclePolicyRequest(input *ecr.DeleteLifecyclePolicyInput) ecr.DeleteLifecyclePolicyRequest {
+	return m.MockDelete(input)
+}
+
+// GetLifecyclePolicyRequest mocks GetLifecyclePolicyRequest method
+func (m *MockLifecyclePolicyClient) GetLifecyclePolicyRequest(input *ecr.GetLifecyclePolicyInput) ecr.GetLifecyclePolicyRequest {
+	return m.MockGet(input)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.RegistryClient             = (*MockRegistryClient)(nil)
+	_ clientset.RepositoryEncryptionClient = (*MockRepositoryEncryptionClient)(nil)
+)
+
+// MockRegistryClient is a type that implements all the methods for RegistryClient interface
+type MockRegistryClient struct {
+	MockDescribeRegistry            func(*svcsdk.DescribeRegistryInput) (*svcsdk.DescribeRegistryOutput, error)
+	MockPutReplicationConfiguration func(*svcsdk.PutReplicationConfigurationInput) (*svcsdk.PutReplicationConfigurationOutput, error)
+	MockGetRegistryPolicy           func(*svcsdk.GetRegistryPolicyInput) (*svcsdk.GetRegistryPolicyOutput, error)
+	MockPutRegistryPolicy           func(*svcsdk.PutRegistryPolicyInput) (*svcsdk.PutRegistryPolicyOutput, error)
+	MockDeleteRegistryPolicy        func(*svcsdk.DeleteRegistryPolicyInput) (*svcsdk.DeleteRegistryPolicyOutput, error)
+}
+
+// DescribeRegistryWithContext mocks DescribeRegistryWithContext method
+func (m *MockRegistryClient) DescribeRegistryWithContext(_ context.Context, input *svcsdk.DescribeRegistryInput, _ ...request.Option) (*svcsdk.DescribeRegistryOutput, error) {
+	return m.MockDescribeRegistry(input)
+}
+
+// PutReplicationConfigurationWithContext mocks PutReplicationConfigurationWithContext method
+func (m *MockRegistryClient) PutReplicationConfigurationWithContext(_ context.Context, input *svcsdk.PutReplicationConfigurationInput, _ ...request.Option) (*svcsdk.PutReplicationConfigurationOutput, error) {
+	return m.MockPutReplicationConfiguration(input)
+}
+
+// GetRegistryPolicyWithContext mocks GetRegistryPolicyWithContext method
+func (m *MockRegistryClient) GetRegistryPolicyWithContext(_ context.Context, input *svcsdk.GetRegistryPolicyInput, _ ...request.Option) (*svcsdk.GetRegistryPolicyOutput, error) {
+	return m.MockGetRegistryPolicy(input)
+}
+
+// PutRegistryPolicyWithContext mocks PutRegistryPolicyWithContext method
+func (m *MockRegistryClient) PutRegistryPolicyWithContext(_ context.Context, input *svcsdk.PutRegistryPolicyInput, _ ...request.Option) (*svcsdk.PutRegistryPolicyOutput, error) {
+	return m.MockPutRegistryPolicy(input)
+}
+
+// DeleteRegistryPolicyWithContext mocks DeleteRegistryPolicyWithContext method
+func (m *MockRegistryClient) DeleteRegistryPolicyWithContext(_ context.Context, input *svcsdk.DeleteRegistryPolicyInput, _ ...request.Option) (*svcsdk.DeleteRegistryPolicyOutput, error) {
+	return m.MockDeleteRegistryPolicy(input)
+}
+
+// MockRepositoryEncryptionClient is a type that implements all the methods for RepositoryEncryptionClient interface
+type MockRepositoryEncryptionClient struct {
+	MockCreateRepository func(*svcsdk.CreateRepositoryInput) (*svcsdk.CreateRepositoryOutput, error)
+}
+
+// CreateRepositoryWithContext mocks CreateRepositoryWithContext method
+func (m *MockRepositoryEncryptionClient) CreateRepositoryWithContext(_ context.Context, input *svcsdk.CreateRepositoryInput, _ ...request.Option) (*svcsdk.CreateRepositoryOutput, error) {
+	return m.MockCreateRepository(input)
+}
@@ -0,0 +1,57 @@
+package ecr
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+)
+
+const (
+	// LifecyclePolicyNotFoundException lifecycle policy was not found
+	LifecyclePolicyNotFoundException = "LifecyclePolicyNotFoundException"
+
+	errLifecyclePolicyNotSpecified = "failed to format Lifecycle Policy, no rawPolicy or rules specified"
+)
+
+// LifecyclePolicyClient is the external client used for Lifecycle Policy Resource
+type LifecyclePolicyClient interface {
+	PutLifecyclePolicyRequest(input *ecr.PutLifecyclePolicyInput) ecr.PutLifecyclePolicyRequest
+	GetLifecyclePolicyRequest(input *ecr.GetLifecyclePolicyInput) ecr.GetLifecyclePolicyRequest
+	DeleteLifecyclePolicyRequest(input *ecr.DeleteLifecyclePolicyInput) ecr.DeleteLifecyclePolicyRequest
+}
+
+// GeneratePutLifecyclePolicyInput Generates the PutLifecyclePolicyInput from the LifecyclePolicyParameters
+func GeneratePutLifecyclePolicyInput(params *v1alpha1.LifecyclePolicyParameters, policy *string) *ecr.PutLifecyclePolicyInput {
+	return &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      params.RepositoryName,
+		RegistryId:          params.RegistryID,
+		LifecyclePolicyText: policy,
+	}
+}
+
+// IsLifecyclePolicyNotFoundErr returns true if the error code indicates that the lifecycle policy was not found
+func IsLifecyclePolicyNotFoundErr(err error) bool {
+	if ecrErr, ok := err.(awserr.Error); ok && ecrErr.Code() == LifecyclePolicyNotFoundException {
+		return true
+	}
+	return false
+}
+
+// RawLifecyclePolicyData formats the rules of the LifecyclePolicyParameters
+// as lifecycle policy text, unless a raw policy is given.
+func RawLifecyclePolicyData(params *v1alpha1.LifecyclePolicyParameters) (string, error) {
+	switch {
+	case params.RawPolicy != nil:
+		return *params.RawPolicy, nil
+	case len(params.Rules) != 0:
+		b, err := json.Marshal(struct {
+			Rules []v1alpha1.LifecyclePolicyRule `json:"rules"`
+		}{Rules: params.Rules})
+		return string(b), err
+	}
+	return "", errors.New(errLifecyclePolicyNotSpecified)
+}
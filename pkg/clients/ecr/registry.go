@@ -0,0 +1,98 @@
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+)
+
+const errRegistryPolicyNotSpecified = "failed to format Registry Policy, no rawPolicy or policy specified"
+
+// RegistryClient is the external client used for the registry level
+// resources. The registry operations are only available in aws/aws-sdk-go.
+type RegistryClient interface {
+	DescribeRegistryWithContext(ctx context.Context, input *svcsdk.DescribeRegistryInput, opts ...request.Option) (*svcsdk.DescribeRegistryOutput, error)
+	PutReplicationConfigurationWithContext(ctx context.Context, input *svcsdk.PutReplicationConfigurationInput, opts ...request.Option) (*svcsdk.PutReplicationConfigurationOutput, error)
+	GetRegistryPolicyWithContext(ctx context.Context, input *svcsdk.GetRegistryPolicyInput, opts ...request.Option) (*svcsdk.GetRegistryPolicyOutput, error)
+	PutRegistryPolicyWithContext(ctx context.Context, input *svcsdk.PutRegistryPolicyInput, opts ...request.Option) (*svcsdk.PutRegistryPolicyOutput, error)
+	DeleteRegistryPolicyWithContext(ctx context.Context, input *svcsdk.DeleteRegistryPolicyInput, opts ...request.Option) (*svcsdk.DeleteRegistryPolicyOutput, error)
+}
+
+// NewRegistryClient returns a new RegistryClient with the provided session.
+func NewRegistryClient(sess *session.Session) RegistryClient {
+	return svcsdk.New(sess)
+}
+
+// GenerateReplicationConfiguration generates the ReplicationConfiguration
+// from the RegistryReplicationConfigurationParameters
+func GenerateReplicationConfiguration(params *v1alpha1.RegistryReplicationConfigurationParameters) *svcsdk.ReplicationConfiguration {
+	c := &svcsdk.ReplicationConfiguration{Rules: make([]*svcsdk.ReplicationRule, len(params.Rules))}
+	for i, r := range params.Rules {
+		rule := &svcsdk.ReplicationRule{Destinations: make([]*svcsdk.ReplicationDestination, len(r.Destinations))}
+		for j, d := range r.Destinations {
+			rule.Destinations[j] = &svcsdk.ReplicationDestination{
+				Region:     awsv1.String(d.Region),
+				RegistryId: awsv1.String(d.RegistryID),
+			}
+		}
+		c.Rules[i] = rule
+	}
+	return c
+}
+
+// IsReplicationConfigurationUpToDate checks whether the observed replication
+// configuration matches the RegistryReplicationConfigurationParameters. The
+// order of rules and destinations is significant.
+func IsReplicationConfigurationUpToDate(params *v1alpha1.RegistryReplicationConfigurationParameters, observed *svcsdk.ReplicationConfiguration) bool {
+	if observed == nil {
+		return len(params.Rules) == 0
+	}
+	if len(params.Rules) != len(observed.Rules) {
+		return false
+	}
+	for i, r := range params.Rules {
+		if len(r.Destinations) != len(observed.Rules[i].Destinations) {
+			return false
+		}
+		for j, d := range r.Destinations {
+			o := observed.Rules[i].Destinations[j]
+			if d.Region != awsv1.StringValue(o.Region) || d.RegistryID != awsv1.StringValue(o.RegistryId) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsRegistryPolicyNotFoundErr returns true if the error code indicates that the registry policy was not found
+func IsRegistryPolicyNotFoundErr(err error) bool {
+	if ecrErr, ok := err.(awserr.Error); ok && ecrErr.Code() == svcsdk.ErrCodeRegistryPolicyNotFoundException {
+		return true
+	}
+	return false
+}
+
+// RawRegistryPolicyData parses and formats the policy of the
+// RegistryPolicyParameters
+func RawRegistryPolicyData(params *v1alpha1.RegistryPolicyParameters) (string, error) {
+	switch {
+	case params.RawPolicy != nil:
+		return *params.RawPolicy, nil
+	case params.Policy != nil:
+		body, err := Serialize(params.Policy.DeepCopy())
+		if err != nil {
+			return "", err
+		}
+		b, err := json.Marshal(body)
+		return string(b), err
+	}
+	return "", errors.New(errRegistryPolicyNotSpecified)
+}
@@ -0,0 +1,74 @@
+package ecr
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+)
+
+func TestIsReplicationConfigurationUpToDate(t *testing.T) {
+	params := func() *v1alpha1.RegistryReplicationConfigurationParameters {
+		return &v1alpha1.RegistryReplicationConfigurationParameters{
+			Rules: []v1alpha1.ReplicationRule{{
+				Destinations: []v1alpha1.ReplicationDestination{
+					{Region: "eu-west-1", RegistryID: registryID},
+					{Region: "us-west-2", RegistryID: registryID},
+				},
+			}},
+		}
+	}
+	type args struct {
+		params   *v1alpha1.RegistryReplicationConfigurationParameters
+		observed *svcsdk.ReplicationConfiguration
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				params:   params(),
+				observed: GenerateReplicationConfiguration(params()),
+			},
+			want: true,
+		},
+		"DifferentDestination": {
+			args: args{
+				params: params(),
+				observed: &svcsdk.ReplicationConfiguration{Rules: []*svcsdk.ReplicationRule{{
+					Destinations: []*svcsdk.ReplicationDestination{
+						{Region: awsv1.String("eu-west-1"), RegistryId: awsv1.String(registryID)},
+						{Region: awsv1.String("eu-central-1"), RegistryId: awsv1.String(registryID)},
+					},
+				}}},
+			},
+			want: false,
+		},
+		"MissingRules": {
+			args: args{
+				params:   params(),
+				observed: &svcsdk.ReplicationConfiguration{},
+			},
+			want: false,
+		},
+		"NothingObserved": {
+			args: args{
+				params: &v1alpha1.RegistryReplicationConfigurationParameters{},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReplicationConfigurationUpToDate(tc.args.params, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
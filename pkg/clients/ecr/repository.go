@@ -1,6 +1,7 @@
 package ecr
 
 import (
+	"context"
 	"encoding/json"
 	"sort"
 	"strings"
@@ -8,6 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
@@ -37,6 +42,19 @@ type RepositoryClient interface {
 	UntagResourceRequest(*ecr.UntagResourceInput) ecr.UntagResourceRequest
 }
 
+// RepositoryEncryptionClient is the external client used to create encrypted
+// repositories. Encryption configurations are only available in
+// aws/aws-sdk-go.
+type RepositoryEncryptionClient interface {
+	CreateRepositoryWithContext(ctx context.Context, input *svcsdk.CreateRepositoryInput, opts ...request.Option) (*svcsdk.CreateRepositoryOutput, error)
+}
+
+// NewRepositoryEncryptionClient returns a new RepositoryEncryptionClient with
+// the provided session.
+func NewRepositoryEncryptionClient(sess *session.Session) RepositoryEncryptionClient {
+	return svcsdk.New(sess)
+}
+
 // GenerateRepositoryObservation is used to produce v1alpha1.RepositoryObservation from
 // ecr.Repository
 func GenerateRepositoryObservation(repo ecr.Repository) v1alpha1.RepositoryObservation {
@@ -127,6 +145,28 @@ func GenerateCreateRepositoryInput(name string, params *v1alpha1.RepositoryParam
 	return c
 }
 
+// GenerateCreateEncryptedRepositoryInput Generates the aws/aws-sdk-go
+// CreateRepositoryInput, which supports encryption configurations, from the
+// RepositoryParameters
+func GenerateCreateEncryptedRepositoryInput(name string, params *v1alpha1.RepositoryParameters) *svcsdk.CreateRepositoryInput {
+	c := &svcsdk.CreateRepositoryInput{
+		RepositoryName:     awsv1.String(name),
+		ImageTagMutability: params.ImageTagMutability,
+	}
+	if params.ImageScanningConfiguration != nil {
+		c.ImageScanningConfiguration = &svcsdk.ImageScanningConfiguration{
+			ScanOnPush: awsv1.Bool(params.ImageScanningConfiguration.ScanOnPush),
+		}
+	}
+	if params.EncryptionConfiguration != nil {
+		c.EncryptionConfiguration = &svcsdk.EncryptionConfiguration{
+			EncryptionType: awsv1.String(params.EncryptionConfiguration.EncryptionType),
+			KmsKey:         params.EncryptionConfiguration.KMSKey,
+		}
+	}
+	return c
+}
+
 // CompareTags compares arrays of v1alpha1.Tag and ecr.Tag
 func CompareTags(tags []v1alpha1.Tag, ecrTags []ecr.Tag) bool {
 	if len(tags) != len(ecrTags) {
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -169,6 +171,81 @@ func TestGenerateCreateRepositoryInput(t *testing.T) {
 	}
 }
 
+func TestGenerateCreateEncryptedRepositoryInput(t *testing.T) {
+	kmsKey := "alias/key"
+
+	type args struct {
+		name string
+		p    *v1alpha1.RepositoryParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *svcsdk.CreateRepositoryInput
+	}{
+		"AllFields": {
+			args: args{
+				name: repositoryName,
+				p: &v1alpha1.RepositoryParameters{
+					Tags:                       []v1alpha1.Tag{alpha1Tag},
+					ImageScanningConfiguration: &imageScanConfig,
+					ImageTagMutability:         &tagMutability,
+					EncryptionConfiguration: &v1alpha1.EncryptionConfiguration{
+						EncryptionType: "KMS",
+						KMSKey:         &kmsKey,
+					},
+				},
+			},
+			want: &svcsdk.CreateRepositoryInput{
+				RepositoryName:             &repositoryName,
+				ImageTagMutability:         &tagMutability,
+				ImageScanningConfiguration: &svcsdk.ImageScanningConfiguration{ScanOnPush: &imageScanConfig.ScanOnPush},
+				EncryptionConfiguration: &svcsdk.EncryptionConfiguration{
+					EncryptionType: awsv1.String("KMS"),
+					KmsKey:         &kmsKey,
+				},
+			},
+		},
+		"DefaultKey": {
+			args: args{
+				name: repositoryName,
+				p: &v1alpha1.RepositoryParameters{
+					EncryptionConfiguration: &v1alpha1.EncryptionConfiguration{
+						EncryptionType: "AES256",
+					},
+				},
+			},
+			want: &svcsdk.CreateRepositoryInput{
+				RepositoryName: &repositoryName,
+				EncryptionConfiguration: &svcsdk.EncryptionConfiguration{
+					EncryptionType: awsv1.String("AES256"),
+				},
+			},
+		},
+		"NoEncryption": {
+			args: args{
+				name: repositoryName,
+				p: &v1alpha1.RepositoryParameters{
+					ImageTagMutability: &tagMutability,
+				},
+			},
+			want: &svcsdk.CreateRepositoryInput{
+				RepositoryName:     &repositoryName,
+				ImageTagMutability: &tagMutability,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateEncryptedRepositoryInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitialize(t *testing.T) {
 	cases := map[string]struct {
 		parameters *v1alpha1.RepositoryParameters
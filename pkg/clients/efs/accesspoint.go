@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efs
+
+import (
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+)
+
+// GenerateCreateAccessPointInput returns the input of CreateAccessPoint for
+// the given parameters.
+func GenerateCreateAccessPointInput(p v1alpha1.AccessPointParameters) *svcsdk.CreateAccessPointInput {
+	in := &svcsdk.CreateAccessPointInput{
+		FileSystemId: p.FileSystemID,
+	}
+	if u := p.PosixUser; u != nil {
+		in.PosixUser = &svcsdk.PosixUser{
+			Uid:           awsv1.Int64(u.UID),
+			Gid:           awsv1.Int64(u.GID),
+			SecondaryGids: awsv1.Int64Slice(u.SecondaryGIDs),
+		}
+	}
+	if d := p.RootDirectory; d != nil {
+		in.RootDirectory = &svcsdk.RootDirectory{Path: d.Path}
+		if ci := d.CreationInfo; ci != nil {
+			in.RootDirectory.CreationInfo = &svcsdk.CreationInfo{
+				OwnerUid:    awsv1.Int64(ci.OwnerUID),
+				OwnerGid:    awsv1.Int64(ci.OwnerGID),
+				Permissions: awsv1.String(ci.Permissions),
+			}
+		}
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, &svcsdk.Tag{Key: t.Key, Value: t.Value})
+	}
+	return in
+}
+
+// GenerateAccessPointObservation returns the observation of the given access
+// point.
+func GenerateAccessPointObservation(ap *svcsdk.AccessPointDescription) v1alpha1.AccessPointObservation {
+	return v1alpha1.AccessPointObservation{
+		AccessPointARN: ap.AccessPointArn,
+		LifeCycleState: ap.LifeCycleState,
+		OwnerID:        ap.OwnerId,
+	}
+}
+
+// LateInitializeAccessPoint fills the empty fields of the given parameters
+// with the values of the access point.
+func LateInitializeAccessPoint(p *v1alpha1.AccessPointParameters, ap *svcsdk.AccessPointDescription) {
+	if p.RootDirectory == nil && ap.RootDirectory != nil && ap.RootDirectory.Path != nil {
+		p.RootDirectory = &v1alpha1.RootDirectory{Path: ap.RootDirectory.Path}
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efs
+
+import (
+	"context"
+	"sort"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+)
+
+// Client is the external client used for EFS mount targets and access
+// points.
+type Client interface {
+	CreateMountTargetWithContext(context.Context, *svcsdk.CreateMountTargetInput, ...request.Option) (*svcsdk.MountTargetDescription, error)
+	DescribeMountTargetsWithContext(context.Context, *svcsdk.DescribeMountTargetsInput, ...request.Option) (*svcsdk.DescribeMountTargetsOutput, error)
+	DescribeMountTargetSecurityGroupsWithContext(context.Context, *svcsdk.DescribeMountTargetSecurityGroupsInput, ...request.Option) (*svcsdk.DescribeMountTargetSecurityGroupsOutput, error)
+	ModifyMountTargetSecurityGroupsWithContext(context.Context, *svcsdk.ModifyMountTargetSecurityGroupsInput, ...request.Option) (*svcsdk.ModifyMountTargetSecurityGroupsOutput, error)
+	DeleteMountTargetWithContext(context.Context, *svcsdk.DeleteMountTargetInput, ...request.Option) (*svcsdk.DeleteMountTargetOutput, error)
+
+	CreateAccessPointWithContext(context.Context, *svcsdk.CreateAccessPointInput, ...request.Option) (*svcsdk.CreateAccessPointOutput, error)
+	DescribeAccessPointsWithContext(context.Context, *svcsdk.DescribeAccessPointsInput, ...request.Option) (*svcsdk.DescribeAccessPointsOutput, error)
+	DeleteAccessPointWithContext(context.Context, *svcsdk.DeleteAccessPointInput, ...request.Option) (*svcsdk.DeleteAccessPointOutput, error)
+
+	TagResourceWithContext(context.Context, *svcsdk.TagResourceInput, ...request.Option) (*svcsdk.TagResourceOutput, error)
+	UntagResourceWithContext(context.Context, *svcsdk.UntagResourceInput, ...request.Option) (*svcsdk.UntagResourceOutput, error)
+}
+
+// NewClient returns a new Client with the provided session.
+func NewClient(sess *session.Session) Client {
+	return svcsdk.New(sess)
+}
+
+// IsMountTargetNotFound returns true if the error is because the mount target
+// doesn't exist.
+func IsMountTargetNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeMountTargetNotFound
+}
+
+// IsAccessPointNotFound returns true if the error is because the access point
+// doesn't exist.
+func IsAccessPointNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeAccessPointNotFound
+}
+
+// IsPolicyNotFound returns true if the error is because the file system has
+// no policy.
+func IsPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodePolicyNotFound
+}
+
+// DiffTags returns the tags that need to be added to and removed from a
+// resource so that its current tags match the desired ones.
+func DiffTags(desired []*v1alpha1.Tag, current []*svcsdk.Tag) (add []*svcsdk.Tag, remove []*string) {
+	want := map[string]string{}
+	for _, t := range desired {
+		want[awsv1.StringValue(t.Key)] = awsv1.StringValue(t.Value)
+	}
+	have := map[string]string{}
+	for _, t := range current {
+		have[awsv1.StringValue(t.Key)] = awsv1.StringValue(t.Value)
+	}
+	for k, v := range want {
+		if cv, ok := have[k]; !ok || cv != v {
+			add = append(add, &svcsdk.Tag{Key: awsv1.String(k), Value: awsv1.String(v)})
+		}
+	}
+	for k := range have {
+		if _, ok := want[k]; !ok {
+			remove = append(remove, awsv1.String(k))
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return awsv1.StringValue(add[i].Key) < awsv1.StringValue(add[j].Key) })
+	sort.Slice(remove, func(i, j int) bool { return awsv1.StringValue(remove[i]) < awsv1.StringValue(remove[j]) })
+	return add, remove
+}
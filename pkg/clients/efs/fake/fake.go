@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/efs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateMountTarget                 func(*svcsdk.CreateMountTargetInput) (*svcsdk.MountTargetDescription, error)
+	MockDescribeMountTargets              func(*svcsdk.DescribeMountTargetsInput) (*svcsdk.DescribeMountTargetsOutput, error)
+	MockDescribeMountTargetSecurityGroups func(*svcsdk.DescribeMountTargetSecurityGroupsInput) (*svcsdk.DescribeMountTargetSecurityGroupsOutput, error)
+	MockModifyMountTargetSecurityGroups   func(*svcsdk.ModifyMountTargetSecurityGroupsInput) (*svcsdk.ModifyMountTargetSecurityGroupsOutput, error)
+	MockDeleteMountTarget                 func(*svcsdk.DeleteMountTargetInput) (*svcsdk.DeleteMountTargetOutput, error)
+	MockCreateAccessPoint                 func(*svcsdk.CreateAccessPointInput) (*svcsdk.CreateAccessPointOutput, error)
+	MockDescribeAccessPoints              func(*svcsdk.DescribeAccessPointsInput) (*svcsdk.DescribeAccessPointsOutput, error)
+	MockDeleteAccessPoint                 func(*svcsdk.DeleteAccessPointInput) (*svcsdk.DeleteAccessPointOutput, error)
+	MockTagResource                       func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error)
+	MockUntagResource                     func(*svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error)
+}
+
+// CreateMountTargetWithContext mocks CreateMountTargetWithContext method
+func (m *MockClient) CreateMountTargetWithContext(_ context.Context, input *svcsdk.CreateMountTargetInput, _ ...request.Option) (*svcsdk.MountTargetDescription, error) {
+	return m.MockCreateMountTarget(input)
+}
+
+// DescribeMountTargetsWithContext mocks DescribeMountTargetsWithContext method
+func (m *MockClient) DescribeMountTargetsWithContext(_ context.Context, input *svcsdk.DescribeMountTargetsInput, _ ...request.Option) (*svcsdk.DescribeMountTargetsOutput, error) {
+	return m.MockDescribeMountTargets(input)
+}
+
+// DescribeMountTargetSecurityGroupsWithContext mocks DescribeMountTargetSecurityGroupsWithContext method
+func (m *MockClient) DescribeMountTargetSecurityGroupsWithContext(_ context.Context, input *svcsdk.DescribeMountTargetSecurityGroupsInput, _ ...request.Option) (*svcsdk.DescribeMountTargetSecurityGroupsOutput, error) {
+	return m.MockDescribeMountTargetSecurityGroups(input)
+}
+
+// ModifyMountTargetSecurityGroupsWithContext mocks ModifyMountTargetSecurityGroupsWithContext method
+func (m *MockClient) ModifyMountTargetSecurityGroupsWithContext(_ context.Context, input *svcsdk.ModifyMountTargetSecurityGroupsInput, _ ...request.Option) (*svcsdk.ModifyMountTargetSecurityGroupsOutput, error) {
+	return m.MockModifyMountTargetSecurityGroups(input)
+}
+
+// DeleteMountTargetWithContext mocks DeleteMountTargetWithContext method
+func (m *MockClient) DeleteMountTargetWithContext(_ context.Context, input *svcsdk.DeleteMountTargetInput, _ ...request.Option) (*svcsdk.DeleteMountTargetOutput, error) {
+	return m.MockDeleteMountTarget(input)
+}
+
+// CreateAccessPointWithContext mocks CreateAccessPointWithContext method
+func (m *MockClient) CreateAccessPointWithContext(_ context.Context, input *svcsdk.CreateAccessPointInput, _ ...request.Option) (*svcsdk.CreateAccessPointOutput, error) {
+	return m.MockCreateAccessPoint(input)
+}
+
+// DescribeAccessPointsWithContext mocks DescribeAccessPointsWithContext method
+func (m *MockClient) DescribeAccessPointsWithContext(_ context.Context, input *svcsdk.DescribeAccessPointsInput, _ ...request.Option) (*svcsdk.DescribeAccessPointsOutput, error) {
+	return m.MockDescribeAccessPoints(input)
+}
+
+// DeleteAccessPointWithContext mocks DeleteAccessPointWithContext method
+func (m *MockClient) DeleteAccessPointWithContext(_ context.Context, input *svcsdk.DeleteAccessPointInput, _ ...request.Option) (*svcsdk.DeleteAccessPointOutput, error) {
+	return m.MockDeleteAccessPoint(input)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(_ context.Context, input *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(_ context.Context, input *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	return m.MockUntagResource(input)
+}
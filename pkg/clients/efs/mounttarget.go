@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efs
+
+import (
+	"sort"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+)
+
+// GenerateCreateMountTargetInput returns the input of CreateMountTarget for
+// the given parameters.
+func GenerateCreateMountTargetInput(p v1alpha1.MountTargetParameters) *svcsdk.CreateMountTargetInput {
+	return &svcsdk.CreateMountTargetInput{
+		FileSystemId:   p.FileSystemID,
+		SubnetId:       p.SubnetID,
+		IpAddress:      p.IPAddress,
+		SecurityGroups: awsv1.StringSlice(p.SecurityGroups),
+	}
+}
+
+// GenerateMountTargetObservation returns the observation of the given mount
+// target.
+func GenerateMountTargetObservation(mt *svcsdk.MountTargetDescription) v1alpha1.MountTargetObservation {
+	return v1alpha1.MountTargetObservation{
+		AvailabilityZoneID:   mt.AvailabilityZoneId,
+		AvailabilityZoneName: mt.AvailabilityZoneName,
+		IPAddress:            mt.IpAddress,
+		LifeCycleState:       mt.LifeCycleState,
+		NetworkInterfaceID:   mt.NetworkInterfaceId,
+		OwnerID:              mt.OwnerId,
+		VPCID:                mt.VpcId,
+	}
+}
+
+// LateInitializeMountTarget fills the empty fields of the given parameters
+// with the values of the mount target.
+func LateInitializeMountTarget(p *v1alpha1.MountTargetParameters, mt *svcsdk.MountTargetDescription, securityGroups []*string) {
+	if p.IPAddress == nil {
+		p.IPAddress = mt.IpAddress
+	}
+	if len(p.SecurityGroups) == 0 && len(p.SecurityGroupsRefs) == 0 && p.SecurityGroupsSelector == nil {
+		p.SecurityGroups = awsv1.StringValueSlice(securityGroups)
+	}
+}
+
+// AreSecurityGroupsUpToDate returns whether the security groups of a mount
+// target match the desired ones. The order of the groups is not
+// significant.
+func AreSecurityGroupsUpToDate(desired []string, current []*string) bool {
+	if len(desired) == 0 {
+		return true
+	}
+	if len(desired) != len(current) {
+		return false
+	}
+	d := append([]string{}, desired...)
+	c := awsv1.StringValueSlice(current)
+	sort.Strings(d)
+	sort.Strings(c)
+	for i := range d {
+		if d[i] != c[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AddonClient defines the EKS addon operations. They are only available in
+// aws/aws-sdk-go.
+type AddonClient interface {
+	CreateAddonWithContext(ctx context.Context, input *eks.CreateAddonInput, opts ...request.Option) (*eks.CreateAddonOutput, error)
+	DescribeAddonWithContext(ctx context.Context, input *eks.DescribeAddonInput, opts ...request.Option) (*eks.DescribeAddonOutput, error)
+	UpdateAddonWithContext(ctx context.Context, input *eks.UpdateAddonInput, opts ...request.Option) (*eks.UpdateAddonOutput, error)
+	DeleteAddonWithContext(ctx context.Context, input *eks.DeleteAddonInput, opts ...request.Option) (*eks.DeleteAddonOutput, error)
+	TagResourceWithContext(ctx context.Context, input *eks.TagResourceInput, opts ...request.Option) (*eks.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *eks.UntagResourceInput, opts ...request.Option) (*eks.UntagResourceOutput, error)
+}
+
+// NewAddonClient creates a new AddonClient with the provided session.
+func NewAddonClient(sess *session.Session) AddonClient {
+	return eks.New(sess)
+}
+
+// GenerateCreateAddonInput from AddonParameters.
+func GenerateCreateAddonInput(name string, p v1alpha1.AddonParameters) *eks.CreateAddonInput {
+	c := &eks.CreateAddonInput{
+		AddonName:             aws.String(name),
+		AddonVersion:          p.AddonVersion,
+		ClusterName:           aws.String(p.ClusterName),
+		ResolveConflicts:      p.ResolveConflicts,
+		ServiceAccountRoleArn: p.ServiceAccountRoleARN,
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = aws.StringMap(p.Tags)
+	}
+	return c
+}
+
+// GenerateUpdateAddonInput from AddonParameters.
+func GenerateUpdateAddonInput(name string, p v1alpha1.AddonParameters) *eks.UpdateAddonInput {
+	return &eks.UpdateAddonInput{
+		AddonName:             aws.String(name),
+		AddonVersion:          p.AddonVersion,
+		ClusterName:           aws.String(p.ClusterName),
+		ResolveConflicts:      p.ResolveConflicts,
+		ServiceAccountRoleArn: p.ServiceAccountRoleARN,
+	}
+}
+
+// GenerateAddonObservation is used to produce v1alpha1.AddonObservation from
+// eks.Addon.
+func GenerateAddonObservation(a *eks.Addon) v1alpha1.AddonObservation {
+	if a == nil {
+		return v1alpha1.AddonObservation{}
+	}
+	o := v1alpha1.AddonObservation{
+		AddonARN: aws.StringValue(a.AddonArn),
+		Status:   v1alpha1.AddonStatusType(aws.StringValue(a.Status)),
+	}
+	if a.CreatedAt != nil {
+		t := metav1.NewTime(*a.CreatedAt)
+		o.CreatedAt = &t
+	}
+	if a.ModifiedAt != nil {
+		t := metav1.NewTime(*a.ModifiedAt)
+		o.ModifiedAt = &t
+	}
+	if a.Health != nil {
+		for _, i := range a.Health.Issues {
+			o.HealthIssues = append(o.HealthIssues, v1alpha1.AddonIssue{
+				Code:        aws.StringValue(i.Code),
+				Message:     aws.StringValue(i.Message),
+				ResourceIDs: aws.StringValueSlice(i.ResourceIds),
+			})
+		}
+	}
+	return o
+}
+
+// LateInitializeAddon fills the empty fields in *v1alpha1.AddonParameters with
+// the values seen in eks.Addon.
+func LateInitializeAddon(in *v1alpha1.AddonParameters, a *eks.Addon) {
+	if a == nil {
+		return
+	}
+	in.AddonVersion = awsclients.LateInitializeStringPtr(in.AddonVersion, a.AddonVersion)
+	in.ServiceAccountRoleARN = awsclients.LateInitializeStringPtr(in.ServiceAccountRoleARN, a.ServiceAccountRoleArn)
+	if len(in.Tags) == 0 && len(a.Tags) != 0 {
+		in.Tags = aws.StringValueMap(a.Tags)
+	}
+}
+
+// IsAddonUpToDate checks whether there is a change in any of the modifiable
+// fields of the addon.
+func IsAddonUpToDate(p v1alpha1.AddonParameters, a *eks.Addon) bool {
+	if aws.StringValue(p.AddonVersion) != aws.StringValue(a.AddonVersion) {
+		return false
+	}
+	if aws.StringValue(p.ServiceAccountRoleARN) != aws.StringValue(a.ServiceAccountRoleArn) {
+		return false
+	}
+	return cmp.Equal(p.Tags, aws.StringValueMap(a.Tags), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+var (
+	addonName    = "vpc-cni"
+	addonVersion = "v1.7.5-eksbuild.1"
+)
+
+func TestGenerateCreateAddonInput(t *testing.T) {
+	type args struct {
+		name string
+		p    v1alpha1.AddonParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.CreateAddonInput
+	}{
+		"AllFields": {
+			args: args{
+				name: addonName,
+				p: v1alpha1.AddonParameters{
+					ClusterName:           clusterName,
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleARN: &roleArn,
+					ResolveConflicts:      aws.String(eks.ResolveConflictsOverwrite),
+					Tags:                  map[string]string{"cool": "tag"},
+				},
+			},
+			want: &eks.CreateAddonInput{
+				AddonName:             &addonName,
+				AddonVersion:          &addonVersion,
+				ClusterName:           &clusterName,
+				ResolveConflicts:      aws.String(eks.ResolveConflictsOverwrite),
+				ServiceAccountRoleArn: &roleArn,
+				Tags:                  aws.StringMap(map[string]string{"cool": "tag"}),
+			},
+		},
+		"SomeFields": {
+			args: args{
+				name: addonName,
+				p: v1alpha1.AddonParameters{
+					ClusterName: clusterName,
+				},
+			},
+			want: &eks.CreateAddonInput{
+				AddonName:   &addonName,
+				ClusterName: &clusterName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateAddonInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAddon(t *testing.T) {
+	type args struct {
+		p     *v1alpha1.AddonParameters
+		addon *eks.Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.AddonParameters
+	}{
+		"AllFieldsEmpty": {
+			args: args{
+				p: &v1alpha1.AddonParameters{},
+				addon: &eks.Addon{
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleArn: &roleArn,
+					Tags:                  aws.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: &v1alpha1.AddonParameters{
+				AddonVersion:          &addonVersion,
+				ServiceAccountRoleARN: &roleArn,
+				Tags:                  map[string]string{"cool": "tag"},
+			},
+		},
+		"NoOverride": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonVersion: aws.String("v1.7.5-eksbuild.2"),
+				},
+				addon: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: &v1alpha1.AddonParameters{
+				AddonVersion: aws.String("v1.7.5-eksbuild.2"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAddon(tc.args.p, tc.args.addon)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAddonUpToDate(t *testing.T) {
+	type args struct {
+		p     v1alpha1.AddonParameters
+		addon *eks.Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha1.AddonParameters{
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleARN: &roleArn,
+				},
+				addon: &eks.Addon{
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleArn: &roleArn,
+					Tags:                  map[string]*string{},
+				},
+			},
+			want: true,
+		},
+		"DifferentVersion": {
+			args: args{
+				p: v1alpha1.AddonParameters{
+					AddonVersion: aws.String("v1.7.5-eksbuild.2"),
+				},
+				addon: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				p: v1alpha1.AddonParameters{
+					AddonVersion: &addonVersion,
+					Tags:         map[string]string{"cool": "tag"},
+				},
+				addon: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAddonUpToDate(tc.args.p, tc.args.addon)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"encoding/json"
 
 	"net"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +40,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -221,6 +224,78 @@ func GenerateObservation(cluster *eks.Cluster) v1beta1.ClusterObservation { // n
 	return o
 }
 
+// GenerateClusterUpdate is used to produce v1beta1.ClusterUpdate from
+// eks.Update.
+func GenerateClusterUpdate(u *eks.Update) *v1beta1.ClusterUpdate {
+	if u == nil {
+		return nil
+	}
+	o := &v1beta1.ClusterUpdate{
+		ID:     awsclients.StringValue(u.Id),
+		Type:   string(u.Type),
+		Status: string(u.Status),
+	}
+	if u.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *u.CreatedAt}
+	}
+	for _, e := range u.Errors {
+		o.Errors = append(o.Errors, string(e.ErrorCode)+": "+awsclients.StringValue(e.ErrorMessage))
+	}
+	return o
+}
+
+// IsClusterUpdateInProgress returns true if the supplied update is still in
+// progress.
+func IsClusterUpdateInProgress(u *v1beta1.ClusterUpdate) bool {
+	return u != nil && u.Status == string(eks.UpdateStatusInProgress)
+}
+
+// parseMinorVersion returns the major and minor components of a Kubernetes
+// version, e.g. 1 and 18 for 1.18.9.
+func parseMinorVersion(v string) (int, int, bool) {
+	parts := strings.SplitN(v, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	return major, minor, err1 == nil && err2 == nil
+}
+
+// isSupportedNodeVersion returns true if nodes of the supplied version may
+// keep running while the control plane is upgraded from the current version,
+// i.e. if they run its minor version or the one before. Nodes never run a
+// newer version than the control plane, so a newer version is an upgrade of
+// the node group that waits for the control plane.
+func isSupportedNodeVersion(node, current string) bool {
+	nodeMajor, nodeMinor, ok1 := parseMinorVersion(node)
+	major, minor, ok2 := parseMinorVersion(current)
+	if !ok1 || !ok2 || nodeMajor != major {
+		return false
+	}
+	return nodeMinor >= minor-1
+}
+
+// NodeGroupsBlockingUpgrade returns the names of the node groups of the
+// supplied cluster that prevent its control plane from being upgraded from
+// the current version. EKS only upgrades the control plane if all node
+// groups run its current minor version or the one before.
+func NodeGroupsBlockingUpgrade(clusterName, current string, ngs []v1alpha1.NodeGroup) []string {
+	var blocking []string
+	for _, ng := range ngs {
+		if ng.Spec.ForProvider.ClusterName != clusterName || ng.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if ng.Spec.ForProvider.Version == nil ||
+			!isSupportedNodeVersion(*ng.Spec.ForProvider.Version, current) ||
+			ng.Status.AtProvider.Status == v1alpha1.NodeGroupStatusUpdating {
+			blocking = append(blocking, ng.GetName())
+		}
+	}
+	sort.Strings(blocking)
+	return blocking
+}
+
 // LateInitialize fills the empty fields in *v1beta1.ClusterParameters with the
 // values seen in eks.Cluster.
 func LateInitialize(in *v1beta1.ClusterParameters, cluster *eks.Cluster) { // nolint:gocyclo
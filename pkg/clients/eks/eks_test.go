@@ -27,6 +27,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 )
 
@@ -818,3 +819,116 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateClusterUpdate(t *testing.T) {
+	id := "update-id"
+	msg := "node group is not at version 1.16"
+	createdAt := time.Now()
+
+	cases := map[string]struct {
+		in   *eks.Update
+		want *v1beta1.ClusterUpdate
+	}{
+		"Nil": {},
+		"AllFields": {
+			in: &eks.Update{
+				Id:        &id,
+				Type:      eks.UpdateTypeVersionUpdate,
+				Status:    eks.UpdateStatusFailed,
+				CreatedAt: &createdAt,
+				Errors: []eks.ErrorDetail{
+					{ErrorCode: eks.ErrorCodeNodeCreationFailure, ErrorMessage: &msg},
+				},
+			},
+			want: &v1beta1.ClusterUpdate{
+				ID:        id,
+				Type:      string(eks.UpdateTypeVersionUpdate),
+				Status:    string(eks.UpdateStatusFailed),
+				CreatedAt: &metav1.Time{Time: createdAt},
+				Errors:    []string{string(eks.ErrorCodeNodeCreationFailure) + ": " + msg},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateClusterUpdate(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeGroupsBlockingUpgrade(t *testing.T) {
+	nodeGroup := func(name, cluster string, version *string, status v1alpha1.NodeGroupStatusType) v1alpha1.NodeGroup {
+		ng := v1alpha1.NodeGroup{}
+		ng.SetName(name)
+		ng.Spec.ForProvider.ClusterName = cluster
+		ng.Spec.ForProvider.Version = version
+		ng.Status.AtProvider.Status = status
+		return ng
+	}
+	patch := "1.16.8"
+	previous := "1.15.11"
+	older := "1.14"
+	newer := "1.17"
+	deleted := nodeGroup("deleted", clusterName, &older, v1alpha1.NodeGroupStatusDeleting)
+	deleted.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+
+	type args struct {
+		current string
+		ngs     []v1alpha1.NodeGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NoNodeGroups": {
+			args: args{current: version},
+		},
+		"AllAtCurrentVersion": {
+			args: args{
+				current: version,
+				ngs: []v1alpha1.NodeGroup{
+					nodeGroup("a", clusterName, &version, v1alpha1.NodeGroupStatusActive),
+					nodeGroup("b", clusterName, &patch, v1alpha1.NodeGroupStatusActive),
+				},
+			},
+		},
+		"SupportedSkew": {
+			args: args{
+				current: version,
+				ngs: []v1alpha1.NodeGroup{
+					nodeGroup("a", clusterName, &version, v1alpha1.NodeGroupStatusActive),
+					nodeGroup("b", clusterName, &previous, v1alpha1.NodeGroupStatusActive),
+					nodeGroup("c", clusterName, &newer, v1alpha1.NodeGroupStatusActive),
+				},
+			},
+		},
+		"Blocking": {
+			args: args{
+				current: version,
+				ngs: []v1alpha1.NodeGroup{
+					nodeGroup("old", clusterName, &older, v1alpha1.NodeGroupStatusActive),
+					nodeGroup("unknown", clusterName, nil, v1alpha1.NodeGroupStatusCreating),
+					nodeGroup("updating", clusterName, &version, v1alpha1.NodeGroupStatusUpdating),
+					nodeGroup("current", clusterName, &version, v1alpha1.NodeGroupStatusActive),
+					nodeGroup("other", "other-cluster", &older, v1alpha1.NodeGroupStatusActive),
+					deleted,
+				},
+			},
+			want: []string{"old", "unknown", "updating"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NodeGroupsBlockingUpgrade(clusterName, tc.args.current, tc.args.ngs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/eks"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+var _ clientset.AddonClient = &MockAddonClient{}
+
+// MockAddonClient is a fake implementation of eks.AddonClient.
+type MockAddonClient struct {
+	MockCreateAddon   func(*svcsdk.CreateAddonInput) (*svcsdk.CreateAddonOutput, error)
+	MockDescribeAddon func(*svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error)
+	MockUpdateAddon   func(*svcsdk.UpdateAddonInput) (*svcsdk.UpdateAddonOutput, error)
+	MockDeleteAddon   func(*svcsdk.DeleteAddonInput) (*svcsdk.DeleteAddonOutput, error)
+	MockTagResource   func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error)
+	MockUntagResource func(*svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error)
+}
+
+// CreateAddonWithContext calls the underlying MockCreateAddon method.
+func (c *MockAddonClient) CreateAddonWithContext(_ context.Context, i *svcsdk.CreateAddonInput, _ ...request.Option) (*svcsdk.CreateAddonOutput, error) {
+	return c.MockCreateAddon(i)
+}
+
+// DescribeAddonWithContext calls the underlying MockDescribeAddon method.
+func (c *MockAddonClient) DescribeAddonWithContext(_ context.Context, i *svcsdk.DescribeAddonInput, _ ...request.Option) (*svcsdk.DescribeAddonOutput, error) {
+	return c.MockDescribeAddon(i)
+}
+
+// UpdateAddonWithContext calls the underlying MockUpdateAddon method.
+func (c *MockAddonClient) UpdateAddonWithContext(_ context.Context, i *svcsdk.UpdateAddonInput, _ ...request.Option) (*svcsdk.UpdateAddonOutput, error) {
+	return c.MockUpdateAddon(i)
+}
+
+// DeleteAddonWithContext calls the underlying MockDeleteAddon method.
+func (c *MockAddonClient) DeleteAddonWithContext(_ context.Context, i *svcsdk.DeleteAddonInput, _ ...request.Option) (*svcsdk.DeleteAddonOutput, error) {
+	return c.MockDeleteAddon(i)
+}
+
+// TagResourceWithContext calls the underlying MockTagResource method.
+func (c *MockAddonClient) TagResourceWithContext(_ context.Context, i *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	return c.MockTagResource(i)
+}
+
+// UntagResourceWithContext calls the underlying MockUntagResource method.
+func (c *MockAddonClient) UntagResourceWithContext(_ context.Context, i *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	return c.MockUntagResource(i)
+}
+
+var _ clientset.IdentityProviderConfigClient = &MockIdentityProviderConfigClient{}
+
+// MockIdentityProviderConfigClient is a fake implementation of
+// eks.IdentityProviderConfigClient.
+type MockIdentityProviderConfigClient struct {
+	MockAssociateIdentityProviderConfig    func(*svcsdk.AssociateIdentityProviderConfigInput) (*svcsdk.AssociateIdentityProviderConfigOutput, error)
+	MockDescribeIdentityProviderConfig     func(*svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error)
+	MockDisassociateIdentityProviderConfig func(*svcsdk.DisassociateIdentityProviderConfigInput) (*svcsdk.DisassociateIdentityProviderConfigOutput, error)
+	MockTagResource                        func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error)
+	MockUntagResource                      func(*svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error)
+}
+
+// AssociateIdentityProviderConfigWithContext calls the underlying
+// MockAssociateIdentityProviderConfig method.
+func (c *MockIdentityProviderConfigClient) AssociateIdentityProviderConfigWithContext(_ context.Context, i *svcsdk.AssociateIdentityProviderConfigInput, _ ...request.Option) (*svcsdk.AssociateIdentityProviderConfigOutput, error) {
+	return c.MockAssociateIdentityProviderConfig(i)
+}
+
+// DescribeIdentityProviderConfigWithContext calls the underlying
+// MockDescribeIdentityProviderConfig method.
+func (c *MockIdentityProviderConfigClient) DescribeIdentityProviderConfigWithContext(_ context.Context, i *svcsdk.DescribeIdentityProviderConfigInput, _ ...request.Option) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+	return c.MockDescribeIdentityProviderConfig(i)
+}
+
+// DisassociateIdentityProviderConfigWithContext calls the underlying
+// MockDisassociateIdentityProviderConfig method.
+func (c *MockIdentityProviderConfigClient) DisassociateIdentityProviderConfigWithContext(_ context.Context, i *svcsdk.DisassociateIdentityProviderConfigInput, _ ...request.Option) (*svcsdk.DisassociateIdentityProviderConfigOutput, error) {
+	return c.MockDisassociateIdentityProviderConfig(i)
+}
+
+// TagResourceWithContext calls the underlying MockTagResource method.
+func (c *MockIdentityProviderConfigClient) TagResourceWithContext(_ context.Context, i *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	return c.MockTagResource(i)
+}
+
+// UntagResourceWithContext calls the underlying MockUntagResource method.
+func (c *MockIdentityProviderConfigClient) UntagResourceWithContext(_ context.Context, i *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	return c.MockUntagResource(i)
+}
@@ -34,6 +34,7 @@ type MockClient struct {
 	MockTagResourceRequest          func(*eks.TagResourceInput) eks.TagResourceRequest
 	MockUntagResourceRequest        func(*eks.UntagResourceInput) eks.UntagResourceRequest
 	MockUpdateClusterVersionRequest func(*eks.UpdateClusterVersionInput) eks.UpdateClusterVersionRequest
+	MockDescribeUpdateRequest       func(*eks.DescribeUpdateInput) eks.DescribeUpdateRequest
 
 	MockDescribeNodegroupRequest      func(*eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest
 	MockCreateNodegroupRequest        func(*eks.CreateNodegroupInput) eks.CreateNodegroupRequest
@@ -131,3 +132,9 @@ func (c *MockClient) CreateFargateProfileRequest(i *eks.CreateFargateProfileInpu
 func (c *MockClient) DeleteFargateProfileRequest(i *eks.DeleteFargateProfileInput) eks.DeleteFargateProfileRequest {
 	return c.MockDeleteFargateProfileRequest(i)
 }
+
+// DescribeUpdateRequest calls the underlying MockDescribeUpdateRequest
+// method.
+func (c *MockClient) DescribeUpdateRequest(i *eks.DescribeUpdateInput) eks.DescribeUpdateRequest {
+	return c.MockDescribeUpdateRequest(i)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+// IdentityProviderConfigTypeOIDC is the type of OpenID Connect identity
+// provider configs.
+const IdentityProviderConfigTypeOIDC = "oidc"
+
+// IdentityProviderConfigClient defines the EKS identity provider config
+// operations. They are only available in aws/aws-sdk-go.
+type IdentityProviderConfigClient interface {
+	AssociateIdentityProviderConfigWithContext(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts ...request.Option) (*eks.AssociateIdentityProviderConfigOutput, error)
+	DescribeIdentityProviderConfigWithContext(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts ...request.Option) (*eks.DescribeIdentityProviderConfigOutput, error)
+	DisassociateIdentityProviderConfigWithContext(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts ...request.Option) (*eks.DisassociateIdentityProviderConfigOutput, error)
+	TagResourceWithContext(ctx context.Context, input *eks.TagResourceInput, opts ...request.Option) (*eks.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *eks.UntagResourceInput, opts ...request.Option) (*eks.UntagResourceOutput, error)
+}
+
+// NewIdentityProviderConfigClient creates a new IdentityProviderConfigClient
+// with the provided session.
+func NewIdentityProviderConfigClient(sess *session.Session) IdentityProviderConfigClient {
+	return eks.New(sess)
+}
+
+// GenerateAssociateIdentityProviderConfigInput from
+// IdentityProviderConfigParameters.
+func GenerateAssociateIdentityProviderConfigInput(name string, p v1alpha1.IdentityProviderConfigParameters) *eks.AssociateIdentityProviderConfigInput {
+	c := &eks.AssociateIdentityProviderConfigInput{
+		ClusterName: aws.String(p.ClusterName),
+		Oidc: &eks.OidcIdentityProviderConfigRequest{
+			ClientId:                   aws.String(p.OIDC.ClientID),
+			GroupsClaim:                p.OIDC.GroupsClaim,
+			GroupsPrefix:               p.OIDC.GroupsPrefix,
+			IdentityProviderConfigName: aws.String(name),
+			IssuerUrl:                  aws.String(p.OIDC.IssuerURL),
+			UsernameClaim:              p.OIDC.UsernameClaim,
+			UsernamePrefix:             p.OIDC.UsernamePrefix,
+		},
+	}
+	if len(p.OIDC.RequiredClaims) != 0 {
+		c.Oidc.RequiredClaims = aws.StringMap(p.OIDC.RequiredClaims)
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = aws.StringMap(p.Tags)
+	}
+	return c
+}
+
+// GenerateIdentityProviderConfig returns the identifier of the identity
+// provider config with the supplied name.
+func GenerateIdentityProviderConfig(name string) *eks.IdentityProviderConfig {
+	return &eks.IdentityProviderConfig{Name: aws.String(name), Type: aws.String(IdentityProviderConfigTypeOIDC)}
+}
+
+// GenerateIdentityProviderConfigObservation is used to produce
+// v1alpha1.IdentityProviderConfigObservation from
+// eks.OidcIdentityProviderConfig.
+func GenerateIdentityProviderConfigObservation(c *eks.OidcIdentityProviderConfig) v1alpha1.IdentityProviderConfigObservation {
+	if c == nil {
+		return v1alpha1.IdentityProviderConfigObservation{}
+	}
+	return v1alpha1.IdentityProviderConfigObservation{
+		IdentityProviderConfigARN: aws.StringValue(c.IdentityProviderConfigArn),
+		Status:                    v1alpha1.IdentityProviderConfigStatusType(aws.StringValue(c.Status)),
+	}
+}
+
+// LateInitializeIdentityProviderConfig fills the empty fields in
+// *v1alpha1.IdentityProviderConfigParameters with the values seen in
+// eks.OidcIdentityProviderConfig.
+func LateInitializeIdentityProviderConfig(in *v1alpha1.IdentityProviderConfigParameters, c *eks.OidcIdentityProviderConfig) {
+	if c == nil {
+		return
+	}
+	if len(in.Tags) == 0 && len(c.Tags) != 0 {
+		in.Tags = aws.StringValueMap(c.Tags)
+	}
+}
+
+// IsIdentityProviderConfigUpToDate checks whether the tags of the identity
+// provider config, which are the only mutable fields, are up to date.
+func IsIdentityProviderConfigUpToDate(p v1alpha1.IdentityProviderConfigParameters, c *eks.OidcIdentityProviderConfig) bool {
+	return cmp.Equal(p.Tags, aws.StringValueMap(c.Tags), cmpopts.EquateEmpty())
+}
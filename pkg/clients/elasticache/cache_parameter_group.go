@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"context"
+	"sort"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+const (
+	// ParameterSourceUser is the source of the parameters whose values were
+	// changed from the defaults of the family.
+	ParameterSourceUser = "user"
+
+	// MaxParametersPerRequest is the maximum number of parameters that can be
+	// modified or reset in a single request.
+	MaxParametersPerRequest = 20
+)
+
+// CacheParameterGroupClient is the external client used for ElastiCache Cache
+// Parameter Groups.
+type CacheParameterGroupClient interface {
+	CreateCacheParameterGroupWithContext(ctx context.Context, input *svcsdk.CreateCacheParameterGroupInput, opts ...request.Option) (*svcsdk.CreateCacheParameterGroupOutput, error)
+	DescribeCacheParameterGroupsWithContext(ctx context.Context, input *svcsdk.DescribeCacheParameterGroupsInput, opts ...request.Option) (*svcsdk.DescribeCacheParameterGroupsOutput, error)
+	DescribeCacheParametersPagesWithContext(ctx context.Context, input *svcsdk.DescribeCacheParametersInput, fn func(*svcsdk.DescribeCacheParametersOutput, bool) bool, opts ...request.Option) error
+	ModifyCacheParameterGroupWithContext(ctx context.Context, input *svcsdk.ModifyCacheParameterGroupInput, opts ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error)
+	ResetCacheParameterGroupWithContext(ctx context.Context, input *svcsdk.ResetCacheParameterGroupInput, opts ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error)
+	DeleteCacheParameterGroupWithContext(ctx context.Context, input *svcsdk.DeleteCacheParameterGroupInput, opts ...request.Option) (*svcsdk.DeleteCacheParameterGroupOutput, error)
+}
+
+// NewCacheParameterGroupClient returns a new CacheParameterGroupClient with
+// the provided session.
+func NewCacheParameterGroupClient(sess *session.Session) CacheParameterGroupClient {
+	return svcsdk.New(sess)
+}
+
+// GenerateCreateCacheParameterGroupInput returns ElastiCache cache parameter
+// group creation input.
+func GenerateCreateCacheParameterGroupInput(name string, p cachev1alpha1.CacheParameterGroupParameters) *svcsdk.CreateCacheParameterGroupInput {
+	return &svcsdk.CreateCacheParameterGroupInput{
+		CacheParameterGroupName:   awsv1.String(name),
+		CacheParameterGroupFamily: awsv1.String(p.CacheParameterGroupFamily),
+		Description:               awsv1.String(p.Description),
+	}
+}
+
+// GenerateParameterChanges compares the desired parameters with the observed
+// user-modified ones and returns the parameters that need to be modified and
+// the ones that need to be reset to their default values, both sorted by
+// name.
+func GenerateParameterChanges(desired []cachev1alpha1.ParameterNameValue, observed []*svcsdk.Parameter) (modify, reset []*svcsdk.ParameterNameValue) {
+	current := make(map[string]string, len(observed))
+	for _, p := range observed {
+		current[awsv1.StringValue(p.ParameterName)] = awsv1.StringValue(p.ParameterValue)
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, p := range desired {
+		wanted[p.ParameterName] = true
+		if v, ok := current[p.ParameterName]; ok && v == p.ParameterValue {
+			continue
+		}
+		modify = append(modify, &svcsdk.ParameterNameValue{
+			ParameterName:  awsv1.String(p.ParameterName),
+			ParameterValue: awsv1.String(p.ParameterValue),
+		})
+	}
+	for name := range current {
+		if !wanted[name] {
+			reset = append(reset, &svcsdk.ParameterNameValue{ParameterName: awsv1.String(name)})
+		}
+	}
+	sort.Slice(modify, func(i, j int) bool {
+		return awsv1.StringValue(modify[i].ParameterName) < awsv1.StringValue(modify[j].ParameterName)
+	})
+	sort.Slice(reset, func(i, j int) bool {
+		return awsv1.StringValue(reset[i].ParameterName) < awsv1.StringValue(reset[j].ParameterName)
+	})
+	return modify, reset
+}
+
+// IsCacheParameterGroupNotFound returns true if the supplied error indicates
+// a Cache Parameter Group was not found.
+func IsCacheParameterGroupNotFound(err error) bool {
+	return isErrorCodeEqual(svcsdk.ErrCodeCacheParameterGroupNotFoundFault, err)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+func TestGenerateParameterChanges(t *testing.T) {
+	type args struct {
+		desired  []cachev1alpha1.ParameterNameValue
+		observed []*svcsdk.Parameter
+	}
+	type want struct {
+		modify []*svcsdk.ParameterNameValue
+		reset  []*svcsdk.ParameterNameValue
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				desired: []cachev1alpha1.ParameterNameValue{{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"}},
+				observed: []*svcsdk.Parameter{
+					{ParameterName: awsv1.String("maxmemory-policy"), ParameterValue: awsv1.String("allkeys-lru")},
+				},
+			},
+			want: want{},
+		},
+		"ModifyAndReset": {
+			args: args{
+				desired: []cachev1alpha1.ParameterNameValue{
+					{ParameterName: "timeout", ParameterValue: "300"},
+					{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"},
+				},
+				observed: []*svcsdk.Parameter{
+					{ParameterName: awsv1.String("maxmemory-policy"), ParameterValue: awsv1.String("volatile-lru")},
+					{ParameterName: awsv1.String("notify-keyspace-events"), ParameterValue: awsv1.String("Ex")},
+				},
+			},
+			want: want{
+				modify: []*svcsdk.ParameterNameValue{
+					{ParameterName: awsv1.String("maxmemory-policy"), ParameterValue: awsv1.String("allkeys-lru")},
+					{ParameterName: awsv1.String("timeout"), ParameterValue: awsv1.String("300")},
+				},
+				reset: []*svcsdk.ParameterNameValue{
+					{ParameterName: awsv1.String("notify-keyspace-events")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := GenerateParameterChanges(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreUnexported(svcsdk.ParameterNameValue{})); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset, cmpopts.IgnoreUnexported(svcsdk.ParameterNameValue{})); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
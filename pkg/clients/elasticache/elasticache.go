@@ -69,6 +69,7 @@ func NewCreateReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 		CacheSecurityGroupNames:    g.CacheSecurityGroupNames,
 		CacheSubnetGroupName:       g.CacheSubnetGroupName,
 		EngineVersion:              g.EngineVersion,
+		GlobalReplicationGroupId:   g.GlobalReplicationGroupID,
 		NotificationTopicArn:       g.NotificationTopicARN,
 		NumCacheClusters:           clients.Int64Address(g.NumCacheClusters),
 		NumNodeGroups:              clients.Int64Address(g.NumNodeGroups),
@@ -308,6 +309,10 @@ func GenerateObservation(rg elasticache.ReplicationGroup) v1beta1.ReplicationGro
 	if rg.PendingModifiedValues != nil {
 		o.PendingModifiedValues = generateReplicationGroupPendingModifiedValues(*rg.PendingModifiedValues)
 	}
+	if rg.GlobalReplicationGroupInfo != nil {
+		o.GlobalReplicationGroupID = clients.StringValue(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+		o.GlobalReplicationGroupMemberRole = clients.StringValue(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupMemberRole)
+	}
 	return o
 }
 
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.CacheParameterGroupClient = (*MockCacheParameterGroupClient)(nil)
+)
+
+// MockCacheParameterGroupClient is a type that implements all the methods for CacheParameterGroupClient interface
+type MockCacheParameterGroupClient struct {
+	MockCreateCacheParameterGroup    func(*svcsdk.CreateCacheParameterGroupInput) (*svcsdk.CreateCacheParameterGroupOutput, error)
+	MockDescribeCacheParameterGroups func(*svcsdk.DescribeCacheParameterGroupsInput) (*svcsdk.DescribeCacheParameterGroupsOutput, error)
+	MockDescribeCacheParametersPages func(*svcsdk.DescribeCacheParametersInput, func(*svcsdk.DescribeCacheParametersOutput, bool) bool) error
+	MockModifyCacheParameterGroup    func(*svcsdk.ModifyCacheParameterGroupInput) (*svcsdk.CacheParameterGroupNameMessage, error)
+	MockResetCacheParameterGroup     func(*svcsdk.ResetCacheParameterGroupInput) (*svcsdk.CacheParameterGroupNameMessage, error)
+	MockDeleteCacheParameterGroup    func(*svcsdk.DeleteCacheParameterGroupInput) (*svcsdk.DeleteCacheParameterGroupOutput, error)
+}
+
+// CreateCacheParameterGroupWithContext mocks CreateCacheParameterGroupWithContext method
+func (m *MockCacheParameterGroupClient) CreateCacheParameterGroupWithContext(_ context.Context, input *svcsdk.CreateCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CreateCacheParameterGroupOutput, error) {
+	return m.MockCreateCacheParameterGroup(input)
+}
+
+// DescribeCacheParameterGroupsWithContext mocks DescribeCacheParameterGroupsWithContext method
+func (m *MockCacheParameterGroupClient) DescribeCacheParameterGroupsWithContext(_ context.Context, input *svcsdk.DescribeCacheParameterGroupsInput, _ ...request.Option) (*svcsdk.DescribeCacheParameterGroupsOutput, error) {
+	return m.MockDescribeCacheParameterGroups(input)
+}
+
+// DescribeCacheParametersPagesWithContext mocks DescribeCacheParametersPagesWithContext method
+func (m *MockCacheParameterGroupClient) DescribeCacheParametersPagesWithContext(_ context.Context, input *svcsdk.DescribeCacheParametersInput, fn func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error {
+	return m.MockDescribeCacheParametersPages(input, fn)
+}
+
+// ModifyCacheParameterGroupWithContext mocks ModifyCacheParameterGroupWithContext method
+func (m *MockCacheParameterGroupClient) ModifyCacheParameterGroupWithContext(_ context.Context, input *svcsdk.ModifyCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+	return m.MockModifyCacheParameterGroup(input)
+}
+
+// ResetCacheParameterGroupWithContext mocks ResetCacheParameterGroupWithContext method
+func (m *MockCacheParameterGroupClient) ResetCacheParameterGroupWithContext(_ context.Context, input *svcsdk.ResetCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+	return m.MockResetCacheParameterGroup(input)
+}
+
+// DeleteCacheParameterGroupWithContext mocks DeleteCacheParameterGroupWithContext method
+func (m *MockCacheParameterGroupClient) DeleteCacheParameterGroupWithContext(_ context.Context, input *svcsdk.DeleteCacheParameterGroupInput, _ ...request.Option) (*svcsdk.DeleteCacheParameterGroupOutput, error) {
+	return m.MockDeleteCacheParameterGroup(input)
+}
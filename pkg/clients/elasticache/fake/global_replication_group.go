@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.GlobalReplicationGroupClient = (*MockGlobalReplicationGroupClient)(nil)
+)
+
+// MockGlobalReplicationGroupClient is a type that implements all the methods for GlobalReplicationGroupClient interface
+type MockGlobalReplicationGroupClient struct {
+	MockCreateGlobalReplicationGroup    func(*svcsdk.CreateGlobalReplicationGroupInput) (*svcsdk.CreateGlobalReplicationGroupOutput, error)
+	MockDescribeGlobalReplicationGroups func(*svcsdk.DescribeGlobalReplicationGroupsInput) (*svcsdk.DescribeGlobalReplicationGroupsOutput, error)
+	MockModifyGlobalReplicationGroup    func(*svcsdk.ModifyGlobalReplicationGroupInput) (*svcsdk.ModifyGlobalReplicationGroupOutput, error)
+	MockDeleteGlobalReplicationGroup    func(*svcsdk.DeleteGlobalReplicationGroupInput) (*svcsdk.DeleteGlobalReplicationGroupOutput, error)
+}
+
+// CreateGlobalReplicationGroupWithContext mocks CreateGlobalReplicationGroupWithContext method
+func (m *MockGlobalReplicationGroupClient) CreateGlobalReplicationGroupWithContext(_ context.Context, input *svcsdk.CreateGlobalReplicationGroupInput, _ ...request.Option) (*svcsdk.CreateGlobalReplicationGroupOutput, error) {
+	return m.MockCreateGlobalReplicationGroup(input)
+}
+
+// DescribeGlobalReplicationGroupsWithContext mocks DescribeGlobalReplicationGroupsWithContext method
+func (m *MockGlobalReplicationGroupClient) DescribeGlobalReplicationGroupsWithContext(_ context.Context, input *svcsdk.DescribeGlobalReplicationGroupsInput, _ ...request.Option) (*svcsdk.DescribeGlobalReplicationGroupsOutput, error) {
+	return m.MockDescribeGlobalReplicationGroups(input)
+}
+
+// ModifyGlobalReplicationGroupWithContext mocks ModifyGlobalReplicationGroupWithContext method
+func (m *MockGlobalReplicationGroupClient) ModifyGlobalReplicationGroupWithContext(_ context.Context, input *svcsdk.ModifyGlobalReplicationGroupInput, _ ...request.Option) (*svcsdk.ModifyGlobalReplicationGroupOutput, error) {
+	return m.MockModifyGlobalReplicationGroup(input)
+}
+
+// DeleteGlobalReplicationGroupWithContext mocks DeleteGlobalReplicationGroupWithContext method
+func (m *MockGlobalReplicationGroupClient) DeleteGlobalReplicationGroupWithContext(_ context.Context, input *svcsdk.DeleteGlobalReplicationGroupInput, _ ...request.Option) (*svcsdk.DeleteGlobalReplicationGroupOutput, error) {
+	return m.MockDeleteGlobalReplicationGroup(input)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.UserClient = (*MockUserClient)(nil)
+)
+
+// MockUserClient is a type that implements all the methods for UserClient interface
+type MockUserClient struct {
+	MockCreateUser    func(*svcsdk.CreateUserInput) (*svcsdk.CreateUserOutput, error)
+	MockDescribeUsers func(*svcsdk.DescribeUsersInput) (*svcsdk.DescribeUsersOutput, error)
+	MockModifyUser    func(*svcsdk.ModifyUserInput) (*svcsdk.ModifyUserOutput, error)
+	MockDeleteUser    func(*svcsdk.DeleteUserInput) (*svcsdk.DeleteUserOutput, error)
+}
+
+// CreateUserWithContext mocks CreateUserWithContext method
+func (m *MockUserClient) CreateUserWithContext(_ context.Context, input *svcsdk.CreateUserInput, _ ...request.Option) (*svcsdk.CreateUserOutput, error) {
+	return m.MockCreateUser(input)
+}
+
+// DescribeUsersWithContext mocks DescribeUsersWithContext method
+func (m *MockUserClient) DescribeUsersWithContext(_ context.Context, input *svcsdk.DescribeUsersInput, _ ...request.Option) (*svcsdk.DescribeUsersOutput, error) {
+	return m.MockDescribeUsers(input)
+}
+
+// ModifyUserWithContext mocks ModifyUserWithContext method
+func (m *MockUserClient) ModifyUserWithContext(_ context.Context, input *svcsdk.ModifyUserInput, _ ...request.Option) (*svcsdk.ModifyUserOutput, error) {
+	return m.MockModifyUser(input)
+}
+
+// DeleteUserWithContext mocks DeleteUserWithContext method
+func (m *MockUserClient) DeleteUserWithContext(_ context.Context, input *svcsdk.DeleteUserInput, _ ...request.Option) (*svcsdk.DeleteUserOutput, error) {
+	return m.MockDeleteUser(input)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.UserGroupClient                 = (*MockUserGroupClient)(nil)
+	_ clientset.ReplicationGroupUserGroupClient = (*MockReplicationGroupUserGroupClient)(nil)
+)
+
+// MockUserGroupClient is a type that implements all the methods for UserGroupClient interface
+type MockUserGroupClient struct {
+	MockCreateUserGroup    func(*svcsdk.CreateUserGroupInput) (*svcsdk.CreateUserGroupOutput, error)
+	MockDescribeUserGroups func(*svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error)
+	MockModifyUserGroup    func(*svcsdk.ModifyUserGroupInput) (*svcsdk.ModifyUserGroupOutput, error)
+	MockDeleteUserGroup    func(*svcsdk.DeleteUserGroupInput) (*svcsdk.DeleteUserGroupOutput, error)
+}
+
+// CreateUserGroupWithContext mocks CreateUserGroupWithContext method
+func (m *MockUserGroupClient) CreateUserGroupWithContext(_ context.Context, input *svcsdk.CreateUserGroupInput, _ ...request.Option) (*svcsdk.CreateUserGroupOutput, error) {
+	return m.MockCreateUserGroup(input)
+}
+
+// DescribeUserGroupsWithContext mocks DescribeUserGroupsWithContext method
+func (m *MockUserGroupClient) DescribeUserGroupsWithContext(_ context.Context, input *svcsdk.DescribeUserGroupsInput, _ ...request.Option) (*svcsdk.DescribeUserGroupsOutput, error) {
+	return m.MockDescribeUserGroups(input)
+}
+
+// ModifyUserGroupWithContext mocks ModifyUserGroupWithContext method
+func (m *MockUserGroupClient) ModifyUserGroupWithContext(_ context.Context, input *svcsdk.ModifyUserGroupInput, _ ...request.Option) (*svcsdk.ModifyUserGroupOutput, error) {
+	return m.MockModifyUserGroup(input)
+}
+
+// DeleteUserGroupWithContext mocks DeleteUserGroupWithContext method
+func (m *MockUserGroupClient) DeleteUserGroupWithContext(_ context.Context, input *svcsdk.DeleteUserGroupInput, _ ...request.Option) (*svcsdk.DeleteUserGroupOutput, error) {
+	return m.MockDeleteUserGroup(input)
+}
+
+// MockReplicationGroupUserGroupClient is a type that implements all the methods for ReplicationGroupUserGroupClient interface
+type MockReplicationGroupUserGroupClient struct {
+	MockCreateReplicationGroup    func(*svcsdk.CreateReplicationGroupInput) (*svcsdk.CreateReplicationGroupOutput, error)
+	MockDescribeReplicationGroups func(*svcsdk.DescribeReplicationGroupsInput) (*svcsdk.DescribeReplicationGroupsOutput, error)
+	MockModifyReplicationGroup    func(*svcsdk.ModifyReplicationGroupInput) (*svcsdk.ModifyReplicationGroupOutput, error)
+}
+
+// CreateReplicationGroupWithContext mocks CreateReplicationGroupWithContext method
+func (m *MockReplicationGroupUserGroupClient) CreateReplicationGroupWithContext(_ context.Context, input *svcsdk.CreateReplicationGroupInput, _ ...request.Option) (*svcsdk.CreateReplicationGroupOutput, error) {
+	return m.MockCreateReplicationGroup(input)
+}
+
+// DescribeReplicationGroupsWithContext mocks DescribeReplicationGroupsWithContext method
+func (m *MockReplicationGroupUserGroupClient) DescribeReplicationGroupsWithContext(_ context.Context, input *svcsdk.DescribeReplicationGroupsInput, _ ...request.Option) (*svcsdk.DescribeReplicationGroupsOutput, error) {
+	return m.MockDescribeReplicationGroups(input)
+}
+
+// ModifyReplicationGroupWithContext mocks ModifyReplicationGroupWithContext method
+func (m *MockReplicationGroupUserGroupClient) ModifyReplicationGroupWithContext(_ context.Context, input *svcsdk.ModifyReplicationGroupInput, _ ...request.Option) (*svcsdk.ModifyReplicationGroupOutput, error) {
+	return m.MockModifyReplicationGroup(input)
+}
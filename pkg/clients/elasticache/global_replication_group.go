@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+// GlobalReplicationGroupClient is the external client used for ElastiCache
+// Global Replication Groups. Global replication groups are only available in
+// aws/aws-sdk-go.
+type GlobalReplicationGroupClient interface {
+	CreateGlobalReplicationGroupWithContext(ctx context.Context, input *svcsdk.CreateGlobalReplicationGroupInput, opts ...request.Option) (*svcsdk.CreateGlobalReplicationGroupOutput, error)
+	DescribeGlobalReplicationGroupsWithContext(ctx context.Context, input *svcsdk.DescribeGlobalReplicationGroupsInput, opts ...request.Option) (*svcsdk.DescribeGlobalReplicationGroupsOutput, error)
+	ModifyGlobalReplicationGroupWithContext(ctx context.Context, input *svcsdk.ModifyGlobalReplicationGroupInput, opts ...request.Option) (*svcsdk.ModifyGlobalReplicationGroupOutput, error)
+	DeleteGlobalReplicationGroupWithContext(ctx context.Context, input *svcsdk.DeleteGlobalReplicationGroupInput, opts ...request.Option) (*svcsdk.DeleteGlobalReplicationGroupOutput, error)
+}
+
+// NewGlobalReplicationGroupClient returns a new GlobalReplicationGroupClient
+// with the provided session.
+func NewGlobalReplicationGroupClient(sess *session.Session) GlobalReplicationGroupClient {
+	return svcsdk.New(sess)
+}
+
+// GenerateCreateGlobalReplicationGroupInput returns ElastiCache global
+// replication group creation input.
+func GenerateCreateGlobalReplicationGroupInput(p cachev1alpha1.GlobalReplicationGroupParameters) *svcsdk.CreateGlobalReplicationGroupInput {
+	return &svcsdk.CreateGlobalReplicationGroupInput{
+		GlobalReplicationGroupIdSuffix:    awsv1.String(p.GlobalReplicationGroupIDSuffix),
+		GlobalReplicationGroupDescription: p.GlobalReplicationGroupDescription,
+		PrimaryReplicationGroupId:         p.PrimaryReplicationGroupID,
+	}
+}
+
+// GenerateModifyGlobalReplicationGroupInput returns ElastiCache global
+// replication group modification input. Only the fields that differ from the
+// observed global replication group are set so that ElastiCache does not start
+// unnecessary scaling or upgrade operations on the members.
+func GenerateModifyGlobalReplicationGroupInput(id string, p cachev1alpha1.GlobalReplicationGroupParameters, g *svcsdk.GlobalReplicationGroup) *svcsdk.ModifyGlobalReplicationGroupInput {
+	in := &svcsdk.ModifyGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: awsv1.String(id),
+		ApplyImmediately:         awsv1.Bool(true),
+	}
+	if awsv1.StringValue(p.GlobalReplicationGroupDescription) != awsv1.StringValue(g.GlobalReplicationGroupDescription) {
+		in.GlobalReplicationGroupDescription = p.GlobalReplicationGroupDescription
+	}
+	if p.CacheNodeType != nil && *p.CacheNodeType != awsv1.StringValue(g.CacheNodeType) {
+		in.CacheNodeType = p.CacheNodeType
+	}
+	if p.EngineVersion != nil && !versionMatches(p.EngineVersion, g.EngineVersion) {
+		in.EngineVersion = p.EngineVersion
+	}
+	return in
+}
+
+// LateInitializeGlobalReplicationGroup fills the empty fields of the supplied
+// GlobalReplicationGroupParameters with the values of the observed global
+// replication group.
+func LateInitializeGlobalReplicationGroup(p *cachev1alpha1.GlobalReplicationGroupParameters, g *svcsdk.GlobalReplicationGroup) {
+	if p.CacheNodeType == nil {
+		p.CacheNodeType = g.CacheNodeType
+	}
+	if p.EngineVersion == nil {
+		p.EngineVersion = g.EngineVersion
+	}
+}
+
+// IsGlobalReplicationGroupUpToDate checks whether the observed ElastiCache
+// global replication group matches the supplied
+// GlobalReplicationGroupParameters.
+func IsGlobalReplicationGroupUpToDate(p cachev1alpha1.GlobalReplicationGroupParameters, g *svcsdk.GlobalReplicationGroup) bool {
+	in := GenerateModifyGlobalReplicationGroupInput("", p, g)
+	return in.GlobalReplicationGroupDescription == nil && in.CacheNodeType == nil && in.EngineVersion == nil
+}
+
+// GenerateGlobalReplicationGroupObservation produces a
+// GlobalReplicationGroupObservation out of the supplied ElastiCache global
+// replication group.
+func GenerateGlobalReplicationGroupObservation(g *svcsdk.GlobalReplicationGroup) cachev1alpha1.GlobalReplicationGroupObservation {
+	o := cachev1alpha1.GlobalReplicationGroupObservation{
+		ARN:                      awsv1.StringValue(g.ARN),
+		Status:                   awsv1.StringValue(g.Status),
+		Engine:                   awsv1.StringValue(g.Engine),
+		ClusterEnabled:           awsv1.BoolValue(g.ClusterEnabled),
+		AuthTokenEnabled:         awsv1.BoolValue(g.AuthTokenEnabled),
+		TransitEncryptionEnabled: awsv1.BoolValue(g.TransitEncryptionEnabled),
+		AtRestEncryptionEnabled:  awsv1.BoolValue(g.AtRestEncryptionEnabled),
+	}
+	if len(g.Members) != 0 {
+		o.Members = make([]cachev1alpha1.GlobalReplicationGroupMember, len(g.Members))
+		for i, m := range g.Members {
+			o.Members[i] = cachev1alpha1.GlobalReplicationGroupMember{
+				ReplicationGroupID:     awsv1.StringValue(m.ReplicationGroupId),
+				ReplicationGroupRegion: awsv1.StringValue(m.ReplicationGroupRegion),
+				Role:                   awsv1.StringValue(m.Role),
+				AutomaticFailover:      awsv1.StringValue(m.AutomaticFailover),
+				Status:                 awsv1.StringValue(m.Status),
+			}
+		}
+	}
+	return o
+}
+
+// IsGlobalReplicationGroupNotFound returns true if the supplied error
+// indicates a Global Replication Group was not found.
+func IsGlobalReplicationGroupNotFound(err error) bool {
+	return isErrorCodeEqual(svcsdk.ErrCodeGlobalReplicationGroupNotFoundFault, err)
+}
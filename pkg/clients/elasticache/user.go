@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"context"
+	"strconv"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+const (
+	errGetUserPasswordSecret = "cannot get user password secret"
+	errGetConnectionSecret   = "cannot get connection secret"
+
+	// authenticationTypeNoPassword is the authentication type of users that
+	// do not require a password.
+	authenticationTypeNoPassword = "no-password"
+)
+
+// UserClient is the external client used for ElastiCache Users. Users are
+// only available in aws/aws-sdk-go.
+type UserClient interface {
+	CreateUserWithContext(ctx context.Context, input *svcsdk.CreateUserInput, opts ...request.Option) (*svcsdk.CreateUserOutput, error)
+	DescribeUsersWithContext(ctx context.Context, input *svcsdk.DescribeUsersInput, opts ...request.Option) (*svcsdk.DescribeUsersOutput, error)
+	ModifyUserWithContext(ctx context.Context, input *svcsdk.ModifyUserInput, opts ...request.Option) (*svcsdk.ModifyUserOutput, error)
+	DeleteUserWithContext(ctx context.Context, input *svcsdk.DeleteUserInput, opts ...request.Option) (*svcsdk.DeleteUserOutput, error)
+}
+
+// NewUserClient returns a new UserClient with the provided session.
+func NewUserClient(sess *session.Session) UserClient {
+	return svcsdk.New(sess)
+}
+
+// GenerateCreateUserInput returns ElastiCache user creation input.
+func GenerateCreateUserInput(id string, p cachev1alpha1.UserParameters, passwords []string) *svcsdk.CreateUserInput {
+	return &svcsdk.CreateUserInput{
+		UserId:             awsv1.String(id),
+		UserName:           awsv1.String(p.UserName),
+		Engine:             awsv1.String(p.Engine),
+		AccessString:       awsv1.String(p.AccessString),
+		NoPasswordRequired: p.NoPasswordRequired,
+		Passwords:          stringSlice(passwords),
+	}
+}
+
+// GenerateModifyUserInput returns ElastiCache user modification input.
+func GenerateModifyUserInput(id string, p cachev1alpha1.UserParameters, passwords []string) *svcsdk.ModifyUserInput {
+	return &svcsdk.ModifyUserInput{
+		UserId:             awsv1.String(id),
+		AccessString:       awsv1.String(p.AccessString),
+		NoPasswordRequired: p.NoPasswordRequired,
+		Passwords:          stringSlice(passwords),
+	}
+}
+
+// stringSlice converts the supplied strings to a slice of string pointers.
+// Unlike awsv1.StringSlice it returns nil for an empty slice, since an empty
+// list is sent to the query API as an empty parameter.
+func stringSlice(s []string) []*string {
+	if len(s) == 0 {
+		return nil
+	}
+	return awsv1.StringSlice(s)
+}
+
+// GenerateUserObservation produces a UserObservation out of the supplied
+// ElastiCache user.
+func GenerateUserObservation(u *svcsdk.User) cachev1alpha1.UserObservation {
+	o := cachev1alpha1.UserObservation{
+		ARN:          awsv1.StringValue(u.ARN),
+		Status:       awsv1.StringValue(u.Status),
+		UserGroupIDs: awsv1.StringValueSlice(u.UserGroupIds),
+	}
+	if u.Authentication != nil {
+		o.AuthenticationType = awsv1.StringValue(u.Authentication.Type)
+		o.PasswordCount = awsv1.Int64Value(u.Authentication.PasswordCount)
+	}
+	return o
+}
+
+// IsUserUpToDate checks whether the observed ElastiCache user matches the
+// supplied UserParameters. Passwords cannot be read back from AWS, so only
+// their number is compared and passwordsChanged reports whether the
+// referenced passwords differ from the published ones.
+func IsUserUpToDate(p cachev1alpha1.UserParameters, u *svcsdk.User, passwords []string, passwordsChanged bool) bool {
+	if p.AccessString != awsv1.StringValue(u.AccessString) || passwordsChanged {
+		return false
+	}
+	var authType string
+	var count int64
+	if u.Authentication != nil {
+		authType = awsv1.StringValue(u.Authentication.Type)
+		count = awsv1.Int64Value(u.Authentication.PasswordCount)
+	}
+	if awsv1.BoolValue(p.NoPasswordRequired) {
+		return authType == authenticationTypeNoPassword
+	}
+	return authType != authenticationTypeNoPassword && count == int64(len(passwords))
+}
+
+// UserConnectionDetails returns the connection details of an ElastiCache user.
+// The first password is published under the password key and the second one,
+// if any, under password2.
+func UserConnectionDetails(p cachev1alpha1.UserParameters, passwords []string) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(p.UserName),
+	}
+	for i, pwd := range passwords {
+		cd[passwordKey(i)] = []byte(pwd)
+	}
+	return cd
+}
+
+func passwordKey(i int) string {
+	if i == 0 {
+		return xpv1.ResourceCredentialsSecretPasswordKey
+	}
+	return xpv1.ResourceCredentialsSecretPasswordKey + strconv.Itoa(i+1)
+}
+
+// GetUserPasswords fetches the passwords referenced by the supplied
+// selectors and determines whether they differ from the ones published to
+// the supplied connection secret.
+func GetUserPasswords(ctx context.Context, kube client.Client, in []xpv1.SecretKeySelector, out *xpv1.SecretReference) (passwords []string, changed bool, err error) {
+	if len(in) == 0 {
+		return nil, false, nil
+	}
+	passwords = make([]string, len(in))
+	for i, ref := range in {
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, false, errors.Wrap(err, errGetUserPasswordSecret)
+		}
+		passwords[i] = string(s.Data[ref.Key])
+	}
+	if out == nil {
+		return passwords, false, nil
+	}
+	s := &corev1.Secret{}
+	// the connection secret may not exist yet, so we can skip returning an
+	// error if the error is NotFound
+	if err := kube.Get(ctx, types.NamespacedName{Name: out.Name, Namespace: out.Namespace}, s); resource.IgnoreNotFound(err) != nil {
+		return nil, false, errors.Wrap(err, errGetConnectionSecret)
+	}
+	for i, pwd := range passwords {
+		if pwd != string(s.Data[passwordKey(i)]) {
+			changed = true
+		}
+	}
+	return passwords, changed, nil
+}
+
+// IsUserNotFound returns true if the supplied error indicates a User was not
+// found.
+func IsUserNotFound(err error) bool {
+	return isErrorCodeEqual(svcsdk.ErrCodeUserNotFoundFault, err)
+}
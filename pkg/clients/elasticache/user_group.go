@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"context"
+	"sort"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// UserGroupClient is the external client used for ElastiCache User Groups.
+// User groups are only available in aws/aws-sdk-go.
+type UserGroupClient interface {
+	CreateUserGroupWithContext(ctx context.Context, input *svcsdk.CreateUserGroupInput, opts ...request.Option) (*svcsdk.CreateUserGroupOutput, error)
+	DescribeUserGroupsWithContext(ctx context.Context, input *svcsdk.DescribeUserGroupsInput, opts ...request.Option) (*svcsdk.DescribeUserGroupsOutput, error)
+	ModifyUserGroupWithContext(ctx context.Context, input *svcsdk.ModifyUserGroupInput, opts ...request.Option) (*svcsdk.ModifyUserGroupOutput, error)
+	DeleteUserGroupWithContext(ctx context.Context, input *svcsdk.DeleteUserGroupInput, opts ...request.Option) (*svcsdk.DeleteUserGroupOutput, error)
+}
+
+// NewUserGroupClient returns a new UserGroupClient with the provided session.
+func NewUserGroupClient(sess *session.Session) UserGroupClient {
+	return svcsdk.New(sess)
+}
+
+// ReplicationGroupUserGroupClient is the external client used to associate
+// user groups with replication groups. The user group fields of replication
+// groups are only available in aws/aws-sdk-go.
+type ReplicationGroupUserGroupClient interface {
+	CreateReplicationGroupWithContext(ctx context.Context, input *svcsdk.CreateReplicationGroupInput, opts ...request.Option) (*svcsdk.CreateReplicationGroupOutput, error)
+	DescribeReplicationGroupsWithContext(ctx context.Context, input *svcsdk.DescribeReplicationGroupsInput, opts ...request.Option) (*svcsdk.DescribeReplicationGroupsOutput, error)
+	ModifyReplicationGroupWithContext(ctx context.Context, input *svcsdk.ModifyReplicationGroupInput, opts ...request.Option) (*svcsdk.ModifyReplicationGroupOutput, error)
+}
+
+// NewReplicationGroupUserGroupClient returns a new
+// ReplicationGroupUserGroupClient with the provided session.
+func NewReplicationGroupUserGroupClient(sess *session.Session) ReplicationGroupUserGroupClient {
+	return svcsdk.New(sess)
+}
+
+// GenerateUserGroupObservation produces a UserGroupObservation out of the
+// supplied ElastiCache user group.
+func GenerateUserGroupObservation(ug *svcsdk.UserGroup) cachev1alpha1.UserGroupObservation {
+	o := cachev1alpha1.UserGroupObservation{
+		ARN:               awsv1.StringValue(ug.ARN),
+		Status:            awsv1.StringValue(ug.Status),
+		UserIDs:           awsv1.StringValueSlice(ug.UserIds),
+		ReplicationGroups: awsv1.StringValueSlice(ug.ReplicationGroups),
+	}
+	if ug.PendingChanges != nil {
+		o.PendingUserIDsToAdd = awsv1.StringValueSlice(ug.PendingChanges.UserIdsToAdd)
+		o.PendingUserIDsToRemove = awsv1.StringValueSlice(ug.PendingChanges.UserIdsToRemove)
+	}
+	return o
+}
+
+// GenerateModifyUserGroupInput returns ElastiCache user group modification
+// input that adds and removes users so that the user group contains the
+// desired users. It returns nil if no users need to be added or removed.
+func GenerateModifyUserGroupInput(id string, p cachev1alpha1.UserGroupParameters, ug *svcsdk.UserGroup) *svcsdk.ModifyUserGroupInput {
+	add, remove := DiffIDs(p.UserIDs, observedUserIDs(ug))
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	in := &svcsdk.ModifyUserGroupInput{UserGroupId: awsv1.String(id)}
+	if len(add) > 0 {
+		in.UserIdsToAdd = awsv1.StringSlice(add)
+	}
+	if len(remove) > 0 {
+		in.UserIdsToRemove = awsv1.StringSlice(remove)
+	}
+	return in
+}
+
+// IsUserGroupUpToDate checks whether the users of the observed ElastiCache
+// user group, including pending changes, match the supplied
+// UserGroupParameters.
+func IsUserGroupUpToDate(p cachev1alpha1.UserGroupParameters, ug *svcsdk.UserGroup) bool {
+	add, remove := DiffIDs(p.UserIDs, observedUserIDs(ug))
+	return len(add) == 0 && len(remove) == 0
+}
+
+// observedUserIDs returns the users the user group will contain once its
+// pending changes are applied.
+func observedUserIDs(ug *svcsdk.UserGroup) []string {
+	ids := map[string]bool{}
+	for _, id := range ug.UserIds {
+		ids[awsv1.StringValue(id)] = true
+	}
+	if ug.PendingChanges != nil {
+		for _, id := range ug.PendingChanges.UserIdsToAdd {
+			ids[awsv1.StringValue(id)] = true
+		}
+		for _, id := range ug.PendingChanges.UserIdsToRemove {
+			delete(ids, awsv1.StringValue(id))
+		}
+	}
+	res := make([]string, 0, len(ids))
+	for id := range ids {
+		res = append(res, id)
+	}
+	return res
+}
+
+// DiffIDs returns the sorted IDs that are desired but not observed and the
+// sorted IDs that are observed but not desired.
+func DiffIDs(desired, observed []string) (toAdd, toRemove []string) {
+	d := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		d[id] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		o[id] = true
+		if !d[id] {
+			toRemove = append(toRemove, id)
+		}
+	}
+	for id := range d {
+		if !o[id] {
+			toAdd = append(toAdd, id)
+		}
+	}
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	return toAdd, toRemove
+}
+
+// GenerateCreateReplicationGroupWithUserGroupsInput returns ElastiCache
+// replication group creation input for replication groups that are associated
+// with user groups on creation. User groups replace the auth token, so none is
+// set.
+func GenerateCreateReplicationGroupWithUserGroupsInput(g v1beta1.ReplicationGroupParameters, id string) *svcsdk.CreateReplicationGroupInput {
+	c := &svcsdk.CreateReplicationGroupInput{
+		ReplicationGroupId:          awsv1.String(id),
+		ReplicationGroupDescription: awsv1.String(g.ReplicationGroupDescription),
+		Engine:                      awsv1.String(g.Engine),
+		CacheNodeType:               awsv1.String(g.CacheNodeType),
+		UserGroupIds:                stringSlice(g.UserGroupIDs),
+
+		AtRestEncryptionEnabled:    g.AtRestEncryptionEnabled,
+		AutomaticFailoverEnabled:   g.AutomaticFailoverEnabled,
+		CacheParameterGroupName:    g.CacheParameterGroupName,
+		CacheSecurityGroupNames:    stringSlice(g.CacheSecurityGroupNames),
+		CacheSubnetGroupName:       g.CacheSubnetGroupName,
+		EngineVersion:              g.EngineVersion,
+		GlobalReplicationGroupId:   g.GlobalReplicationGroupID,
+		NotificationTopicArn:       g.NotificationTopicARN,
+		NumCacheClusters:           clients.Int64Address(g.NumCacheClusters),
+		NumNodeGroups:              clients.Int64Address(g.NumNodeGroups),
+		Port:                       clients.Int64Address(g.Port),
+		PreferredCacheClusterAZs:   stringSlice(g.PreferredCacheClusterAZs),
+		PreferredMaintenanceWindow: g.PreferredMaintenanceWindow,
+		PrimaryClusterId:           g.PrimaryClusterID,
+		ReplicasPerNodeGroup:       clients.Int64Address(g.ReplicasPerNodeGroup),
+		SecurityGroupIds:           stringSlice(g.SecurityGroupIDs),
+		SnapshotArns:               stringSlice(g.SnapshotARNs),
+		SnapshotName:               g.SnapshotName,
+		SnapshotRetentionLimit:     clients.Int64Address(g.SnapshotRetentionLimit),
+		SnapshotWindow:             g.SnapshotWindow,
+		TransitEncryptionEnabled:   g.TransitEncryptionEnabled,
+	}
+	if len(g.Tags) != 0 {
+		c.Tags = make([]*svcsdk.Tag, len(g.Tags))
+		for i, tag := range g.Tags {
+			c.Tags[i] = &svcsdk.Tag{
+				Key:   awsv1.String(tag.Key),
+				Value: awsv1.String(tag.Value),
+			}
+		}
+	}
+	if len(g.NodeGroupConfiguration) != 0 {
+		c.NodeGroupConfiguration = make([]*svcsdk.NodeGroupConfiguration, len(g.NodeGroupConfiguration))
+		for i, cfg := range g.NodeGroupConfiguration {
+			c.NodeGroupConfiguration[i] = &svcsdk.NodeGroupConfiguration{
+				PrimaryAvailabilityZone:  cfg.PrimaryAvailabilityZone,
+				ReplicaAvailabilityZones: stringSlice(cfg.ReplicaAvailabilityZones),
+				ReplicaCount:             clients.Int64Address(cfg.ReplicaCount),
+				Slots:                    cfg.Slots,
+			}
+		}
+	}
+	return c
+}
+
+// GenerateModifyReplicationGroupUserGroupsInput returns ElastiCache
+// replication group modification input that associates the desired user
+// groups with the replication group. It returns nil if the observed user
+// groups already match.
+func GenerateModifyReplicationGroupUserGroupsInput(id string, applyImmediately bool, desired, observed []string) *svcsdk.ModifyReplicationGroupInput {
+	add, remove := DiffIDs(desired, observed)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	in := &svcsdk.ModifyReplicationGroupInput{
+		ReplicationGroupId: awsv1.String(id),
+		ApplyImmediately:   awsv1.Bool(applyImmediately),
+	}
+	if len(add) > 0 {
+		in.UserGroupIdsToAdd = awsv1.StringSlice(add)
+	}
+	if len(remove) > 0 {
+		in.UserGroupIdsToRemove = awsv1.StringSlice(remove)
+	}
+	return in
+}
+
+// IsUserGroupNotFound returns true if the supplied error indicates a User
+// Group was not found.
+func IsUserGroupNotFound(err error) bool {
+	return isErrorCodeEqual(svcsdk.ErrCodeUserGroupNotFoundFault, err)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+func TestDiffIDs(t *testing.T) {
+	type want struct {
+		toAdd    []string
+		toRemove []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Same": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "a"},
+			want:     want{},
+		},
+		"Different": {
+			desired:  []string{"c", "a", "b"},
+			observed: []string{"d", "a"},
+			want: want{
+				toAdd:    []string{"b", "c"},
+				toRemove: []string{"d"},
+			},
+		},
+		"RemoveAll": {
+			observed: []string{"a"},
+			want: want{
+				toRemove: []string{"a"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			toAdd, toRemove := DiffIDs(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.toAdd, toAdd); diff != "" {
+				t.Errorf("toAdd: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.toRemove, toRemove); diff != "" {
+				t.Errorf("toRemove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUserGroupUpToDate(t *testing.T) {
+	type args struct {
+		p  cachev1alpha1.UserGroupParameters
+		ug *svcsdk.UserGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameUsers": {
+			args: args{
+				p:  cachev1alpha1.UserGroupParameters{UserIDs: []string{"default", "app"}},
+				ug: &svcsdk.UserGroup{UserIds: awsv1.StringSlice([]string{"app", "default"})},
+			},
+			want: true,
+		},
+		"PendingChanges": {
+			args: args{
+				p: cachev1alpha1.UserGroupParameters{UserIDs: []string{"default", "app"}},
+				ug: &svcsdk.UserGroup{
+					UserIds: awsv1.StringSlice([]string{"default", "old"}),
+					PendingChanges: &svcsdk.UserGroupPendingChanges{
+						UserIdsToAdd:    awsv1.StringSlice([]string{"app"}),
+						UserIdsToRemove: awsv1.StringSlice([]string{"old"}),
+					},
+				},
+			},
+			want: true,
+		},
+		"MissingUser": {
+			args: args{
+				p:  cachev1alpha1.UserGroupParameters{UserIDs: []string{"default", "app"}},
+				ug: &svcsdk.UserGroup{UserIds: awsv1.StringSlice([]string{"default"})},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUserGroupUpToDate(tc.args.p, tc.args.ug)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+func TestIsUserUpToDate(t *testing.T) {
+	type args struct {
+		p                cachev1alpha1.UserParameters
+		u                *svcsdk.User
+		passwords        []string
+		passwordsChanged bool
+	}
+
+	accessString := "on ~* +@all"
+	passwordUser := &svcsdk.User{
+		AccessString: awsv1.String(accessString),
+		Authentication: &svcsdk.Authentication{
+			Type:          awsv1.String("password"),
+			PasswordCount: awsv1.Int64(1),
+		},
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p:         cachev1alpha1.UserParameters{AccessString: accessString},
+				u:         passwordUser,
+				passwords: []string{"secret"},
+			},
+			want: true,
+		},
+		"AccessStringChanged": {
+			args: args{
+				p:         cachev1alpha1.UserParameters{AccessString: "on ~app:* +get"},
+				u:         passwordUser,
+				passwords: []string{"secret"},
+			},
+			want: false,
+		},
+		"PasswordChanged": {
+			args: args{
+				p:                cachev1alpha1.UserParameters{AccessString: accessString},
+				u:                passwordUser,
+				passwords:        []string{"secret"},
+				passwordsChanged: true,
+			},
+			want: false,
+		},
+		"PasswordAdded": {
+			args: args{
+				p:         cachev1alpha1.UserParameters{AccessString: accessString},
+				u:         passwordUser,
+				passwords: []string{"secret", "rotated"},
+			},
+			want: false,
+		},
+		"NoPasswordRequired": {
+			args: args{
+				p: cachev1alpha1.UserParameters{AccessString: accessString, NoPasswordRequired: awsv1.Bool(true)},
+				u: &svcsdk.User{
+					AccessString:   awsv1.String(accessString),
+					Authentication: &svcsdk.Authentication{Type: awsv1.String(authenticationTypeNoPassword)},
+				},
+			},
+			want: true,
+		},
+		"PasswordNowRequired": {
+			args: args{
+				p: cachev1alpha1.UserParameters{AccessString: accessString},
+				u: &svcsdk.User{
+					AccessString:   awsv1.String(accessString),
+					Authentication: &svcsdk.Authentication{Type: awsv1.String(authenticationTypeNoPassword)},
+				},
+				passwords: []string{"secret"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUserUpToDate(tc.args.p, tc.args.u, tc.args.passwords, tc.args.passwordsChanged)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
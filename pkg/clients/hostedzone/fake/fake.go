@@ -17,7 +17,11 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/route53"
 )
 
 // MockHostedZoneClient is a type that implements all the methods for Hosted Zone Client interface
@@ -26,6 +30,14 @@ type MockHostedZoneClient struct {
 	MockDeleteHostedZoneRequest        func(input *route53.DeleteHostedZoneInput) route53.DeleteHostedZoneRequest
 	MockGetHostedZoneRequest           func(input *route53.GetHostedZoneInput) route53.GetHostedZoneRequest
 	MockUpdateHostedZoneCommentRequest func(input *route53.UpdateHostedZoneCommentInput) route53.UpdateHostedZoneCommentRequest
+
+	MockAssociateVPCWithHostedZoneRequest        func(input *route53.AssociateVPCWithHostedZoneInput) route53.AssociateVPCWithHostedZoneRequest
+	MockDisassociateVPCFromHostedZoneRequest     func(input *route53.DisassociateVPCFromHostedZoneInput) route53.DisassociateVPCFromHostedZoneRequest
+	MockCreateVPCAssociationAuthorizationRequest func(input *route53.CreateVPCAssociationAuthorizationInput) route53.CreateVPCAssociationAuthorizationRequest
+	MockDeleteVPCAssociationAuthorizationRequest func(input *route53.DeleteVPCAssociationAuthorizationInput) route53.DeleteVPCAssociationAuthorizationRequest
+	MockListQueryLoggingConfigsRequest           func(input *route53.ListQueryLoggingConfigsInput) route53.ListQueryLoggingConfigsRequest
+	MockCreateQueryLoggingConfigRequest          func(input *route53.CreateQueryLoggingConfigInput) route53.CreateQueryLoggingConfigRequest
+	MockDeleteQueryLoggingConfigRequest          func(input *route53.DeleteQueryLoggingConfigInput) route53.DeleteQueryLoggingConfigRequest
 }
 
 // GetHostedZoneRequest mocks GetHostedZoneRequest method
@@ -47,3 +59,84 @@ func (m *MockHostedZoneClient) UpdateHostedZoneCommentRequest(input *route53.Upd
 func (m *MockHostedZoneClient) DeleteHostedZoneRequest(input *route53.DeleteHostedZoneInput) route53.DeleteHostedZoneRequest {
 	return m.MockDeleteHostedZoneRequest(input)
 }
+
+// AssociateVPCWithHostedZoneRequest mocks AssociateVPCWithHostedZoneRequest method
+func (m *MockHostedZoneClient) AssociateVPCWithHostedZoneRequest(input *route53.AssociateVPCWithHostedZoneInput) route53.AssociateVPCWithHostedZoneRequest {
+	return m.MockAssociateVPCWithHostedZoneRequest(input)
+}
+
+// DisassociateVPCFromHostedZoneRequest mocks DisassociateVPCFromHostedZoneRequest method
+func (m *MockHostedZoneClient) DisassociateVPCFromHostedZoneRequest(input *route53.DisassociateVPCFromHostedZoneInput) route53.DisassociateVPCFromHostedZoneRequest {
+	return m.MockDisassociateVPCFromHostedZoneRequest(input)
+}
+
+// CreateVPCAssociationAuthorizationRequest mocks CreateVPCAssociationAuthorizationRequest method
+func (m *MockHostedZoneClient) CreateVPCAssociationAuthorizationRequest(input *route53.CreateVPCAssociationAuthorizationInput) route53.CreateVPCAssociationAuthorizationRequest {
+	return m.MockCreateVPCAssociationAuthorizationRequest(input)
+}
+
+// DeleteVPCAssociationAuthorizationRequest mocks DeleteVPCAssociationAuthorizationRequest method
+func (m *MockHostedZoneClient) DeleteVPCAssociationAuthorizationRequest(input *route53.DeleteVPCAssociationAuthorizationInput) route53.DeleteVPCAssociationAuthorizationRequest {
+	return m.MockDeleteVPCAssociationAuthorizationRequest(input)
+}
+
+// ListQueryLoggingConfigsRequest mocks ListQueryLoggingConfigsRequest method
+func (m *MockHostedZoneClient) ListQueryLoggingConfigsRequest(input *route53.ListQueryLoggingConfigsInput) route53.ListQueryLoggingConfigsRequest {
+	return m.MockListQueryLoggingConfigsRequest(input)
+}
+
+// CreateQueryLoggingConfigRequest mocks CreateQueryLoggingConfigRequest method
+func (m *MockHostedZoneClient) CreateQueryLoggingConfigRequest(input *route53.CreateQueryLoggingConfigInput) route53.CreateQueryLoggingConfigRequest {
+	return m.MockCreateQueryLoggingConfigRequest(input)
+}
+
+// DeleteQueryLoggingConfigRequest mocks DeleteQueryLoggingConfigRequest method
+func (m *MockHostedZoneClient) DeleteQueryLoggingConfigRequest(input *route53.DeleteQueryLoggingConfigInput) route53.DeleteQueryLoggingConfigRequest {
+	return m.MockDeleteQueryLoggingConfigRequest(input)
+}
+
+// MockDNSSECClient is a type that implements all the methods for the DNSSEC Client interface
+type MockDNSSECClient struct {
+	MockGetDNSSEC               func(input *svcsdk.GetDNSSECInput) (*svcsdk.GetDNSSECOutput, error)
+	MockEnableHostedZoneDNSSEC  func(input *svcsdk.EnableHostedZoneDNSSECInput) (*svcsdk.EnableHostedZoneDNSSECOutput, error)
+	MockDisableHostedZoneDNSSEC func(input *svcsdk.DisableHostedZoneDNSSECInput) (*svcsdk.DisableHostedZoneDNSSECOutput, error)
+	MockCreateKeySigningKey     func(input *svcsdk.CreateKeySigningKeyInput) (*svcsdk.CreateKeySigningKeyOutput, error)
+	MockActivateKeySigningKey   func(input *svcsdk.ActivateKeySigningKeyInput) (*svcsdk.ActivateKeySigningKeyOutput, error)
+	MockDeactivateKeySigningKey func(input *svcsdk.DeactivateKeySigningKeyInput) (*svcsdk.DeactivateKeySigningKeyOutput, error)
+	MockDeleteKeySigningKey     func(input *svcsdk.DeleteKeySigningKeyInput) (*svcsdk.DeleteKeySigningKeyOutput, error)
+}
+
+// GetDNSSECWithContext mocks GetDNSSECWithContext method
+func (m *MockDNSSECClient) GetDNSSECWithContext(_ context.Context, input *svcsdk.GetDNSSECInput, _ ...request.Option) (*svcsdk.GetDNSSECOutput, error) {
+	return m.MockGetDNSSEC(input)
+}
+
+// EnableHostedZoneDNSSECWithContext mocks EnableHostedZoneDNSSECWithContext method
+func (m *MockDNSSECClient) EnableHostedZoneDNSSECWithContext(_ context.Context, input *svcsdk.EnableHostedZoneDNSSECInput, _ ...request.Option) (*svcsdk.EnableHostedZoneDNSSECOutput, error) {
+	return m.MockEnableHostedZoneDNSSEC(input)
+}
+
+// DisableHostedZoneDNSSECWithContext mocks DisableHostedZoneDNSSECWithContext method
+func (m *MockDNSSECClient) DisableHostedZoneDNSSECWithContext(_ context.Context, input *svcsdk.DisableHostedZoneDNSSECInput, _ ...request.Option) (*svcsdk.DisableHostedZoneDNSSECOutput, error) {
+	return m.MockDisableHostedZoneDNSSEC(input)
+}
+
+// CreateKeySigningKeyWithContext mocks CreateKeySigningKeyWithContext method
+func (m *MockDNSSECClient) CreateKeySigningKeyWithContext(_ context.Context, input *svcsdk.CreateKeySigningKeyInput, _ ...request.Option) (*svcsdk.CreateKeySigningKeyOutput, error) {
+	return m.MockCreateKeySigningKey(input)
+}
+
+// ActivateKeySigningKeyWithContext mocks ActivateKeySigningKeyWithContext method
+func (m *MockDNSSECClient) ActivateKeySigningKeyWithContext(_ context.Context, input *svcsdk.ActivateKeySigningKeyInput, _ ...request.Option) (*svcsdk.ActivateKeySigningKeyOutput, error) {
+	return m.MockActivateKeySigningKey(input)
+}
+
+// DeactivateKeySigningKeyWithContext mocks DeactivateKeySigningKeyWithContext method
+func (m *MockDNSSECClient) DeactivateKeySigningKeyWithContext(_ context.Context, input *svcsdk.DeactivateKeySigningKeyInput, _ ...request.Option) (*svcsdk.DeactivateKeySigningKeyOutput, error) {
+	return m.MockDeactivateKeySigningKey(input)
+}
+
+// DeleteKeySigningKeyWithContext mocks DeleteKeySigningKeyWithContext method
+func (m *MockDNSSECClient) DeleteKeySigningKeyWithContext(_ context.Context, input *svcsdk.DeleteKeySigningKeyInput, _ ...request.Option) (*svcsdk.DeleteKeySigningKeyOutput, error) {
+	return m.MockDeleteKeySigningKey(input)
+}
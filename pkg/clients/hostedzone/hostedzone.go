@@ -17,6 +17,14 @@ limitations under the License.
 package hostedzone
 
 import (
+	"context"
+	"fmt"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/route53"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
@@ -28,12 +36,33 @@ import (
 // IDPrefix is the prefix of the actual ID that's returned from GET call.
 const IDPrefix = "/hostedzone/"
 
+const maxCallerReferenceLength = 128
+
 // Client defines Route53 Client operations
 type Client interface {
 	CreateHostedZoneRequest(input *route53.CreateHostedZoneInput) route53.CreateHostedZoneRequest
 	DeleteHostedZoneRequest(input *route53.DeleteHostedZoneInput) route53.DeleteHostedZoneRequest
 	GetHostedZoneRequest(input *route53.GetHostedZoneInput) route53.GetHostedZoneRequest
 	UpdateHostedZoneCommentRequest(input *route53.UpdateHostedZoneCommentInput) route53.UpdateHostedZoneCommentRequest
+	AssociateVPCWithHostedZoneRequest(input *route53.AssociateVPCWithHostedZoneInput) route53.AssociateVPCWithHostedZoneRequest
+	DisassociateVPCFromHostedZoneRequest(input *route53.DisassociateVPCFromHostedZoneInput) route53.DisassociateVPCFromHostedZoneRequest
+	CreateVPCAssociationAuthorizationRequest(input *route53.CreateVPCAssociationAuthorizationInput) route53.CreateVPCAssociationAuthorizationRequest
+	DeleteVPCAssociationAuthorizationRequest(input *route53.DeleteVPCAssociationAuthorizationInput) route53.DeleteVPCAssociationAuthorizationRequest
+	ListQueryLoggingConfigsRequest(input *route53.ListQueryLoggingConfigsInput) route53.ListQueryLoggingConfigsRequest
+	CreateQueryLoggingConfigRequest(input *route53.CreateQueryLoggingConfigInput) route53.CreateQueryLoggingConfigRequest
+	DeleteQueryLoggingConfigRequest(input *route53.DeleteQueryLoggingConfigInput) route53.DeleteQueryLoggingConfigRequest
+}
+
+// DNSSECClient defines the Route53 DNSSEC operations. They are only available
+// in aws/aws-sdk-go.
+type DNSSECClient interface {
+	GetDNSSECWithContext(ctx context.Context, input *svcsdk.GetDNSSECInput, opts ...request.Option) (*svcsdk.GetDNSSECOutput, error)
+	EnableHostedZoneDNSSECWithContext(ctx context.Context, input *svcsdk.EnableHostedZoneDNSSECInput, opts ...request.Option) (*svcsdk.EnableHostedZoneDNSSECOutput, error)
+	DisableHostedZoneDNSSECWithContext(ctx context.Context, input *svcsdk.DisableHostedZoneDNSSECInput, opts ...request.Option) (*svcsdk.DisableHostedZoneDNSSECOutput, error)
+	CreateKeySigningKeyWithContext(ctx context.Context, input *svcsdk.CreateKeySigningKeyInput, opts ...request.Option) (*svcsdk.CreateKeySigningKeyOutput, error)
+	ActivateKeySigningKeyWithContext(ctx context.Context, input *svcsdk.ActivateKeySigningKeyInput, opts ...request.Option) (*svcsdk.ActivateKeySigningKeyOutput, error)
+	DeactivateKeySigningKeyWithContext(ctx context.Context, input *svcsdk.DeactivateKeySigningKeyInput, opts ...request.Option) (*svcsdk.DeactivateKeySigningKeyOutput, error)
+	DeleteKeySigningKeyWithContext(ctx context.Context, input *svcsdk.DeleteKeySigningKeyInput, opts ...request.Option) (*svcsdk.DeleteKeySigningKeyOutput, error)
 }
 
 // NewClient creates new RDS RDSClient with provided AWS Configurations/Credentials
@@ -41,6 +70,11 @@ func NewClient(cfg aws.Config) Client {
 	return route53.New(cfg)
 }
 
+// NewDNSSECClient creates a new DNSSECClient with the provided session.
+func NewDNSSECClient(sess *session.Session) DNSSECClient {
+	return svcsdk.New(sess)
+}
+
 // IsNotFound returns true if the error code indicates that the requested Zone was not found
 func IsNotFound(err error) bool {
 	if zoneErr, ok := err.(awserr.Error); ok && zoneErr.Code() == route53.ErrCodeNoSuchHostedZone {
@@ -49,6 +83,18 @@ func IsNotFound(err error) bool {
 	return false
 }
 
+// KSK statuses.
+const (
+	KeySigningKeyStatusActive   = "ACTIVE"
+	KeySigningKeyStatusInactive = "INACTIVE"
+)
+
+// DNSSEC signing statuses.
+const (
+	DNSSECStatusSigning    = "SIGNING"
+	DNSSECStatusNotSigning = "NOT_SIGNING"
+)
+
 // IsUpToDate check whether the comment in Spec and Response are same or not
 func IsUpToDate(spec v1alpha1.HostedZoneParameters, obs route53.HostedZone) bool {
 	s := ""
@@ -142,3 +188,151 @@ func GenerateUpdateHostedZoneCommentInput(spec v1alpha1.HostedZoneParameters, id
 		Id:      &id,
 	}
 }
+
+// DesiredVPCs returns all VPCs that should be associated with the hosted zone
+// keyed by their ID.
+func DesiredVPCs(spec v1alpha1.HostedZoneParameters) map[string]v1alpha1.VPCAssociation {
+	vpcs := map[string]v1alpha1.VPCAssociation{}
+	if spec.VPC != nil && spec.VPC.VPCID != nil {
+		vpcs[*spec.VPC.VPCID] = v1alpha1.VPCAssociation{VPC: *spec.VPC}
+	}
+	for _, a := range spec.VPCAssociations {
+		if a.VPCID != nil {
+			vpcs[*a.VPCID] = a
+		}
+	}
+	return vpcs
+}
+
+// DiffVPCs returns the VPCs that need to be associated with and disassociated
+// from the hosted zone. VPCs are only managed for private hosted zones.
+func DiffVPCs(spec v1alpha1.HostedZoneParameters, observed []v1alpha1.VPCObservation) (associate []v1alpha1.VPCAssociation, disassociate []v1alpha1.VPC) {
+	if spec.Config == nil || !awsclients.BoolValue(spec.Config.PrivateZone) {
+		return nil, nil
+	}
+	desired := DesiredVPCs(spec)
+	current := map[string]bool{}
+	for _, v := range observed {
+		current[v.VPCID] = true
+		if _, ok := desired[v.VPCID]; !ok {
+			disassociate = append(disassociate, v1alpha1.VPC{VPCID: aws.String(v.VPCID), VPCRegion: aws.String(v.VPCRegion)})
+		}
+	}
+	for _, a := range spec.VPCAssociations {
+		if a.VPCID != nil && !current[*a.VPCID] {
+			associate = append(associate, a)
+		}
+	}
+	return associate, disassociate
+}
+
+// GenerateVPC returns the route53 VPC of the supplied VPC.
+func GenerateVPC(v v1alpha1.VPC) *route53.VPC {
+	return &route53.VPC{VPCId: v.VPCID, VPCRegion: route53.VPCRegion(awsclients.StringValue(v.VPCRegion))}
+}
+
+// IsQueryLoggingEnabled returns true if the spec requires queries to be
+// logged.
+func IsQueryLoggingEnabled(spec v1alpha1.QueryLogging) bool {
+	return spec.Enabled == nil || *spec.Enabled
+}
+
+// IsQueryLoggingUpToDate returns true if the observed query logging
+// configuration matches the spec. Query logging is not managed if the spec
+// omits it.
+func IsQueryLoggingUpToDate(spec *v1alpha1.QueryLogging, obs *v1alpha1.QueryLoggingObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if !IsQueryLoggingEnabled(*spec) {
+		return obs == nil
+	}
+	return obs != nil && obs.CloudWatchLogsLogGroupARN == spec.CloudWatchLogsLogGroupARN
+}
+
+// GenerateQueryLoggingObservation returns the observed query logging
+// configuration of a hosted zone. A hosted zone has at most one.
+func GenerateQueryLoggingObservation(configs []route53.QueryLoggingConfig) *v1alpha1.QueryLoggingObservation {
+	if len(configs) == 0 {
+		return nil
+	}
+	return &v1alpha1.QueryLoggingObservation{
+		ID:                        aws.StringValue(configs[0].Id),
+		CloudWatchLogsLogGroupARN: aws.StringValue(configs[0].CloudWatchLogsLogGroupArn),
+	}
+}
+
+// KeySigningKeyStatus returns the desired status of the supplied KSK.
+func KeySigningKeyStatus(k v1alpha1.KeySigningKey) string {
+	if k.Status == nil {
+		return KeySigningKeyStatusActive
+	}
+	return *k.Status
+}
+
+// IsSigningEnabled returns true if the spec requires the hosted zone to be
+// signed.
+func IsSigningEnabled(spec v1alpha1.DNSSEC) bool {
+	return spec.SigningEnabled == nil || *spec.SigningEnabled
+}
+
+// IsDNSSECUpToDate returns true if the observed DNSSEC state matches the
+// spec. DNSSEC is not managed if the spec omits it.
+func IsDNSSECUpToDate(spec *v1alpha1.DNSSEC, obs *v1alpha1.DNSSECObservation) bool {
+	if spec == nil {
+		return true
+	}
+	if obs == nil {
+		return false
+	}
+	if (obs.ServeSignature == DNSSECStatusSigning) != IsSigningEnabled(*spec) {
+		return false
+	}
+	if len(spec.KeySigningKeys) != len(obs.KeySigningKeys) {
+		return false
+	}
+	current := map[string]string{}
+	for _, k := range obs.KeySigningKeys {
+		current[k.Name] = k.Status
+	}
+	for _, k := range spec.KeySigningKeys {
+		if s, ok := current[k.Name]; !ok || s != KeySigningKeyStatus(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateDNSSECObservation returns the observed DNSSEC state of a hosted zone.
+func GenerateDNSSECObservation(obs *svcsdk.GetDNSSECOutput) *v1alpha1.DNSSECObservation {
+	if obs == nil {
+		return nil
+	}
+	o := &v1alpha1.DNSSECObservation{}
+	if obs.Status != nil {
+		o.ServeSignature = awsv1.StringValue(obs.Status.ServeSignature)
+		o.StatusMessage = awsv1.StringValue(obs.Status.StatusMessage)
+	}
+	for _, k := range obs.KeySigningKeys {
+		o.KeySigningKeys = append(o.KeySigningKeys, v1alpha1.KeySigningKeyObservation{
+			Name:          awsv1.StringValue(k.Name),
+			Status:        awsv1.StringValue(k.Status),
+			StatusMessage: awsv1.StringValue(k.StatusMessage),
+			KeyTag:        awsv1.Int64Value(k.KeyTag),
+			DSRecord:      awsv1.StringValue(k.DSRecord),
+		})
+	}
+	return o
+}
+
+// KeySigningKeyCallerReference returns a caller reference for the creation of
+// the supplied KSK. Like the caller reference of the hosted zone it is derived
+// from the resource version of the HostedZone, so that a KSK that is deleted
+// and created again does not reuse the caller reference.
+func KeySigningKeyCallerReference(resourceVersion string, k v1alpha1.KeySigningKey) string {
+	ref := fmt.Sprintf("%s-%s", k.Name, resourceVersion)
+	if len(ref) > maxCallerReferenceLength {
+		ref = ref[len(ref)-maxCallerReferenceLength:]
+	}
+	return ref
+}
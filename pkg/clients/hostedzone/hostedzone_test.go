@@ -20,8 +20,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 )
 
 func TestIsErrorNoSuchHostedZone(t *testing.T) {
@@ -50,3 +54,140 @@ func TestIsErrorNoSuchHostedZone(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffVPCs(t *testing.T) {
+	private := &v1alpha1.Config{PrivateZone: aws.Bool(true)}
+	primary := v1alpha1.VPC{VPCID: aws.String("vpc-1"), VPCRegion: aws.String("us-east-1")}
+	other := v1alpha1.VPCAssociation{VPC: v1alpha1.VPC{VPCID: aws.String("vpc-2"), VPCRegion: aws.String("us-west-2")}}
+
+	type want struct {
+		associate    []v1alpha1.VPCAssociation
+		disassociate []v1alpha1.VPC
+	}
+
+	cases := map[string]struct {
+		spec     v1alpha1.HostedZoneParameters
+		observed []v1alpha1.VPCObservation
+		want     want
+	}{
+		"PublicZone": {
+			spec:     v1alpha1.HostedZoneParameters{VPCAssociations: []v1alpha1.VPCAssociation{other}},
+			observed: []v1alpha1.VPCObservation{},
+		},
+		"UpToDate": {
+			spec:     v1alpha1.HostedZoneParameters{Config: private, VPC: &primary, VPCAssociations: []v1alpha1.VPCAssociation{other}},
+			observed: []v1alpha1.VPCObservation{{VPCID: "vpc-1", VPCRegion: "us-east-1"}, {VPCID: "vpc-2", VPCRegion: "us-west-2"}},
+		},
+		"Associate": {
+			spec:     v1alpha1.HostedZoneParameters{Config: private, VPC: &primary, VPCAssociations: []v1alpha1.VPCAssociation{other}},
+			observed: []v1alpha1.VPCObservation{{VPCID: "vpc-1", VPCRegion: "us-east-1"}},
+			want:     want{associate: []v1alpha1.VPCAssociation{other}},
+		},
+		"Disassociate": {
+			spec:     v1alpha1.HostedZoneParameters{Config: private, VPC: &primary},
+			observed: []v1alpha1.VPCObservation{{VPCID: "vpc-1", VPCRegion: "us-east-1"}, {VPCID: "vpc-2", VPCRegion: "us-west-2"}},
+			want:     want{disassociate: []v1alpha1.VPC{other.VPC}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffVPCs(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDNSSECUpToDate(t *testing.T) {
+	active := v1alpha1.KeySigningKeyObservation{Name: "ksk", Status: KeySigningKeyStatusActive}
+
+	cases := map[string]struct {
+		spec *v1alpha1.DNSSEC
+		obs  *v1alpha1.DNSSECObservation
+		want bool
+	}{
+		"NotManaged": {
+			obs:  &v1alpha1.DNSSECObservation{ServeSignature: DNSSECStatusSigning},
+			want: true,
+		},
+		"UpToDate": {
+			spec: &v1alpha1.DNSSEC{KeySigningKeys: []v1alpha1.KeySigningKey{{Name: "ksk"}}},
+			obs:  &v1alpha1.DNSSECObservation{ServeSignature: DNSSECStatusSigning, KeySigningKeys: []v1alpha1.KeySigningKeyObservation{active}},
+			want: true,
+		},
+		"NotSigning": {
+			spec: &v1alpha1.DNSSEC{KeySigningKeys: []v1alpha1.KeySigningKey{{Name: "ksk"}}},
+			obs:  &v1alpha1.DNSSECObservation{ServeSignature: DNSSECStatusNotSigning, KeySigningKeys: []v1alpha1.KeySigningKeyObservation{active}},
+			want: false,
+		},
+		"KeyStatusDiffers": {
+			spec: &v1alpha1.DNSSEC{SigningEnabled: aws.Bool(false), KeySigningKeys: []v1alpha1.KeySigningKey{{Name: "ksk", Status: aws.String(KeySigningKeyStatusInactive)}}},
+			obs:  &v1alpha1.DNSSECObservation{ServeSignature: DNSSECStatusNotSigning, KeySigningKeys: []v1alpha1.KeySigningKeyObservation{active}},
+			want: false,
+		},
+		"ExtraKey": {
+			spec: &v1alpha1.DNSSEC{},
+			obs:  &v1alpha1.DNSSECObservation{ServeSignature: DNSSECStatusSigning, KeySigningKeys: []v1alpha1.KeySigningKeyObservation{active}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDNSSECUpToDate(tc.spec, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsQueryLoggingUpToDate(t *testing.T) {
+	arn := "arn:aws:logs:us-east-1:123456789012:log-group:/aws/route53/example.com"
+
+	cases := map[string]struct {
+		spec *v1alpha1.QueryLogging
+		obs  *v1alpha1.QueryLoggingObservation
+		want bool
+	}{
+		"BothNil": {
+			want: true,
+		},
+		"Missing": {
+			spec: &v1alpha1.QueryLogging{CloudWatchLogsLogGroupARN: arn},
+			want: false,
+		},
+		"Unmanaged": {
+			obs:  &v1alpha1.QueryLoggingObservation{ID: "id", CloudWatchLogsLogGroupARN: arn},
+			want: true,
+		},
+		"Unwanted": {
+			spec: &v1alpha1.QueryLogging{Enabled: aws.Bool(false)},
+			obs:  &v1alpha1.QueryLoggingObservation{ID: "id", CloudWatchLogsLogGroupARN: arn},
+			want: false,
+		},
+		"Disabled": {
+			spec: &v1alpha1.QueryLogging{Enabled: aws.Bool(false)},
+			want: true,
+		},
+		"Same": {
+			spec: &v1alpha1.QueryLogging{CloudWatchLogsLogGroupARN: arn},
+			obs:  &v1alpha1.QueryLoggingObservation{ID: "id", CloudWatchLogsLogGroupARN: arn},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsQueryLoggingUpToDate(tc.spec, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,10 +27,14 @@ var _ clientset.GroupClient = (*MockGroupClient)(nil)
 
 // MockGroupClient is a type that implements all the methods for RoleClient interface
 type MockGroupClient struct {
-	MockGetGroup    func(*iam.GetGroupInput) iam.GetGroupRequest
-	MockCreateGroup func(*iam.CreateGroupInput) iam.CreateGroupRequest
-	MockDeleteGroup func(*iam.DeleteGroupInput) iam.DeleteGroupRequest
-	MockUpdateGroup func(*iam.UpdateGroupInput) iam.UpdateGroupRequest
+	MockGetGroup          func(*iam.GetGroupInput) iam.GetGroupRequest
+	MockCreateGroup       func(*iam.CreateGroupInput) iam.CreateGroupRequest
+	MockDeleteGroup       func(*iam.DeleteGroupInput) iam.DeleteGroupRequest
+	MockUpdateGroup       func(*iam.UpdateGroupInput) iam.UpdateGroupRequest
+	MockListGroupPolicies func(*iam.ListGroupPoliciesInput) iam.ListGroupPoliciesRequest
+	MockGetGroupPolicy    func(*iam.GetGroupPolicyInput) iam.GetGroupPolicyRequest
+	MockPutGroupPolicy    func(*iam.PutGroupPolicyInput) iam.PutGroupPolicyRequest
+	MockDeleteGroupPolicy func(*iam.DeleteGroupPolicyInput) iam.DeleteGroupPolicyRequest
 }
 
 // GetGroupRequest mocks GetGroupRequest method
@@ -52,3 +56,23 @@ func (m *MockGroupClient) DeleteGroupRequest(input *iam.DeleteGroupInput) iam.De
 func (m *MockGroupClient) UpdateGroupRequest(input *iam.UpdateGroupInput) iam.UpdateGroupRequest {
 	return m.MockUpdateGroup(input)
 }
+
+// ListGroupPoliciesRequest mocks ListGroupPoliciesRequest method
+func (m *MockGroupClient) ListGroupPoliciesRequest(input *iam.ListGroupPoliciesInput) iam.ListGroupPoliciesRequest {
+	return m.MockListGroupPolicies(input)
+}
+
+// GetGroupPolicyRequest mocks GetGroupPolicyRequest method
+func (m *MockGroupClient) GetGroupPolicyRequest(input *iam.GetGroupPolicyInput) iam.GetGroupPolicyRequest {
+	return m.MockGetGroupPolicy(input)
+}
+
+// PutGroupPolicyRequest mocks PutGroupPolicyRequest method
+func (m *MockGroupClient) PutGroupPolicyRequest(input *iam.PutGroupPolicyInput) iam.PutGroupPolicyRequest {
+	return m.MockPutGroupPolicy(input)
+}
+
+// DeleteGroupPolicyRequest mocks DeleteGroupPolicyRequest method
+func (m *MockGroupClient) DeleteGroupPolicyRequest(input *iam.DeleteGroupPolicyInput) iam.DeleteGroupPolicyRequest {
+	return m.MockDeleteGroupPolicy(input)
+}
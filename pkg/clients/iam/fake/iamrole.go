@@ -34,6 +34,10 @@ type MockRoleClient struct {
 	MockUpdateAssumeRolePolicyRequest func(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
 	MockTagRoleRequest                func(input *iam.TagRoleInput) iam.TagRoleRequest
 	MockUntagRoleRequest              func(input *iam.UntagRoleInput) iam.UntagRoleRequest
+	MockListRolePoliciesRequest       func(*iam.ListRolePoliciesInput) iam.ListRolePoliciesRequest
+	MockGetRolePolicyRequest          func(*iam.GetRolePolicyInput) iam.GetRolePolicyRequest
+	MockPutRolePolicyRequest          func(*iam.PutRolePolicyInput) iam.PutRolePolicyRequest
+	MockDeleteRolePolicyRequest       func(*iam.DeleteRolePolicyInput) iam.DeleteRolePolicyRequest
 }
 
 // GetRoleRequest mocks GetRoleRequest method
@@ -70,3 +74,23 @@ func (m *MockRoleClient) TagRoleRequest(input *iam.TagRoleInput) iam.TagRoleRequ
 func (m *MockRoleClient) UntagRoleRequest(input *iam.UntagRoleInput) iam.UntagRoleRequest {
 	return m.MockUntagRoleRequest(input)
 }
+
+// ListRolePoliciesRequest mocks ListRolePoliciesRequest method
+func (m *MockRoleClient) ListRolePoliciesRequest(input *iam.ListRolePoliciesInput) iam.ListRolePoliciesRequest {
+	return m.MockListRolePoliciesRequest(input)
+}
+
+// GetRolePolicyRequest mocks GetRolePolicyRequest method
+func (m *MockRoleClient) GetRolePolicyRequest(input *iam.GetRolePolicyInput) iam.GetRolePolicyRequest {
+	return m.MockGetRolePolicyRequest(input)
+}
+
+// PutRolePolicyRequest mocks PutRolePolicyRequest method
+func (m *MockRoleClient) PutRolePolicyRequest(input *iam.PutRolePolicyInput) iam.PutRolePolicyRequest {
+	return m.MockPutRolePolicyRequest(input)
+}
+
+// DeleteRolePolicyRequest mocks DeleteRolePolicyRequest method
+func (m *MockRoleClient) DeleteRolePolicyRequest(input *iam.DeleteRolePolicyInput) iam.DeleteRolePolicyRequest {
+	return m.MockDeleteRolePolicyRequest(input)
+}
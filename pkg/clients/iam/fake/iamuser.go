@@ -27,10 +27,14 @@ var _ clientset.UserClient = (*MockUserClient)(nil)
 
 // MockUserClient is a type that implements all the methods for RoleClient interface
 type MockUserClient struct {
-	MockGetUser    func(*iam.GetUserInput) iam.GetUserRequest
-	MockCreateUser func(*iam.CreateUserInput) iam.CreateUserRequest
-	MockDeleteUser func(*iam.DeleteUserInput) iam.DeleteUserRequest
-	MockUpdateUser func(*iam.UpdateUserInput) iam.UpdateUserRequest
+	MockGetUser          func(*iam.GetUserInput) iam.GetUserRequest
+	MockCreateUser       func(*iam.CreateUserInput) iam.CreateUserRequest
+	MockDeleteUser       func(*iam.DeleteUserInput) iam.DeleteUserRequest
+	MockUpdateUser       func(*iam.UpdateUserInput) iam.UpdateUserRequest
+	MockListUserPolicies func(*iam.ListUserPoliciesInput) iam.ListUserPoliciesRequest
+	MockGetUserPolicy    func(*iam.GetUserPolicyInput) iam.GetUserPolicyRequest
+	MockPutUserPolicy    func(*iam.PutUserPolicyInput) iam.PutUserPolicyRequest
+	MockDeleteUserPolicy func(*iam.DeleteUserPolicyInput) iam.DeleteUserPolicyRequest
 }
 
 // GetUserRequest mocks GetUserRequest method
@@ -52,3 +56,23 @@ func (m *MockUserClient) DeleteUserRequest(input *iam.DeleteUserInput) iam.Delet
 func (m *MockUserClient) UpdateUserRequest(input *iam.UpdateUserInput) iam.UpdateUserRequest {
 	return m.MockUpdateUser(input)
 }
+
+// ListUserPoliciesRequest mocks ListUserPoliciesRequest method
+func (m *MockUserClient) ListUserPoliciesRequest(input *iam.ListUserPoliciesInput) iam.ListUserPoliciesRequest {
+	return m.MockListUserPolicies(input)
+}
+
+// GetUserPolicyRequest mocks GetUserPolicyRequest method
+func (m *MockUserClient) GetUserPolicyRequest(input *iam.GetUserPolicyInput) iam.GetUserPolicyRequest {
+	return m.MockGetUserPolicy(input)
+}
+
+// PutUserPolicyRequest mocks PutUserPolicyRequest method
+func (m *MockUserClient) PutUserPolicyRequest(input *iam.PutUserPolicyInput) iam.PutUserPolicyRequest {
+	return m.MockPutUserPolicy(input)
+}
+
+// DeleteUserPolicyRequest mocks DeleteUserPolicyRequest method
+func (m *MockUserClient) DeleteUserPolicyRequest(input *iam.DeleteUserPolicyInput) iam.DeleteUserPolicyRequest {
+	return m.MockDeleteUserPolicy(input)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LoginProfileClient = (*MockLoginProfileClient)(nil)
+
+// MockLoginProfileClient is a type that implements all the methods for
+// LoginProfileClient interface
+type MockLoginProfileClient struct {
+	MockCreateLoginProfileRequest func(*iam.CreateLoginProfileInput) iam.CreateLoginProfileRequest
+	MockGetLoginProfileRequest    func(*iam.GetLoginProfileInput) iam.GetLoginProfileRequest
+	MockUpdateLoginProfileRequest func(*iam.UpdateLoginProfileInput) iam.UpdateLoginProfileRequest
+	MockDeleteLoginProfileRequest func(*iam.DeleteLoginProfileInput) iam.DeleteLoginProfileRequest
+}
+
+// CreateLoginProfileRequest mocks CreateLoginProfileRequest method
+func (m MockLoginProfileClient) CreateLoginProfileRequest(input *iam.CreateLoginProfileInput) iam.CreateLoginProfileRequest {
+	return m.MockCreateLoginProfileRequest(input)
+}
+
+// GetLoginProfileRequest mocks GetLoginProfileRequest method
+func (m MockLoginProfileClient) GetLoginProfileRequest(input *iam.GetLoginProfileInput) iam.GetLoginProfileRequest {
+	return m.MockGetLoginProfileRequest(input)
+}
+
+// UpdateLoginProfileRequest mocks UpdateLoginProfileRequest method
+func (m MockLoginProfileClient) UpdateLoginProfileRequest(input *iam.UpdateLoginProfileInput) iam.UpdateLoginProfileRequest {
+	return m.MockUpdateLoginProfileRequest(input)
+}
+
+// DeleteLoginProfileRequest mocks DeleteLoginProfileRequest method
+func (m MockLoginProfileClient) DeleteLoginProfileRequest(input *iam.DeleteLoginProfileInput) iam.DeleteLoginProfileRequest {
+	return m.MockDeleteLoginProfileRequest(input)
+}
@@ -17,10 +17,22 @@ limitations under the License.
 package iam
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 )
 
+// DefaultAccessKeyGracePeriod is how long a rotated access key stays active
+// if the rotation policy does not specify a grace period.
+const DefaultAccessKeyGracePeriod = 24 * time.Hour
+
 // AccessClient is the external client used for IAMAccessKey Custom Resource
 type AccessClient interface {
 	CreateAccessKeyRequest(*iam.CreateAccessKeyInput) iam.CreateAccessKeyRequest
@@ -33,3 +45,73 @@ type AccessClient interface {
 func NewAccessClient(conf aws.Config) AccessClient {
 	return iam.New(conf)
 }
+
+// RetiredAccessKeyAction is the action that needs to be taken on a retired
+// access key.
+type RetiredAccessKeyAction int
+
+// Actions that can be taken on a retired access key.
+const (
+	RetiredAccessKeyKeep RetiredAccessKeyAction = iota
+	RetiredAccessKeyDeactivate
+	RetiredAccessKeyDelete
+)
+
+// AccessKeyGracePeriod returns the grace period of the supplied rotation
+// policy.
+func AccessKeyGracePeriod(p *v1alpha1.AccessKeyRotationPolicy) time.Duration {
+	if p == nil || p.GracePeriod == nil {
+		return DefaultAccessKeyGracePeriod
+	}
+	return p.GracePeriod.Duration
+}
+
+// IsRotationDue returns true if an access key created at the supplied time
+// needs to be replaced according to the rotation policy.
+func IsRotationDue(p *v1alpha1.AccessKeyRotationPolicy, created, now time.Time) bool {
+	if p == nil || p.RotationPeriod.Duration <= 0 {
+		return false
+	}
+	return !now.Before(created.Add(p.RotationPeriod.Duration))
+}
+
+// GetRetiredAccessKeyAction returns what needs to be done with a retired
+// access key in the supplied status. It stays active for a grace period, and
+// is deleted after it has been inactive for another grace period.
+func GetRetiredAccessKeyAction(p *v1alpha1.AccessKeyRotationPolicy, k v1alpha1.RetiredAccessKey, status iam.StatusType, now time.Time) RetiredAccessKeyAction {
+	grace := AccessKeyGracePeriod(p)
+	switch {
+	case !now.Before(k.RotatedAt.Add(2 * grace)):
+		return RetiredAccessKeyDelete
+	case !now.Before(k.RotatedAt.Add(grace)) && status != iam.StatusTypeInactive:
+		return RetiredAccessKeyDeactivate
+	}
+	return RetiredAccessKeyKeep
+}
+
+// GetRetiredAccessKeys returns the retired access keys recorded in the
+// annotations of the supplied object.
+func GetRetiredAccessKeys(o metav1.Object) ([]v1alpha1.RetiredAccessKey, error) {
+	v, ok := o.GetAnnotations()[v1alpha1.AnnotationKeyRetiredAccessKeys]
+	if !ok || v == "" {
+		return nil, nil
+	}
+	var keys []v1alpha1.RetiredAccessKey
+	err := json.Unmarshal([]byte(v), &keys)
+	return keys, err
+}
+
+// SetRetiredAccessKeys records the supplied retired access keys in the
+// annotations of the supplied object.
+func SetRetiredAccessKeys(o metav1.Object, keys []v1alpha1.RetiredAccessKey) error {
+	if len(keys) == 0 {
+		meta.RemoveAnnotations(o, v1alpha1.AnnotationKeyRetiredAccessKeys)
+		return nil
+	}
+	v, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	meta.AddAnnotations(o, map[string]string{v1alpha1.AnnotationKeyRetiredAccessKeys: string(v)})
+	return nil
+}
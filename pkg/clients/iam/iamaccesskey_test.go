@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+func TestIsRotationDue(t *testing.T) {
+	now := time.Now()
+	policy := &v1alpha1.AccessKeyRotationPolicy{RotationPeriod: metav1.Duration{Duration: 24 * time.Hour}}
+
+	cases := map[string]struct {
+		policy  *v1alpha1.AccessKeyRotationPolicy
+		created time.Time
+		want    bool
+	}{
+		"NoPolicy": {
+			created: now.Add(-48 * time.Hour),
+			want:    false,
+		},
+		"NotDue": {
+			policy:  policy,
+			created: now.Add(-time.Hour),
+			want:    false,
+		},
+		"Due": {
+			policy:  policy,
+			created: now.Add(-48 * time.Hour),
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRotationDue(tc.policy, tc.created, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetRetiredAccessKeyAction(t *testing.T) {
+	now := time.Now()
+	grace := metav1.Duration{Duration: time.Hour}
+	policy := &v1alpha1.AccessKeyRotationPolicy{GracePeriod: &grace}
+	rotated := func(ago time.Duration) v1alpha1.RetiredAccessKey {
+		return v1alpha1.RetiredAccessKey{AccessKeyID: "key", RotatedAt: metav1.NewTime(now.Add(-ago))}
+	}
+
+	cases := map[string]struct {
+		policy *v1alpha1.AccessKeyRotationPolicy
+		key    v1alpha1.RetiredAccessKey
+		status iam.StatusType
+		want   RetiredAccessKeyAction
+	}{
+		"WithinGracePeriod": {
+			policy: policy,
+			key:    rotated(30 * time.Minute),
+			status: iam.StatusTypeActive,
+			want:   RetiredAccessKeyKeep,
+		},
+		"Deactivate": {
+			policy: policy,
+			key:    rotated(90 * time.Minute),
+			status: iam.StatusTypeActive,
+			want:   RetiredAccessKeyDeactivate,
+		},
+		"AlreadyInactive": {
+			policy: policy,
+			key:    rotated(90 * time.Minute),
+			status: iam.StatusTypeInactive,
+			want:   RetiredAccessKeyKeep,
+		},
+		"Delete": {
+			policy: policy,
+			key:    rotated(3 * time.Hour),
+			status: iam.StatusTypeInactive,
+			want:   RetiredAccessKeyDelete,
+		},
+		"DefaultGracePeriod": {
+			key:    rotated(3 * time.Hour),
+			status: iam.StatusTypeActive,
+			want:   RetiredAccessKeyKeep,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetRetiredAccessKeyAction(tc.policy, tc.key, tc.status, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,10 @@ type GroupClient interface {
 	GetGroupRequest(*iam.GetGroupInput) iam.GetGroupRequest
 	UpdateGroupRequest(*iam.UpdateGroupInput) iam.UpdateGroupRequest
 	DeleteGroupRequest(*iam.DeleteGroupInput) iam.DeleteGroupRequest
+	ListGroupPoliciesRequest(*iam.ListGroupPoliciesInput) iam.ListGroupPoliciesRequest
+	GetGroupPolicyRequest(*iam.GetGroupPolicyInput) iam.GetGroupPolicyRequest
+	PutGroupPolicyRequest(*iam.PutGroupPolicyInput) iam.PutGroupPolicyRequest
+	DeleteGroupPolicyRequest(*iam.DeleteGroupPolicyInput) iam.DeleteGroupPolicyRequest
 }
 
 // NewGroupClient returns a new client using AWS credentials as JSON encoded data.
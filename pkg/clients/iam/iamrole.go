@@ -30,6 +30,10 @@ type RoleClient interface {
 	UpdateAssumeRolePolicyRequest(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
 	TagRoleRequest(input *iam.TagRoleInput) iam.TagRoleRequest
 	UntagRoleRequest(input *iam.UntagRoleInput) iam.UntagRoleRequest
+	ListRolePoliciesRequest(*iam.ListRolePoliciesInput) iam.ListRolePoliciesRequest
+	GetRolePolicyRequest(*iam.GetRolePolicyInput) iam.GetRolePolicyRequest
+	PutRolePolicyRequest(*iam.PutRolePolicyInput) iam.PutRolePolicyRequest
+	DeleteRolePolicyRequest(*iam.DeleteRolePolicyInput) iam.DeleteRolePolicyRequest
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
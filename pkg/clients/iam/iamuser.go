@@ -15,6 +15,10 @@ type UserClient interface {
 	CreateUserRequest(*iam.CreateUserInput) iam.CreateUserRequest
 	UpdateUserRequest(*iam.UpdateUserInput) iam.UpdateUserRequest
 	DeleteUserRequest(*iam.DeleteUserInput) iam.DeleteUserRequest
+	ListUserPoliciesRequest(*iam.ListUserPoliciesInput) iam.ListUserPoliciesRequest
+	GetUserPolicyRequest(*iam.GetUserPolicyInput) iam.GetUserPolicyRequest
+	PutUserPolicyRequest(*iam.PutUserPolicyInput) iam.PutUserPolicyRequest
+	DeleteUserPolicyRequest(*iam.DeleteUserPolicyInput) iam.DeleteUserPolicyRequest
 }
 
 // NewUserClient returns a new client using AWS credentials as JSON encoded data.
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/openpgp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+const (
+	// DefaultLoginProfilePasswordLength is the length of the generated
+	// password if none is specified.
+	DefaultLoginProfilePasswordLength = 20
+
+	// loginProfilePasswordCharacters are the characters an IAM user password
+	// is generated from. IAM accepts all printable ASCII characters, but
+	// quotes and backslashes are left out for the convenience of consumers.
+	loginProfilePasswordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!@#$%^&*()_+-=[]{}|:;<>,.?"
+
+	errDecodePGPKey    = "cannot decode base64 encoded PGP key"
+	errReadPGPKey      = "cannot read PGP public key"
+	errNoPGPKey        = "PGP key ring does not contain a public key"
+	errEncryptPassword = "cannot encrypt password"
+)
+
+// LoginProfileClient is the external client used for IAMUserLoginProfile
+// Custom Resource
+type LoginProfileClient interface {
+	CreateLoginProfileRequest(*iam.CreateLoginProfileInput) iam.CreateLoginProfileRequest
+	GetLoginProfileRequest(*iam.GetLoginProfileInput) iam.GetLoginProfileRequest
+	UpdateLoginProfileRequest(*iam.UpdateLoginProfileInput) iam.UpdateLoginProfileRequest
+	DeleteLoginProfileRequest(*iam.DeleteLoginProfileInput) iam.DeleteLoginProfileRequest
+}
+
+// NewLoginProfileClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLoginProfileClient(conf aws.Config) LoginProfileClient {
+	return iam.New(conf)
+}
+
+// GenerateLoginProfilePassword returns a random password of the supplied
+// length that contains at least one lowercase letter, uppercase letter,
+// number and symbol so that it satisfies any account password policy.
+func GenerateLoginProfilePassword(length int) (string, error) {
+	s := password.Settings{CharacterSet: loginProfilePasswordCharacters, Length: length}
+	for {
+		pw, err := s.Generate()
+		if err != nil {
+			return "", err
+		}
+		if isComplexPassword(pw) {
+			return pw, nil
+		}
+	}
+}
+
+func isComplexPassword(pw string) bool {
+	var lower, upper, number, symbol bool
+	for _, r := range pw {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			number = true
+		default:
+			symbol = true
+		}
+	}
+	return lower && upper && number && symbol
+}
+
+// EncryptLoginProfilePassword encrypts the supplied password with the base64
+// encoded PGP public key. It returns the base64 encoded encrypted password
+// and the fingerprint of the key it was encrypted with.
+func EncryptLoginProfilePassword(pw, key string) (string, string, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", "", errors.Wrap(err, errDecodePGPKey)
+	}
+	entities, err := openpgp.ReadKeyRing(bytes.NewReader(raw))
+	if err != nil {
+		return "", "", errors.Wrap(err, errReadPGPKey)
+	}
+	if len(entities) == 0 || entities[0].PrimaryKey == nil {
+		return "", "", errors.New(errNoPGPKey)
+	}
+	buf := &bytes.Buffer{}
+	w, err := openpgp.Encrypt(buf, entities[:1], nil, nil, nil)
+	if err != nil {
+		return "", "", errors.Wrap(err, errEncryptPassword)
+	}
+	if _, err := w.Write([]byte(pw)); err != nil {
+		return "", "", errors.Wrap(err, errEncryptPassword)
+	}
+	if err := w.Close(); err != nil {
+		return "", "", errors.Wrap(err, errEncryptPassword)
+	}
+	fingerprint := strings.ToUpper(hex.EncodeToString(entities[0].PrimaryKey.Fingerprint[:]))
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), fingerprint, nil
+}
+
+// LateInitializeLoginProfile fills the empty fields in
+// *v1alpha1.IAMUserLoginProfileParameters with the values seen in
+// iam.LoginProfile.
+func LateInitializeLoginProfile(in *v1alpha1.IAMUserLoginProfileParameters, observed *iam.LoginProfile) {
+	if observed == nil {
+		return
+	}
+	if in.PasswordResetRequired == nil {
+		in.PasswordResetRequired = observed.PasswordResetRequired
+	}
+}
+
+// IsLoginProfileUpToDate checks whether there is a change in any of the
+// modifiable fields of the login profile.
+func IsLoginProfileUpToDate(in v1alpha1.IAMUserLoginProfileParameters, observed iam.LoginProfile) bool {
+	return aws.BoolValue(in.PasswordResetRequired) == aws.BoolValue(observed.PasswordResetRequired)
+}
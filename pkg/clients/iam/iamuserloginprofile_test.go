@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+func TestGenerateLoginProfilePassword(t *testing.T) {
+	for _, length := range []int{8, 20, 128} {
+		pw, err := GenerateLoginProfilePassword(length)
+		if err != nil {
+			t.Fatalf("GenerateLoginProfilePassword(%d): %s", length, err)
+		}
+		if diff := cmp.Diff(length, len(pw)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if !isComplexPassword(pw) {
+			t.Errorf("GenerateLoginProfilePassword(%d): %q is not complex", length, pw)
+		}
+	}
+}
+
+func TestEncryptLoginProfilePassword(t *testing.T) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", &packet.Config{DefaultHash: crypto.SHA256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Serializing the private key signs the identities of the entity.
+	if err := entity.SerializePrivate(ioutil.Discard, nil); err != nil {
+		t.Fatal(err)
+	}
+	pub := &bytes.Buffer{}
+	if err := entity.Serialize(pub); err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, fingerprint, err := EncryptLoginProfilePassword("s3cr3t!", base64.StdEncoding.EncodeToString(pub.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fingerprint == "" {
+		t.Error("EncryptLoginProfilePassword: empty fingerprint")
+	}
+	raw, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, err := openpgp.ReadMessage(bytes.NewReader(raw), openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("s3cr3t!", string(got)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"net/url"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListInlinePolicies  = "cannot list inline policies"
+	errGetInlinePolicy     = "cannot get inline policy"
+	errPutInlinePolicy     = "cannot put inline policy"
+	errDeleteInlinePolicy  = "cannot delete inline policy"
+	errInlinePolicyJSON    = "malformed inline policy document JSON"
+	errInlinePolicyEscaped = "cannot unescape inline policy document"
+)
+
+// InlinePolicies manages the inline policies embedded in a single IAM role,
+// user or group.
+type InlinePolicies interface {
+	List(ctx context.Context) ([]string, error)
+	Get(ctx context.Context, name string) (string, error)
+	Put(ctx context.Context, name, document string) error
+	Delete(ctx context.Context, name string) error
+}
+
+// NewRoleInlinePolicies returns the InlinePolicies of the supplied role.
+func NewRoleInlinePolicies(c RoleClient, roleName string) InlinePolicies {
+	return &roleInlinePolicies{client: c, name: aws.String(roleName)}
+}
+
+type roleInlinePolicies struct {
+	client RoleClient
+	name   *string
+}
+
+func (r *roleInlinePolicies) List(ctx context.Context) ([]string, error) {
+	var names []string
+	in := &iam.ListRolePoliciesInput{RoleName: r.name}
+	for {
+		res, err := r.client.ListRolePoliciesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, res.PolicyNames...)
+		if !aws.BoolValue(res.IsTruncated) {
+			return names, nil
+		}
+		in.Marker = res.Marker
+	}
+}
+
+func (r *roleInlinePolicies) Get(ctx context.Context, name string) (string, error) {
+	res, err := r.client.GetRolePolicyRequest(&iam.GetRolePolicyInput{RoleName: r.name, PolicyName: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(res.PolicyDocument), nil
+}
+
+func (r *roleInlinePolicies) Put(ctx context.Context, name, document string) error {
+	_, err := r.client.PutRolePolicyRequest(&iam.PutRolePolicyInput{RoleName: r.name, PolicyName: aws.String(name), PolicyDocument: aws.String(document)}).Send(ctx)
+	return err
+}
+
+func (r *roleInlinePolicies) Delete(ctx context.Context, name string) error {
+	_, err := r.client.DeleteRolePolicyRequest(&iam.DeleteRolePolicyInput{RoleName: r.name, PolicyName: aws.String(name)}).Send(ctx)
+	return err
+}
+
+// NewUserInlinePolicies returns the InlinePolicies of the supplied user.
+func NewUserInlinePolicies(c UserClient, userName string) InlinePolicies {
+	return &userInlinePolicies{client: c, name: aws.String(userName)}
+}
+
+type userInlinePolicies struct {
+	client UserClient
+	name   *string
+}
+
+func (u *userInlinePolicies) List(ctx context.Context) ([]string, error) {
+	var names []string
+	in := &iam.ListUserPoliciesInput{UserName: u.name}
+	for {
+		res, err := u.client.ListUserPoliciesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, res.PolicyNames...)
+		if !aws.BoolValue(res.IsTruncated) {
+			return names, nil
+		}
+		in.Marker = res.Marker
+	}
+}
+
+func (u *userInlinePolicies) Get(ctx context.Context, name string) (string, error) {
+	res, err := u.client.GetUserPolicyRequest(&iam.GetUserPolicyInput{UserName: u.name, PolicyName: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(res.PolicyDocument), nil
+}
+
+func (u *userInlinePolicies) Put(ctx context.Context, name, document string) error {
+	_, err := u.client.PutUserPolicyRequest(&iam.PutUserPolicyInput{UserName: u.name, PolicyName: aws.String(name), PolicyDocument: aws.String(document)}).Send(ctx)
+	return err
+}
+
+func (u *userInlinePolicies) Delete(ctx context.Context, name string) error {
+	_, err := u.client.DeleteUserPolicyRequest(&iam.DeleteUserPolicyInput{UserName: u.name, PolicyName: aws.String(name)}).Send(ctx)
+	return err
+}
+
+// NewGroupInlinePolicies returns the InlinePolicies of the supplied group.
+func NewGroupInlinePolicies(c GroupClient, groupName string) InlinePolicies {
+	return &groupInlinePolicies{client: c, name: aws.String(groupName)}
+}
+
+type groupInlinePolicies struct {
+	client GroupClient
+	name   *string
+}
+
+func (g *groupInlinePolicies) List(ctx context.Context) ([]string, error) {
+	var names []string
+	in := &iam.ListGroupPoliciesInput{GroupName: g.name}
+	for {
+		res, err := g.client.ListGroupPoliciesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, res.PolicyNames...)
+		if !aws.BoolValue(res.IsTruncated) {
+			return names, nil
+		}
+		in.Marker = res.Marker
+	}
+}
+
+func (g *groupInlinePolicies) Get(ctx context.Context, name string) (string, error) {
+	res, err := g.client.GetGroupPolicyRequest(&iam.GetGroupPolicyInput{GroupName: g.name, PolicyName: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(res.PolicyDocument), nil
+}
+
+func (g *groupInlinePolicies) Put(ctx context.Context, name, document string) error {
+	_, err := g.client.PutGroupPolicyRequest(&iam.PutGroupPolicyInput{GroupName: g.name, PolicyName: aws.String(name), PolicyDocument: aws.String(document)}).Send(ctx)
+	return err
+}
+
+func (g *groupInlinePolicies) Delete(ctx context.Context, name string) error {
+	_, err := g.client.DeleteGroupPolicyRequest(&iam.DeleteGroupPolicyInput{GroupName: g.name, PolicyName: aws.String(name)}).Send(ctx)
+	return err
+}
+
+// ObserveInlinePolicies returns the documents of all inline policies, keyed by
+// policy name. The documents are returned as they are by the IAM API, i.e.
+// URL encoded.
+func ObserveInlinePolicies(ctx context.Context, p InlinePolicies) (map[string]string, error) {
+	names, err := p.List(ctx)
+	if err != nil {
+		return nil, awsclients.Wrap(err, errListInlinePolicies)
+	}
+	observed := make(map[string]string, len(names))
+	for _, n := range names {
+		doc, err := p.Get(ctx, n)
+		if err != nil {
+			return nil, awsclients.Wrap(err, errGetInlinePolicy)
+		}
+		observed[n] = doc
+	}
+	return observed, nil
+}
+
+// DiffInlinePolicies returns the inline policies that need to be put and the
+// names of those that need to be deleted so that the observed inline policies
+// match the desired ones. Nothing is managed if desired is nil.
+func DiffInlinePolicies(desired, observed map[string]string) (put map[string]string, remove []string, err error) {
+	if desired == nil {
+		return nil, nil, nil
+	}
+	put = map[string]string{}
+	for name, doc := range desired {
+		want, err := awsclients.CompactAndEscapeJSON(doc)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errInlinePolicyJSON)
+		}
+		current, ok := observed[name]
+		if !ok {
+			put[name] = doc
+			continue
+		}
+		// The IAM API returns policy documents URL encoded.
+		unescaped, err := url.QueryUnescape(current)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errInlinePolicyEscaped)
+		}
+		got, err := awsclients.CompactAndEscapeJSON(unescaped)
+		if err != nil || got != want {
+			put[name] = doc
+		}
+	}
+	for name := range observed {
+		if _, ok := desired[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(remove)
+	return put, remove, nil
+}
+
+// IsInlinePoliciesUpToDate returns true if the observed inline policies match
+// the desired ones.
+func IsInlinePoliciesUpToDate(desired, observed map[string]string) (bool, error) {
+	put, remove, err := DiffInlinePolicies(desired, observed)
+	if err != nil {
+		return false, err
+	}
+	return len(put) == 0 && len(remove) == 0, nil
+}
+
+// UpdateInlinePolicies puts and deletes inline policies so that they match
+// the desired ones.
+func UpdateInlinePolicies(ctx context.Context, p InlinePolicies, desired map[string]string) error {
+	if desired == nil {
+		return nil
+	}
+	observed, err := ObserveInlinePolicies(ctx, p)
+	if err != nil {
+		return err
+	}
+	put, remove, err := DiffInlinePolicies(desired, observed)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(put))
+	for name := range put {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := p.Put(ctx, name, put[name]); err != nil {
+			return awsclients.Wrap(err, errPutInlinePolicy)
+		}
+	}
+	for _, name := range remove {
+		if err := p.Delete(ctx, name); err != nil {
+			return awsclients.Wrap(err, errDeleteInlinePolicy)
+		}
+	}
+	return nil
+}
+
+// DeleteInlinePolicies deletes all inline policies. IAM refuses to delete a
+// role, user or group that still has inline policies.
+func DeleteInlinePolicies(ctx context.Context, p InlinePolicies) error {
+	names, err := p.List(ctx)
+	if err != nil {
+		return awsclients.Wrap(resource.Ignore(IsErrorNotFound, err), errListInlinePolicies)
+	}
+	for _, name := range names {
+		if err := p.Delete(ctx, name); resource.Ignore(IsErrorNotFound, err) != nil {
+			return awsclients.Wrap(err, errDeleteInlinePolicy)
+		}
+	}
+	return nil
+}
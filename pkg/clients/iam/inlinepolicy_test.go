@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDiffInlinePolicies(t *testing.T) {
+	document := `{
+		"Version": "2012-10-17",
+		"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]
+	}`
+	changed := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	// The IAM API returns documents URL encoded and without the whitespace.
+	observed := url.QueryEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`)
+
+	type args struct {
+		desired  map[string]string
+		observed map[string]string
+	}
+	type want struct {
+		put    map[string]string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotManaged": {
+			args: args{
+				observed: map[string]string{"a": observed},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				desired:  map[string]string{"a": document},
+				observed: map[string]string{"a": observed},
+			},
+			want: want{
+				put: map[string]string{},
+			},
+		},
+		"Changed": {
+			args: args{
+				desired:  map[string]string{"a": changed},
+				observed: map[string]string{"a": observed},
+			},
+			want: want{
+				put: map[string]string{"a": changed},
+			},
+		},
+		"AddAndRemove": {
+			args: args{
+				desired:  map[string]string{"b": document},
+				observed: map[string]string{"a": observed, "c": observed},
+			},
+			want: want{
+				put:    map[string]string{"b": document},
+				remove: []string{"a", "c"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove, err := DiffInlinePolicies(tc.args.desired, tc.args.observed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.put, put, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// InstanceProfileClient is the external client used for InstanceProfile
+// Custom Resource
+type InstanceProfileClient interface {
+	GetInstanceProfileRequest(*iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest
+	CreateInstanceProfileRequest(*iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest
+	DeleteInstanceProfileRequest(*iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest
+	AddRoleToInstanceProfileRequest(*iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest
+	RemoveRoleFromInstanceProfileRequest(*iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest
+}
+
+// NewInstanceProfileClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewInstanceProfileClient(cfg aws.Config) InstanceProfileClient {
+	return iam.New(cfg)
+}
+
+// GenerateInstanceProfileObservation is used to produce
+// InstanceProfileObservation from iam.InstanceProfile.
+func GenerateInstanceProfileObservation(p iam.InstanceProfile) v1alpha1.InstanceProfileObservation {
+	o := v1alpha1.InstanceProfileObservation{
+		ARN:               aws.StringValue(p.Arn),
+		InstanceProfileID: aws.StringValue(p.InstanceProfileId),
+	}
+	if len(p.Roles) > 0 {
+		o.RoleARN = aws.StringValue(p.Roles[0].Arn)
+	}
+	return o
+}
+
+// LateInitializeInstanceProfile fills the empty fields in
+// *v1alpha1.InstanceProfileParameters with the values seen in
+// iam.InstanceProfile.
+func LateInitializeInstanceProfile(in *v1alpha1.InstanceProfileParameters, p *iam.InstanceProfile) {
+	if p == nil {
+		return
+	}
+	in.Path = awsclients.LateInitializeStringPtr(in.Path, p.Path)
+}
+
+// DiffInstanceProfileRoles returns the names of the roles that need to be
+// added to and removed from the instance profile.
+func DiffInstanceProfileRoles(in v1alpha1.InstanceProfileParameters, p iam.InstanceProfile) (add *string, remove []string) {
+	found := false
+	for _, r := range p.Roles {
+		if in.RoleName != nil && aws.StringValue(r.RoleName) == *in.RoleName {
+			found = true
+			continue
+		}
+		remove = append(remove, aws.StringValue(r.RoleName))
+	}
+	if in.RoleName != nil && !found {
+		add = in.RoleName
+	}
+	return add, remove
+}
+
+// IsInstanceProfileUpToDate checks whether the instance profile contains the
+// desired role.
+func IsInstanceProfileUpToDate(in v1alpha1.InstanceProfileParameters, p iam.InstanceProfile) bool {
+	add, remove := DiffInstanceProfileRoles(in, p)
+	return add == nil && len(remove) == 0
+}
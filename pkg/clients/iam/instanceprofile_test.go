@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+func TestDiffInstanceProfileRoles(t *testing.T) {
+	withRoles := func(names ...string) iam.InstanceProfile {
+		p := iam.InstanceProfile{}
+		for _, n := range names {
+			p.Roles = append(p.Roles, iam.Role{RoleName: aws.String(n)})
+		}
+		return p
+	}
+
+	type want struct {
+		add    *string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		in      v1alpha1.InstanceProfileParameters
+		profile iam.InstanceProfile
+		want    want
+	}{
+		"UpToDate": {
+			in:      v1alpha1.InstanceProfileParameters{RoleName: aws.String("a")},
+			profile: withRoles("a"),
+		},
+		"AddRole": {
+			in:      v1alpha1.InstanceProfileParameters{RoleName: aws.String("a")},
+			profile: withRoles(),
+			want:    want{add: aws.String("a")},
+		},
+		"ReplaceRole": {
+			in:      v1alpha1.InstanceProfileParameters{RoleName: aws.String("a")},
+			profile: withRoles("b"),
+			want:    want{add: aws.String("a"), remove: []string{"b"}},
+		},
+		"RemoveRole": {
+			in:      v1alpha1.InstanceProfileParameters{},
+			profile: withRoles("b"),
+			want:    want{remove: []string{"b"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffInstanceProfileRoles(tc.in, tc.profile)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.add == nil && len(tc.want.remove) == 0, IsInstanceProfileUpToDate(tc.in, tc.profile)); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+	"sort"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DBClusterInstanceClient defines the RDS operations used to manage the
+// instances of Aurora DB clusters.
+type DBClusterInstanceClient interface {
+	CreateDBInstanceWithContext(ctx context.Context, input *svcsdk.CreateDBInstanceInput, opts ...request.Option) (*svcsdk.CreateDBInstanceOutput, error)
+	DescribeDBInstancesWithContext(ctx context.Context, input *svcsdk.DescribeDBInstancesInput, opts ...request.Option) (*svcsdk.DescribeDBInstancesOutput, error)
+	ModifyDBInstanceWithContext(ctx context.Context, input *svcsdk.ModifyDBInstanceInput, opts ...request.Option) (*svcsdk.ModifyDBInstanceOutput, error)
+	DeleteDBInstanceWithContext(ctx context.Context, input *svcsdk.DeleteDBInstanceInput, opts ...request.Option) (*svcsdk.DeleteDBInstanceOutput, error)
+	DescribeDBClustersWithContext(ctx context.Context, input *svcsdk.DescribeDBClustersInput, opts ...request.Option) (*svcsdk.DescribeDBClustersOutput, error)
+	AddTagsToResourceWithContext(ctx context.Context, input *svcsdk.AddTagsToResourceInput, opts ...request.Option) (*svcsdk.AddTagsToResourceOutput, error)
+	RemoveTagsFromResourceWithContext(ctx context.Context, input *svcsdk.RemoveTagsFromResourceInput, opts ...request.Option) (*svcsdk.RemoveTagsFromResourceOutput, error)
+}
+
+// NewDBClusterInstanceClient creates a new DBClusterInstanceClient with the
+// provided session.
+func NewDBClusterInstanceClient(sess *session.Session) DBClusterInstanceClient {
+	return svcsdk.New(sess)
+}
+
+// IsDBInstanceNotFound returns true if the error is because the DB instance
+// doesn't exist.
+func IsDBInstanceNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeDBInstanceNotFoundFault
+}
+
+// GenerateCreateDBClusterInstanceInput from DBClusterInstanceParameters.
+func GenerateCreateDBClusterInstanceInput(name string, p v1alpha1.DBClusterInstanceParameters) *svcsdk.CreateDBInstanceInput {
+	return &svcsdk.CreateDBInstanceInput{
+		DBInstanceIdentifier:       awsv1.String(name),
+		DBClusterIdentifier:        p.DBClusterIdentifier,
+		DBInstanceClass:            awsv1.String(p.DBInstanceClass),
+		Engine:                     p.Engine,
+		PromotionTier:              p.PromotionTier,
+		AvailabilityZone:           p.AvailabilityZone,
+		DBParameterGroupName:       p.DBParameterGroupName,
+		PubliclyAccessible:         p.PubliclyAccessible,
+		AutoMinorVersionUpgrade:    p.AutoMinorVersionUpgrade,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		MonitoringInterval:         p.MonitoringInterval,
+		MonitoringRoleArn:          p.MonitoringRoleARN,
+		EnablePerformanceInsights:  p.EnablePerformanceInsights,
+		Tags:                       generateTags(p.Tags),
+	}
+}
+
+// GenerateModifyDBClusterInstanceInput from DBClusterInstanceParameters.
+func GenerateModifyDBClusterInstanceInput(name string, p v1alpha1.DBClusterInstanceParameters) *svcsdk.ModifyDBInstanceInput {
+	return &svcsdk.ModifyDBInstanceInput{
+		DBInstanceIdentifier:       awsv1.String(name),
+		DBInstanceClass:            awsv1.String(p.DBInstanceClass),
+		PromotionTier:              p.PromotionTier,
+		DBParameterGroupName:       p.DBParameterGroupName,
+		PubliclyAccessible:         p.PubliclyAccessible,
+		AutoMinorVersionUpgrade:    p.AutoMinorVersionUpgrade,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		CACertificateIdentifier:    p.CACertificateIdentifier,
+		MonitoringInterval:         p.MonitoringInterval,
+		MonitoringRoleArn:          p.MonitoringRoleARN,
+		EnablePerformanceInsights:  p.EnablePerformanceInsights,
+		ApplyImmediately:           p.ApplyImmediately,
+	}
+}
+
+// GenerateDBClusterInstanceObservation is used to produce
+// v1alpha1.DBClusterInstanceObservation from svcsdk.DBInstance.
+func GenerateDBClusterInstanceObservation(db *svcsdk.DBInstance) v1alpha1.DBClusterInstanceObservation {
+	o := v1alpha1.DBClusterInstanceObservation{
+		DBInstanceARN:    awsv1.StringValue(db.DBInstanceArn),
+		DBInstanceStatus: awsv1.StringValue(db.DBInstanceStatus),
+		DBIResourceID:    awsv1.StringValue(db.DbiResourceId),
+		AvailabilityZone: awsv1.StringValue(db.AvailabilityZone),
+		EngineVersion:    awsv1.StringValue(db.EngineVersion),
+	}
+	if db.Endpoint != nil {
+		o.Endpoint = awsv1.StringValue(db.Endpoint.Address)
+		o.Port = awsv1.Int64Value(db.Endpoint.Port)
+	}
+	if db.InstanceCreateTime != nil {
+		t := metav1.NewTime(*db.InstanceCreateTime)
+		o.InstanceCreateTime = &t
+	}
+	return o
+}
+
+// LateInitializeDBClusterInstance fills the empty fields in
+// *v1alpha1.DBClusterInstanceParameters with the values seen in
+// svcsdk.DBInstance.
+func LateInitializeDBClusterInstance(in *v1alpha1.DBClusterInstanceParameters, db *svcsdk.DBInstance) {
+	in.DBClusterIdentifier = awsclients.LateInitializeStringPtr(in.DBClusterIdentifier, db.DBClusterIdentifier)
+	in.Engine = awsclients.LateInitializeStringPtr(in.Engine, db.Engine)
+	in.PromotionTier = awsclients.LateInitializeInt64Ptr(in.PromotionTier, db.PromotionTier)
+	in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, db.AvailabilityZone)
+	in.PubliclyAccessible = awsclients.LateInitializeBoolPtr(in.PubliclyAccessible, db.PubliclyAccessible)
+	in.AutoMinorVersionUpgrade = awsclients.LateInitializeBoolPtr(in.AutoMinorVersionUpgrade, db.AutoMinorVersionUpgrade)
+	in.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(in.PreferredMaintenanceWindow, db.PreferredMaintenanceWindow)
+	in.CACertificateIdentifier = awsclients.LateInitializeStringPtr(in.CACertificateIdentifier, db.CACertificateIdentifier)
+	in.MonitoringInterval = awsclients.LateInitializeInt64Ptr(in.MonitoringInterval, db.MonitoringInterval)
+	in.MonitoringRoleARN = awsclients.LateInitializeStringPtr(in.MonitoringRoleARN, db.MonitoringRoleArn)
+	in.EnablePerformanceInsights = awsclients.LateInitializeBoolPtr(in.EnablePerformanceInsights, db.PerformanceInsightsEnabled)
+	if in.DBParameterGroupName == nil {
+		for _, pg := range db.DBParameterGroups {
+			if pg.DBParameterGroupName != nil {
+				in.DBParameterGroupName = pg.DBParameterGroupName
+				break
+			}
+		}
+	}
+}
+
+// IsDBClusterInstanceUpToDate checks whether there is a change in any of the
+// modifiable fields of the DB instance. Tags are compared separately.
+func IsDBClusterInstanceUpToDate(p v1alpha1.DBClusterInstanceParameters, db *svcsdk.DBInstance) bool {
+	pg := ""
+	for _, g := range db.DBParameterGroups {
+		if g.DBParameterGroupName != nil {
+			pg = awsv1.StringValue(g.DBParameterGroupName)
+			break
+		}
+	}
+	switch {
+	case p.DBInstanceClass != awsv1.StringValue(db.DBInstanceClass),
+		awsv1.Int64Value(p.PromotionTier) != awsv1.Int64Value(db.PromotionTier),
+		awsv1.StringValue(p.DBParameterGroupName) != pg,
+		awsv1.BoolValue(p.PubliclyAccessible) != awsv1.BoolValue(db.PubliclyAccessible),
+		awsv1.BoolValue(p.AutoMinorVersionUpgrade) != awsv1.BoolValue(db.AutoMinorVersionUpgrade),
+		awsv1.StringValue(p.PreferredMaintenanceWindow) != awsv1.StringValue(db.PreferredMaintenanceWindow),
+		awsv1.StringValue(p.CACertificateIdentifier) != awsv1.StringValue(db.CACertificateIdentifier),
+		awsv1.Int64Value(p.MonitoringInterval) != awsv1.Int64Value(db.MonitoringInterval),
+		awsv1.StringValue(p.MonitoringRoleARN) != awsv1.StringValue(db.MonitoringRoleArn),
+		awsv1.BoolValue(p.EnablePerformanceInsights) != awsv1.BoolValue(db.PerformanceInsightsEnabled):
+		return false
+	}
+	return true
+}
+
+// DiffTags returns the tags that should be added and the keys of the tags
+// that should be removed so that the current tags match the desired ones.
+func DiffTags(desired []*v1alpha1.Tag, current []*svcsdk.Tag) (add []*svcsdk.Tag, remove []*string) {
+	want := map[string]string{}
+	for _, t := range desired {
+		want[awsv1.StringValue(t.Key)] = awsv1.StringValue(t.Value)
+	}
+	have := map[string]string{}
+	for _, t := range current {
+		have[awsv1.StringValue(t.Key)] = awsv1.StringValue(t.Value)
+	}
+	for k, v := range want {
+		if cv, ok := have[k]; !ok || cv != v {
+			add = append(add, &svcsdk.Tag{Key: awsv1.String(k), Value: awsv1.String(v)})
+		}
+	}
+	for k := range have {
+		if _, ok := want[k]; !ok {
+			remove = append(remove, awsv1.String(k))
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return awsv1.StringValue(add[i].Key) < awsv1.StringValue(add[j].Key) })
+	sort.Slice(remove, func(i, j int) bool { return awsv1.StringValue(remove[i]) < awsv1.StringValue(remove[j]) })
+	return add, remove
+}
+
+func generateTags(in []*v1alpha1.Tag) []*svcsdk.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*svcsdk.Tag, len(in))
+	for i, t := range in {
+		out[i] = &svcsdk.Tag{Key: t.Key, Value: t.Value}
+	}
+	return out
+}
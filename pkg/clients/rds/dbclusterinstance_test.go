@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+)
+
+func TestLateInitializeDBClusterInstance(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.DBClusterInstanceParameters
+		db   *svcsdk.DBInstance
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.DBClusterInstanceParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: &v1alpha1.DBClusterInstanceParameters{
+					DBInstanceClass:      "db.r5.large",
+					PromotionTier:        awsv1.Int64(2),
+					DBParameterGroupName: awsv1.String("custom"),
+				},
+				db: &svcsdk.DBInstance{
+					PromotionTier:     awsv1.Int64(1),
+					DBParameterGroups: []*svcsdk.DBParameterGroupStatus{{DBParameterGroupName: awsv1.String("default")}},
+				},
+			},
+			want: &v1alpha1.DBClusterInstanceParameters{
+				DBInstanceClass:      "db.r5.large",
+				PromotionTier:        awsv1.Int64(2),
+				DBParameterGroupName: awsv1.String("custom"),
+			},
+		},
+		"EmptyFields": {
+			args: args{
+				spec: &v1alpha1.DBClusterInstanceParameters{
+					DBInstanceClass: "db.r5.large",
+				},
+				db: &svcsdk.DBInstance{
+					DBClusterIdentifier: awsv1.String("cluster"),
+					Engine:              awsv1.String("aurora-postgresql"),
+					PromotionTier:       awsv1.Int64(1),
+					AvailabilityZone:    awsv1.String("us-east-1a"),
+					DBParameterGroups:   []*svcsdk.DBParameterGroupStatus{{DBParameterGroupName: awsv1.String("default")}},
+				},
+			},
+			want: &v1alpha1.DBClusterInstanceParameters{
+				DBInstanceClass:      "db.r5.large",
+				DBClusterIdentifier:  awsv1.String("cluster"),
+				Engine:               awsv1.String("aurora-postgresql"),
+				PromotionTier:        awsv1.Int64(1),
+				AvailabilityZone:     awsv1.String("us-east-1a"),
+				DBParameterGroupName: awsv1.String("default"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDBClusterInstance(tc.args.spec, tc.args.db)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDBClusterInstanceUpToDate(t *testing.T) {
+	type args struct {
+		p  v1alpha1.DBClusterInstanceParameters
+		db *svcsdk.DBInstance
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.DBClusterInstanceParameters{
+					DBInstanceClass:      "db.r5.large",
+					PromotionTier:        awsv1.Int64(1),
+					DBParameterGroupName: awsv1.String("default"),
+				},
+				db: &svcsdk.DBInstance{
+					DBInstanceClass:   awsv1.String("db.r5.large"),
+					PromotionTier:     awsv1.Int64(1),
+					DBParameterGroups: []*svcsdk.DBParameterGroupStatus{{DBParameterGroupName: awsv1.String("default")}},
+				},
+			},
+			want: true,
+		},
+		"ClassChanged": {
+			args: args{
+				p: v1alpha1.DBClusterInstanceParameters{
+					DBInstanceClass: "db.r5.xlarge",
+				},
+				db: &svcsdk.DBInstance{
+					DBInstanceClass: awsv1.String("db.r5.large"),
+				},
+			},
+			want: false,
+		},
+		"PromotionTierChanged": {
+			args: args{
+				p: v1alpha1.DBClusterInstanceParameters{
+					DBInstanceClass: "db.r5.large",
+					PromotionTier:   awsv1.Int64(0),
+				},
+				db: &svcsdk.DBInstance{
+					DBInstanceClass: awsv1.String("db.r5.large"),
+					PromotionTier:   awsv1.Int64(1),
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDBClusterInstanceUpToDate(tc.args.p, tc.args.db)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type args struct {
+		desired []*v1alpha1.Tag
+		current []*svcsdk.Tag
+	}
+	type want struct {
+		add    []*svcsdk.Tag
+		remove []*string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChange": {
+			args: args{
+				desired: []*v1alpha1.Tag{{Key: awsv1.String("k"), Value: awsv1.String("v")}},
+				current: []*svcsdk.Tag{{Key: awsv1.String("k"), Value: awsv1.String("v")}},
+			},
+		},
+		"AddUpdateRemove": {
+			args: args{
+				desired: []*v1alpha1.Tag{
+					{Key: awsv1.String("new"), Value: awsv1.String("v")},
+					{Key: awsv1.String("changed"), Value: awsv1.String("v2")},
+				},
+				current: []*svcsdk.Tag{
+					{Key: awsv1.String("changed"), Value: awsv1.String("v1")},
+					{Key: awsv1.String("old"), Value: awsv1.String("v")},
+				},
+			},
+			want: want{
+				add: []*svcsdk.Tag{
+					{Key: awsv1.String("changed"), Value: awsv1.String("v2")},
+					{Key: awsv1.String("new"), Value: awsv1.String("v")},
+				},
+				remove: []*string{awsv1.String("old")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.args.desired, tc.args.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/rds"
+)
+
+var _ clientset.DBClusterInstanceClient = &MockDBClusterInstanceClient{}
+
+// MockDBClusterInstanceClient is a fake implementation of
+// rds.DBClusterInstanceClient.
+type MockDBClusterInstanceClient struct {
+	MockCreateDBInstance       func(*svcsdk.CreateDBInstanceInput) (*svcsdk.CreateDBInstanceOutput, error)
+	MockDescribeDBInstances    func(*svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error)
+	MockModifyDBInstance       func(*svcsdk.ModifyDBInstanceInput) (*svcsdk.ModifyDBInstanceOutput, error)
+	MockDeleteDBInstance       func(*svcsdk.DeleteDBInstanceInput) (*svcsdk.DeleteDBInstanceOutput, error)
+	MockDescribeDBClusters     func(*svcsdk.DescribeDBClustersInput) (*svcsdk.DescribeDBClustersOutput, error)
+	MockAddTagsToResource      func(*svcsdk.AddTagsToResourceInput) (*svcsdk.AddTagsToResourceOutput, error)
+	MockRemoveTagsFromResource func(*svcsdk.RemoveTagsFromResourceInput) (*svcsdk.RemoveTagsFromResourceOutput, error)
+}
+
+// CreateDBInstanceWithContext calls the underlying MockCreateDBInstance method.
+func (c *MockDBClusterInstanceClient) CreateDBInstanceWithContext(_ context.Context, i *svcsdk.CreateDBInstanceInput, _ ...request.Option) (*svcsdk.CreateDBInstanceOutput, error) {
+	return c.MockCreateDBInstance(i)
+}
+
+// DescribeDBInstancesWithContext calls the underlying MockDescribeDBInstances
+// method.
+func (c *MockDBClusterInstanceClient) DescribeDBInstancesWithContext(_ context.Context, i *svcsdk.DescribeDBInstancesInput, _ ...request.Option) (*svcsdk.DescribeDBInstancesOutput, error) {
+	return c.MockDescribeDBInstances(i)
+}
+
+// ModifyDBInstanceWithContext calls the underlying MockModifyDBInstance method.
+func (c *MockDBClusterInstanceClient) ModifyDBInstanceWithContext(_ context.Context, i *svcsdk.ModifyDBInstanceInput, _ ...request.Option) (*svcsdk.ModifyDBInstanceOutput, error) {
+	return c.MockModifyDBInstance(i)
+}
+
+// DeleteDBInstanceWithContext calls the underlying MockDeleteDBInstance method.
+func (c *MockDBClusterInstanceClient) DeleteDBInstanceWithContext(_ context.Context, i *svcsdk.DeleteDBInstanceInput, _ ...request.Option) (*svcsdk.DeleteDBInstanceOutput, error) {
+	return c.MockDeleteDBInstance(i)
+}
+
+// DescribeDBClustersWithContext calls the underlying MockDescribeDBClusters
+// method.
+func (c *MockDBClusterInstanceClient) DescribeDBClustersWithContext(_ context.Context, i *svcsdk.DescribeDBClustersInput, _ ...request.Option) (*svcsdk.DescribeDBClustersOutput, error) {
+	return c.MockDescribeDBClusters(i)
+}
+
+// AddTagsToResourceWithContext calls the underlying MockAddTagsToResource
+// method.
+func (c *MockDBClusterInstanceClient) AddTagsToResourceWithContext(_ context.Context, i *svcsdk.AddTagsToResourceInput, _ ...request.Option) (*svcsdk.AddTagsToResourceOutput, error) {
+	return c.MockAddTagsToResource(i)
+}
+
+// RemoveTagsFromResourceWithContext calls the underlying
+// MockRemoveTagsFromResource method.
+func (c *MockDBClusterInstanceClient) RemoveTagsFromResourceWithContext(_ context.Context, i *svcsdk.RemoveTagsFromResourceInput, _ ...request.Option) (*svcsdk.RemoveTagsFromResourceOutput, error) {
+	return c.MockRemoveTagsFromResource(i)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcerecordset
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+)
+
+const (
+	// DefaultBatchInterval is how long changes to the record sets of a hosted
+	// zone are accumulated before they are submitted together.
+	DefaultBatchInterval = 2 * time.Second
+
+	// Route53 accepts at most 1000 ResourceRecord elements in a change batch,
+	// counting those of UPSERT changes twice.
+	maxBatchRecords = 1000
+
+	flushTimeout = 30 * time.Second
+)
+
+// A ChangeBatcher accumulates changes to the record sets of each hosted zone
+// and submits them with a single ChangeResourceRecordSets call, so that many
+// ResourceRecordSets in the same zone do not each spend a request against the
+// Route53 API rate limit. Changes are submitted asynchronously; their outcome
+// can be retrieved with Result once they are no longer Pending.
+type ChangeBatcher struct {
+	interval time.Duration
+
+	mu       sync.Mutex
+	zones    map[string]*zoneBatch
+	inflight map[string][]route53.Change
+	results  map[string]error
+}
+
+type zoneBatch struct {
+	client  Client
+	keys    []string
+	changes map[string][]route53.Change
+}
+
+// NewChangeBatcher returns a ChangeBatcher that submits the changes of a
+// hosted zone the supplied interval after the first of them was enqueued.
+func NewChangeBatcher(interval time.Duration) *ChangeBatcher {
+	return &ChangeBatcher{
+		interval: interval,
+		zones:    map[string]*zoneBatch{},
+		inflight: map[string][]route53.Change{},
+		results:  map[string]error{},
+	}
+}
+
+// Enqueue schedules the supplied changes to be submitted with the next batch
+// of the hosted zone. Changes enqueued with the same key replace each other,
+// and the changes of a key are always submitted in the same change batch.
+// Changes that are identical to the ones of the key that are being submitted
+// are ignored, so that a change is not submitted twice.
+func (b *ChangeBatcher) Enqueue(c Client, zoneID, key string, changes ...route53.Change) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if inflight, ok := b.inflight[key]; ok && reflect.DeepEqual(inflight, changes) {
+		return
+	}
+	delete(b.results, key)
+	z, ok := b.zones[zoneID]
+	if !ok {
+		z = &zoneBatch{changes: map[string][]route53.Change{}}
+		b.zones[zoneID] = z
+		time.AfterFunc(b.interval, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+			defer cancel()
+			b.Flush(ctx, zoneID)
+		})
+	}
+	if _, ok := z.changes[key]; !ok {
+		z.keys = append(z.keys, key)
+	}
+	z.client = c
+	z.changes[key] = changes
+}
+
+// Pending returns true if the changes of the supplied key have not been
+// submitted yet.
+func (b *ChangeBatcher) Pending(key string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.inflight[key]; ok {
+		return true
+	}
+	for _, z := range b.zones {
+		if _, ok := z.changes[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Result returns the outcome of the most recently submitted changes of the
+// supplied key and forgets it. It returns false if there is no outcome to
+// report.
+func (b *ChangeBatcher) Result(key string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err, ok := b.results[key]
+	delete(b.results, key)
+	return ok, err
+}
+
+// Peek returns the outcome of the most recently submitted changes of the
+// supplied key like Result, but without forgetting it.
+func (b *ChangeBatcher) Peek(key string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err, ok := b.results[key]
+	return ok, err
+}
+
+// Flush submits the pending changes of the supplied hosted zone.
+func (b *ChangeBatcher) Flush(ctx context.Context, zoneID string) {
+	b.mu.Lock()
+	z, ok := b.zones[zoneID]
+	delete(b.zones, zoneID)
+	if ok {
+		for _, k := range z.keys {
+			b.inflight[k] = z.changes[k]
+		}
+	}
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	results := map[string]error{}
+	for _, keys := range chunk(z) {
+		err := submit(ctx, z.client, zoneID, z.changes, keys)
+		// Route53 rejects a change batch as a whole if any of its changes
+		// is invalid. Submit the changes of each key on their own so that
+		// a single broken record set does not block the rest of the zone.
+		if isInvalidChangeBatch(err) && len(keys) > 1 {
+			for _, k := range keys {
+				results[k] = submit(ctx, z.client, zoneID, z.changes, []string{k})
+			}
+			continue
+		}
+		for _, k := range keys {
+			results[k] = err
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for k, err := range results {
+		delete(b.inflight, k)
+		b.results[k] = err
+	}
+}
+
+func submit(ctx context.Context, c Client, zoneID string, changes map[string][]route53.Change, keys []string) error {
+	in := &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch:  &route53.ChangeBatch{},
+	}
+	for _, k := range keys {
+		in.ChangeBatch.Changes = append(in.ChangeBatch.Changes, changes[k]...)
+	}
+	_, err := c.ChangeResourceRecordSetsRequest(in).Send(ctx)
+	return err
+}
+
+// chunk splits the keys of the supplied batch into groups whose changes fit
+// into a single change batch.
+func chunk(z *zoneBatch) [][]string {
+	var chunks [][]string
+	var current []string
+	size := 0
+	for _, k := range z.keys {
+		s := changeSize(z.changes[k])
+		if len(current) > 0 && size+s > maxBatchRecords {
+			chunks = append(chunks, current)
+			current, size = nil, 0
+		}
+		current = append(current, k)
+		size += s
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+func changeSize(changes []route53.Change) int {
+	size := 0
+	for _, c := range changes {
+		s := 1
+		if c.ResourceRecordSet != nil && len(c.ResourceRecordSet.ResourceRecords) > 1 {
+			s = len(c.ResourceRecordSet.ResourceRecords)
+		}
+		if c.Action == route53.ChangeActionUpsert {
+			s *= 2
+		}
+		size += s
+	}
+	return size
+}
+
+func isInvalidChangeBatch(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == route53.ErrCodeInvalidChangeBatch {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcerecordset
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
+)
+
+func change(name string) route53.Change {
+	return route53.Change{
+		Action: route53.ChangeActionCreate,
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name: aws.String(name),
+			Type: route53.RRTypeA,
+		},
+	}
+}
+
+func TestChangeBatcher(t *testing.T) {
+	zone := "zone"
+	errInvalid := awserr.New(route53.ErrCodeInvalidChangeBatch, "invalid", nil)
+
+	type want struct {
+		calls   int
+		results map[string]error
+	}
+
+	cases := map[string]struct {
+		changes map[string][]route53.Change
+		invalid string
+		want    want
+	}{
+		"SingleBatch": {
+			changes: map[string][]route53.Change{
+				"a": {change("a")},
+				"b": {change("b")},
+			},
+			want: want{
+				calls:   1,
+				results: map[string]error{"a": nil, "b": nil},
+			},
+		},
+		"InvalidChangeIsolated": {
+			changes: map[string][]route53.Change{
+				"a": {change("a")},
+				"b": {change("b")},
+			},
+			invalid: "b",
+			want: want{
+				calls:   3,
+				results: map[string]error{"a": nil, "b": errInvalid},
+			},
+		},
+		"SplitLargeBatches": {
+			changes: func() map[string][]route53.Change {
+				c := map[string][]route53.Change{}
+				for i := 0; i < maxBatchRecords+1; i++ {
+					k := fmt.Sprintf("%d", i)
+					c[k] = []route53.Change{change(k)}
+				}
+				return c
+			}(),
+			want: want{
+				calls: 2,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			c := &fake.MockResourceRecordSetClient{
+				MockChangeResourceRecordSetsRequest: func(in *route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest {
+					calls++
+					var err error
+					for _, c := range in.ChangeBatch.Changes {
+						if aws.StringValue(c.ResourceRecordSet.Name) == tc.invalid {
+							err = errInvalid
+						}
+					}
+					return route53.ChangeResourceRecordSetsRequest{
+						Request: &aws.Request{
+							HTTPRequest: &http.Request{},
+							Data:        &route53.ChangeResourceRecordSetsOutput{},
+							Error:       err,
+							Retryer:     aws.NoOpRetryer{},
+						},
+					}
+				},
+			}
+
+			b := NewChangeBatcher(time.Hour)
+			for k, changes := range tc.changes {
+				b.Enqueue(c, zone, k, changes...)
+				if !b.Pending(k) {
+					t.Errorf("Pending(%s): want true, got false", k)
+				}
+			}
+			b.Flush(context.Background(), zone)
+
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+			for k, want := range tc.want.results {
+				if b.Pending(k) {
+					t.Errorf("Pending(%s): want false, got true", k)
+				}
+				ok, err := b.Result(k)
+				if !ok {
+					t.Errorf("Result(%s): want a result", k)
+				}
+				if diff := cmp.Diff(want, err, test.EquateErrors()); diff != "" {
+					t.Errorf("Result(%s): -want, +got:\n%s", k, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestChangeBatcherInflight(t *testing.T) {
+	zone := "zone"
+
+	cases := map[string]struct {
+		enqueued []route53.Change
+		pending  bool
+	}{
+		"SameChangesIgnored": {
+			enqueued: []route53.Change{change("a")},
+			pending:  false,
+		},
+		"OtherChangesEnqueued": {
+			enqueued: []route53.Change{change("b")},
+			pending:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := NewChangeBatcher(time.Hour)
+			calls := 0
+			c := &fake.MockResourceRecordSetClient{}
+			c.MockChangeResourceRecordSetsRequest = func(in *route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest {
+				calls++
+				b.Enqueue(c, zone, "a", tc.enqueued...)
+				return route53.ChangeResourceRecordSetsRequest{
+					Request: &aws.Request{
+						HTTPRequest: &http.Request{},
+						Data:        &route53.ChangeResourceRecordSetsOutput{},
+						Retryer:     aws.NoOpRetryer{},
+					},
+				}
+			}
+
+			b.Enqueue(c, zone, "a", change("a"))
+			b.Flush(context.Background(), zone)
+
+			if diff := cmp.Diff(1, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.pending, b.Pending("a")); diff != "" {
+				t.Errorf("Pending(a): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -35,6 +35,13 @@ import (
 
 const (
 	errResourceRecordSetNotFound = "ResourceRecordSet.NotFound"
+
+	defaultOwnerID      = "default"
+	registryRecordTTL   = 300
+	registryHeritage    = "crossplane"
+	registryKeyHeritage = "heritage"
+	registryKeyOwner    = "crossplane/owner"
+	registryKeyResource = "crossplane/resource"
 )
 
 // Client defines ResourceRecordSet operations
@@ -66,32 +73,49 @@ func NewClient(cfg aws.Config) Client {
 
 // GetResourceRecordSet returns recordSet if present or err
 func GetResourceRecordSet(ctx context.Context, name string, params v1alpha1.ResourceRecordSetParameters, c Client) (*route53.ResourceRecordSet, error) {
+	return getResourceRecordSet(ctx, c, params.ZoneID, name, params.Type, params.SetIdentifier)
+}
+
+// GetRegistryRecordSet returns the TXT registry record set of the supplied
+// resource record set if present or err
+func GetRegistryRecordSet(ctx context.Context, name string, params v1alpha1.ResourceRecordSetParameters, c Client) (*route53.ResourceRecordSet, error) {
+	return getResourceRecordSet(ctx, c, params.ZoneID, RegistryRecordName(name, params), string(route53.RRTypeTxt), nil)
+}
+
+func getResourceRecordSet(ctx context.Context, c Client, zoneID *string, name, rrType string, setIdentifier *string) (*route53.ResourceRecordSet, error) {
 	res, err := c.ListResourceRecordSetsRequest(&route53.ListResourceRecordSetsInput{
-		HostedZoneId:    params.ZoneID,
+		HostedZoneId:    zoneID,
 		StartRecordName: &name,
-		StartRecordType: route53.RRType(params.Type),
+		StartRecordType: route53.RRType(rrType),
 	}).Send(ctx)
 	if err != nil {
 		return nil, err
 	}
-	appendDot := func(s string) string {
-		if !strings.HasSuffix(s, ".") {
-			return fmt.Sprintf("%s.", s)
-		}
-		return s
-	}
 	for _, rr := range res.ResourceRecordSets {
 		if appendDot(aws.StringValue(rr.Name)) == appendDot(name) &&
-			string(rr.Type) == params.Type &&
-			aws.StringValue(rr.SetIdentifier) == aws.StringValue(params.SetIdentifier) {
+			string(rr.Type) == rrType &&
+			aws.StringValue(rr.SetIdentifier) == aws.StringValue(setIdentifier) {
 			return &rr, nil
 		}
 	}
 	return nil, &NotFoundError{}
 }
 
-// GenerateChangeResourceRecordSetsInput prepares input for a ChangeResourceRecordSetsInput
-func GenerateChangeResourceRecordSetsInput(name string, p v1alpha1.ResourceRecordSetParameters, action route53.ChangeAction) *route53.ChangeResourceRecordSetsInput {
+func appendDot(s string) string {
+	if !strings.HasSuffix(s, ".") {
+		return fmt.Sprintf("%s.", s)
+	}
+	return s
+}
+
+// ChangeKey returns a key that identifies the supplied resource record set
+// within its hosted zone.
+func ChangeKey(name string, p v1alpha1.ResourceRecordSetParameters) string {
+	return strings.Join([]string{aws.StringValue(p.ZoneID), strings.ToLower(appendDot(name)), p.Type, aws.StringValue(p.SetIdentifier)}, "|")
+}
+
+// GenerateChange returns a change to the supplied resource record set
+func GenerateChange(name string, p v1alpha1.ResourceRecordSetParameters, action route53.ChangeAction) route53.Change {
 	r := &route53.ResourceRecordSet{
 		Name:                    aws.String(name),
 		Type:                    route53.RRType(p.Type),
@@ -123,18 +147,107 @@ func GenerateChangeResourceRecordSetsInput(name string, p v1alpha1.ResourceRecor
 			SubdivisionCode: p.GeoLocation.SubdivisionCode,
 		}
 	}
+	return route53.Change{
+		Action:            action,
+		ResourceRecordSet: r,
+	}
+}
 
+// GenerateChangeResourceRecordSetsInput prepares input for a ChangeResourceRecordSetsInput
+func GenerateChangeResourceRecordSetsInput(name string, p v1alpha1.ResourceRecordSetParameters, action route53.ChangeAction) *route53.ChangeResourceRecordSetsInput {
 	return &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: p.ZoneID,
 		ChangeBatch: &route53.ChangeBatch{
-			Changes: []route53.Change{
-				{
-					Action:            action,
-					ResourceRecordSet: r,
+			Changes: []route53.Change{GenerateChange(name, p, action)},
+		},
+	}
+}
+
+// GenerateChanges returns the changes required to perform the supplied action
+// on the resource record set according to its ownership policy. The returned
+// changes must be submitted in a single change batch. The resource is the name
+// of the managed resource and is only recorded in the TXT registry.
+func GenerateChanges(name, resource string, p v1alpha1.ResourceRecordSetParameters, action route53.ChangeAction) []route53.Change {
+	switch OwnershipPolicy(p) {
+	case v1alpha1.OwnershipPolicyCreateOnly:
+		return []route53.Change{GenerateChange(name, p, action)}
+	case v1alpha1.OwnershipPolicyTXTRegistry:
+		registryAction := action
+		if action == route53.ChangeActionCreate {
+			registryAction = route53.ChangeActionUpsert
+		}
+		return []route53.Change{
+			GenerateChange(name, p, action),
+			{
+				Action: registryAction,
+				ResourceRecordSet: &route53.ResourceRecordSet{
+					Name:            aws.String(RegistryRecordName(name, p)),
+					Type:            route53.RRTypeTxt,
+					TTL:             aws.Int64(registryRecordTTL),
+					ResourceRecords: []route53.ResourceRecord{{Value: aws.String(RegistryRecordValue(p, resource))}},
 				},
 			},
-		},
+		}
+	default:
+		// Without an ownership policy record sets are always upserted.
+		if action == route53.ChangeActionCreate {
+			action = route53.ChangeActionUpsert
+		}
+		return []route53.Change{GenerateChange(name, p, action)}
+	}
+}
+
+// OwnershipPolicy returns the ownership policy of the supplied resource record
+// set, defaulting to Upsert.
+func OwnershipPolicy(p v1alpha1.ResourceRecordSetParameters) v1alpha1.OwnershipPolicy {
+	if p.Ownership == nil || p.Ownership.Policy == "" {
+		return v1alpha1.OwnershipPolicyUpsert
+	}
+	return p.Ownership.Policy
+}
+
+// RegistryRecordName returns the name of the TXT registry record of the
+// supplied resource record set. The record type is part of the name so that
+// the registry record never clashes with a CNAME of the same name.
+func RegistryRecordName(name string, p v1alpha1.ResourceRecordSetParameters) string {
+	prefix := ""
+	if p.Ownership != nil {
+		prefix = aws.StringValue(p.Ownership.TXTPrefix)
+	}
+	return fmt.Sprintf("%s%s-%s", prefix, strings.ToLower(p.Type), name)
+}
+
+// RegistryRecordValue returns the value of the TXT registry record of the
+// supplied resource record set.
+func RegistryRecordValue(p v1alpha1.ResourceRecordSetParameters, resource string) string {
+	return fmt.Sprintf("%q", fmt.Sprintf("%s=%s,%s=%s,%s=%s",
+		registryKeyHeritage, registryHeritage,
+		registryKeyOwner, registryOwnerID(p),
+		registryKeyResource, resource))
+}
+
+// IsRegistryOwner returns true if the supplied TXT registry record set marks
+// the resource record set as owned by its owner ID.
+func IsRegistryOwner(p v1alpha1.ResourceRecordSetParameters, registry route53.ResourceRecordSet) bool {
+	for _, rr := range registry.ResourceRecords {
+		labels := map[string]string{}
+		for _, kv := range strings.Split(strings.Trim(aws.StringValue(rr.Value), `"`), ",") {
+			if i := strings.Index(kv, "="); i > 0 {
+				labels[kv[:i]] = kv[i+1:]
+			}
+		}
+		if labels[registryKeyHeritage] == registryHeritage && labels[registryKeyOwner] == registryOwnerID(p) {
+			return true
+		}
 	}
+	return false
+}
+
+func registryOwnerID(p v1alpha1.ResourceRecordSetParameters) string {
+	if p.Ownership == nil || p.Ownership.OwnerID == nil {
+		return defaultOwnerID
+	}
+	return *p.Ownership.OwnerID
 }
 
 // IsUpToDate checks if object is up to date
@@ -178,6 +291,9 @@ func CreatePatch(in *route53.ResourceRecordSet, target *v1alpha1.ResourceRecordS
 	// skip its comparison.
 	currentParams.ZoneID = target.ZoneID
 
+	// Ownership only affects how changes are submitted.
+	currentParams.Ownership = target.Ownership
+
 	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
 	if err != nil {
 		return nil, err
@@ -19,6 +19,7 @@ package resourcerecordset
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/google/go-cmp/cmp"
 
@@ -149,3 +150,84 @@ func TestIsUpToDate(t *testing.T) {
 	}
 
 }
+
+func TestGenerateChanges(t *testing.T) {
+	recordName := "x.y.z."
+	p := v1alpha1.ResourceRecordSetParameters{Type: "A"}
+	withPolicy := func(policy v1alpha1.OwnershipPolicy) v1alpha1.ResourceRecordSetParameters {
+		p := p
+		p.Ownership = &v1alpha1.Ownership{Policy: policy}
+		return p
+	}
+
+	type args struct {
+		p      v1alpha1.ResourceRecordSetParameters
+		action route53.ChangeAction
+	}
+
+	cases := map[string]struct {
+		args args
+		want []route53.ChangeAction
+	}{
+		"UpsertByDefault": {
+			args: args{p: p, action: route53.ChangeActionCreate},
+			want: []route53.ChangeAction{route53.ChangeActionUpsert},
+		},
+		"CreateOnly": {
+			args: args{p: withPolicy(v1alpha1.OwnershipPolicyCreateOnly), action: route53.ChangeActionCreate},
+			want: []route53.ChangeAction{route53.ChangeActionCreate},
+		},
+		"TXTRegistryCreate": {
+			args: args{p: withPolicy(v1alpha1.OwnershipPolicyTXTRegistry), action: route53.ChangeActionCreate},
+			want: []route53.ChangeAction{route53.ChangeActionCreate, route53.ChangeActionUpsert},
+		},
+		"TXTRegistryDelete": {
+			args: args{p: withPolicy(v1alpha1.OwnershipPolicyTXTRegistry), action: route53.ChangeActionDelete},
+			want: []route53.ChangeAction{route53.ChangeActionDelete, route53.ChangeActionDelete},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []route53.ChangeAction
+			for _, c := range GenerateChanges(recordName, "cr", tc.args.p, tc.args.action) {
+				got = append(got, c.Action)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRegistryOwner(t *testing.T) {
+	p := v1alpha1.ResourceRecordSetParameters{Type: "A"}
+	other := v1alpha1.ResourceRecordSetParameters{Type: "A", Ownership: &v1alpha1.Ownership{OwnerID: aws.String("other")}}
+
+	cases := map[string]struct {
+		registry route53.ResourceRecordSet
+		want     bool
+	}{
+		"Owned": {
+			registry: route53.ResourceRecordSet{ResourceRecords: []route53.ResourceRecord{{Value: aws.String(RegistryRecordValue(p, "cr"))}}},
+			want:     true,
+		},
+		"OtherOwner": {
+			registry: route53.ResourceRecordSet{ResourceRecords: []route53.ResourceRecord{{Value: aws.String(RegistryRecordValue(other, "cr"))}}},
+			want:     false,
+		},
+		"NotARegistryRecord": {
+			registry: route53.ResourceRecordSet{ResourceRecords: []route53.ResourceRecord{{Value: aws.String(`"v=spf1 -all"`)}}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRegistryOwner(p, tc.registry)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
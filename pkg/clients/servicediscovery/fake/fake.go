@@ -36,6 +36,28 @@ type MockServicediscoveryClient struct {
 	MockCreateHTTPNamespaceRequest func(*svcsdk.CreateHttpNamespaceInput) (*request.Request, *svcsdk.CreateHttpNamespaceOutput)
 	// MockDeleteNamespaceRequest is a function pointer
 	MockDeleteNamespaceRequest func(*svcsdk.DeleteNamespaceInput) (*request.Request, *svcsdk.DeleteNamespaceOutput)
+	// MockCreateService is a function pointer
+	MockCreateService func(*svcsdk.CreateServiceInput) (*svcsdk.CreateServiceOutput, error)
+	// MockGetService is a function pointer
+	MockGetService func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error)
+	// MockUpdateService is a function pointer
+	MockUpdateService func(*svcsdk.UpdateServiceInput) (*svcsdk.UpdateServiceOutput, error)
+	// MockDeleteService is a function pointer
+	MockDeleteService func(*svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error)
+	// MockRegisterInstance is a function pointer
+	MockRegisterInstance func(*svcsdk.RegisterInstanceInput) (*svcsdk.RegisterInstanceOutput, error)
+	// MockDeregisterInstance is a function pointer
+	MockDeregisterInstance func(*svcsdk.DeregisterInstanceInput) (*svcsdk.DeregisterInstanceOutput, error)
+	// MockGetInstance is a function pointer
+	MockGetInstance func(*svcsdk.GetInstanceInput) (*svcsdk.GetInstanceOutput, error)
+	// MockListInstances is a function pointer
+	MockListInstances func(*svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error)
+	// MockListTagsForResource is a function pointer
+	MockListTagsForResource func(*svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error)
+	// MockTagResource is a function pointer
+	MockTagResource func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error)
+	// MockUntagResource is a function pointer
+	MockUntagResource func(*svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error)
 }
 
 // CreatePrivateDnsNamespace is the interface function to call the mock function pointer
@@ -215,3 +237,102 @@ func (m *MockServicediscoveryClient) DeleteNamespaceRequest(input *svcsdk.Delete
 	}
 	return m.MockDeleteNamespaceRequest(input)
 }
+
+// CreateServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) CreateServiceWithContext(_ context.Context, input *svcsdk.CreateServiceInput, _ ...request.Option) (*svcsdk.CreateServiceOutput, error) {
+	if m.MockCreateService == nil {
+		fmt.Println(".MockCreateService == nil")
+		return &svcsdk.CreateServiceOutput{}, nil
+	}
+	return m.MockCreateService(input)
+}
+
+// GetServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) GetServiceWithContext(_ context.Context, input *svcsdk.GetServiceInput, _ ...request.Option) (*svcsdk.GetServiceOutput, error) {
+	if m.MockGetService == nil {
+		fmt.Println(".MockGetService == nil")
+		return &svcsdk.GetServiceOutput{}, nil
+	}
+	return m.MockGetService(input)
+}
+
+// UpdateServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) UpdateServiceWithContext(_ context.Context, input *svcsdk.UpdateServiceInput, _ ...request.Option) (*svcsdk.UpdateServiceOutput, error) {
+	if m.MockUpdateService == nil {
+		fmt.Println(".MockUpdateService == nil")
+		return &svcsdk.UpdateServiceOutput{}, nil
+	}
+	return m.MockUpdateService(input)
+}
+
+// DeleteServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) DeleteServiceWithContext(_ context.Context, input *svcsdk.DeleteServiceInput, _ ...request.Option) (*svcsdk.DeleteServiceOutput, error) {
+	if m.MockDeleteService == nil {
+		fmt.Println(".MockDeleteService == nil")
+		return &svcsdk.DeleteServiceOutput{}, nil
+	}
+	return m.MockDeleteService(input)
+}
+
+// RegisterInstanceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) RegisterInstanceWithContext(_ context.Context, input *svcsdk.RegisterInstanceInput, _ ...request.Option) (*svcsdk.RegisterInstanceOutput, error) {
+	if m.MockRegisterInstance == nil {
+		fmt.Println(".MockRegisterInstance == nil")
+		return &svcsdk.RegisterInstanceOutput{}, nil
+	}
+	return m.MockRegisterInstance(input)
+}
+
+// DeregisterInstanceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) DeregisterInstanceWithContext(_ context.Context, input *svcsdk.DeregisterInstanceInput, _ ...request.Option) (*svcsdk.DeregisterInstanceOutput, error) {
+	if m.MockDeregisterInstance == nil {
+		fmt.Println(".MockDeregisterInstance == nil")
+		return &svcsdk.DeregisterInstanceOutput{}, nil
+	}
+	return m.MockDeregisterInstance(input)
+}
+
+// GetInstanceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) GetInstanceWithContext(_ context.Context, input *svcsdk.GetInstanceInput, _ ...request.Option) (*svcsdk.GetInstanceOutput, error) {
+	if m.MockGetInstance == nil {
+		fmt.Println(".MockGetInstance == nil")
+		return &svcsdk.GetInstanceOutput{}, nil
+	}
+	return m.MockGetInstance(input)
+}
+
+// ListInstancesWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) ListInstancesWithContext(_ context.Context, input *svcsdk.ListInstancesInput, _ ...request.Option) (*svcsdk.ListInstancesOutput, error) {
+	if m.MockListInstances == nil {
+		fmt.Println(".MockListInstances == nil")
+		return &svcsdk.ListInstancesOutput{}, nil
+	}
+	return m.MockListInstances(input)
+}
+
+// ListTagsForResourceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) ListTagsForResourceWithContext(_ context.Context, input *svcsdk.ListTagsForResourceInput, _ ...request.Option) (*svcsdk.ListTagsForResourceOutput, error) {
+	if m.MockListTagsForResource == nil {
+		fmt.Println(".MockListTagsForResource == nil")
+		return &svcsdk.ListTagsForResourceOutput{}, nil
+	}
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) TagResourceWithContext(_ context.Context, input *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	if m.MockTagResource == nil {
+		fmt.Println(".MockTagResource == nil")
+		return &svcsdk.TagResourceOutput{}, nil
+	}
+	return m.MockTagResource(input)
+}
+
+// UntagResourceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) UntagResourceWithContext(_ context.Context, input *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	if m.MockUntagResource == nil {
+		fmt.Println(".MockUntagResource == nil")
+		return &svcsdk.UntagResourceOutput{}, nil
+	}
+	return m.MockUntagResource(input)
+}
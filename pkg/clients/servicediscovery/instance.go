@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"strconv"
+	"strings"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+)
+
+// Attribute keys that are set from the endpoints of a Kubernetes Service.
+const (
+	AttributeInstanceIPv4 = "AWS_INSTANCE_IPV4"
+	AttributeInstancePort = "AWS_INSTANCE_PORT"
+)
+
+// EndpointInstanceIDSeparator separates the prefix of the IDs of the
+// instances that are registered for endpoint addresses from the address.
+const EndpointInstanceIDSeparator = "-"
+
+// GenerateEndpointInstances returns the attributes of the instances that are
+// registered for the ready addresses of the given endpoints, keyed by their
+// instance IDs which are prefixed with the given ID.
+func GenerateEndpointInstances(prefix string, p v1alpha1.InstanceParameters, ep *corev1.Endpoints) map[string]map[string]*string {
+	out := map[string]map[string]*string{}
+	for _, s := range ep.Subsets {
+		port, ok := endpointPort(s.Ports, p.KubernetesServiceRef.Port)
+		if !ok {
+			continue
+		}
+		for _, a := range s.Addresses {
+			attrs := GenerateInstanceAttributes(p.Attributes)
+			attrs[AttributeInstanceIPv4] = awsv1.String(a.IP)
+			attrs[AttributeInstancePort] = awsv1.String(port)
+			out[EndpointInstanceID(prefix, a.IP)] = attrs
+		}
+	}
+	return out
+}
+
+// EndpointInstanceID returns the ID of the instance that is registered for
+// the given endpoint address.
+func EndpointInstanceID(prefix, ip string) string {
+	return prefix + EndpointInstanceIDSeparator + strings.NewReplacer(".", "-", ":", "-").Replace(ip)
+}
+
+// GenerateInstanceAttributes converts the given attributes to their SDK
+// representation.
+func GenerateInstanceAttributes(in map[string]string) map[string]*string {
+	out := make(map[string]*string, len(in))
+	for k, v := range in {
+		out[k] = awsv1.String(v)
+	}
+	return out
+}
+
+// IsInstanceUpToDate returns whether the current attributes of an instance
+// match the desired ones.
+func IsInstanceUpToDate(desired, current map[string]*string) bool {
+	if len(desired) != len(current) {
+		return false
+	}
+	for k, v := range desired {
+		c, ok := current[k]
+		if !ok || awsv1.StringValue(v) != awsv1.StringValue(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func endpointPort(ports []corev1.EndpointPort, name *string) (string, bool) {
+	for _, p := range ports {
+		if name == nil || p.Name == awsv1.StringValue(name) {
+			return strconv.Itoa(int(p.Port)), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateServiceInput from ServiceParameters.
+func GenerateCreateServiceInput(requestID string, p v1alpha1.ServiceParameters) *svcsdk.CreateServiceInput {
+	in := &svcsdk.CreateServiceInput{
+		CreatorRequestId:  awsv1.String(requestID),
+		Name:              awsv1.String(p.Name),
+		NamespaceId:       p.NamespaceID,
+		Description:       p.Description,
+		HealthCheckConfig: generateHealthCheckConfig(p.HealthCheckConfig),
+	}
+	if p.DNSConfig != nil {
+		in.DnsConfig = &svcsdk.DnsConfig{
+			RoutingPolicy: p.DNSConfig.RoutingPolicy,
+			DnsRecords:    generateDNSRecords(p.DNSConfig.DNSRecords),
+		}
+	}
+	if p.HealthCheckCustomConfig != nil {
+		in.HealthCheckCustomConfig = &svcsdk.HealthCheckCustomConfig{
+			FailureThreshold: p.HealthCheckCustomConfig.FailureThreshold,
+		}
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, &svcsdk.Tag{Key: t.Key, Value: t.Value})
+	}
+	return in
+}
+
+// GenerateUpdateServiceInput from ServiceParameters.
+func GenerateUpdateServiceInput(id string, p v1alpha1.ServiceParameters) *svcsdk.UpdateServiceInput {
+	in := &svcsdk.UpdateServiceInput{
+		Id: awsv1.String(id),
+		Service: &svcsdk.ServiceChange{
+			Description:       p.Description,
+			HealthCheckConfig: generateHealthCheckConfig(p.HealthCheckConfig),
+		},
+	}
+	if p.DNSConfig != nil {
+		in.Service.DnsConfig = &svcsdk.DnsConfigChange{
+			DnsRecords: generateDNSRecords(p.DNSConfig.DNSRecords),
+		}
+	}
+	return in
+}
+
+// GenerateServiceObservation returns the observation of the given service.
+func GenerateServiceObservation(s *svcsdk.Service) v1alpha1.ServiceObservation {
+	o := v1alpha1.ServiceObservation{
+		ARN:           awsv1.StringValue(s.Arn),
+		InstanceCount: awsv1.Int64Value(s.InstanceCount),
+	}
+	if s.CreateDate != nil {
+		t := metav1.NewTime(*s.CreateDate)
+		o.CreateDate = &t
+	}
+	return o
+}
+
+// LateInitializeService fills the empty fields of ServiceParameters with the
+// values of the given service.
+func LateInitializeService(p *v1alpha1.ServiceParameters, s *svcsdk.Service) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, s.Description)
+	if p.DNSConfig != nil && s.DnsConfig != nil {
+		p.DNSConfig.RoutingPolicy = awsclients.LateInitializeStringPtr(p.DNSConfig.RoutingPolicy, s.DnsConfig.RoutingPolicy)
+	}
+	if p.HealthCheckConfig != nil && s.HealthCheckConfig != nil {
+		p.HealthCheckConfig.FailureThreshold = awsclients.LateInitializeInt64Ptr(p.HealthCheckConfig.FailureThreshold, s.HealthCheckConfig.FailureThreshold)
+	}
+	if p.HealthCheckCustomConfig != nil && s.HealthCheckCustomConfig != nil {
+		p.HealthCheckCustomConfig.FailureThreshold = awsclients.LateInitializeInt64Ptr(p.HealthCheckCustomConfig.FailureThreshold, s.HealthCheckCustomConfig.FailureThreshold)
+	}
+}
+
+// IsServiceUpToDate returns whether the updatable fields of the service match
+// the desired ones.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, s *svcsdk.Service) bool {
+	if awsv1.StringValue(p.Description) != awsv1.StringValue(s.Description) {
+		return false
+	}
+	if p.DNSConfig != nil {
+		if s.DnsConfig == nil || len(p.DNSConfig.DNSRecords) != len(s.DnsConfig.DnsRecords) {
+			return false
+		}
+		for i, r := range p.DNSConfig.DNSRecords {
+			if r.Type != awsv1.StringValue(s.DnsConfig.DnsRecords[i].Type) || r.TTL != awsv1.Int64Value(s.DnsConfig.DnsRecords[i].TTL) {
+				return false
+			}
+		}
+	}
+	if p.HealthCheckConfig != nil {
+		h := s.HealthCheckConfig
+		if h == nil ||
+			p.HealthCheckConfig.Type != awsv1.StringValue(h.Type) ||
+			awsv1.StringValue(p.HealthCheckConfig.ResourcePath) != awsv1.StringValue(h.ResourcePath) ||
+			awsv1.Int64Value(p.HealthCheckConfig.FailureThreshold) != awsv1.Int64Value(h.FailureThreshold) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateDNSRecords(in []v1alpha1.DNSRecord) []*svcsdk.DnsRecord {
+	out := make([]*svcsdk.DnsRecord, len(in))
+	for i, r := range in {
+		out[i] = &svcsdk.DnsRecord{Type: awsv1.String(r.Type), TTL: awsv1.Int64(r.TTL)}
+	}
+	return out
+}
+
+func generateHealthCheckConfig(in *v1alpha1.HealthCheckConfig) *svcsdk.HealthCheckConfig {
+	if in == nil {
+		return nil
+	}
+	return &svcsdk.HealthCheckConfig{
+		Type:             awsv1.String(in.Type),
+		ResourcePath:     in.ResourcePath,
+		FailureThreshold: in.FailureThreshold,
+	}
+}
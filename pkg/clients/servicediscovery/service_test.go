@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+)
+
+func TestIsServiceUpToDate(t *testing.T) {
+	current := &svcsdk.Service{
+		Description: awsv1.String("web"),
+		DnsConfig: &svcsdk.DnsConfig{
+			RoutingPolicy: awsv1.String("MULTIVALUE"),
+			DnsRecords:    []*svcsdk.DnsRecord{{Type: awsv1.String("A"), TTL: awsv1.Int64(60)}},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ServiceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ServiceParameters{
+				Description: awsv1.String("web"),
+				DNSConfig:   &v1alpha1.DNSConfig{DNSRecords: []v1alpha1.DNSRecord{{Type: "A", TTL: 60}}},
+			},
+			want: true,
+		},
+		"TTLChanged": {
+			p: v1alpha1.ServiceParameters{
+				Description: awsv1.String("web"),
+				DNSConfig:   &v1alpha1.DNSConfig{DNSRecords: []v1alpha1.DNSRecord{{Type: "A", TTL: 300}}},
+			},
+			want: false,
+		},
+		"DescriptionChanged": {
+			p: v1alpha1.ServiceParameters{
+				Description: awsv1.String("api"),
+			},
+			want: false,
+		},
+		"HealthCheckAdded": {
+			p: v1alpha1.ServiceParameters{
+				Description:       awsv1.String("web"),
+				HealthCheckConfig: &v1alpha1.HealthCheckConfig{Type: "HTTP"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServiceUpToDate(tc.p, current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*svcsdk.Tag
+		remove []*string
+	}
+
+	cases := map[string]struct {
+		desired []*v1alpha1.Tag
+		current []*svcsdk.Tag
+		want    want
+	}{
+		"Same": {
+			desired: []*v1alpha1.Tag{{Key: awsv1.String("k"), Value: awsv1.String("v")}},
+			current: []*svcsdk.Tag{{Key: awsv1.String("k"), Value: awsv1.String("v")}},
+		},
+		"Changed": {
+			desired: []*v1alpha1.Tag{{Key: awsv1.String("k"), Value: awsv1.String("new")}},
+			current: []*svcsdk.Tag{
+				{Key: awsv1.String("k"), Value: awsv1.String("v")},
+				{Key: awsv1.String("stale"), Value: awsv1.String("v")},
+			},
+			want: want{
+				add:    []*svcsdk.Tag{{Key: awsv1.String("k"), Value: awsv1.String("new")}},
+				remove: []*string{awsv1.String("stale")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,10 +1,63 @@
 package servicediscovery
 
 import (
+	"sort"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
 )
 
 // Client is the external client
 type Client interface {
 	servicediscoveryiface.ServiceDiscoveryAPI
 }
+
+// NewClient returns a new Client with the provided session.
+func NewClient(sess *session.Session) Client {
+	return svcsdk.New(sess)
+}
+
+// IsServiceNotFound returns true if the error is because the service doesn't
+// exist.
+func IsServiceNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeServiceNotFound
+}
+
+// IsInstanceNotFound returns true if the error is because the instance
+// doesn't exist.
+func IsInstanceNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeInstanceNotFound
+}
+
+// DiffTags returns the tags that need to be added to and removed from a
+// resource so that its current tags match the desired ones.
+func DiffTags(desired []*v1alpha1.Tag, current []*svcsdk.Tag) (add []*svcsdk.Tag, remove []*string) {
+	want := map[string]string{}
+	for _, t := range desired {
+		want[awsv1.StringValue(t.Key)] = awsv1.StringValue(t.Value)
+	}
+	have := map[string]string{}
+	for _, t := range current {
+		have[awsv1.StringValue(t.Key)] = awsv1.StringValue(t.Value)
+	}
+	for k, v := range want {
+		if cv, ok := have[k]; !ok || cv != v {
+			add = append(add, &svcsdk.Tag{Key: awsv1.String(k), Value: awsv1.String(v)})
+		}
+	}
+	for k := range have {
+		if _, ok := want[k]; !ok {
+			remove = append(remove, awsv1.String(k))
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return awsv1.StringValue(add[i].Key) < awsv1.StringValue(add[j].Key) })
+	sort.Slice(remove, func(i, j int) bool { return awsv1.StringValue(remove[i]) < awsv1.StringValue(remove[j]) })
+	return add, remove
+}
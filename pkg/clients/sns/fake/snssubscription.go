@@ -8,6 +8,7 @@ type MockSubscriptionClient struct {
 	MockUnsubscribeRequest               func(*sns.UnsubscribeInput) sns.UnsubscribeRequest
 	MockGetSubscriptionAttributesRequest func(*sns.GetSubscriptionAttributesInput) sns.GetSubscriptionAttributesRequest
 	MockSetSubscriptionAttributesRequest func(*sns.SetSubscriptionAttributesInput) sns.SetSubscriptionAttributesRequest
+	MockConfirmSubscriptionRequest       func(*sns.ConfirmSubscriptionInput) sns.ConfirmSubscriptionRequest
 }
 
 // SubscribeRequest mocks SubscribeRequest method
@@ -29,3 +30,8 @@ func (m *MockSubscriptionClient) GetSubscriptionAttributesRequest(input *sns.Get
 func (m *MockSubscriptionClient) SetSubscriptionAttributesRequest(input *sns.SetSubscriptionAttributesInput) sns.SetSubscriptionAttributesRequest {
 	return m.MockSetSubscriptionAttributesRequest(input)
 }
+
+// ConfirmSubscriptionRequest mocks ConfirmSubscriptionRequest method
+func (m *MockSubscriptionClient) ConfirmSubscriptionRequest(input *sns.ConfirmSubscriptionInput) sns.ConfirmSubscriptionRequest {
+	return m.MockConfirmSubscriptionRequest(input)
+}
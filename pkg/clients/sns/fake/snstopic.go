@@ -10,6 +10,9 @@ type MockTopicClient struct {
 	MockDeleteTopicRequest        func(*sns.DeleteTopicInput) sns.DeleteTopicRequest
 	MockGetTopicAttributesRequest func(*sns.GetTopicAttributesInput) sns.GetTopicAttributesRequest
 	MockSetTopicAttributesRequest func(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest
+	MockListTagsForResource       func(*sns.ListTagsForResourceInput) sns.ListTagsForResourceRequest
+	MockTagResource               func(*sns.TagResourceInput) sns.TagResourceRequest
+	MockUntagResource             func(*sns.UntagResourceInput) sns.UntagResourceRequest
 }
 
 // CreateTopicRequest mocks CreateTopicRequest method
@@ -31,3 +34,18 @@ func (m *MockTopicClient) GetTopicAttributesRequest(input *sns.GetTopicAttribute
 func (m *MockTopicClient) SetTopicAttributesRequest(input *sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest {
 	return m.MockSetTopicAttributesRequest(input)
 }
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockTopicClient) ListTagsForResourceRequest(input *sns.ListTagsForResourceInput) sns.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockTopicClient) TagResourceRequest(input *sns.TagResourceInput) sns.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockTopicClient) UntagResourceRequest(input *sns.UntagResourceInput) sns.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
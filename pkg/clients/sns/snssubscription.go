@@ -54,6 +54,7 @@ type SubscriptionClient interface {
 	UnsubscribeRequest(*sns.UnsubscribeInput) sns.UnsubscribeRequest
 	GetSubscriptionAttributesRequest(*sns.GetSubscriptionAttributesInput) sns.GetSubscriptionAttributesRequest
 	SetSubscriptionAttributesRequest(*sns.SetSubscriptionAttributesInput) sns.SetSubscriptionAttributesRequest
+	ConfirmSubscriptionRequest(*sns.ConfirmSubscriptionInput) sns.ConfirmSubscriptionRequest
 }
 
 // NewSubscriptionClient returns a new client using AWS credentials as JSON encoded
@@ -74,6 +75,19 @@ func GenerateSubscribeInput(p *v1alpha1.SNSSubscriptionParameters) *sns.Subscrib
 	return input
 }
 
+// GenerateConfirmSubscriptionInput prepares input for
+// ConfirmSubscriptionRequest
+func GenerateConfirmSubscriptionInput(p *v1alpha1.SNSSubscriptionParameters, token string) *sns.ConfirmSubscriptionInput {
+	input := &sns.ConfirmSubscriptionInput{
+		TopicArn: aws.String(p.TopicARN),
+		Token:    aws.String(token),
+	}
+	if p.AuthenticateOnUnsubscribe != nil {
+		input.AuthenticateOnUnsubscribe = aws.String(strconv.FormatBool(*p.AuthenticateOnUnsubscribe))
+	}
+	return input
+}
+
 // GenerateSubscriptionObservation is used to produce SNSSubscriptionObservation
 // from resource at cloud & its attributes
 func GenerateSubscriptionObservation(attr map[string]string) v1alpha1.SNSSubscriptionObservation {
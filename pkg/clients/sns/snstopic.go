@@ -50,6 +50,11 @@ const (
 	TopicSubscriptionsDeleted TopicAttributes = "SubscriptionsDeleted"
 	// TopicARN is the ARN for the SNS Topic
 	TopicARN TopicAttributes = "TopicArn"
+	// TopicFifoTopic is whether the SNS Topic is a FIFO topic
+	TopicFifoTopic TopicAttributes = "FifoTopic"
+	// TopicContentBasedDeduplication is whether the SNS Topic deduplicates
+	// messages based on their content
+	TopicContentBasedDeduplication TopicAttributes = "ContentBasedDeduplication"
 )
 
 // TopicClient is the external client used for AWS SNSTopic
@@ -58,6 +63,9 @@ type TopicClient interface {
 	DeleteTopicRequest(*sns.DeleteTopicInput) sns.DeleteTopicRequest
 	GetTopicAttributesRequest(*sns.GetTopicAttributesInput) sns.GetTopicAttributesRequest
 	SetTopicAttributesRequest(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest
+	ListTagsForResourceRequest(*sns.ListTagsForResourceInput) sns.ListTagsForResourceRequest
+	TagResourceRequest(*sns.TagResourceInput) sns.TagResourceRequest
+	UntagResourceRequest(*sns.UntagResourceInput) sns.UntagResourceRequest
 }
 
 // NewTopicClient returns a new client using AWS credentials as JSON encoded data.
@@ -71,6 +79,18 @@ func GenerateCreateTopicInput(p *v1alpha1.SNSTopicParameters) *sns.CreateTopicIn
 		Name: &p.Name,
 	}
 
+	// NOTE: FIFO topics can only be created as such, and content-based
+	// deduplication is only accepted for FIFO topics.
+	if p.FifoTopic != nil || p.ContentBasedDeduplication != nil {
+		input.Attributes = map[string]string{}
+	}
+	if p.FifoTopic != nil {
+		input.Attributes[string(TopicFifoTopic)] = strconv.FormatBool(*p.FifoTopic)
+	}
+	if p.ContentBasedDeduplication != nil {
+		input.Attributes[string(TopicContentBasedDeduplication)] = strconv.FormatBool(*p.ContentBasedDeduplication)
+	}
+
 	if len(p.Tags) != 0 {
 		input.Tags = make([]sns.Tag, len(p.Tags))
 		for i, val := range p.Tags {
@@ -91,6 +111,8 @@ func LateInitializeTopicAttr(in *v1alpha1.SNSTopicParameters, attrs map[string]s
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(attrs[string(TopicDeliveryPolicy)]))
 	in.KMSMasterKeyID = awsclients.LateInitializeStringPtr(in.KMSMasterKeyID, aws.String(attrs[string(TopicKmsMasterKeyID)]))
 	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	in.FifoTopic = awsclients.LateInitializeBoolPtr(in.FifoTopic, boolAttribute(attrs, TopicFifoTopic))
+	in.ContentBasedDeduplication = awsclients.LateInitializeBoolPtr(in.ContentBasedDeduplication, boolAttribute(attrs, TopicContentBasedDeduplication))
 
 }
 
@@ -140,7 +162,26 @@ func IsSNSTopicUpToDate(p v1alpha1.SNSTopicParameters, attr map[string]string) b
 	return aws.StringValue(p.DeliveryPolicy) == attr[string(TopicDeliveryPolicy)] &&
 		aws.StringValue(p.DisplayName) == attr[string(TopicDisplayName)] &&
 		aws.StringValue(p.KMSMasterKeyID) == attr[string(TopicKmsMasterKeyID)] &&
-		aws.StringValue(p.Policy) == attr[string(TopicPolicy)]
+		aws.StringValue(p.Policy) == attr[string(TopicPolicy)] &&
+		(p.ContentBasedDeduplication == nil || strconv.FormatBool(*p.ContentBasedDeduplication) == attr[string(TopicContentBasedDeduplication)])
+}
+
+// DiffTags returns the tags that should be added to or removed from a SNS
+// Topic.
+func DiffTags(spec []v1alpha1.Tag, current []sns.Tag) (add []sns.Tag, remove []string) {
+	local := make(map[string]string, len(spec))
+	for _, t := range spec {
+		local[t.Key] = aws.StringValue(t.Value)
+	}
+	remote := make(map[string]string, len(current))
+	for _, t := range current {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffLabels(local, remote)
+	for k, v := range addMap {
+		add = append(add, sns.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
 }
 
 func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
@@ -151,10 +192,23 @@ func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
 	topicAttr[string(TopicDisplayName)] = aws.StringValue(p.DisplayName)
 	topicAttr[string(TopicKmsMasterKeyID)] = aws.StringValue(p.KMSMasterKeyID)
 	topicAttr[string(TopicPolicy)] = aws.StringValue(p.Policy)
+	if p.ContentBasedDeduplication != nil {
+		topicAttr[string(TopicContentBasedDeduplication)] = strconv.FormatBool(*p.ContentBasedDeduplication)
+	}
 
 	return topicAttr
 }
 
+// boolAttribute returns the value of the given boolean attribute, or nil if
+// it is not set.
+func boolAttribute(attrs map[string]string, key TopicAttributes) *bool {
+	b, err := strconv.ParseBool(attrs[string(key)])
+	if err != nil {
+		return nil
+	}
+	return aws.Bool(b)
+}
+
 // IsTopicNotFound returns true if the error code indicates that the item was not found
 func IsTopicNotFound(err error) bool {
 	if topicErr, ok := err.(awserr.Error); ok && topicErr.Code() == sns.ErrCodeNotFoundException {
@@ -162,6 +162,20 @@ func TestGenerateCreateTopicInput(t *testing.T) {
 				},
 			},
 		},
+		"FifoTopic": {
+			in: v1alpha1.SNSTopicParameters{
+				Name:                      topicName + ".fifo",
+				FifoTopic:                 aws.Bool(true),
+				ContentBasedDeduplication: aws.Bool(false),
+			},
+			out: awssns.CreateTopicInput{
+				Name: aws.String(topicName + ".fifo"),
+				Attributes: map[string]string{
+					"FifoTopic":                 "true",
+					"ContentBasedDeduplication": "false",
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
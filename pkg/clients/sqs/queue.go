@@ -28,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -115,6 +116,15 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //
 	if p.ContentBasedDeduplication != nil {
 		m[v1beta1.AttributeContentBasedDeduplication] = strconv.FormatBool(aws.BoolValue(p.ContentBasedDeduplication))
 	}
+	if p.RedriveAllowPolicy != nil {
+		val, err := json.Marshal(redriveAllowPolicy(p.RedriveAllowPolicy))
+		if err == nil {
+			m[v1beta1.AttributeRedriveAllowPolicy] = string(val)
+		}
+	}
+	if p.SQSManagedSSEEnabled != nil {
+		m[v1beta1.AttributeSqsManagedSseEnabled] = strconv.FormatBool(aws.BoolValue(p.SQSManagedSSEEnabled))
+	}
 	if len(m) == 0 {
 		return nil
 	}
@@ -172,6 +182,9 @@ func LateInitialize(in *v1beta1.QueueParameters, attributes map[string]string, t
 	if in.KMSMasterKeyID == nil && attributes[v1beta1.AttributeKmsMasterKeyID] != "" {
 		in.KMSMasterKeyID = aws.String(attributes[v1beta1.AttributeKmsMasterKeyID])
 	}
+	if b, err := strconv.ParseBool(attributes[v1beta1.AttributeSqsManagedSseEnabled]); err == nil {
+		in.SQSManagedSSEEnabled = awsclients.LateInitializeBoolPtr(in.SQSManagedSSEEnabled, aws.Bool(b))
+	}
 }
 
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
@@ -208,7 +221,14 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	if !cmp.Equal(aws.StringValue(p.KMSMasterKeyID), attributes[v1beta1.AttributeKmsMasterKeyID]) {
 		return false
 	}
-	if !cmp.Equal(aws.StringValue(p.Policy), attributes[v1beta1.AttributePolicy]) {
+	// NOTE: The policy may be managed by a QueuePolicy instead.
+	if p.Policy != nil && !cmp.Equal(aws.StringValue(p.Policy), attributes[v1beta1.AttributePolicy]) {
+		return false
+	}
+	if p.SQSManagedSSEEnabled != nil && attributes[v1beta1.AttributeSqsManagedSseEnabled] != "" && strconv.FormatBool(aws.BoolValue(p.SQSManagedSSEEnabled)) != attributes[v1beta1.AttributeSqsManagedSseEnabled] {
+		return false
+	}
+	if p.RedriveAllowPolicy != nil && !isRedriveAllowPolicyUpToDate(p.RedriveAllowPolicy, attributes[v1beta1.AttributeRedriveAllowPolicy]) {
 		return false
 	}
 	if attributes[v1beta1.AttributeContentBasedDeduplication] != "" && strconv.FormatBool(aws.BoolValue(p.ContentBasedDeduplication)) != attributes[v1beta1.AttributeContentBasedDeduplication] {
@@ -229,6 +249,30 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	return true
 }
 
+// redriveAllowPolicy returns the RedriveAllowPolicy attribute of a queue.
+func redriveAllowPolicy(p *v1beta1.RedriveAllowPolicy) map[string]interface{} {
+	r := map[string]interface{}{
+		"redrivePermission": p.RedrivePermission,
+	}
+	if len(p.SourceQueueARNs) != 0 {
+		r["sourceQueueArns"] = p.SourceQueueARNs
+	}
+	return r
+}
+
+func isRedriveAllowPolicyUpToDate(p *v1beta1.RedriveAllowPolicy, attr string) bool {
+	var observed map[string]interface{}
+	if err := json.Unmarshal([]byte(attr), &observed); err != nil {
+		return false
+	}
+	desired := map[string]interface{}{}
+	b, err := json.Marshal(redriveAllowPolicy(p))
+	if err != nil || json.Unmarshal(b, &desired) != nil {
+		return false
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty())
+}
+
 // TagsDiff returns the tags added and removed from spec when compared to the AWS SQS tags.
 func TagsDiff(sqsTags map[string]string, newTags map[string]string) (removed, added map[string]string) {
 	removed = map[string]string{}
@@ -171,6 +171,19 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"RedriveAllowPolicy": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					RedriveAllowPolicy: &v1beta1.RedriveAllowPolicy{
+						RedrivePermission: "denyAll",
+					},
+				},
+				attributes: map[string]string{
+					v1beta1.AttributeRedriveAllowPolicy: `{"redrivePermission":"allowAll"}`,
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -208,6 +221,21 @@ func TestGenerateQueueAttributes(t *testing.T) {
 				v1beta1.AttributeKmsMasterKeyID: kmsMasterKeyID,
 			},
 		},
+		"RedriveAllowPolicy": {
+			in: *sqsParams(func(p *v1beta1.QueueParameters) {
+				p.RedriveAllowPolicy = &v1beta1.RedriveAllowPolicy{
+					RedrivePermission: "byQueue",
+					SourceQueueARNs:   []string{arn},
+				}
+				p.SQSManagedSSEEnabled = aws.Bool(true)
+			}),
+			out: map[string]string{
+				v1beta1.AttributeDelaySeconds:         strconv.FormatInt(delaySeconds, 10),
+				v1beta1.AttributeRedriveAllowPolicy:   `{"redrivePermission":"byQueue","sourceQueueArns":["arn"]}`,
+				v1beta1.AttributeKmsMasterKeyID:       kmsMasterKeyID,
+				v1beta1.AttributeSqsManagedSseEnabled: "true",
+			},
+		},
 		"EmptyInput": {
 			in:  v1beta1.QueueParameters{},
 			out: nil,
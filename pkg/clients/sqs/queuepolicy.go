@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+)
+
+const (
+	errPolicyNotSpecified = "one of policy, rawPolicy or snsTopicArns must be specified"
+
+	// DefaultPolicyVersion is the policy language version used when the
+	// QueuePolicy does not specify a policy body.
+	DefaultPolicyVersion = "2012-10-17"
+
+	snsPrincipal       = "sns.amazonaws.com"
+	snsSendMessage     = "sqs:SendMessage"
+	snsStatementID     = "AllowSNSTopicsToSendMessage"
+	conditionArnEquals = "ArnEquals"
+	conditionSourceArn = "aws:SourceArn"
+)
+
+// RawQueuePolicy returns the JSON policy document described by the given
+// QueuePolicyParameters. Statements without a resource are applied to the
+// Queue with the given ARN.
+func RawQueuePolicy(p v1alpha1.QueuePolicyParameters, queueARN string) (string, error) {
+	if p.RawPolicy != nil {
+		return *p.RawPolicy, nil
+	}
+	if p.Policy == nil && len(p.SNSTopicARNs) == 0 {
+		return "", errors.New(errPolicyNotSpecified)
+	}
+	body := &v1alpha1.QueuePolicyBody{Version: DefaultPolicyVersion}
+	if p.Policy != nil {
+		body = p.Policy.DeepCopy()
+	}
+	if len(p.SNSTopicARNs) > 0 {
+		body.Statements = append(body.Statements, SNSTopicStatement(p.SNSTopicARNs))
+	}
+	m, err := SerializeQueuePolicy(body, queueARN)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(m)
+	return string(b), err
+}
+
+// SNSTopicStatement returns a statement that allows the given SNS topics to
+// send messages to the Queue.
+func SNSTopicStatement(topicARNs []string) v1alpha1.QueuePolicyStatement {
+	return v1alpha1.QueuePolicyStatement{
+		SID:       aws.String(snsStatementID),
+		Effect:    "Allow",
+		Principal: &v1alpha1.QueuePrincipal{Service: []string{snsPrincipal}},
+		Action:    []string{snsSendMessage},
+		Condition: []v1alpha1.Condition{{
+			OperatorKey: conditionArnEquals,
+			Conditions: []v1alpha1.ConditionPair{{
+				ConditionKey:       conditionSourceArn,
+				ConditionListValue: topicARNs,
+			}},
+		}},
+	}
+}
+
+// SerializeQueuePolicy is the custom marshaller for the QueuePolicyBody.
+func SerializeQueuePolicy(p *v1alpha1.QueuePolicyBody, queueARN string) (map[string]interface{}, error) {
+	m := map[string]interface{}{"Version": p.Version}
+	if aws.StringValue(p.ID) != "" {
+		m["Id"] = aws.StringValue(p.ID)
+	}
+	slc := make([]interface{}, len(p.Statements))
+	for i, s := range p.Statements {
+		st, err := serializeQueuePolicyStatement(s, queueARN)
+		if err != nil {
+			return nil, err
+		}
+		slc[i] = st
+	}
+	m["Statement"] = slc
+	return m, nil
+}
+
+func serializeQueuePolicyStatement(p v1alpha1.QueuePolicyStatement, queueARN string) (map[string]interface{}, error) {
+	m := map[string]interface{}{"Effect": p.Effect}
+	if p.SID != nil {
+		m["Sid"] = *p.SID
+	}
+	if p.Principal != nil {
+		m["Principal"] = serializeQueuePrincipal(p.Principal)
+	}
+	if p.NotPrincipal != nil {
+		m["NotPrincipal"] = serializeQueuePrincipal(p.NotPrincipal)
+	}
+	if len(p.Action) > 0 {
+		m["Action"] = tryFirst(p.Action)
+	}
+	if len(p.NotAction) > 0 {
+		m["NotAction"] = tryFirst(p.NotAction)
+	}
+	m["Resource"] = queueARN
+	if len(p.Resource) > 0 {
+		m["Resource"] = tryFirst(p.Resource)
+	}
+	if len(p.Condition) > 0 {
+		c, err := serializeQueueCondition(p.Condition)
+		if err != nil {
+			return nil, err
+		}
+		m["Condition"] = c
+	}
+	return m, nil
+}
+
+func serializeQueuePrincipal(p *v1alpha1.QueuePrincipal) interface{} {
+	if aws.BoolValue(p.AllowAnon) {
+		return "*"
+	}
+	m := map[string]interface{}{}
+	if len(p.Service) > 0 {
+		m["Service"] = tryFirst(p.Service)
+	}
+	if len(p.AWSPrincipals) > 0 {
+		principals := make([]string, len(p.AWSPrincipals))
+		for i, a := range p.AWSPrincipals {
+			// AWS converts account IDs to the ARN of their root user, so we
+			// do the same to be able to compare with the observed policy.
+			if _, err := strconv.ParseInt(a, 10, 64); err == nil {
+				a = fmt.Sprintf("arn:aws:iam::%s:root", a)
+			}
+			principals[i] = a
+		}
+		m["AWS"] = tryFirst(principals)
+	}
+	return m
+}
+
+func serializeQueueCondition(p []v1alpha1.Condition) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	for _, v := range p {
+		sub := map[string]interface{}{}
+		for _, c := range v.Conditions {
+			switch {
+			case c.ConditionStringValue != nil:
+				sub[c.ConditionKey] = *c.ConditionStringValue
+			case c.ConditionBooleanValue != nil:
+				sub[c.ConditionKey] = *c.ConditionBooleanValue
+			case c.ConditionNumericValue != nil:
+				sub[c.ConditionKey] = *c.ConditionNumericValue
+			case c.ConditionListValue != nil:
+				sub[c.ConditionKey] = tryFirst(c.ConditionListValue)
+			default:
+				return nil, fmt.Errorf("no value provided for key with value %s, condition %s", c.ConditionKey, v.OperatorKey)
+			}
+		}
+		m[v.OperatorKey] = sub
+	}
+	return m, nil
+}
+
+func tryFirst(slc []string) interface{} {
+	if len(slc) == 1 {
+		return slc[0]
+	}
+	return slc
+}
+
+// IsQueuePolicyUpToDate compares the given JSON policies regardless of their
+// formatting and the ordering of their lists.
+func IsQueuePolicyUpToDate(local, remote string) bool {
+	var l, r interface{}
+	if err := json.Unmarshal([]byte(local), &l); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(remote), &r); err != nil {
+		return false
+	}
+	sortSlices := cmpopts.SortSlices(func(x, y interface{}) bool {
+		a, aok := x.(string)
+		b, bok := y.(string)
+		return aok && bok && a < b
+	})
+	return cmp.Equal(l, r, cmpopts.EquateEmpty(), sortSlices)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+)
+
+var (
+	queueARN = "arn:aws:sqs:us-east-1:123456789012:queue"
+	topicARN = "arn:aws:sns:us-east-1:123456789012:topic"
+)
+
+func TestRawQueuePolicy(t *testing.T) {
+	type want struct {
+		policy string
+		err    error
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.QueuePolicyParameters
+		want want
+	}{
+		"RawPolicy": {
+			p: v1alpha1.QueuePolicyParameters{
+				RawPolicy: aws.String(`{"Version":"2012-10-17"}`),
+				Policy:    &v1alpha1.QueuePolicyBody{Version: "2008-10-17"},
+			},
+			want: want{
+				policy: `{"Version":"2012-10-17"}`,
+			},
+		},
+		"DefaultResource": {
+			p: v1alpha1.QueuePolicyParameters{
+				Policy: &v1alpha1.QueuePolicyBody{
+					Version: "2012-10-17",
+					Statements: []v1alpha1.QueuePolicyStatement{{
+						Effect:    "Allow",
+						Principal: &v1alpha1.QueuePrincipal{AWSPrincipals: []string{"123456789012"}},
+						Action:    []string{"sqs:ReceiveMessage"},
+					}},
+				},
+			},
+			want: want{
+				policy: `{"Statement":[{"Action":"sqs:ReceiveMessage","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"` + queueARN + `"}],"Version":"2012-10-17"}`,
+			},
+		},
+		"SNSTopics": {
+			p: v1alpha1.QueuePolicyParameters{
+				SNSTopicARNs: []string{topicARN},
+			},
+			want: want{
+				policy: `{"Statement":[{"Action":"sqs:SendMessage","Condition":{"ArnEquals":{"aws:SourceArn":"` + topicARN + `"}},"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Resource":"` + queueARN + `","Sid":"AllowSNSTopicsToSendMessage"}],"Version":"2012-10-17"}`,
+			},
+		},
+		"NotSpecified": {
+			p: v1alpha1.QueuePolicyParameters{},
+			want: want{
+				err: errors.New(errPolicyNotSpecified),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			policy, err := RawQueuePolicy(tc.p, queueARN)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, policy); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsQueuePolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		local  string
+		remote string
+		want   bool
+	}{
+		"SameUnordered": {
+			local:  `{"Statement":[{"Action":["sqs:SendMessage","sqs:ReceiveMessage"],"Effect":"Allow"}]}`,
+			remote: `{"Statement": [{"Effect": "Allow", "Action": ["sqs:ReceiveMessage", "sqs:SendMessage"]}]}`,
+			want:   true,
+		},
+		"Different": {
+			local:  `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow"}]}`,
+			remote: `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Deny"}]}`,
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsQueuePolicyUpToDate(tc.local, tc.remote)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -71,7 +71,6 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
 			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
-			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -133,11 +132,26 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}
 	}
 	if certificate.Status == awsacm.CertificateStatusIssued {
-		cr.SetConditions(xpv1.Available())
+		cr.SetConditions(xpv1.Available(), v1alpha1.Validated())
+	} else {
+		cr.SetConditions(v1alpha1.NotValidated(certificate.Status))
 	}
 
 	cr.Status.AtProvider = acm.GenerateCertificateStatus(certificate)
 
+	validation, err := e.observeValidationRecords(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	imported, err := e.isImportUpToDate(ctx, cr, certificate.Serial)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	exported, err := e.isExportUpToDate(ctx, cr, certificate)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	tags, err := e.client.ListTagsForCertificateRequest(&awsacm.ListTagsForCertificateInput{
 		CertificateArn: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
@@ -146,8 +160,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	return managed.ExternalObservation{
-		ResourceUpToDate: acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags),
-		ResourceExists:   true,
+		ResourceUpToDate: acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags) &&
+			validation.isUpToDate() && imported && exported,
+		ResourceExists: true,
 	}, nil
 }
 
@@ -158,6 +173,15 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if cr.Spec.ForProvider.Import != nil {
+		arn, err := e.importCertificate(ctx, cr, "")
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		meta.SetExternalName(cr, arn)
+		return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+	}
+
 	response, err := e.client.RequestCertificateRequest(acm.GenerateCreateCertificateInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
@@ -174,6 +198,15 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	if err := e.syncValidationRecords(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	conn, err := e.syncTLS(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	// Update Certificate tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 
@@ -208,8 +241,9 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	// Update the Certificate Option
-	if cr.Spec.ForProvider.CertificateTransparencyLoggingPreference != nil {
+	// Update the Certificate Option, which is not supported for imported
+	// certificates.
+	if cr.Spec.ForProvider.CertificateTransparencyLoggingPreference != nil && cr.Spec.ForProvider.Import == nil {
 		_, err := e.client.UpdateCertificateOptionsRequest(&awsacm.UpdateCertificateOptionsInput{
 			CertificateArn: aws.String(meta.GetExternalName(cr)),
 			Options:        &awsacm.CertificateOptions{CertificateTransparencyLoggingPreference: *cr.Spec.ForProvider.CertificateTransparencyLoggingPreference},
@@ -236,7 +270,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errIneligibleForRenewal)
 	}
 
-	return managed.ExternalUpdate{}, nil
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -147,7 +147,7 @@ func TestObserve(t *testing.T) {
 				cr: certificate(),
 			},
 			want: want{
-				cr: certificate(withCertificateArn(), withStatus(awsacm.CertificateStatusIssued), withConditions(xpv1.Available())),
+				cr: certificate(withCertificateArn(), withStatus(awsacm.CertificateStatusIssued), withConditions(xpv1.Available(), v1alpha1.Validated())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
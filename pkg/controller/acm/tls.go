@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/acm"
+)
+
+const (
+	errGetImportSecret     = "cannot get Secret of the imported Certificate"
+	errImport              = "failed to import the Certificate"
+	errGetConnectionSecret = "cannot get connection secret of Certificate"
+	errNoConnectionSecret  = "writeConnectionSecretToRef of Certificate must be set to export it"
+	errGeneratePassphrase  = "cannot generate passphrase to export Certificate"
+	errExport              = "failed to export the Certificate"
+)
+
+// getSecret returns the given Secret.
+func (e *external) getSecret(ctx context.Context, ref xpv1.SecretReference) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s)
+	return s, err
+}
+
+// isImportUpToDate returns whether the imported certificate is the one in its
+// Secret. It is always up to date if the certificate is not imported.
+func (e *external) isImportUpToDate(ctx context.Context, cr *v1alpha1.Certificate, serial *string) (bool, error) {
+	imp := cr.Spec.ForProvider.Import
+	if imp == nil {
+		return true, nil
+	}
+	s, err := e.getSecret(ctx, imp.SecretRef)
+	if err != nil {
+		return false, errors.Wrap(err, errGetImportSecret)
+	}
+	return acm.IsSameCertificate(serial, s.Data[corev1.TLSCertKey]), nil
+}
+
+// importCertificate imports the certificate from its Secret and returns its
+// ARN. The certificate with the given ARN is re-imported if it is not empty.
+func (e *external) importCertificate(ctx context.Context, cr *v1alpha1.Certificate, arn string) (string, error) {
+	s, err := e.getSecret(ctx, cr.Spec.ForProvider.Import.SecretRef)
+	if err != nil {
+		return "", errors.Wrap(err, errGetImportSecret)
+	}
+	in, err := acm.GenerateImportCertificateInput(arn, &cr.Spec.ForProvider, s)
+	if err != nil {
+		return "", errors.Wrap(err, errImport)
+	}
+	rsp, err := e.client.ImportCertificateRequest(in).Send(ctx)
+	if err != nil {
+		return "", awsclient.Wrap(err, errImport)
+	}
+	return aws.StringValue(rsp.CertificateArn), nil
+}
+
+// isExportUpToDate returns whether the connection secret contains the issued
+// certificate. It is always up to date if the certificate is not exported or
+// not issued yet.
+func (e *external) isExportUpToDate(ctx context.Context, cr *v1alpha1.Certificate, certificate awsacm.CertificateDetail) (bool, error) {
+	if !aws.BoolValue(cr.Spec.ForProvider.ExportToConnectionSecret) || certificate.Status != awsacm.CertificateStatusIssued {
+		return true, nil
+	}
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return false, errors.New(errNoConnectionSecret)
+	}
+	s, err := e.getSecret(ctx, xpv1.SecretReference{Name: ref.Name, Namespace: ref.Namespace})
+	if resource.IgnoreNotFound(err) != nil {
+		return false, errors.Wrap(err, errGetConnectionSecret)
+	}
+	return acm.IsSameCertificate(certificate.Serial, s.Data[corev1.TLSCertKey]), nil
+}
+
+// export exports the certificate with a generated passphrase, and returns the
+// certificate followed by its chain, its decrypted private key and its chain
+// as connection details.
+func (e *external) export(ctx context.Context, cr *v1alpha1.Certificate) (managed.ConnectionDetails, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errors.Wrap(err, errGeneratePassphrase)
+	}
+	passphrase := []byte(hex.EncodeToString(b))
+	rsp, err := e.client.ExportCertificateRequest(&awsacm.ExportCertificateInput{
+		CertificateArn: aws.String(meta.GetExternalName(cr)),
+		Passphrase:     passphrase,
+	}).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errExport)
+	}
+	key, err := acm.DecryptPrivateKey([]byte(aws.StringValue(rsp.PrivateKey)), passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, errExport)
+	}
+	cert := strings.TrimSpace(aws.StringValue(rsp.Certificate)) + "\n"
+	chain := aws.StringValue(rsp.CertificateChain)
+	return managed.ConnectionDetails{
+		corev1.TLSCertKey:             []byte(cert + chain),
+		corev1.TLSPrivateKeyKey:       key,
+		acm.SecretKeyCertificateChain: []byte(chain),
+	}, nil
+}
+
+// syncTLS re-imports the certificate if its Secret changed, and exports it if
+// the connection secret does not contain the issued certificate yet.
+func (e *external) syncTLS(ctx context.Context, cr *v1alpha1.Certificate) (managed.ConnectionDetails, error) {
+	if cr.Spec.ForProvider.Import == nil && !aws.BoolValue(cr.Spec.ForProvider.ExportToConnectionSecret) {
+		return nil, nil
+	}
+	rsp, err := e.client.DescribeCertificateRequest(&awsacm.DescribeCertificateInput{
+		CertificateArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errGet)
+	}
+	if rsp.Certificate == nil {
+		return nil, errors.New(errSDK)
+	}
+	imported, err := e.isImportUpToDate(ctx, cr, rsp.Certificate.Serial)
+	if err != nil {
+		return nil, err
+	}
+	if !imported {
+		if _, err := e.importCertificate(ctx, cr, meta.GetExternalName(cr)); err != nil {
+			return nil, err
+		}
+	}
+	exported, err := e.isExportUpToDate(ctx, cr, *rsp.Certificate)
+	if err != nil || exported {
+		return nil, err
+	}
+	return e.export(ctx, cr)
+}
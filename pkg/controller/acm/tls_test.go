@@ -0,0 +1,432 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/youmark/pkcs8"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	acm "github.com/crossplane/provider-aws/pkg/clients/acm"
+	"github.com/crossplane/provider-aws/pkg/clients/acm/fake"
+)
+
+const (
+	importSecretName     = "imported"
+	connectionSecretName = "connection"
+)
+
+type testTLS struct {
+	key  *ecdsa.PrivateKey
+	cert []byte
+}
+
+func newTestTLS(t *testing.T, serial int64) testTLS {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: domainName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testTLS{key: key, cert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (tt testTLS) privateKey(t *testing.T) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(tt.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func (tt testTLS) encryptedPrivateKey(t *testing.T, passphrase []byte) string {
+	t.Helper()
+	der, err := pkcs8.MarshalPrivateKey(tt.key, passphrase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}))
+}
+
+// mockSecrets returns a Get function that returns the given Secrets by name.
+func mockSecrets(secrets map[string]map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		data, ok := secrets[key.Name]
+		if !ok {
+			return errBoom
+		}
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func withImport() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Spec.ForProvider.Import = &v1alpha1.CertificateImport{
+			SecretRef: xpv1.SecretReference{Name: importSecretName, Namespace: "default"},
+		}
+	}
+}
+
+func withExport() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Spec.ForProvider.ExportToConnectionSecret = aws.Bool(true)
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: connectionSecretName, Namespace: "default"}
+	}
+}
+
+func describeCertificate(c *awsacm.CertificateDetail) func(*awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+	return func(*awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+		return awsacm.DescribeCertificateRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{Certificate: c}},
+		}
+	}
+}
+
+func TestImportCertificate(t *testing.T) {
+	tt := newTestTLS(t, 1)
+
+	type args struct {
+		secrets map[string]map[string][]byte
+		cr      *v1alpha1.Certificate
+		arn     string
+		err     error
+	}
+	type want struct {
+		in  *awsacm.ImportCertificateInput
+		arn string
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Imported": {
+			args: args{
+				secrets: map[string]map[string][]byte{importSecretName: {
+					corev1.TLSCertKey:       tt.cert,
+					corev1.TLSPrivateKeyKey: tt.privateKey(t),
+				}},
+				cr: certificate(withImport(), withTags()),
+			},
+			want: want{
+				in: &awsacm.ImportCertificateInput{
+					Certificate: tt.cert,
+					PrivateKey:  tt.privateKey(t),
+					Tags:        []awsacm.Tag{{Key: aws.String("Name"), Value: aws.String("somename")}},
+				},
+				arn: certificateArn,
+			},
+		},
+		"Reimported": {
+			args: args{
+				secrets: map[string]map[string][]byte{importSecretName: {
+					corev1.TLSCertKey:       tt.cert,
+					corev1.TLSPrivateKeyKey: tt.privateKey(t),
+				}},
+				cr:  certificate(withImport(), withTags()),
+				arn: certificateArn,
+			},
+			want: want{
+				in: &awsacm.ImportCertificateInput{
+					CertificateArn: aws.String(certificateArn),
+					Certificate:    tt.cert,
+					PrivateKey:     tt.privateKey(t),
+				},
+				arn: certificateArn,
+			},
+		},
+		"GetSecretFailed": {
+			args: args{
+				cr: certificate(withImport()),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetImportSecret),
+			},
+		},
+		"NoPrivateKey": {
+			args: args{
+				secrets: map[string]map[string][]byte{importSecretName: {
+					corev1.TLSCertKey: tt.cert,
+				}},
+				cr: certificate(withImport()),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(corev1.TLSPrivateKeyKey+" is empty"), errImport),
+			},
+		},
+		"ImportFailed": {
+			args: args{
+				secrets: map[string]map[string][]byte{importSecretName: {
+					corev1.TLSCertKey:       tt.cert,
+					corev1.TLSPrivateKeyKey: tt.privateKey(t),
+				}},
+				cr:  certificate(withImport()),
+				err: errBoom,
+			},
+			want: want{
+				in: &awsacm.ImportCertificateInput{
+					Certificate: tt.cert,
+					PrivateKey:  tt.privateKey(t),
+				},
+				err: awsclient.Wrap(errBoom, errImport),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var in *awsacm.ImportCertificateInput
+			e := &external{
+				client: &fake.MockCertificateClient{
+					MockImportCertificateRequest: func(input *awsacm.ImportCertificateInput) awsacm.ImportCertificateRequest {
+						in = input
+						return awsacm.ImportCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.args.err, Data: &awsacm.ImportCertificateOutput{
+								CertificateArn: aws.String(certificateArn),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecrets(tc.args.secrets)},
+			}
+			arn, err := e.importCertificate(context.Background(), tc.args.cr, tc.args.arn)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, arn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncTLS(t *testing.T) {
+	current := newTestTLS(t, 1)
+	rotated := newTestTLS(t, 2)
+	chain := newTestTLS(t, 3)
+
+	type args struct {
+		secrets     map[string]map[string][]byte
+		certificate *awsacm.CertificateDetail
+		exportKey   func(passphrase []byte) string
+		exportErr   error
+		cr          *v1alpha1.Certificate
+	}
+	type want struct {
+		conn       managed.ConnectionDetails
+		reimported bool
+		exported   bool
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotImportedNorExported": {
+			args: args{
+				cr: certificate(),
+			},
+			want: want{},
+		},
+		"ImportUpToDate": {
+			args: args{
+				secrets: map[string]map[string][]byte{importSecretName: {
+					corev1.TLSCertKey:       current.cert,
+					corev1.TLSPrivateKeyKey: current.privateKey(t),
+				}},
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				cr:          certificate(withImport()),
+			},
+			want: want{},
+		},
+		"SecretRotated": {
+			args: args{
+				secrets: map[string]map[string][]byte{importSecretName: {
+					corev1.TLSCertKey:       rotated.cert,
+					corev1.TLSPrivateKeyKey: rotated.privateKey(t),
+				}},
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				cr:          certificate(withImport()),
+			},
+			want: want{reimported: true},
+		},
+		"DescribeFailed": {
+			args: args{
+				cr: certificate(withImport()),
+			},
+			want: want{
+				err: errors.New(errSDK),
+			},
+		},
+		"ExportUpToDate": {
+			args: args{
+				secrets: map[string]map[string][]byte{connectionSecretName: {
+					corev1.TLSCertKey: current.cert,
+				}},
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				cr:          certificate(withExport()),
+			},
+			want: want{},
+		},
+		"NotIssued": {
+			args: args{
+				certificate: &awsacm.CertificateDetail{Status: awsacm.CertificateStatusPendingValidation},
+				cr:          certificate(withExport()),
+			},
+			want: want{},
+		},
+		"Exported": {
+			args: args{
+				secrets:     map[string]map[string][]byte{connectionSecretName: {}},
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				exportKey: func(passphrase []byte) string {
+					return current.encryptedPrivateKey(t, passphrase)
+				},
+				cr: certificate(withExport()),
+			},
+			want: want{
+				conn: managed.ConnectionDetails{
+					corev1.TLSCertKey:             append(append([]byte{}, current.cert...), chain.cert...),
+					corev1.TLSPrivateKeyKey:       current.privateKey(t),
+					acm.SecretKeyCertificateChain: chain.cert,
+				},
+				exported: true,
+			},
+		},
+		"NoConnectionSecret": {
+			args: args{
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				cr: certificate(withExport(), func(r *v1alpha1.Certificate) {
+					r.Spec.WriteConnectionSecretToReference = nil
+				}),
+			},
+			want: want{
+				err: errors.New(errNoConnectionSecret),
+			},
+		},
+		"ExportFailed": {
+			args: args{
+				secrets:     map[string]map[string][]byte{connectionSecretName: {}},
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				exportErr:   errBoom,
+				cr:          certificate(withExport()),
+			},
+			want: want{
+				exported: true,
+				err:      awsclient.Wrap(errBoom, errExport),
+			},
+		},
+		"WrongPassphrase": {
+			args: args{
+				secrets:     map[string]map[string][]byte{connectionSecretName: {}},
+				certificate: &awsacm.CertificateDetail{Serial: aws.String("01"), Status: awsacm.CertificateStatusIssued},
+				exportKey: func([]byte) string {
+					return current.encryptedPrivateKey(t, []byte("wrong"))
+				},
+				cr: certificate(withExport()),
+			},
+			want: want{
+				exported: true,
+				err:      errors.Wrap(errors.Wrap(errors.New("pkcs8: incorrect password"), "cannot decrypt private key"), errExport),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var reimported, exported bool
+			e := &external{
+				client: &fake.MockCertificateClient{
+					MockDescribeCertificateRequest: describeCertificate(tc.args.certificate),
+					MockImportCertificateRequest: func(input *awsacm.ImportCertificateInput) awsacm.ImportCertificateRequest {
+						reimported = aws.StringValue(input.CertificateArn) == certificateArn
+						return awsacm.ImportCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ImportCertificateOutput{
+								CertificateArn: input.CertificateArn,
+							}},
+						}
+					},
+					MockExportCertificateRequest: func(input *awsacm.ExportCertificateInput) awsacm.ExportCertificateRequest {
+						exported = true
+						out := &awsacm.ExportCertificateOutput{
+							Certificate:      aws.String(string(current.cert)),
+							CertificateChain: aws.String(string(chain.cert)),
+						}
+						if tc.args.exportKey != nil {
+							out.PrivateKey = aws.String(tc.args.exportKey(input.Passphrase))
+						}
+						return awsacm.ExportCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.args.exportErr, Data: out},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecrets(tc.args.secrets)},
+			}
+			conn, err := e.syncTLS(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reimported, reimported); diff != "" {
+				t.Errorf("reimported: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.exported, exported); diff != "" {
+				t.Errorf("exported: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
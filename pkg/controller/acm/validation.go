@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+)
+
+const (
+	errNoHostedZoneID         = "hostedZoneId of Route53 validation is not set"
+	errListValidationRecords  = "cannot list validation ResourceRecordSets of Certificate"
+	errCreateValidationRecord = "cannot create validation ResourceRecordSet of Certificate"
+	errDeleteValidationRecord = "cannot delete validation ResourceRecordSet of Certificate"
+
+	defaultValidationTTL = 300
+)
+
+// validationRecords are the ResourceRecordSets that have to be created and
+// deleted so that the DNS validation records of a certificate are published.
+type validationRecords struct {
+	missing []*route53v1alpha1.ResourceRecordSet
+	stale   []route53v1alpha1.ResourceRecordSet
+}
+
+func (v validationRecords) isUpToDate() bool {
+	return len(v.missing) == 0 && len(v.stale) == 0
+}
+
+// observeValidationRecords compares the validation ResourceRecordSets of the
+// certificate with the DNS validation records reported by ACM. Nothing is
+// observed if Route53 validation is not enabled.
+func (e *external) observeValidationRecords(ctx context.Context, cr *v1alpha1.Certificate) (validationRecords, error) {
+	v := cr.Spec.ForProvider.Validation
+	if v == nil || v.Route53 == nil {
+		return validationRecords{}, nil
+	}
+	if aws.StringValue(v.Route53.HostedZoneID) == "" {
+		return validationRecords{}, errors.New(errNoHostedZoneID)
+	}
+	l := &route53v1alpha1.ResourceRecordSetList{}
+	if err := e.kube.List(ctx, l, client.MatchingLabels{v1alpha1.LabelKeyCertificate: cr.GetName()}); err != nil {
+		return validationRecords{}, errors.Wrap(err, errListValidationRecords)
+	}
+	desired := generateValidationRecordSets(cr)
+	existing := map[string]bool{}
+	out := validationRecords{}
+	for _, rrs := range l.Items {
+		existing[rrs.GetName()] = true
+		if _, ok := desired[rrs.GetName()]; !ok && !meta.WasDeleted(&rrs) {
+			out.stale = append(out.stale, rrs)
+		}
+	}
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !existing[name] {
+			out.missing = append(out.missing, desired[name])
+		}
+	}
+	return out, nil
+}
+
+// syncValidationRecords creates the missing validation ResourceRecordSets of
+// the certificate and deletes the ones that are no longer needed.
+func (e *external) syncValidationRecords(ctx context.Context, cr *v1alpha1.Certificate) error {
+	v, err := e.observeValidationRecords(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for _, rrs := range v.missing {
+		if err := e.kube.Create(ctx, rrs); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+			return errors.Wrap(err, errCreateValidationRecord)
+		}
+	}
+	for i := range v.stale {
+		if err := e.kube.Delete(ctx, &v.stale[i]); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errDeleteValidationRecord)
+		}
+	}
+	return nil
+}
+
+// generateValidationRecordSets returns the ResourceRecordSets that publish
+// the DNS validation records of the certificate, keyed by their names.
+// Domains that share a validation record, like a domain and its wildcard,
+// share the ResourceRecordSet.
+func generateValidationRecordSets(cr *v1alpha1.Certificate) map[string]*route53v1alpha1.ResourceRecordSet {
+	r53 := cr.Spec.ForProvider.Validation.Route53
+	ttl := int64(defaultValidationTTL)
+	if r53.TTL != nil {
+		ttl = *r53.TTL
+	}
+	out := map[string]*route53v1alpha1.ResourceRecordSet{}
+	for _, r := range cr.Status.AtProvider.DomainValidationRecords {
+		name := validationRecordSetName(cr.GetName(), r.Name)
+		if _, ok := out[name]; ok {
+			continue
+		}
+		rrs := &route53v1alpha1.ResourceRecordSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1alpha1.LabelKeyCertificate: cr.GetName()},
+			},
+			Spec: route53v1alpha1.ResourceRecordSetSpec{
+				ForProvider: route53v1alpha1.ResourceRecordSetParameters{
+					Type:            string(r.Type),
+					TTL:             aws.Int64(ttl),
+					ResourceRecords: []route53v1alpha1.ResourceRecord{{Value: r.Value}},
+					ZoneID:          r53.HostedZoneID,
+				},
+			},
+		}
+		meta.SetExternalName(rrs, r.Name)
+		rrs.SetProviderConfigReference(cr.GetProviderConfigReference())
+		rrs.SetDeletionPolicy(cr.GetDeletionPolicy())
+		meta.AddOwnerReference(rrs, meta.AsController(meta.TypedReferenceTo(cr, v1alpha1.CertificateGroupVersionKind)))
+		out[name] = rrs
+	}
+	return out
+}
+
+// validationRecordSetName derives the name of a validation ResourceRecordSet
+// from the record name, since the latter is not a valid object name.
+func validationRecordSetName(certificate, record string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.ToLower(strings.TrimSuffix(record, "."))))
+	return fmt.Sprintf("%s-%08x", certificate, h.Sum32())
+}
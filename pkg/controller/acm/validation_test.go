@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+)
+
+func withRoute53Validation() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.SetName("example")
+		r.Spec.ForProvider.Validation = &v1alpha1.CertificateValidation{
+			Route53: &v1alpha1.Route53Validation{HostedZoneID: aws.String("Z123")},
+		}
+		r.Status.AtProvider.DomainValidationRecords = []v1alpha1.DomainValidationRecord{
+			{DomainName: "example.com", Name: "_a.example.com.", Type: awsacm.RecordTypeCname, Value: "_b.acm-validations.aws."},
+			{DomainName: "*.example.com", Name: "_a.example.com.", Type: awsacm.RecordTypeCname, Value: "_b.acm-validations.aws."},
+			{DomainName: "api.example.org", Name: "_c.api.example.org.", Type: awsacm.RecordTypeCname, Value: "_d.acm-validations.aws."},
+		}
+	}
+}
+
+func recordSet(name string) route53v1alpha1.ResourceRecordSet {
+	rrs := route53v1alpha1.ResourceRecordSet{}
+	rrs.SetName(name)
+	return rrs
+}
+
+func TestObserveValidationRecords(t *testing.T) {
+	apex := validationRecordSetName("example", "_a.example.com.")
+	api := validationRecordSetName("example", "_c.api.example.org.")
+
+	type want struct {
+		missing []string
+		stale   []string
+	}
+
+	cases := map[string]struct {
+		existing []route53v1alpha1.ResourceRecordSet
+		cr       *v1alpha1.Certificate
+		want     want
+	}{
+		"Disabled": {
+			cr: certificate(),
+		},
+		"Missing": {
+			existing: []route53v1alpha1.ResourceRecordSet{recordSet(apex)},
+			cr:       certificate(withRoute53Validation()),
+			want:     want{missing: []string{api}},
+		},
+		"Stale": {
+			existing: []route53v1alpha1.ResourceRecordSet{recordSet(apex), recordSet(api), recordSet("example-old")},
+			cr:       certificate(withRoute53Validation()),
+			want:     want{stale: []string{"example-old"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: &test.MockClient{
+				MockList: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					list.(*route53v1alpha1.ResourceRecordSetList).Items = tc.existing
+					return nil
+				},
+			}}
+			v, err := e.observeValidationRecords(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := want{}
+			for _, rrs := range v.missing {
+				got.missing = append(got.missing, rrs.GetName())
+			}
+			for _, rrs := range v.stale {
+				got.stale = append(got.stale, rrs.GetName())
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
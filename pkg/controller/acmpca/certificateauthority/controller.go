@@ -54,6 +54,12 @@ const (
 	errListTagsFailed       = "failed to list tags for ACMPCA"
 	errRemoveTagsFailed     = "failed to remove tags for ACMPCA"
 	errCertificateAuthority = "failed to update the ACMPCA resource"
+
+	errGetCSR            = "cannot get the certificate signing request of the ACMPCA"
+	errIssue             = "cannot issue the certificate of the ACMPCA"
+	errRecordCertificate = "cannot record the issued certificate of the ACMPCA"
+	errGetCertificate    = "cannot get the certificate of the ACMPCA"
+	errImportCertificate = "cannot import the certificate of the ACMPCA"
 )
 
 // SetupCertificateAuthority adds a controller that reconciles ACMPCA.
@@ -147,9 +153,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(acmpca.IsErrorNotFound, err), errListTagsFailed)
 	}
 
+	// NOTE: A certificate authority whose certificate we can issue is not up
+	// to date until the certificate is installed.
+	pending := certificateAuthority.Status == awsacmpca.CertificateAuthorityStatusPendingCertificate && acmpca.IsCertificateIssuable(cr.Spec.ForProvider)
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
+		ResourceUpToDate: !pending && acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
 	}, nil
 }
 
@@ -176,6 +186,13 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	if cr.Status.AtProvider.Status == string(awsacmpca.CertificateAuthorityStatusPendingCertificate) && acmpca.IsCertificateIssuable(cr.Spec.ForProvider) {
+		installed, err := e.installCertificate(ctx, cr)
+		if err != nil || !installed {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	// Update the Certificate Authority tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 		tags := make([]awsacmpca.Tag, len(cr.Spec.ForProvider.Tags))
@@ -216,6 +233,57 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errCertificateAuthority)
 }
 
+// installCertificate issues the certificate of the certificate authority from
+// its CSR and installs it. The ARN of the issued certificate is recorded in an
+// annotation so that it is not issued again while it is pending. It returns
+// false if the CSR or the certificate is not ready yet, in which case it
+// should be called again later.
+func (e *external) installCertificate(ctx context.Context, cr *v1alpha1.CertificateAuthority) (bool, error) {
+	arn := meta.GetExternalName(cr)
+	issued := cr.GetAnnotations()[v1alpha1.AnnotationKeyIssuedCertificateARN]
+	if issued == "" {
+		csr, err := e.client.GetCertificateAuthorityCsrRequest(&awsacmpca.GetCertificateAuthorityCsrInput{
+			CertificateAuthorityArn: aws.String(arn),
+		}).Send(ctx)
+		if acmpca.IsRequestInProgress(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, awsclient.Wrap(err, errGetCSR)
+		}
+		in := acmpca.GenerateIssueCACertificateInput(arn, cr.Spec.ForProvider, []byte(aws.StringValue(csr.Csr)), string(cr.GetUID()))
+		rsp, err := e.client.IssueCertificateRequest(in).Send(ctx)
+		if err != nil {
+			return false, awsclient.Wrap(err, errIssue)
+		}
+		issued = aws.StringValue(rsp.CertificateArn)
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyIssuedCertificateARN: issued})
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return false, errors.Wrap(err, errRecordCertificate)
+		}
+	}
+	cert, err := e.client.GetCertificateRequest(&awsacmpca.GetCertificateInput{
+		CertificateArn:          aws.String(issued),
+		CertificateAuthorityArn: acmpca.IssuerARN(arn, cr.Spec.ForProvider),
+	}).Send(ctx)
+	if acmpca.IsRequestInProgress(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, awsclient.Wrap(err, errGetCertificate)
+	}
+
+	imp := &awsacmpca.ImportCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(arn),
+		Certificate:             []byte(aws.StringValue(cert.Certificate)),
+	}
+	if cert.CertificateChain != nil {
+		imp.CertificateChain = []byte(aws.StringValue(cert.CertificateChain))
+	}
+	_, err = e.client.ImportCertificateAuthorityCertificateRequest(imp).Send(ctx)
+	return err == nil, awsclient.Wrap(err, errImportCertificate)
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1alpha1.CertificateAuthority)
 	if !ok {
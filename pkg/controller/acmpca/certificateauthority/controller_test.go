@@ -26,6 +26,7 @@ import (
 	awsacmpca "github.com/aws/aws-sdk-go-v2/service/acmpca"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -594,3 +595,213 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestInstallCertificate(t *testing.T) {
+	issuedArn := certificateAuthorityArn + "/certificate/someid"
+	parentArn := "someparentarn"
+	inProgress := awserr.New(awsacmpca.ErrCodeRequestInProgressException, "", nil)
+
+	withType := func(t awsacmpca.CertificateAuthorityType) certificateAuthorityModifier {
+		return func(r *v1alpha1.CertificateAuthority) { r.Spec.ForProvider.Type = t }
+	}
+	withParent := func(r *v1alpha1.CertificateAuthority) {
+		r.Spec.ForProvider.ParentCertificateAuthorityARN = aws.String(parentArn)
+	}
+	withIssued := func(r *v1alpha1.CertificateAuthority) {
+		meta.AddAnnotations(r, map[string]string{v1alpha1.AnnotationKeyIssuedCertificateARN: issuedArn})
+	}
+
+	type args struct {
+		csrErr    error
+		issueErr  error
+		getErr    error
+		importErr error
+		kube      client.Client
+		cr        *v1alpha1.CertificateAuthority
+	}
+	type want struct {
+		cr        *v1alpha1.CertificateAuthority
+		installed bool
+		issued    bool
+		get       *awsacmpca.GetCertificateInput
+		err       error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Installed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr:        certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+				installed: true,
+				issued:    true,
+				get: &awsacmpca.GetCertificateInput{
+					CertificateArn:          aws.String(issuedArn),
+					CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+				},
+			},
+		},
+		"CSRInProgress": {
+			args: args{
+				csrErr: inProgress,
+				cr:     certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr: certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+		},
+		"IssueFailed": {
+			args: args{
+				issueErr: errBoom,
+				cr:       certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr:     certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+				issued: true,
+				err:    awsclient.Wrap(errBoom, errIssue),
+			},
+		},
+		"RecordFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr:     certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+				issued: true,
+				err:    errors.Wrap(errBoom, errRecordCertificate),
+			},
+		},
+		"CertificateInProgress": {
+			args: args{
+				getErr: inProgress,
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:     certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr:     certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+				issued: true,
+				get: &awsacmpca.GetCertificateInput{
+					CertificateArn:          aws.String(issuedArn),
+					CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+				},
+			},
+		},
+		"AlreadyIssued": {
+			args: args{
+				cr: certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+			},
+			want: want{
+				cr:        certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+				installed: true,
+				get: &awsacmpca.GetCertificateInput{
+					CertificateArn:          aws.String(issuedArn),
+					CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+				},
+			},
+		},
+		"IssuedByParent": {
+			args: args{
+				cr: certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeSubordinate), withParent, withIssued),
+			},
+			want: want{
+				cr:        certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeSubordinate), withParent, withIssued),
+				installed: true,
+				get: &awsacmpca.GetCertificateInput{
+					CertificateArn:          aws.String(issuedArn),
+					CertificateAuthorityArn: aws.String(parentArn),
+				},
+			},
+		},
+		"GetCertificateFailed": {
+			args: args{
+				getErr: errBoom,
+				cr:     certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+			},
+			want: want{
+				cr: certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+				get: &awsacmpca.GetCertificateInput{
+					CertificateArn:          aws.String(issuedArn),
+					CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+				},
+				err: awsclient.Wrap(errBoom, errGetCertificate),
+			},
+		},
+		"ImportFailed": {
+			args: args{
+				importErr: errBoom,
+				cr:        certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+			},
+			want: want{
+				cr: certificateAuthority(withType(awsacmpca.CertificateAuthorityTypeRoot), withIssued),
+				get: &awsacmpca.GetCertificateInput{
+					CertificateArn:          aws.String(issuedArn),
+					CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+				},
+				err: awsclient.Wrap(errBoom, errImportCertificate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var issued bool
+			var get *awsacmpca.GetCertificateInput
+			e := &external{
+				client: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(*awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.args.csrErr, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String("somecsr"),
+							}},
+						}
+					},
+					MockIssueCertificateRequest: func(*awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						issued = true
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.args.issueErr, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(issuedArn),
+							}},
+						}
+					},
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						get = input
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.args.getErr, Data: &awsacmpca.GetCertificateOutput{
+								Certificate: aws.String("somecertificate"),
+							}},
+						}
+					},
+					MockImportCertificateAuthorityCertificateRequest: func(*awsacmpca.ImportCertificateAuthorityCertificateInput) awsacmpca.ImportCertificateAuthorityCertificateRequest {
+						return awsacmpca.ImportCertificateAuthorityCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.args.importErr, Data: &awsacmpca.ImportCertificateAuthorityCertificateOutput{}},
+						}
+					},
+				},
+				kube: tc.args.kube,
+			}
+			installed, err := e.installCertificate(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.installed, installed); diff != "" {
+				t.Errorf("installed: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.issued, issued); diff != "" {
+				t.Errorf("issued: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.get, get); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
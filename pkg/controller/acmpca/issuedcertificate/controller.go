@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuedcertificate
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacmpca "github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an IssuedCertificate resource"
+	errGet              = "cannot get the issued certificate"
+	errIssue            = "cannot issue the certificate"
+	errRevoke           = "cannot revoke the issued certificate"
+	errGetSecret        = "cannot get the connection secret"
+	errKubeUpdateFailed = "cannot update IssuedCertificate custom resource"
+)
+
+// SetupIssuedCertificate adds a controller that reconciles IssuedCertificates.
+func SetupIssuedCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.IssuedCertificateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.IssuedCertificate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IssuedCertificateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewIssuedCertificateClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) acmpca.IssuedCertificateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.IssuedCertificate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.client, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{c.newClientFn(cfg), c.client}, nil
+}
+
+type external struct {
+	client acmpca.IssuedCertificateClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.IssuedCertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// NOTE: Issued certificates cannot be deleted, they are at most revoked.
+	// We consider them gone once we have been through Delete.
+	if meta.WasDeleted(cr) && cr.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonDeleting {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetCertificateRequest(&awsacmpca.GetCertificateInput{
+		CertificateArn:          aws.String(meta.GetExternalName(cr)),
+		CertificateAuthorityArn: cr.Spec.ForProvider.CertificateAuthorityARN,
+	}).Send(ctx)
+	if acmpca.IsRequestInProgress(err) {
+		// NOTE: The previous certificate stays available while its renewal
+		// is being issued.
+		if cr.Status.AtProvider.Serial == "" {
+			cr.SetConditions(xpv1.Creating())
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(acmpca.IsCertificateNotFound, err), errGet)
+	}
+
+	c, err := acmpca.ParseCertificate(aws.StringValue(rsp.Certificate))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+	cr.Status.AtProvider = acmpca.GenerateIssuedCertificateObservation(cr.Spec.ForProvider, c)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  time.Now().Before(acmpca.RenewalTime(cr.Spec.ForProvider, c)),
+		ConnectionDetails: acmpca.GenerateIssuedCertificateConnectionDetails(rsp.GetCertificateOutput),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.IssuedCertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	arn, conn, err := e.issue(ctx, cr, string(cr.GetUID()))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, arn)
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: conn}, nil
+}
+
+// Update renews the certificate, which is the only reason for it to be out of
+// date.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.IssuedCertificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// NOTE: The renewal is issued with a token derived from the certificate it
+	// renews so that retrying a failed update doesn't issue another one.
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(meta.GetExternalName(cr)))
+	arn, conn, err := e.issue(ctx, cr, fmt.Sprintf("%08x", h.Sum32()))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	meta.SetExternalName(cr, arn)
+	return managed.ExternalUpdate{ConnectionDetails: conn}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+// issue issues a certificate and returns its ARN. A private key found in the
+// connection secret is reused, otherwise a new one is generated and returned
+// as connection details.
+func (e *external) issue(ctx context.Context, cr *v1alpha1.IssuedCertificate, token string) (string, managed.ConnectionDetails, error) {
+	key, err := e.getPrivateKey(ctx, cr)
+	if err != nil {
+		return "", nil, err
+	}
+	csr, key, err := acmpca.GenerateCertificateSigningRequest(cr.Spec.ForProvider, key)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errIssue)
+	}
+	rsp, err := e.client.IssueCertificateRequest(acmpca.GenerateIssueCertificateInput(cr.Spec.ForProvider, csr, token)).Send(ctx)
+	if err != nil {
+		return "", nil, awsclient.Wrap(err, errIssue)
+	}
+	conn := managed.ConnectionDetails{}
+	if key != nil {
+		conn[corev1.TLSPrivateKeyKey] = key
+	}
+	return aws.StringValue(rsp.CertificateArn), conn, nil
+}
+
+// getPrivateKey returns the private key in the connection secret, if any.
+func (e *external) getPrivateKey(ctx context.Context, cr *v1alpha1.IssuedCertificate) ([]byte, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil || cr.Spec.ForProvider.CertificateSigningRequest != nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrap(resource.IgnoreNotFound(err), errGetSecret)
+	}
+	return s.Data[corev1.TLSPrivateKeyKey], nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.IssuedCertificate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	if cr.Spec.ForProvider.RevocationReason == nil || cr.Status.AtProvider.Serial == "" {
+		return nil
+	}
+	_, err := e.client.RevokeCertificateRequest(&awsacmpca.RevokeCertificateInput{
+		CertificateAuthorityArn: cr.Spec.ForProvider.CertificateAuthorityARN,
+		CertificateSerial:       aws.String(cr.Status.AtProvider.Serial),
+		RevocationReason:        awsacmpca.RevocationReason(aws.StringValue(cr.Spec.ForProvider.RevocationReason)),
+	}).Send(ctx)
+	if acmpca.IsRequestAlreadyProcessed(err) || acmpca.IsCertificateNotFound(err) {
+		return nil
+	}
+	return awsclient.Wrap(err, errRevoke)
+}
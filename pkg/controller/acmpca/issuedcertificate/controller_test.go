@@ -0,0 +1,384 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuedcertificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsacmpca "github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/clients/acmpca/fake"
+)
+
+var (
+	caARN   = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/ca"
+	certARN = caARN + "/certificate/cert"
+	csr     = "-----BEGIN CERTIFICATE REQUEST-----"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client acmpca.IssuedCertificateClient
+	cr     *v1alpha1.IssuedCertificate
+}
+
+type issuedCertificateModifier func(*v1alpha1.IssuedCertificate)
+
+func withExternalName(name string) issuedCertificateModifier {
+	return func(r *v1alpha1.IssuedCertificate) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) issuedCertificateModifier {
+	return func(r *v1alpha1.IssuedCertificate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.IssuedCertificateObservation) issuedCertificateModifier {
+	return func(r *v1alpha1.IssuedCertificate) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp(t time.Time) issuedCertificateModifier {
+	return func(r *v1alpha1.IssuedCertificate) { r.SetDeletionTimestamp(&metav1.Time{Time: t}) }
+}
+
+func withRevocationReason(reason string) issuedCertificateModifier {
+	return func(r *v1alpha1.IssuedCertificate) { r.Spec.ForProvider.RevocationReason = aws.String(reason) }
+}
+
+func issuedCertificate(m ...issuedCertificateModifier) *v1alpha1.IssuedCertificate {
+	cr := &v1alpha1.IssuedCertificate{
+		Spec: v1alpha1.IssuedCertificateSpec{
+			ForProvider: v1alpha1.IssuedCertificateParameters{
+				CertificateAuthorityARN:   aws.String(caARN),
+				CertificateSigningRequest: aws.String(csr),
+				SigningAlgorithm:          awsacmpca.SigningAlgorithmSha256withrsa,
+				Validity:                  v1alpha1.Validity{Type: awsacmpca.ValidityPeriodTypeDays, Value: 30},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func testCertificate(t *testing.T, notBefore, notAfter time.Time) (string, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(0x0a1b2c),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), c
+}
+
+func getCertificate(out *awsacmpca.GetCertificateOutput, err error) func(*awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+	return func(*awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+		return awsacmpca.GetCertificateRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	valid, validCert := testCertificate(t, now.Add(-time.Hour), now.Add(30*24*time.Hour))
+	expiring, expiringCert := testCertificate(t, now.Add(-29*24*time.Hour), now.Add(24*time.Hour))
+	chain := "chain"
+
+	type want struct {
+		cr     *v1alpha1.IssuedCertificate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Issued": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockGetCertificateRequest: getCertificate(&awsacmpca.GetCertificateOutput{Certificate: aws.String(valid), CertificateChain: aws.String(chain)}, nil),
+				},
+				cr: issuedCertificate(withExternalName(certARN)),
+			},
+			want: want{
+				cr: issuedCertificate(
+					withExternalName(certARN),
+					withObservation(acmpca.GenerateIssuedCertificateObservation(issuedCertificate().Spec.ForProvider, validCert)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						corev1.TLSCertKey:                []byte(valid + chain + "\n"),
+						acmpca.SecretKeyCertificateChain: []byte(chain + "\n"),
+					},
+				},
+			},
+		},
+		"RenewalDue": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockGetCertificateRequest: getCertificate(&awsacmpca.GetCertificateOutput{Certificate: aws.String(expiring)}, nil),
+				},
+				cr: issuedCertificate(withExternalName(certARN)),
+			},
+			want: want{
+				cr: issuedCertificate(
+					withExternalName(certARN),
+					withObservation(acmpca.GenerateIssuedCertificateObservation(issuedCertificate().Spec.ForProvider, expiringCert)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						corev1.TLSCertKey:                []byte(expiring),
+						acmpca.SecretKeyCertificateChain: []byte(""),
+					},
+				},
+			},
+		},
+		"InProgress": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockGetCertificateRequest: getCertificate(nil, awserr.New(awsacmpca.ErrCodeRequestInProgressException, "", nil)),
+				},
+				cr: issuedCertificate(withExternalName(certARN)),
+			},
+			want: want{
+				cr: issuedCertificate(withExternalName(certARN), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockGetCertificateRequest: getCertificate(nil, awserr.New(awsacmpca.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: issuedCertificate(withExternalName(certARN)),
+			},
+			want: want{
+				cr: issuedCertificate(withExternalName(certARN)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: issuedCertificate(withExternalName(certARN), withDeletionTimestamp(now), withConditions(xpv1.Deleting())),
+			},
+			want: want{
+				cr: issuedCertificate(withExternalName(certARN), withDeletionTimestamp(now), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockGetCertificateRequest: getCertificate(nil, errBoom),
+				},
+				cr: issuedCertificate(withExternalName(certARN)),
+			},
+			want: want{
+				cr:  issuedCertificate(withExternalName(certARN)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IssuedCertificate
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Issued": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockIssueCertificateRequest: func(in *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						if diff := cmp.Diff(csr, string(in.Csr)); diff != "" {
+							t.Errorf("csr: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(certARN),
+							}},
+						}
+					},
+				},
+				cr: issuedCertificate(),
+			},
+			want: want{
+				cr: issuedCertificate(withExternalName(certARN), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    managed.ConnectionDetails{},
+				},
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockIssueCertificateRequest: func(in *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: issuedCertificate(),
+			},
+			want: want{
+				cr:  issuedCertificate(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errIssue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	serial := "0a:1b:2c"
+
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"NotRevoked": {
+			args: args{
+				cr: issuedCertificate(withObservation(v1alpha1.IssuedCertificateObservation{Serial: serial})),
+			},
+		},
+		"Revoked": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockRevokeCertificateRequest: func(in *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+						if diff := cmp.Diff(serial, aws.StringValue(in.CertificateSerial)); diff != "" {
+							t.Errorf("serial: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.RevokeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.RevokeCertificateOutput{}},
+						}
+					},
+				},
+				cr: issuedCertificate(withRevocationReason("SUPERSEDED"), withObservation(v1alpha1.IssuedCertificateObservation{Serial: serial})),
+			},
+		},
+		"AlreadyRevoked": {
+			args: args{
+				client: &fake.MockIssuedCertificateClient{
+					MockRevokeCertificateRequest: func(in *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+						return awsacmpca.RevokeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsacmpca.ErrCodeRequestAlreadyProcessedException, "", nil)},
+						}
+					},
+				},
+				cr: issuedCertificate(withRevocationReason("SUPERSEDED"), withObservation(v1alpha1.IssuedCertificateObservation{Serial: serial})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), tc.args.cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,23 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -34,6 +47,19 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigatewayv2"
+)
+
+const (
+	errImport          = "cannot import API in AWS"
+	errReimport        = "cannot reimport API in AWS"
+	errGetConfigMap    = "cannot get ConfigMap of API definition"
+	errConfigMapKeyFmt = "ConfigMap %s/%s has no key %s"
+	errHeadObject      = "cannot get metadata of S3 object of API definition"
+	errGetObject       = "cannot get S3 object of API definition"
+	errReadObject      = "cannot read S3 object of API definition"
+	errNoBodySource    = "no source of API definition is given"
+	errRecordBody      = "cannot record imported API definition"
 )
 
 // SetupAPI adds a controller that reconciles API.
@@ -45,6 +71,10 @@ func SetupAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) erro
 			e.postObserve = postObserve
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			u := &updateClient{client: e.client}
+			e.preUpdate = u.preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -55,7 +85,7 @@ func SetupAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) erro
 		For(&svcapitypes.API{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.APIGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(&importConnector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -87,3 +117,250 @@ func preDelete(_ context.Context, cr *svcapitypes.API, obj *svcsdk.DeleteApiInpu
 	obj.ApiId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.APIParameters, resp *svcsdk.GetApiOutput) error {
+	in.APIKeySelectionExpression = aws.LateInitializeStringPtr(in.APIKeySelectionExpression, resp.ApiKeySelectionExpression)
+	in.Description = aws.LateInitializeStringPtr(in.Description, resp.Description)
+	in.DisableExecuteAPIEndpoint = aws.LateInitializeBoolPtr(in.DisableExecuteAPIEndpoint, resp.DisableExecuteApiEndpoint)
+	in.DisableSchemaValidation = aws.LateInitializeBoolPtr(in.DisableSchemaValidation, resp.DisableSchemaValidation)
+	in.Name = aws.LateInitializeStringPtr(in.Name, resp.Name)
+	in.ProtocolType = aws.LateInitializeStringPtr(in.ProtocolType, resp.ProtocolType)
+	in.RouteSelectionExpression = aws.LateInitializeStringPtr(in.RouteSelectionExpression, resp.RouteSelectionExpression)
+	in.Version = aws.LateInitializeStringPtr(in.Version, resp.Version)
+	if resp.CorsConfiguration != nil {
+		if in.CorsConfiguration == nil {
+			in.CorsConfiguration = &svcapitypes.Cors{}
+		}
+		c := in.CorsConfiguration
+		c.AllowCredentials = aws.LateInitializeBoolPtr(c.AllowCredentials, resp.CorsConfiguration.AllowCredentials)
+		c.MaxAge = aws.LateInitializeInt64Ptr(c.MaxAge, resp.CorsConfiguration.MaxAge)
+		if c.AllowHeaders == nil {
+			c.AllowHeaders = resp.CorsConfiguration.AllowHeaders
+		}
+		if c.AllowMethods == nil {
+			c.AllowMethods = resp.CorsConfiguration.AllowMethods
+		}
+		if c.AllowOrigins == nil {
+			c.AllowOrigins = resp.CorsConfiguration.AllowOrigins
+		}
+		if c.ExposeHeaders == nil {
+			c.ExposeHeaders = resp.CorsConfiguration.ExposeHeaders
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.API, resp *svcsdk.GetApiOutput) (bool, error) {
+	current := &svcapitypes.APIParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	// CredentialsARN, RouteKey and Target are only used for quick create and
+	// are not returned by the API.
+	if !cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.APIParameters{}, "Region", "CredentialsARN", "RouteKey", "Target", "Tags", "CustomAPIParameters")) {
+		return false, nil
+	}
+	return apigatewayv2.IsTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+type updateClient struct {
+	client svcsdkapi.ApiGatewayV2API
+}
+
+func (u *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.API, obj *svcsdk.UpdateApiInput) error {
+	obj.ApiId = aws.String(meta.GetExternalName(cr))
+	return apigatewayv2.UpdateTags(ctx, u.client, apigatewayv2.APIARN(cr.Spec.ForProvider.Region, meta.GetExternalName(cr)), cr.Spec.ForProvider.Tags)
+}
+
+// importConnector connects to API Gateway like the generated connector, and
+// wraps its client with one that imports the APIs that have an OpenAPI
+// definition.
+type importConnector struct {
+	kube client.Client
+	opts []option
+}
+
+func (c *importConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.API)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := aws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	client := svcsdk.New(sess)
+	return &importer{
+		ExternalClient: newExternal(c.kube, client, c.opts),
+		kube:           c.kube,
+		client:         client,
+		s3:             s3.New(sess),
+	}, nil
+}
+
+// importer creates APIs with an OpenAPI definition by importing it, and
+// re-imports the definition whenever it changes. All other operations are
+// handled by the generated client.
+type importer struct {
+	managed.ExternalClient
+	kube   client.Client
+	client svcsdkapi.ApiGatewayV2API
+	s3     s3iface.S3API
+}
+
+func (i *importer) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := i.ExternalClient.Observe(ctx, mg)
+	cr, ok := mg.(*svcapitypes.API)
+	if err != nil || !ok || !obs.ResourceExists || cr.Spec.ForProvider.Body == nil {
+		return obs, err
+	}
+	v, err := i.getBodyVersion(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if cr.GetAnnotations()[svcapitypes.AnnotationKeyImportedBody] != v {
+		obs.ResourceUpToDate = false
+	}
+	return obs, nil
+}
+
+func (i *importer) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.API)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Body == nil {
+		return i.ExternalClient.Create(ctx, mg)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	body, _, err := i.getBody(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := i.client.ImportApiWithContext(ctx, &svcsdk.ImportApiInput{
+		Basepath:       cr.Spec.ForProvider.Basepath,
+		Body:           aws.String(body),
+		FailOnWarnings: cr.Spec.ForProvider.FailOnWarnings,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, aws.Wrap(err, errImport)
+	}
+	cr.Status.AtProvider.APIEndpoint = resp.ApiEndpoint
+	cr.Status.AtProvider.APIGatewayManaged = resp.ApiGatewayManaged
+	cr.Status.AtProvider.APIID = resp.ApiId
+	cr.Status.AtProvider.ImportInfo = resp.ImportInfo
+	cr.Status.AtProvider.Warnings = resp.Warnings
+	// The imported definition is not recorded here since only the external
+	// name survives creation. It is re-imported once on the first update,
+	// which also applies the name, description and tags of the spec.
+	meta.SetExternalName(cr, aws.StringValue(resp.ApiId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (i *importer) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.API)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Body != nil {
+		if err := i.reimport(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return i.ExternalClient.Update(ctx, mg)
+}
+
+// reimport imports the OpenAPI definition of the API if it changed since it
+// was last imported, and records the imported definition.
+func (i *importer) reimport(ctx context.Context, cr *svcapitypes.API) error {
+	v, err := i.getBodyVersion(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if cr.GetAnnotations()[svcapitypes.AnnotationKeyImportedBody] == v {
+		return nil
+	}
+	body, v, err := i.getBody(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if _, err := i.client.ReimportApiWithContext(ctx, &svcsdk.ReimportApiInput{
+		ApiId:          aws.String(meta.GetExternalName(cr)),
+		Basepath:       cr.Spec.ForProvider.Basepath,
+		Body:           aws.String(body),
+		FailOnWarnings: cr.Spec.ForProvider.FailOnWarnings,
+	}); err != nil {
+		return aws.Wrap(err, errReimport)
+	}
+	meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyImportedBody: v})
+	return errors.Wrap(i.kube.Update(ctx, cr), errRecordBody)
+}
+
+// getBodyVersion returns the version of the OpenAPI definition of the API
+// that is recorded once it is imported. The version of an S3 object is derived
+// from its ETag so that the object is only downloaded when it changed.
+func (i *importer) getBodyVersion(ctx context.Context, cr *svcapitypes.API) (string, error) {
+	b := cr.Spec.ForProvider.Body
+	if b.S3 == nil {
+		_, v, err := i.getBody(ctx, cr)
+		return v, err
+	}
+	resp, err := i.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket:    aws.String(b.S3.Bucket),
+		Key:       aws.String(b.S3.Key),
+		VersionId: b.S3.Version,
+	})
+	if err != nil {
+		return "", aws.Wrap(err, errHeadObject)
+	}
+	return s3Version(b.S3, resp.ETag), nil
+}
+
+// getBody returns the OpenAPI definition of the API from its source, and its
+// version.
+func (i *importer) getBody(ctx context.Context, cr *svcapitypes.API) (string, string, error) {
+	b := cr.Spec.ForProvider.Body
+	switch {
+	case b.Inline != nil:
+		return aws.StringValue(b.Inline), hash(aws.StringValue(b.Inline)), nil
+	case b.ConfigMapRef != nil:
+		ref := b.ConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := i.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", "", errors.Wrap(err, errGetConfigMap)
+		}
+		v, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", "", errors.Errorf(errConfigMapKeyFmt, ref.Namespace, ref.Name, ref.Key)
+		}
+		return v, hash(v), nil
+	case b.S3 != nil:
+		resp, err := i.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket:    aws.String(b.S3.Bucket),
+			Key:       aws.String(b.S3.Key),
+			VersionId: b.S3.Version,
+		})
+		if err != nil {
+			return "", "", aws.Wrap(err, errGetObject)
+		}
+		defer resp.Body.Close() // nolint:errcheck
+		v, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return "", "", errors.Wrap(err, errReadObject)
+		}
+		return string(v), s3Version(b.S3, resp.ETag), nil
+	}
+	return "", "", errors.New(errNoBodySource)
+}
+
+func hash(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
+
+// s3Version identifies an S3 object by its location and ETag, so that both a
+// change of the referenced object and of its content are detected.
+func s3Version(ref *svcapitypes.S3ObjectReference, etag *string) string {
+	return hash(strings.Join([]string{ref.Bucket, ref.Key, aws.StringValue(ref.Version), aws.StringValue(etag)}, "/"))
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	body = `{"openapi": "3.0.1"}`
+	etag = "etag"
+
+	s3Ref = &svcapitypes.S3ObjectReference{Bucket: "bucket", Key: "openapi.json"}
+
+	errBoom = errors.New("boom")
+)
+
+type mockClient struct {
+	svcsdkapi.ApiGatewayV2API
+	reimported *string
+	err        error
+}
+
+func (m *mockClient) ReimportApiWithContext(_ context.Context, in *svcsdk.ReimportApiInput, _ ...request.Option) (*svcsdk.ReimportApiOutput, error) {
+	m.reimported = in.Body
+	return &svcsdk.ReimportApiOutput{}, m.err
+}
+
+// mockS3 serves a single object with the given ETag and counts the
+// downloads of the object.
+type mockS3 struct {
+	s3iface.S3API
+	etag      string
+	downloads int
+	err       error
+}
+
+func (m *mockS3) HeadObjectWithContext(_ context.Context, _ *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+	return &s3.HeadObjectOutput{ETag: aws.String(m.etag)}, m.err
+}
+
+func (m *mockS3) GetObjectWithContext(_ context.Context, _ *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	m.downloads++
+	return &s3.GetObjectOutput{ETag: aws.String(m.etag), Body: ioutil.NopCloser(strings.NewReader(body))}, m.err
+}
+
+type apiModifier func(*svcapitypes.API)
+
+func withBody(b *svcapitypes.APIBody) apiModifier {
+	return func(r *svcapitypes.API) { r.Spec.ForProvider.Body = b }
+}
+
+func withImportedBody(b string) apiModifier {
+	return func(r *svcapitypes.API) {
+		meta.AddAnnotations(r, map[string]string{svcapitypes.AnnotationKeyImportedBody: hash(b)})
+	}
+}
+
+func withImportedObject(ref *svcapitypes.S3ObjectReference, etag string) apiModifier {
+	return func(r *svcapitypes.API) {
+		meta.AddAnnotations(r, map[string]string{svcapitypes.AnnotationKeyImportedBody: s3Version(ref, aws.String(etag))})
+	}
+}
+
+func api(m ...apiModifier) *svcapitypes.API {
+	cr := &svcapitypes.API{}
+	meta.SetExternalName(cr, "api")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		s3 *mockS3
+		cr *svcapitypes.API
+	}
+	type want struct {
+		obs       managed.ExternalObservation
+		downloads int
+		err       error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cr: api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)}), withImportedBody(body)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"BodyChanged": {
+			args: args{
+				cr: api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)}), withImportedBody("old")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ObjectUnchanged": {
+			args: args{
+				s3: &mockS3{etag: etag},
+				cr: api(withBody(&svcapitypes.APIBody{S3: s3Ref}), withImportedObject(s3Ref, etag)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObjectChanged": {
+			args: args{
+				s3: &mockS3{etag: "new"},
+				cr: api(withBody(&svcapitypes.APIBody{S3: s3Ref}), withImportedObject(s3Ref, etag)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"HeadObjectFailed": {
+			args: args{
+				s3: &mockS3{err: errBoom},
+				cr: api(withBody(&svcapitypes.APIBody{S3: s3Ref})),
+			},
+			want: want{
+				err: aws.Wrap(errBoom, errHeadObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			i := &importer{
+				ExternalClient: &managed.ExternalClientFns{
+					ObserveFn: func(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+				},
+			}
+			if tc.args.s3 != nil {
+				i.s3 = tc.args.s3
+			}
+			obs, err := i.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.args.s3 != nil && tc.want.downloads != tc.args.s3.downloads {
+				t.Errorf("downloads: want %d, got %d", tc.want.downloads, tc.args.s3.downloads)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		kube   client.Client
+		client *mockClient
+		s3     *mockS3
+		cr     *svcapitypes.API
+	}
+	type want struct {
+		cr         *svcapitypes.API
+		reimported *string
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoBody": {
+			args: args{
+				client: &mockClient{},
+				cr:     api(),
+			},
+			want: want{
+				cr: api(),
+			},
+		},
+		"Reimport": {
+			args: args{
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &mockClient{},
+				cr:     api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)}), withImportedBody("old")),
+			},
+			want: want{
+				cr:         api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)}), withImportedBody(body)),
+				reimported: aws.String(body),
+			},
+		},
+		"ReimportFromConfigMap": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"openapi.json": body}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &mockClient{},
+				cr:     api(withBody(&svcapitypes.APIBody{ConfigMapRef: &svcapitypes.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "openapi.json"}})),
+			},
+			want: want{
+				cr: api(withBody(&svcapitypes.APIBody{ConfigMapRef: &svcapitypes.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "openapi.json"}}),
+					withImportedBody(body)),
+				reimported: aws.String(body),
+			},
+		},
+		"ReimportFromS3": {
+			args: args{
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &mockClient{},
+				s3:     &mockS3{etag: "new"},
+				cr:     api(withBody(&svcapitypes.APIBody{S3: s3Ref}), withImportedObject(s3Ref, etag)),
+			},
+			want: want{
+				cr:         api(withBody(&svcapitypes.APIBody{S3: s3Ref}), withImportedObject(s3Ref, "new")),
+				reimported: aws.String(body),
+			},
+		},
+		"S3Unchanged": {
+			args: args{
+				client: &mockClient{},
+				s3:     &mockS3{etag: etag},
+				cr:     api(withBody(&svcapitypes.APIBody{S3: s3Ref}), withImportedObject(s3Ref, etag)),
+			},
+			want: want{
+				cr: api(withBody(&svcapitypes.APIBody{S3: s3Ref}), withImportedObject(s3Ref, etag)),
+			},
+		},
+		"Unchanged": {
+			args: args{
+				client: &mockClient{},
+				cr:     api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)}), withImportedBody(body)),
+			},
+			want: want{
+				cr: api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)}), withImportedBody(body)),
+			},
+		},
+		"ReimportFailed": {
+			args: args{
+				client: &mockClient{err: errBoom},
+				cr:     api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)})),
+			},
+			want: want{
+				cr:         api(withBody(&svcapitypes.APIBody{Inline: aws.String(body)})),
+				reimported: aws.String(body),
+				err:        aws.Wrap(errBoom, errReimport),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			i := &importer{
+				ExternalClient: &managed.ExternalClientFns{
+					UpdateFn: func(context.Context, resource.Managed) (managed.ExternalUpdate, error) {
+						return managed.ExternalUpdate{}, nil
+					},
+				},
+				kube:   tc.args.kube,
+				client: tc.args.client,
+			}
+			if tc.args.s3 != nil {
+				i.s3 = tc.args.s3
+			}
+			_, err := i.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reimported, tc.args.client.reimported); diff != "" {
+				t.Errorf("reimported: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -46,6 +46,9 @@ func SetupAPIMapping(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -97,3 +100,20 @@ func preDelete(_ context.Context, cr *svcapitypes.APIMapping, obj *svcsdk.Delete
 	obj.DomainName = cr.Spec.ForProvider.DomainName
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.APIMappingParameters, resp *svcsdk.GetApiMappingOutput) error {
+	in.APIMappingKey = aws.LateInitializeStringPtr(in.APIMappingKey, resp.ApiMappingKey)
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.APIMapping, resp *svcsdk.GetApiMappingOutput) (bool, error) {
+	return aws.StringValue(cr.Spec.ForProvider.APIMappingKey) == aws.StringValue(resp.ApiMappingKey), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.APIMapping, obj *svcsdk.UpdateApiMappingInput) error {
+	obj.ApiMappingId = aws.String(meta.GetExternalName(cr))
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.DomainName = cr.Spec.ForProvider.DomainName
+	obj.Stage = cr.Spec.ForProvider.Stage
+	return nil
+}
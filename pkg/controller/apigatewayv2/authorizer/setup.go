@@ -20,6 +20,8 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,6 +48,9 @@ func SetupAuthorizer(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -95,3 +100,42 @@ func preDelete(_ context.Context, cr *svcapitypes.Authorizer, obj *svcsdk.Delete
 	obj.AuthorizerId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.AuthorizerParameters, resp *svcsdk.GetAuthorizerOutput) error {
+	in.AuthorizerCredentialsARN = aws.LateInitializeStringPtr(in.AuthorizerCredentialsARN, resp.AuthorizerCredentialsArn)
+	in.AuthorizerPayloadFormatVersion = aws.LateInitializeStringPtr(in.AuthorizerPayloadFormatVersion, resp.AuthorizerPayloadFormatVersion)
+	in.AuthorizerResultTtlInSeconds = aws.LateInitializeInt64Ptr(in.AuthorizerResultTtlInSeconds, resp.AuthorizerResultTtlInSeconds)
+	in.AuthorizerType = aws.LateInitializeStringPtr(in.AuthorizerType, resp.AuthorizerType)
+	in.AuthorizerURI = aws.LateInitializeStringPtr(in.AuthorizerURI, resp.AuthorizerUri)
+	in.EnableSimpleResponses = aws.LateInitializeBoolPtr(in.EnableSimpleResponses, resp.EnableSimpleResponses)
+	in.IDentityValidationExpression = aws.LateInitializeStringPtr(in.IDentityValidationExpression, resp.IdentityValidationExpression)
+	in.Name = aws.LateInitializeStringPtr(in.Name, resp.Name)
+	if in.IDentitySource == nil {
+		in.IDentitySource = resp.IdentitySource
+	}
+	if resp.JwtConfiguration != nil {
+		if in.JWTConfiguration == nil {
+			in.JWTConfiguration = &svcapitypes.JWTConfiguration{}
+		}
+		in.JWTConfiguration.Issuer = aws.LateInitializeStringPtr(in.JWTConfiguration.Issuer, resp.JwtConfiguration.Issuer)
+		if in.JWTConfiguration.Audience == nil {
+			in.JWTConfiguration.Audience = resp.JwtConfiguration.Audience
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Authorizer, resp *svcsdk.GetAuthorizerOutput) (bool, error) {
+	current := &svcapitypes.AuthorizerParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	return cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.AuthorizerParameters{}, "Region", "CustomAuthorizerParameters")), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Authorizer, obj *svcsdk.UpdateAuthorizerInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.AuthorizerId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
@@ -46,6 +46,9 @@ func SetupDeployment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -95,3 +98,19 @@ func preDelete(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.Delete
 	obj.DeploymentId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.DeploymentParameters, resp *svcsdk.GetDeploymentOutput) error {
+	in.Description = aws.LateInitializeStringPtr(in.Description, resp.Description)
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Deployment, resp *svcsdk.GetDeploymentOutput) (bool, error) {
+	// Only the description of a deployment can be updated.
+	return aws.StringValue(cr.Spec.ForProvider.Description) == aws.StringValue(resp.Description), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.UpdateDeploymentInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.DeploymentId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
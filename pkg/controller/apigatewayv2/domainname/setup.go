@@ -20,6 +20,9 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -34,6 +37,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigatewayv2"
 )
 
 // SetupDomainName adds a controller that reconciles DomainName.
@@ -45,6 +49,10 @@ func SetupDomainName(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			e.postObserve = postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			u := &updateClient{client: e.client}
+			e.preUpdate = u.preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -82,3 +90,52 @@ func preDelete(_ context.Context, cr *svcapitypes.DomainName, obj *svcsdk.Delete
 	obj.DomainName = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.DomainNameParameters, resp *svcsdk.GetDomainNameOutput) error {
+	if in.DomainNameConfigurations == nil {
+		for range resp.DomainNameConfigurations {
+			in.DomainNameConfigurations = append(in.DomainNameConfigurations, &svcapitypes.DomainNameConfiguration{})
+		}
+	}
+	for i, c := range in.DomainNameConfigurations {
+		if i >= len(resp.DomainNameConfigurations) || c == nil {
+			break
+		}
+		o := resp.DomainNameConfigurations[i]
+		c.CertificateARN = aws.LateInitializeStringPtr(c.CertificateARN, o.CertificateArn)
+		c.CertificateName = aws.LateInitializeStringPtr(c.CertificateName, o.CertificateName)
+		c.EndpointType = aws.LateInitializeStringPtr(c.EndpointType, o.EndpointType)
+		c.SecurityPolicy = aws.LateInitializeStringPtr(c.SecurityPolicy, o.SecurityPolicy)
+	}
+	if in.MutualTLSAuthentication == nil && resp.MutualTlsAuthentication != nil {
+		in.MutualTLSAuthentication = &svcapitypes.MutualTLSAuthenticationInput{
+			TruststoreURI:     resp.MutualTlsAuthentication.TruststoreUri,
+			TruststoreVersion: resp.MutualTlsAuthentication.TruststoreVersion,
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.DomainName, resp *svcsdk.GetDomainNameOutput) (bool, error) {
+	current := &svcapitypes.DomainNameParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	// The status fields of domain name configurations are managed by API
+	// Gateway.
+	if !cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.DomainNameParameters{}, "Region", "Tags", "CustomDomainNameParameters"),
+		cmpopts.IgnoreFields(svcapitypes.DomainNameConfiguration{}, "APIGatewayDomainName", "CertificateUploadDate", "DomainNameStatus", "DomainNameStatusMessage", "HostedZoneID")) {
+		return false, nil
+	}
+	return apigatewayv2.IsTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+type updateClient struct {
+	client svcsdkapi.ApiGatewayV2API
+}
+
+func (u *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.DomainName, obj *svcsdk.UpdateDomainNameInput) error {
+	obj.DomainName = aws.String(meta.GetExternalName(cr))
+	return apigatewayv2.UpdateTags(ctx, u.client, apigatewayv2.DomainNameARN(cr.Spec.ForProvider.Region, meta.GetExternalName(cr)), cr.Spec.ForProvider.Tags)
+}
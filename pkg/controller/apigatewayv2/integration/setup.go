@@ -18,9 +18,10 @@ package integration
 
 import (
 	"context"
-	"fmt"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -35,6 +36,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigatewayv2"
 )
 
 // SetupIntegration adds a controller that reconciles Integration.
@@ -47,6 +49,9 @@ func SetupIntegration(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -79,17 +84,7 @@ func postObserve(_ context.Context, cr *svcapitypes.Integration, _ *svcsdk.GetIn
 
 func preCreate(_ context.Context, cr *svcapitypes.Integration, obj *svcsdk.CreateIntegrationInput) error {
 	obj.ApiId = cr.Spec.ForProvider.APIID
-	if len(cr.Spec.ForProvider.ResponseParameters) != 0 {
-		obj.ResponseParameters = make(map[string]map[string]*string, len(cr.Spec.ForProvider.ResponseParameters))
-	}
-	for k, m := range cr.Spec.ForProvider.ResponseParameters {
-		if m.OverwriteStatusCode != nil {
-			obj.ResponseParameters[k]["overwrite:statuscode"] = m.OverwriteStatusCode
-		}
-		for _, h := range m.HeaderEntries {
-			obj.ResponseParameters[k][fmt.Sprintf("%s:header.%s", h.Operation, h.Name)] = aws.String(h.Value)
-		}
-	}
+	obj.ResponseParameters = apigatewayv2.GenerateResponseParameters(cr.Spec.ForProvider.ResponseParameters)
 	return nil
 }
 
@@ -107,3 +102,57 @@ func preDelete(_ context.Context, cr *svcapitypes.Integration, obj *svcsdk.Delet
 	obj.IntegrationId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.IntegrationParameters, resp *svcsdk.GetIntegrationOutput) error {
+	in.ConnectionID = aws.LateInitializeStringPtr(in.ConnectionID, resp.ConnectionId)
+	in.ConnectionType = aws.LateInitializeStringPtr(in.ConnectionType, resp.ConnectionType)
+	in.ContentHandlingStrategy = aws.LateInitializeStringPtr(in.ContentHandlingStrategy, resp.ContentHandlingStrategy)
+	in.CredentialsARN = aws.LateInitializeStringPtr(in.CredentialsARN, resp.CredentialsArn)
+	in.Description = aws.LateInitializeStringPtr(in.Description, resp.Description)
+	in.IntegrationMethod = aws.LateInitializeStringPtr(in.IntegrationMethod, resp.IntegrationMethod)
+	in.IntegrationSubtype = aws.LateInitializeStringPtr(in.IntegrationSubtype, resp.IntegrationSubtype)
+	in.IntegrationType = aws.LateInitializeStringPtr(in.IntegrationType, resp.IntegrationType)
+	in.IntegrationURI = aws.LateInitializeStringPtr(in.IntegrationURI, resp.IntegrationUri)
+	in.PassthroughBehavior = aws.LateInitializeStringPtr(in.PassthroughBehavior, resp.PassthroughBehavior)
+	in.PayloadFormatVersion = aws.LateInitializeStringPtr(in.PayloadFormatVersion, resp.PayloadFormatVersion)
+	in.TemplateSelectionExpression = aws.LateInitializeStringPtr(in.TemplateSelectionExpression, resp.TemplateSelectionExpression)
+	in.TimeoutInMillis = aws.LateInitializeInt64Ptr(in.TimeoutInMillis, resp.TimeoutInMillis)
+	if in.RequestParameters == nil {
+		in.RequestParameters = resp.RequestParameters
+	}
+	if in.RequestTemplates == nil {
+		in.RequestTemplates = resp.RequestTemplates
+	}
+	if in.ResponseParameters == nil {
+		in.ResponseParameters = apigatewayv2.GenerateResponseParametersFromAPI(resp.ResponseParameters)
+	}
+	if in.TLSConfig == nil && resp.TlsConfig != nil {
+		in.TLSConfig = &svcapitypes.TLSConfigInput{ServerNameToVerify: resp.TlsConfig.ServerNameToVerify}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Integration, resp *svcsdk.GetIntegrationOutput) (bool, error) {
+	current := &svcapitypes.IntegrationParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	// The API returns the header entries of response parameters as a map, so
+	// their order in the spec does not matter.
+	return cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.IntegrationParameters{}, "Region"),
+		cmpopts.IgnoreFields(svcapitypes.CustomIntegrationParameters{}, "APIID", "APIIDRef", "APIIDSelector"),
+		cmpopts.SortSlices(func(a, b svcapitypes.HeaderEntry) bool {
+			if a.Operation != b.Operation {
+				return a.Operation < b.Operation
+			}
+			return a.Name < b.Name
+		})), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Integration, obj *svcsdk.UpdateIntegrationInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.IntegrationId = aws.String(meta.GetExternalName(cr))
+	obj.ResponseParameters = apigatewayv2.GenerateResponseParameters(cr.Spec.ForProvider.ResponseParameters)
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p   svcapitypes.IntegrationParameters
+		obj *svcsdk.GetIntegrationOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.IntegrationParameters{
+					IntegrationType:      aws.String("HTTP_PROXY"),
+					IntegrationURI:       aws.String("https://example.com"),
+					PayloadFormatVersion: aws.String("1.0"),
+					CustomIntegrationParameters: svcapitypes.CustomIntegrationParameters{
+						APIID: aws.String("api"),
+					},
+				},
+				obj: &svcsdk.GetIntegrationOutput{
+					IntegrationType:      aws.String("HTTP_PROXY"),
+					IntegrationUri:       aws.String("https://example.com"),
+					PayloadFormatVersion: aws.String("1.0"),
+					RequestParameters:    map[string]*string{},
+				},
+			},
+			want: true,
+		},
+		"TimeoutChanged": {
+			args: args{
+				p: svcapitypes.IntegrationParameters{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					TimeoutInMillis: aws.Int64(5000),
+				},
+				obj: &svcsdk.GetIntegrationOutput{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					TimeoutInMillis: aws.Int64(30000),
+				},
+			},
+			want: false,
+		},
+		"ResponseHeadersInDifferentOrder": {
+			args: args{
+				p: svcapitypes.IntegrationParameters{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					CustomIntegrationParameters: svcapitypes.CustomIntegrationParameters{
+						ResponseParameters: svcapitypes.ResponseParameters{
+							"200": {HeaderEntries: []svcapitypes.HeaderEntry{
+								{Operation: "overwrite", Name: "x-b", Value: "b"},
+								{Operation: "append", Name: "x-a", Value: "a"},
+							}},
+						},
+					},
+				},
+				obj: &svcsdk.GetIntegrationOutput{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					ResponseParameters: map[string]map[string]*string{
+						"200": {
+							"append:header.x-a":    aws.String("a"),
+							"overwrite:header.x-b": aws.String("b"),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		"ResponseHeaderChanged": {
+			args: args{
+				p: svcapitypes.IntegrationParameters{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					CustomIntegrationParameters: svcapitypes.CustomIntegrationParameters{
+						ResponseParameters: svcapitypes.ResponseParameters{
+							"200": {HeaderEntries: []svcapitypes.HeaderEntry{
+								{Operation: "append", Name: "x-a", Value: "new"},
+							}},
+						},
+					},
+				},
+				obj: &svcsdk.GetIntegrationOutput{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					ResponseParameters: map[string]map[string]*string{
+						"200": {"append:header.x-a": aws.String("a")},
+					},
+				},
+			},
+			want: false,
+		},
+		"StatusCodeOverwriteAdded": {
+			args: args{
+				p: svcapitypes.IntegrationParameters{
+					IntegrationType: aws.String("HTTP_PROXY"),
+					CustomIntegrationParameters: svcapitypes.CustomIntegrationParameters{
+						ResponseParameters: svcapitypes.ResponseParameters{
+							"500": {OverwriteStatusCode: aws.String("200")},
+						},
+					},
+				},
+				obj: &svcsdk.GetIntegrationOutput{
+					IntegrationType: aws.String("HTTP_PROXY"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Integration{}
+			cr.Spec.ForProvider = tc.args.p
+			got, err := isUpToDate(cr, tc.args.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
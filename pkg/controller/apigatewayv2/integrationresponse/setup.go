@@ -20,6 +20,8 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,6 +48,9 @@ func SetupIntegrationResponse(mgr ctrl.Manager, l logging.Logger, rl workqueue.R
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -96,3 +101,32 @@ func preDelete(_ context.Context, cr *svcapitypes.IntegrationResponse, obj *svcs
 	obj.IntegrationResponseId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.IntegrationResponseParameters, resp *svcsdk.GetIntegrationResponseOutput) error {
+	in.ContentHandlingStrategy = aws.LateInitializeStringPtr(in.ContentHandlingStrategy, resp.ContentHandlingStrategy)
+	in.IntegrationResponseKey = aws.LateInitializeStringPtr(in.IntegrationResponseKey, resp.IntegrationResponseKey)
+	in.TemplateSelectionExpression = aws.LateInitializeStringPtr(in.TemplateSelectionExpression, resp.TemplateSelectionExpression)
+	if in.ResponseParameters == nil {
+		in.ResponseParameters = resp.ResponseParameters
+	}
+	if in.ResponseTemplates == nil {
+		in.ResponseTemplates = resp.ResponseTemplates
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.IntegrationResponse, resp *svcsdk.GetIntegrationResponseOutput) (bool, error) {
+	current := &svcapitypes.IntegrationResponseParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	return cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.IntegrationResponseParameters{}, "Region", "CustomIntegrationResponseParameters")), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.IntegrationResponse, obj *svcsdk.UpdateIntegrationResponseInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.IntegrationId = cr.Spec.ForProvider.IntegrationID
+	obj.IntegrationResponseId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
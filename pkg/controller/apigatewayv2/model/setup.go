@@ -46,6 +46,9 @@ func SetupModel(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) er
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -95,3 +98,38 @@ func preDelete(_ context.Context, cr *svcapitypes.Model, obj *svcsdk.DeleteModel
 	obj.ModelId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.ModelParameters, resp *svcsdk.GetModelOutput) error {
+	in.ContentType = aws.LateInitializeStringPtr(in.ContentType, resp.ContentType)
+	in.Description = aws.LateInitializeStringPtr(in.Description, resp.Description)
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Model, resp *svcsdk.GetModelOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	if aws.StringValue(p.ContentType) != aws.StringValue(resp.ContentType) ||
+		aws.StringValue(p.Description) != aws.StringValue(resp.Description) ||
+		aws.StringValue(p.Name) != aws.StringValue(resp.Name) {
+		return false, nil
+	}
+	// The schema is a JSON document whose formatting may not be preserved.
+	return isSchemaUpToDate(aws.StringValue(cr.Spec.ForProvider.Schema), aws.StringValue(resp.Schema)), nil
+}
+
+func isSchemaUpToDate(desired, current string) bool {
+	d, err := aws.CompactAndEscapeJSON(desired)
+	if err != nil {
+		return desired == current
+	}
+	c, err := aws.CompactAndEscapeJSON(current)
+	if err != nil {
+		return false
+	}
+	return d == c
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Model, obj *svcsdk.UpdateModelInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.ModelId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
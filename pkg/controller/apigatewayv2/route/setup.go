@@ -20,6 +20,8 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,6 +48,9 @@ func SetupRoute(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) er
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -97,3 +102,42 @@ func preDelete(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.DeleteRoute
 	obj.RouteId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.RouteParameters, resp *svcsdk.GetRouteOutput) error {
+	in.APIKeyRequired = aws.LateInitializeBoolPtr(in.APIKeyRequired, resp.ApiKeyRequired)
+	in.AuthorizationType = aws.LateInitializeStringPtr(in.AuthorizationType, resp.AuthorizationType)
+	in.AuthorizerID = aws.LateInitializeStringPtr(in.AuthorizerID, resp.AuthorizerId)
+	in.ModelSelectionExpression = aws.LateInitializeStringPtr(in.ModelSelectionExpression, resp.ModelSelectionExpression)
+	in.OperationName = aws.LateInitializeStringPtr(in.OperationName, resp.OperationName)
+	in.RouteKey = aws.LateInitializeStringPtr(in.RouteKey, resp.RouteKey)
+	in.RouteResponseSelectionExpression = aws.LateInitializeStringPtr(in.RouteResponseSelectionExpression, resp.RouteResponseSelectionExpression)
+	in.Target = aws.LateInitializeStringPtr(in.Target, resp.Target)
+	if in.AuthorizationScopes == nil {
+		in.AuthorizationScopes = resp.AuthorizationScopes
+	}
+	if in.RequestModels == nil {
+		in.RequestModels = resp.RequestModels
+	}
+	if in.RequestParameters == nil && resp.RequestParameters != nil {
+		in.RequestParameters = make(map[string]*svcapitypes.ParameterConstraints, len(resp.RequestParameters))
+		for k, v := range resp.RequestParameters {
+			in.RequestParameters[k] = &svcapitypes.ParameterConstraints{Required: v.Required}
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Route, resp *svcsdk.GetRouteOutput) (bool, error) {
+	current := &svcapitypes.RouteParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	return cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.RouteParameters{}, "Region", "CustomRouteParameters")), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.UpdateRouteInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.RouteId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p   svcapitypes.RouteParameters
+		obj *svcsdk.GetRouteOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.RouteParameters{
+					RouteKey: aws.String("GET /pets"),
+					Target:   aws.String("integrations/abc"),
+					CustomRouteParameters: svcapitypes.CustomRouteParameters{
+						APIID: aws.String("api"),
+					},
+				},
+				obj: &svcsdk.GetRouteOutput{
+					RouteKey:      aws.String("GET /pets"),
+					Target:        aws.String("integrations/abc"),
+					RequestModels: map[string]*string{},
+				},
+			},
+			want: true,
+		},
+		"TargetChanged": {
+			args: args{
+				p: svcapitypes.RouteParameters{
+					RouteKey: aws.String("GET /pets"),
+					Target:   aws.String("integrations/new"),
+				},
+				obj: &svcsdk.GetRouteOutput{
+					RouteKey: aws.String("GET /pets"),
+					Target:   aws.String("integrations/abc"),
+				},
+			},
+			want: false,
+		},
+		"RequestParameterAdded": {
+			args: args{
+				p: svcapitypes.RouteParameters{
+					RouteKey: aws.String("GET /pets"),
+					RequestParameters: map[string]*svcapitypes.ParameterConstraints{
+						"route.request.querystring.id": {Required: aws.Bool(true)},
+					},
+				},
+				obj: &svcsdk.GetRouteOutput{
+					RouteKey: aws.String("GET /pets"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Route{}
+			cr.Spec.ForProvider = tc.args.p
+			got, err := isUpToDate(cr, tc.args.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
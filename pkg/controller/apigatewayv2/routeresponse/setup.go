@@ -20,6 +20,8 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,6 +48,9 @@ func SetupRouteResponse(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -98,3 +103,34 @@ func preDelete(_ context.Context, cr *svcapitypes.RouteResponse, obj *svcsdk.Del
 	obj.RouteResponseId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.RouteResponseParameters, resp *svcsdk.GetRouteResponseOutput) error {
+	in.ModelSelectionExpression = aws.LateInitializeStringPtr(in.ModelSelectionExpression, resp.ModelSelectionExpression)
+	in.RouteResponseKey = aws.LateInitializeStringPtr(in.RouteResponseKey, resp.RouteResponseKey)
+	if in.ResponseModels == nil {
+		in.ResponseModels = resp.ResponseModels
+	}
+	if in.ResponseParameters == nil && resp.ResponseParameters != nil {
+		in.ResponseParameters = make(map[string]*svcapitypes.ParameterConstraints, len(resp.ResponseParameters))
+		for k, v := range resp.ResponseParameters {
+			in.ResponseParameters[k] = &svcapitypes.ParameterConstraints{Required: v.Required}
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.RouteResponse, resp *svcsdk.GetRouteResponseOutput) (bool, error) {
+	current := &svcapitypes.RouteResponseParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	return cmp.Equal(current, &cr.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(svcapitypes.RouteResponseParameters{}, "Region", "CustomRouteResponseParameters")), nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.RouteResponse, obj *svcsdk.UpdateRouteResponseInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.RouteId = cr.Spec.ForProvider.RouteID
+	obj.RouteResponseId = aws.String(meta.GetExternalName(cr))
+	return nil
+}
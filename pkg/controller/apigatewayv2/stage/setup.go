@@ -18,10 +18,16 @@ package stage
 
 import (
 	"context"
+	"fmt"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -34,6 +40,14 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigatewayv2"
+)
+
+const (
+	errCreateDeployment = "cannot create deployment"
+	errRecordRevision   = "cannot record deployed revision"
+
+	deploymentDescriptionFmt = "Automatic deployment of revision %s"
 )
 
 // SetupStage adds a controller that reconciles Stage.
@@ -41,10 +55,14 @@ func SetupStage(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) er
 	name := managed.ControllerName(svcapitypes.StageGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{kube: e.kube, client: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = h.postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preUpdate = h.preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -66,22 +84,145 @@ func preObserve(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.GetStageIn
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Stage, _ *svcsdk.GetStageOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func preCreate(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.CreateStageInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.StageName = aws.String(meta.GetExternalName(cr))
+	obj.DeploymentId = cr.Spec.ForProvider.DeploymentID
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.DeleteStageInput) (bool, error) {
+	obj.StageName = aws.String(meta.GetExternalName(cr))
+	obj.ApiId = cr.Spec.ForProvider.CustomStageParameters.APIID
+	return false, nil
+}
+
+func lateInitialize(in *svcapitypes.StageParameters, resp *svcsdk.GetStageOutput) error {
+	in.AutoDeploy = aws.LateInitializeBoolPtr(in.AutoDeploy, resp.AutoDeploy)
+	in.ClientCertificateID = aws.LateInitializeStringPtr(in.ClientCertificateID, resp.ClientCertificateId)
+	in.Description = aws.LateInitializeStringPtr(in.Description, resp.Description)
+	// The deployment of a stage with automatic deployments enabled is managed
+	// by API Gateway, or by us if it is redeployed on changes.
+	if !aws.BoolValue(in.AutoDeploy) && !aws.BoolValue(in.RedeployOnChange) {
+		in.DeploymentID = aws.LateInitializeStringPtr(in.DeploymentID, resp.DeploymentId)
+	}
+	if resp.AccessLogSettings != nil {
+		if in.AccessLogSettings == nil {
+			in.AccessLogSettings = &svcapitypes.AccessLogSettings{}
+		}
+		in.AccessLogSettings.DestinationARN = aws.LateInitializeStringPtr(in.AccessLogSettings.DestinationARN, resp.AccessLogSettings.DestinationArn)
+		in.AccessLogSettings.Format = aws.LateInitializeStringPtr(in.AccessLogSettings.Format, resp.AccessLogSettings.Format)
+	}
+	if resp.DefaultRouteSettings != nil {
+		if in.DefaultRouteSettings == nil {
+			in.DefaultRouteSettings = &svcapitypes.RouteSettings{}
+		}
+		lateInitializeRouteSettings(in.DefaultRouteSettings, resp.DefaultRouteSettings)
+	}
+	for k, rs := range resp.RouteSettings {
+		if in.RouteSettings == nil {
+			in.RouteSettings = map[string]*svcapitypes.RouteSettings{}
+		}
+		if in.RouteSettings[k] == nil {
+			in.RouteSettings[k] = &svcapitypes.RouteSettings{}
+		}
+		lateInitializeRouteSettings(in.RouteSettings[k], rs)
+	}
+	if in.StageVariables == nil {
+		in.StageVariables = resp.StageVariables
+	}
+	return nil
+}
+
+func lateInitializeRouteSettings(in *svcapitypes.RouteSettings, from *svcsdk.RouteSettings) {
+	in.DataTraceEnabled = aws.LateInitializeBoolPtr(in.DataTraceEnabled, from.DataTraceEnabled)
+	in.DetailedMetricsEnabled = aws.LateInitializeBoolPtr(in.DetailedMetricsEnabled, from.DetailedMetricsEnabled)
+	in.LoggingLevel = aws.LateInitializeStringPtr(in.LoggingLevel, from.LoggingLevel)
+	in.ThrottlingBurstLimit = aws.LateInitializeInt64Ptr(in.ThrottlingBurstLimit, from.ThrottlingBurstLimit)
+	if in.ThrottlingRateLimit == nil {
+		in.ThrottlingRateLimit = from.ThrottlingRateLimit
+	}
+}
+
+type hooks struct {
+	kube   client.Client
+	client svcsdkapi.ApiGatewayV2API
+}
+
+func isUpToDate(cr *svcapitypes.Stage, resp *svcsdk.GetStageOutput) (bool, error) {
+	current := &svcapitypes.StageParameters{}
+	if err := lateInitialize(current, resp); err != nil {
+		return false, err
+	}
+	opts := []cmp.Option{cmpopts.EquateEmpty(), cmpopts.IgnoreFields(svcapitypes.StageParameters{}, "Region", "Tags", "CustomStageParameters")}
+	if !cmp.Equal(current, &cr.Spec.ForProvider, opts...) {
+		return false, nil
+	}
+	if !aws.BoolValue(cr.Spec.ForProvider.AutoDeploy) && !aws.BoolValue(cr.Spec.ForProvider.RedeployOnChange) &&
+		aws.StringValue(cr.Spec.ForProvider.DeploymentID) != aws.StringValue(resp.DeploymentId) {
+		return false, nil
+	}
+	return apigatewayv2.IsTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+// postObserve reports a stage that is redeployed on changes as outdated if
+// the routes or integrations of its API changed since the last deployment.
+// This is checked here rather than in isUpToDate so that it is bound to the
+// context of the reconcile.
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Stage, resp *svcsdk.GetStageOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 	cr.SetConditions(xpv1.Available())
+	if !obs.ResourceUpToDate || !aws.BoolValue(cr.Spec.ForProvider.RedeployOnChange) {
+		return obs, nil
+	}
+	rev, err := apigatewayv2.GetAPIRevision(ctx, h.client, aws.StringValue(cr.Spec.ForProvider.APIID))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs.ResourceUpToDate = cr.GetAnnotations()[svcapitypes.AnnotationKeyDeployedRevision] == rev
 	return obs, nil
 }
 
-func preCreate(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.CreateStageInput) error {
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Stage, obj *svcsdk.UpdateStageInput) error {
 	obj.ApiId = cr.Spec.ForProvider.APIID
 	obj.StageName = aws.String(meta.GetExternalName(cr))
-	return nil
+	// The deployment of a stage with automatic deployments enabled is managed
+	// by API Gateway, or by us if it is redeployed on changes.
+	if !aws.BoolValue(cr.Spec.ForProvider.AutoDeploy) && !aws.BoolValue(cr.Spec.ForProvider.RedeployOnChange) {
+		obj.DeploymentId = cr.Spec.ForProvider.DeploymentID
+	}
+	if aws.BoolValue(cr.Spec.ForProvider.RedeployOnChange) {
+		if err := h.redeploy(ctx, cr); err != nil {
+			return err
+		}
+	}
+	return apigatewayv2.UpdateTags(ctx, h.client, apigatewayv2.StageARN(cr.Spec.ForProvider.Region, aws.StringValue(cr.Spec.ForProvider.APIID), meta.GetExternalName(cr)), cr.Spec.ForProvider.Tags)
 }
 
-func preDelete(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.DeleteStageInput) (bool, error) {
-	obj.StageName = aws.String(meta.GetExternalName(cr))
-	obj.ApiId = cr.Spec.ForProvider.CustomStageParameters.APIID
-	return false, nil
+// redeploy deploys the API to the stage if its routes or integrations changed
+// since the last deployment, and records the deployed revision.
+func (h *hooks) redeploy(ctx context.Context, cr *svcapitypes.Stage) error {
+	rev, err := apigatewayv2.GetAPIRevision(ctx, h.client, aws.StringValue(cr.Spec.ForProvider.APIID))
+	if err != nil {
+		return err
+	}
+	if cr.GetAnnotations()[svcapitypes.AnnotationKeyDeployedRevision] == rev {
+		return nil
+	}
+	resp, err := h.client.CreateDeploymentWithContext(ctx, &svcsdk.CreateDeploymentInput{
+		ApiId:       cr.Spec.ForProvider.APIID,
+		StageName:   aws.String(meta.GetExternalName(cr)),
+		Description: aws.String(fmt.Sprintf(deploymentDescriptionFmt, rev)),
+	})
+	if err != nil {
+		return aws.Wrap(err, errCreateDeployment)
+	}
+	meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyDeployedRevision: rev})
+	if err := h.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errRecordRevision)
+	}
+	cr.Status.AtProvider.DeploymentID = resp.DeploymentId
+	return nil
 }
@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"context"
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigatewayv2"
+)
+
+type args struct {
+	cr  *svcapitypes.Stage
+	obj *svcsdk.GetStageOutput
+}
+
+type stageModifier func(*svcapitypes.Stage)
+
+type mockClient struct {
+	svcsdkapi.ApiGatewayV2API
+	routes []*svcsdk.Route
+}
+
+func (m *mockClient) GetRoutesWithContext(context.Context, *svcsdk.GetRoutesInput, ...request.Option) (*svcsdk.GetRoutesOutput, error) {
+	return &svcsdk.GetRoutesOutput{Items: m.routes}, nil
+}
+
+func (m *mockClient) GetIntegrationsWithContext(context.Context, *svcsdk.GetIntegrationsInput, ...request.Option) (*svcsdk.GetIntegrationsOutput, error) {
+	return &svcsdk.GetIntegrationsOutput{}, nil
+}
+
+func withDeployedRevision(c svcsdkapi.ApiGatewayV2API) stageModifier {
+	return func(r *svcapitypes.Stage) {
+		rev, _ := apigatewayv2.GetAPIRevision(context.Background(), c, "")
+		r.SetAnnotations(map[string]string{svcapitypes.AnnotationKeyDeployedRevision: rev})
+	}
+}
+
+func withSpec(p svcapitypes.StageParameters) stageModifier {
+	return func(r *svcapitypes.Stage) { r.Spec.ForProvider = p }
+}
+
+func stage(m ...stageModifier) *svcapitypes.Stage {
+	cr := &svcapitypes.Stage{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want *svcapitypes.StageParameters
+	}{
+		"PartialRouteSettings": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					DefaultRouteSettings: &svcapitypes.RouteSettings{ThrottlingBurstLimit: aws.Int64(10)},
+				})),
+				obj: &svcsdk.GetStageOutput{
+					DefaultRouteSettings: &svcsdk.RouteSettings{
+						DetailedMetricsEnabled: aws.Bool(false),
+						ThrottlingBurstLimit:   aws.Int64(100),
+					},
+					DeploymentId: aws.String("dep"),
+				},
+			},
+			want: &svcapitypes.StageParameters{
+				DefaultRouteSettings: &svcapitypes.RouteSettings{
+					DetailedMetricsEnabled: aws.Bool(false),
+					ThrottlingBurstLimit:   aws.Int64(10),
+				},
+				CustomStageParameters: svcapitypes.CustomStageParameters{DeploymentID: aws.String("dep")},
+			},
+		},
+		"AutoDeploy": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{})),
+				obj: &svcsdk.GetStageOutput{
+					AutoDeploy:   aws.Bool(true),
+					DeploymentId: aws.String("dep"),
+				},
+			},
+			want: &svcapitypes.StageParameters{
+				AutoDeploy: aws.Bool(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := lateInitialize(&tc.args.cr.Spec.ForProvider, tc.args.obj); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, &tc.args.cr.Spec.ForProvider); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					DefaultRouteSettings: &svcapitypes.RouteSettings{ThrottlingRateLimit: awsgo.Float64(10)},
+					Tags:                 map[string]*string{"k": aws.String("v")},
+				})),
+				obj: &svcsdk.GetStageOutput{
+					DefaultRouteSettings: &svcsdk.RouteSettings{ThrottlingRateLimit: awsgo.Float64(10)},
+					Tags:                 map[string]*string{"k": aws.String("v")},
+				},
+			},
+			want: true,
+		},
+		"ThrottlingChanged": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					DefaultRouteSettings: &svcapitypes.RouteSettings{ThrottlingRateLimit: awsgo.Float64(20)},
+				})),
+				obj: &svcsdk.GetStageOutput{
+					DefaultRouteSettings: &svcsdk.RouteSettings{ThrottlingRateLimit: awsgo.Float64(10)},
+				},
+			},
+			want: false,
+		},
+		"AutoDeployIgnoresDeployment": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					AutoDeploy:            aws.Bool(true),
+					CustomStageParameters: svcapitypes.CustomStageParameters{DeploymentID: aws.String("old")},
+				})),
+				obj: &svcsdk.GetStageOutput{
+					AutoDeploy:   aws.Bool(true),
+					DeploymentId: aws.String("new"),
+				},
+			},
+			want: true,
+		},
+		"DeploymentChanged": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					CustomStageParameters: svcapitypes.CustomStageParameters{DeploymentID: aws.String("old")},
+				})),
+				obj: &svcsdk.GetStageOutput{
+					DeploymentId: aws.String("new"),
+				},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					Tags: map[string]*string{"k": aws.String("v")},
+				})),
+				obj: &svcsdk.GetStageOutput{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.args.cr, tc.args.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	cases := map[string]struct {
+		args
+		client svcsdkapi.ApiGatewayV2API
+		obs    managed.ExternalObservation
+		want   managed.ExternalObservation
+	}{
+		"RevisionDeployed": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					CustomStageParameters: svcapitypes.CustomStageParameters{RedeployOnChange: aws.Bool(true)},
+				}), withDeployedRevision(&mockClient{routes: []*svcsdk.Route{{RouteId: aws.String("r")}}})),
+				obj: &svcsdk.GetStageOutput{
+					DeploymentId: aws.String("new"),
+				},
+			},
+			client: &mockClient{routes: []*svcsdk.Route{{RouteId: aws.String("r")}}},
+			obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"RoutesChanged": {
+			args: args{
+				cr: stage(withSpec(svcapitypes.StageParameters{
+					CustomStageParameters: svcapitypes.CustomStageParameters{RedeployOnChange: aws.Bool(true)},
+				}), withDeployedRevision(&mockClient{})),
+				obj: &svcsdk.GetStageOutput{},
+			},
+			client: &mockClient{routes: []*svcsdk.Route{{RouteId: aws.String("r")}}},
+			obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		"NotRedeployedOnChange": {
+			args: args{
+				cr:  stage(withSpec(svcapitypes.StageParameters{})),
+				obj: &svcsdk.GetStageOutput{},
+			},
+			obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.client}
+			got, err := h.postObserve(context.Background(), tc.args.cr, tc.args.obj, tc.obs, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
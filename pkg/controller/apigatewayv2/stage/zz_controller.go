@@ -125,6 +125,11 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 	} else {
 		cr.Status.AtProvider.CreatedDate = nil
 	}
+	if resp.DeploymentId != nil {
+		cr.Status.AtProvider.DeploymentID = resp.DeploymentId
+	} else {
+		cr.Status.AtProvider.DeploymentID = nil
+	}
 	if resp.LastDeploymentStatusMessage != nil {
 		cr.Status.AtProvider.LastDeploymentStatusMessage = resp.LastDeploymentStatusMessage
 	} else {
@@ -55,6 +55,11 @@ func GenerateStage(resp *svcsdk.GetStageOutput) *svcapitypes.Stage {
 	} else {
 		cr.Status.AtProvider.CreatedDate = nil
 	}
+	if resp.DeploymentId != nil {
+		cr.Status.AtProvider.DeploymentID = resp.DeploymentId
+	} else {
+		cr.Status.AtProvider.DeploymentID = nil
+	}
 	if resp.LastDeploymentStatusMessage != nil {
 		cr.Status.AtProvider.LastDeploymentStatusMessage = resp.LastDeploymentStatusMessage
 	} else {
@@ -113,52 +118,49 @@ func GenerateCreateStageInput(cr *svcapitypes.Stage) *svcsdk.CreateStageInput {
 		}
 		res.SetDefaultRouteSettings(f3)
 	}
-	if cr.Spec.ForProvider.DeploymentID != nil {
-		res.SetDeploymentId(*cr.Spec.ForProvider.DeploymentID)
-	}
 	if cr.Spec.ForProvider.Description != nil {
 		res.SetDescription(*cr.Spec.ForProvider.Description)
 	}
 	if cr.Spec.ForProvider.RouteSettings != nil {
-		f6 := map[string]*svcsdk.RouteSettings{}
-		for f6key, f6valiter := range cr.Spec.ForProvider.RouteSettings {
-			f6val := &svcsdk.RouteSettings{}
-			if f6valiter.DataTraceEnabled != nil {
-				f6val.SetDataTraceEnabled(*f6valiter.DataTraceEnabled)
+		f5 := map[string]*svcsdk.RouteSettings{}
+		for f5key, f5valiter := range cr.Spec.ForProvider.RouteSettings {
+			f5val := &svcsdk.RouteSettings{}
+			if f5valiter.DataTraceEnabled != nil {
+				f5val.SetDataTraceEnabled(*f5valiter.DataTraceEnabled)
 			}
-			if f6valiter.DetailedMetricsEnabled != nil {
-				f6val.SetDetailedMetricsEnabled(*f6valiter.DetailedMetricsEnabled)
+			if f5valiter.DetailedMetricsEnabled != nil {
+				f5val.SetDetailedMetricsEnabled(*f5valiter.DetailedMetricsEnabled)
 			}
-			if f6valiter.LoggingLevel != nil {
-				f6val.SetLoggingLevel(*f6valiter.LoggingLevel)
+			if f5valiter.LoggingLevel != nil {
+				f5val.SetLoggingLevel(*f5valiter.LoggingLevel)
 			}
-			if f6valiter.ThrottlingBurstLimit != nil {
-				f6val.SetThrottlingBurstLimit(*f6valiter.ThrottlingBurstLimit)
+			if f5valiter.ThrottlingBurstLimit != nil {
+				f5val.SetThrottlingBurstLimit(*f5valiter.ThrottlingBurstLimit)
 			}
-			if f6valiter.ThrottlingRateLimit != nil {
-				f6val.SetThrottlingRateLimit(*f6valiter.ThrottlingRateLimit)
+			if f5valiter.ThrottlingRateLimit != nil {
+				f5val.SetThrottlingRateLimit(*f5valiter.ThrottlingRateLimit)
 			}
-			f6[f6key] = f6val
+			f5[f5key] = f5val
 		}
-		res.SetRouteSettings(f6)
+		res.SetRouteSettings(f5)
 	}
 	if cr.Spec.ForProvider.StageVariables != nil {
+		f6 := map[string]*string{}
+		for f6key, f6valiter := range cr.Spec.ForProvider.StageVariables {
+			var f6val string
+			f6val = *f6valiter
+			f6[f6key] = &f6val
+		}
+		res.SetStageVariables(f6)
+	}
+	if cr.Spec.ForProvider.Tags != nil {
 		f7 := map[string]*string{}
-		for f7key, f7valiter := range cr.Spec.ForProvider.StageVariables {
+		for f7key, f7valiter := range cr.Spec.ForProvider.Tags {
 			var f7val string
 			f7val = *f7valiter
 			f7[f7key] = &f7val
 		}
-		res.SetStageVariables(f7)
-	}
-	if cr.Spec.ForProvider.Tags != nil {
-		f8 := map[string]*string{}
-		for f8key, f8valiter := range cr.Spec.ForProvider.Tags {
-			var f8val string
-			f8val = *f8valiter
-			f8[f8key] = &f8val
-		}
-		res.SetTags(f8)
+		res.SetTags(f7)
 	}
 
 	return res
@@ -203,9 +205,6 @@ func GenerateUpdateStageInput(cr *svcapitypes.Stage) *svcsdk.UpdateStageInput {
 		}
 		res.SetDefaultRouteSettings(f4)
 	}
-	if cr.Spec.ForProvider.DeploymentID != nil {
-		res.SetDeploymentId(*cr.Spec.ForProvider.DeploymentID)
-	}
 	if cr.Spec.ForProvider.Description != nil {
 		res.SetDescription(*cr.Spec.ForProvider.Description)
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -34,6 +35,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigatewayv2"
 )
 
 // SetupVPCLink adds a controller that reconciles VPCLink.
@@ -46,6 +48,10 @@ func SetupVPCLink(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter)
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			u := &updateClient{client: e.client}
+			e.preUpdate = u.preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -100,3 +106,25 @@ func preDelete(_ context.Context, cr *svcapitypes.VPCLink, obj *svcsdk.DeleteVpc
 	obj.VpcLinkId = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+func lateInitialize(in *svcapitypes.VPCLinkParameters, resp *svcsdk.GetVpcLinkOutput) error {
+	in.Name = aws.LateInitializeStringPtr(in.Name, resp.Name)
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.VPCLink, resp *svcsdk.GetVpcLinkOutput) (bool, error) {
+	// Security groups and subnets of a VPC link cannot be changed.
+	if aws.StringValue(cr.Spec.ForProvider.Name) != aws.StringValue(resp.Name) {
+		return false, nil
+	}
+	return apigatewayv2.IsTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+type updateClient struct {
+	client svcsdkapi.ApiGatewayV2API
+}
+
+func (u *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.VPCLink, obj *svcsdk.UpdateVpcLinkInput) error {
+	obj.VpcLinkId = aws.String(meta.GetExternalName(cr))
+	return apigatewayv2.UpdateTags(ctx, u.client, apigatewayv2.VPCLinkARN(cr.Spec.ForProvider.Region, meta.GetExternalName(cr)), cr.Spec.ForProvider.Tags)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	"github.com/crossplane/provider-aws/pkg/controller/acmpca/issuedcertificate"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/apimapping"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/authorizer"
@@ -38,13 +39,25 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/stage"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/vpclink"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cacheparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cluster"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/globalreplicationgroup"
+	cacheuser "github.com/crossplane/provider-aws/pkg/controller/cache/user"
+	cacheusergroup "github.com/crossplane/provider-aws/pkg/controller/cache/usergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/cachepolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/distribution"
+	cloudfrontfunction "github.com/crossplane/provider-aws/pkg/controller/cloudfront/function"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/invalidation"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/keygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/originaccessidentity"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/originrequestpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/publickey"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/backup"
+	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/backupschedule"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/globaltable"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/table"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/address"
@@ -55,11 +68,19 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpccidrblock"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/authorizationtoken"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/lifecyclepolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/registrypolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/registryreplicationconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repositorypolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/efs/accesspoint"
 	"github.com/crossplane/provider-aws/pkg/controller/efs/filesystem"
+	"github.com/crossplane/provider-aws/pkg/controller/efs/mounttarget"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/addon"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/fargateprofile"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/identityproviderconfig"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
@@ -71,13 +92,16 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrole"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuser"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserloginprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/instanceprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/openidconnectprovider"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
 	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/dbclusterinstance"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/globalcluster"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
@@ -87,11 +111,14 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
+	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/publicdnsnamespace"
+	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/service"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/activity"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/statemachine"
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
+	"github.com/crossplane/provider-aws/pkg/controller/sqs/queuepolicy"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
@@ -102,6 +129,10 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
 		cluster.SetupCacheCluster,
+		cacheparametergroup.SetupCacheParameterGroup,
+		cacheuser.SetupUser,
+		cacheusergroup.SetupUserGroup,
+		globalreplicationgroup.SetupGlobalReplicationGroup,
 		database.SetupRDSInstance,
 		eks.SetupCluster,
 		elb.SetupELB,
@@ -110,6 +141,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		s3.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
 		iamaccesskey.SetupIAMAccessKey,
+		iamuserloginprofile.SetupIAMUserLoginProfile,
 		iamuser.SetupIAMUser,
 		iamgroup.SetupIAMGroup,
 		iampolicy.SetupIAMPolicy,
@@ -118,6 +150,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
 		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
 		iamrolepolicyattachment.SetupIAMRolePolicyAttachment,
+		instanceprofile.SetupInstanceProfile,
 		vpc.SetupVPC,
 		subnet.SetupSubnet,
 		securitygroup.SetupSecurityGroup,
@@ -127,6 +160,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		dbsubnetgroup.SetupDBSubnetGroup,
 		certificateauthority.SetupCertificateAuthority,
 		certificateauthoritypermission.SetupCertificateAuthorityPermission,
+		issuedcertificate.SetupIssuedCertificate,
 		acm.SetupCertificate,
 		resourcerecordset.SetupResourceRecordSet,
 		hostedzone.SetupHostedZone,
@@ -134,10 +168,15 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		snstopic.SetupSNSTopic,
 		snssubscription.SetupSubscription,
 		queue.SetupQueue,
+		queuepolicy.SetupQueuePolicy,
 		redshift.SetupCluster,
 		address.SetupAddress,
 		repository.SetupRepository,
 		repositorypolicy.SetupRepositoryPolicy,
+		lifecyclepolicy.SetupLifecyclePolicy,
+		registryreplicationconfiguration.SetupRegistryReplicationConfiguration,
+		registrypolicy.SetupRegistryPolicy,
+		authorizationtoken.SetupAuthorizationToken,
 		api.SetupAPI,
 		stage.SetupStage,
 		route.SetupRoute,
@@ -151,23 +190,38 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		routeresponse.SetupRouteResponse,
 		vpclink.SetupVPCLink,
 		fargateprofile.SetupFargateProfile,
+		addon.SetupAddon,
+		identityproviderconfig.SetupIdentityProviderConfig,
 		activity.SetupActivity,
 		statemachine.SetupStateMachine,
 		table.SetupTable,
 		backup.SetupBackup,
+		backupschedule.SetupBackupSchedule,
 		globaltable.SetupGlobalTable,
 		key.SetupKey,
 		filesystem.SetupFileSystem,
+		mounttarget.SetupMountTarget,
+		accesspoint.SetupAccessPoint,
 		dbcluster.SetupDBCluster,
+		dbclusterinstance.SetupDBClusterInstance,
 		dbparametergroup.SetupDBParameterGroup,
 		globalcluster.SetupGlobalCluster,
 		vpccidrblock.SetupVPCCIDRBlock,
 		privatednsnamespace.SetupPrivateDNSNamespace,
 		publicdnsnamespace.SetupPublicDNSNamespace,
 		httpnamespace.SetupHTTPNamespace,
+		service.SetupService,
+		instance.SetupInstance,
 		function.SetupFunction,
 		openidconnectprovider.SetupOpenIDConnectProvider,
 		distribution.SetupDistribution,
+		cachepolicy.SetupCachePolicy,
+		originrequestpolicy.SetupOriginRequestPolicy,
+		originaccessidentity.SetupCloudFrontOriginAccessIdentity,
+		publickey.SetupPublicKey,
+		keygroup.SetupKeyGroup,
+		invalidation.SetupInvalidation,
+		cloudfrontfunction.SetupFunction,
 	} {
 		if err := setup(mgr, l, rl); err != nil {
 			return err
@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheparametergroup
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotCacheParameterGroup       = "managed resource is not an ElastiCache cache parameter group"
+	errDescribeCacheParameterGroup  = "cannot describe ElastiCache cache parameter group"
+	errDescribeCacheParameters      = "cannot describe ElastiCache cache parameters"
+	errCreateCacheParameterGroup    = "cannot create ElastiCache cache parameter group"
+	errModifyCacheParameterGroup    = "cannot modify ElastiCache cache parameter group"
+	errResetCacheParameterGroup     = "cannot reset ElastiCache cache parameter group parameters"
+	errDeleteCacheParameterGroup    = "cannot delete ElastiCache cache parameter group"
+	errCacheParameterGroupNotExists = "ElastiCache cache parameter group does not exist"
+)
+
+// SetupCacheParameterGroup adds a controller that reconciles
+// CacheParameterGroups.
+func SetupCacheParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.CacheParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.CacheParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CacheParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewCacheParameterGroupClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) elasticache.CacheParameterGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return nil, errors.New(errNotCacheParameterGroup)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client elasticache.CacheParameterGroupClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCacheParameterGroup)
+	}
+
+	rsp, err := e.client.DescribeCacheParameterGroupsWithContext(ctx, &svcsdk.DescribeCacheParameterGroupsInput{CacheParameterGroupName: awsv1.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(elasticache.IsCacheParameterGroupNotFound, err), errDescribeCacheParameterGroup)
+	}
+	if len(rsp.CacheParameterGroups) == 0 {
+		return managed.ExternalObservation{}, errors.New(errCacheParameterGroupNotExists)
+	}
+	pg := rsp.CacheParameterGroups[0]
+	cr.Status.AtProvider = v1alpha1.CacheParameterGroupExternalStatus{
+		ARN:      awsv1.StringValue(pg.ARN),
+		IsGlobal: awsv1.BoolValue(pg.IsGlobal),
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	observed, err := e.getUserParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeCacheParameters)
+	}
+	modify, reset := elasticache.GenerateParameterChanges(cr.Spec.ForProvider.Parameters, observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(modify) == 0 && len(reset) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCacheParameterGroup)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// The parameters are set by the first update after creation.
+	_, err := e.client.CreateCacheParameterGroupWithContext(ctx, elasticache.GenerateCreateCacheParameterGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateCacheParameterGroup)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCacheParameterGroup)
+	}
+
+	observed, err := e.getUserParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeCacheParameters)
+	}
+	modify, reset := elasticache.GenerateParameterChanges(cr.Spec.ForProvider.Parameters, observed)
+	name := awsv1.String(meta.GetExternalName(cr))
+	for _, batch := range batches(modify) {
+		if _, err := e.client.ModifyCacheParameterGroupWithContext(ctx, &svcsdk.ModifyCacheParameterGroupInput{
+			CacheParameterGroupName: name,
+			ParameterNameValues:     batch,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyCacheParameterGroup)
+		}
+	}
+	for _, batch := range batches(reset) {
+		if _, err := e.client.ResetCacheParameterGroupWithContext(ctx, &svcsdk.ResetCacheParameterGroupInput{
+			CacheParameterGroupName: name,
+			ParameterNameValues:     batch,
+			ResetAllParameters:      awsv1.Bool(false),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errResetCacheParameterGroup)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return errors.New(errNotCacheParameterGroup)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteCacheParameterGroupWithContext(ctx, &svcsdk.DeleteCacheParameterGroupInput{CacheParameterGroupName: awsv1.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(elasticache.IsCacheParameterGroupNotFound, err), errDeleteCacheParameterGroup)
+}
+
+// getUserParameters returns the parameters of the cache parameter group
+// whose values were changed from the defaults of the family.
+func (e *external) getUserParameters(ctx context.Context, cr *v1alpha1.CacheParameterGroup) ([]*svcsdk.Parameter, error) {
+	var params []*svcsdk.Parameter
+	err := e.client.DescribeCacheParametersPagesWithContext(ctx, &svcsdk.DescribeCacheParametersInput{
+		CacheParameterGroupName: awsv1.String(meta.GetExternalName(cr)),
+		Source:                  awsv1.String(elasticache.ParameterSourceUser),
+	}, func(page *svcsdk.DescribeCacheParametersOutput, lastPage bool) bool {
+		params = append(params, page.Parameters...)
+		return !lastPage
+	})
+	return params, err
+}
+
+// batches splits the supplied parameters into chunks that can be sent in a
+// single request.
+func batches(params []*svcsdk.ParameterNameValue) [][]*svcsdk.ParameterNameValue {
+	var res [][]*svcsdk.ParameterNameValue
+	for len(params) > elasticache.MaxParametersPerRequest {
+		res = append(res, params[:elasticache.MaxParametersPerRequest])
+		params = params[elasticache.MaxParametersPerRequest:]
+	}
+	if len(params) > 0 {
+		res = append(res, params)
+	}
+	return res
+}
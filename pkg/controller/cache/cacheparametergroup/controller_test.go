@@ -0,0 +1,531 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheparametergroup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	groupName   = "app-params"
+	groupARN    = "arn:aws:elasticache:us-east-1:123456789012:parametergroup:app-params"
+	family      = "redis6.x"
+	description = "app parameters"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client elasticache.CacheParameterGroupClient
+	cr     *v1alpha1.CacheParameterGroup
+}
+
+type groupModifier func(*v1alpha1.CacheParameterGroup)
+
+func withParameters(p ...v1alpha1.ParameterNameValue) groupModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.CacheParameterGroupExternalStatus) groupModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1alpha1.CacheParameterGroup {
+	cr := &v1alpha1.CacheParameterGroup{
+		Spec: v1alpha1.CacheParameterGroupSpec{
+			ForProvider: v1alpha1.CacheParameterGroupParameters{
+				CacheParameterGroupFamily: family,
+				Description:               description,
+			},
+		},
+	}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// describeParameters returns a DescribeCacheParametersPages mock that serves
+// the supplied user-modified parameters, one per page.
+func describeParameters(params ...*svcsdk.Parameter) func(*svcsdk.DescribeCacheParametersInput, func(*svcsdk.DescribeCacheParametersOutput, bool) bool) error {
+	return func(in *svcsdk.DescribeCacheParametersInput, fn func(*svcsdk.DescribeCacheParametersOutput, bool) bool) error {
+		if awsv1.StringValue(in.CacheParameterGroupName) != groupName || awsv1.StringValue(in.Source) != elasticache.ParameterSourceUser {
+			return errBoom
+		}
+		for i, p := range params {
+			if !fn(&svcsdk.DescribeCacheParametersOutput{Parameters: []*svcsdk.Parameter{p}}, i == len(params)-1) {
+				break
+			}
+		}
+		return nil
+	}
+}
+
+func describeGroups(*svcsdk.DescribeCacheParameterGroupsInput) (*svcsdk.DescribeCacheParameterGroupsOutput, error) {
+	return &svcsdk.DescribeCacheParameterGroupsOutput{CacheParameterGroups: []*svcsdk.CacheParameterGroup{{
+		ARN:      awsv1.String(groupARN),
+		IsGlobal: awsv1.Bool(false),
+	}}}, nil
+}
+
+func parameter(name, value string) *svcsdk.Parameter {
+	return &svcsdk.Parameter{ParameterName: awsv1.String(name), ParameterValue: awsv1.String(value)}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CacheParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	maxmemory := v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"}
+	status := v1alpha1.CacheParameterGroupExternalStatus{ARN: groupARN}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParameterGroups: describeGroups,
+					MockDescribeCacheParametersPages: describeParameters(parameter("maxmemory-policy", "allkeys-lru")),
+				},
+				cr: group(withParameters(maxmemory)),
+			},
+			want: want{
+				cr: group(withParameters(maxmemory), withStatus(status), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParameterGroups: describeGroups,
+					MockDescribeCacheParametersPages: describeParameters(parameter("maxmemory-policy", "volatile-lru")),
+				},
+				cr: group(withParameters(maxmemory)),
+			},
+			want: want{
+				cr: group(withParameters(maxmemory), withStatus(status), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ParameterToReset": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParameterGroups: describeGroups,
+					MockDescribeCacheParametersPages: describeParameters(parameter("timeout", "300")),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withStatus(status), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParameterGroups: func(*svcsdk.DescribeCacheParameterGroupsInput) (*svcsdk.DescribeCacheParameterGroupsOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeCacheParameterGroupNotFoundFault, "", nil)
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParameterGroups: func(*svcsdk.DescribeCacheParameterGroupsInput) (*svcsdk.DescribeCacheParameterGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: awsclient.Wrap(errBoom, errDescribeCacheParameterGroup),
+			},
+		},
+		"DescribeParametersFailed": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParameterGroups: describeGroups,
+					MockDescribeCacheParametersPages: func(*svcsdk.DescribeCacheParametersInput, func(*svcsdk.DescribeCacheParametersOutput, bool) bool) error {
+						return errBoom
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withStatus(status), withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errDescribeCacheParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CacheParameterGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockCreateCacheParameterGroup: func(in *svcsdk.CreateCacheParameterGroupInput) (*svcsdk.CreateCacheParameterGroupOutput, error) {
+						if diff := cmp.Diff(&svcsdk.CreateCacheParameterGroupInput{
+							CacheParameterGroupName:   awsv1.String(groupName),
+							CacheParameterGroupFamily: awsv1.String(family),
+							Description:               awsv1.String(description),
+						}, in); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &svcsdk.CreateCacheParameterGroupOutput{}, nil
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockCreateCacheParameterGroup: func(*svcsdk.CreateCacheParameterGroupInput) (*svcsdk.CreateCacheParameterGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateCacheParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr       *v1alpha1.CacheParameterGroup
+		result   managed.ExternalUpdate
+		err      error
+		modified [][]string
+		reset    [][]string
+	}
+
+	// More parameters than fit in a single modification request.
+	many := make([]v1alpha1.ParameterNameValue, elasticache.MaxParametersPerRequest+1)
+	names := make([]string, len(many))
+	for i := range many {
+		names[i] = fmt.Sprintf("param-%02d", i)
+		many[i] = v1alpha1.ParameterNameValue{ParameterName: names[i], ParameterValue: "yes"}
+	}
+	timeout := v1alpha1.ParameterNameValue{ParameterName: "timeout", ParameterValue: "300"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyAndReset": {
+			args: args{
+				cr: group(withParameters(timeout)),
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParametersPages: describeParameters(
+						parameter("maxmemory-policy", "allkeys-lru"),
+						parameter("timeout", "0"),
+					),
+				},
+			},
+			want: want{
+				cr:       group(withParameters(timeout)),
+				modified: [][]string{{"timeout"}},
+				reset:    [][]string{{"maxmemory-policy"}},
+			},
+		},
+		"ModifyInBatches": {
+			args: args{
+				cr: group(withParameters(many...)),
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParametersPages: describeParameters(),
+				},
+			},
+			want: want{
+				cr:       group(withParameters(many...)),
+				modified: [][]string{names[:elasticache.MaxParametersPerRequest], names[elasticache.MaxParametersPerRequest:]},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: group(withParameters(timeout)),
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParametersPages: describeParameters(parameter("timeout", "300")),
+				},
+			},
+			want: want{
+				cr: group(withParameters(timeout)),
+			},
+		},
+		"DescribeParametersFailed": {
+			args: args{
+				cr: group(withParameters(timeout)),
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParametersPages: func(*svcsdk.DescribeCacheParametersInput, func(*svcsdk.DescribeCacheParametersOutput, bool) bool) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				cr:  group(withParameters(timeout)),
+				err: awsclient.Wrap(errBoom, errDescribeCacheParameters),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				cr: group(withParameters(timeout)),
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParametersPages: describeParameters(),
+					MockModifyCacheParameterGroup: func(*svcsdk.ModifyCacheParameterGroupInput) (*svcsdk.CacheParameterGroupNameMessage, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				cr:  group(withParameters(timeout)),
+				err: awsclient.Wrap(errBoom, errModifyCacheParameterGroup),
+			},
+		},
+		"ResetFailed": {
+			args: args{
+				cr: group(),
+				client: &fake.MockCacheParameterGroupClient{
+					MockDescribeCacheParametersPages: describeParameters(parameter("timeout", "300")),
+					MockResetCacheParameterGroup: func(*svcsdk.ResetCacheParameterGroupInput) (*svcsdk.CacheParameterGroupNameMessage, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				cr:  group(),
+				err: awsclient.Wrap(errBoom, errResetCacheParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified, reset [][]string
+			c := tc.client.(*fake.MockCacheParameterGroupClient)
+			if c.MockModifyCacheParameterGroup == nil {
+				c.MockModifyCacheParameterGroup = func(in *svcsdk.ModifyCacheParameterGroupInput) (*svcsdk.CacheParameterGroupNameMessage, error) {
+					if awsv1.StringValue(in.CacheParameterGroupName) != groupName {
+						return nil, errBoom
+					}
+					modified = append(modified, parameterNames(in.ParameterNameValues))
+					return &svcsdk.CacheParameterGroupNameMessage{}, nil
+				}
+			}
+			if c.MockResetCacheParameterGroup == nil {
+				c.MockResetCacheParameterGroup = func(in *svcsdk.ResetCacheParameterGroupInput) (*svcsdk.CacheParameterGroupNameMessage, error) {
+					if awsv1.StringValue(in.CacheParameterGroupName) != groupName || awsv1.BoolValue(in.ResetAllParameters) {
+						return nil, errBoom
+					}
+					reset = append(reset, parameterNames(in.ParameterNameValues))
+					return &svcsdk.CacheParameterGroupNameMessage{}, nil
+				}
+			}
+			e := &external{client: c}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("modified: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDeleteCacheParameterGroup: func(in *svcsdk.DeleteCacheParameterGroupInput) (*svcsdk.DeleteCacheParameterGroupOutput, error) {
+						if awsv1.StringValue(in.CacheParameterGroupName) != groupName {
+							return nil, errBoom
+						}
+						return &svcsdk.DeleteCacheParameterGroupOutput{}, nil
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDeleteCacheParameterGroup: func(*svcsdk.DeleteCacheParameterGroupInput) (*svcsdk.DeleteCacheParameterGroupOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeCacheParameterGroupNotFoundFault, "", nil)
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockCacheParameterGroupClient{
+					MockDeleteCacheParameterGroup: func(*svcsdk.DeleteCacheParameterGroupInput) (*svcsdk.DeleteCacheParameterGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteCacheParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func parameterNames(params []*svcsdk.ParameterNameValue) []string {
+	res := make([]string, len(params))
+	for i, p := range params {
+		res[i] = awsv1.StringValue(p.ParameterName)
+	}
+	return res
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotGlobalReplicationGroup       = "managed resource is not an ElastiCache global replication group"
+	errDescribeGlobalReplicationGroup  = "cannot describe ElastiCache global replication group"
+	errCreateGlobalReplicationGroup    = "cannot create ElastiCache global replication group"
+	errModifyGlobalReplicationGroup    = "cannot modify ElastiCache global replication group"
+	errDeleteGlobalReplicationGroup    = "cannot delete ElastiCache global replication group"
+	errGlobalReplicationGroupNotExists = "ElastiCache global replication group does not exist"
+	errResolvePrimaryReplicationGroup  = "cannot resolve spec.forProvider.primaryReplicationGroupId"
+	errUpdateGlobalReplicationGroupCR  = "cannot update GlobalReplicationGroup Custom Resource"
+)
+
+// SetupGlobalReplicationGroup adds a controller that reconciles
+// GlobalReplicationGroups.
+func SetupGlobalReplicationGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.GlobalReplicationGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.GlobalReplicationGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GlobalReplicationGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewGlobalReplicationGroupClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(&referenceResolver{kube: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+// referenceResolver resolves the reference to the primary ReplicationGroup.
+// It lives here rather than in the API package because v1beta1, which holds
+// the ReplicationGroup type, already imports v1alpha1.
+type referenceResolver struct {
+	kube client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return errors.New(errNotGlobalReplicationGroup)
+	}
+	existing := cr.DeepCopy()
+
+	rsp, err := reference.NewAPIResolver(r.kube, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.PrimaryReplicationGroupID),
+		Reference:    cr.Spec.ForProvider.PrimaryReplicationGroupIDRef,
+		Selector:     cr.Spec.ForProvider.PrimaryReplicationGroupIDSelector,
+		To:           reference.To{Managed: &v1beta1.ReplicationGroup{}, List: &v1beta1.ReplicationGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errResolvePrimaryReplicationGroup)
+	}
+	cr.Spec.ForProvider.PrimaryReplicationGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.PrimaryReplicationGroupIDRef = rsp.ResolvedReference
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errUpdateGlobalReplicationGroupCR)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) elasticache.GlobalReplicationGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return nil, errors.New(errNotGlobalReplicationGroup)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client elasticache.GlobalReplicationGroupClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.GlobalReplicationGroup) (*svcsdk.GlobalReplicationGroup, error) {
+	rsp, err := e.client.DescribeGlobalReplicationGroupsWithContext(ctx, &svcsdk.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: awsv1.String(meta.GetExternalName(cr)),
+		ShowMemberInfo:           awsv1.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.GlobalReplicationGroups) == 0 {
+		return nil, errors.New(errGlobalReplicationGroupNotExists)
+	}
+	return rsp.GlobalReplicationGroups[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGlobalReplicationGroup)
+	}
+	// The global replication group ID is assigned by AWS on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	g, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(elasticache.IsGlobalReplicationGroupNotFound, err), errDescribeGlobalReplicationGroup)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elasticache.LateInitializeGlobalReplicationGroup(&cr.Spec.ForProvider, g)
+	cr.Status.AtProvider = elasticache.GenerateGlobalReplicationGroupObservation(g)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusAvailable, v1alpha1.StatusPrimaryOnly:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StatusCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.StatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        elasticache.IsGlobalReplicationGroupUpToDate(cr.Spec.ForProvider, g),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGlobalReplicationGroup)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+
+	rsp, err := e.client.CreateGlobalReplicationGroupWithContext(ctx, elasticache.GenerateCreateGlobalReplicationGroupInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateGlobalReplicationGroup)
+	}
+	if rsp.GlobalReplicationGroup == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	meta.SetExternalName(cr, awsv1.StringValue(rsp.GlobalReplicationGroup.GlobalReplicationGroupId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGlobalReplicationGroup)
+	}
+	// AWS API rejects modification requests while the global replication
+	// group is being created or modified.
+	if cr.Status.AtProvider.Status != v1alpha1.StatusAvailable && cr.Status.AtProvider.Status != v1alpha1.StatusPrimaryOnly {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	g, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeGlobalReplicationGroup)
+	}
+	_, err = e.client.ModifyGlobalReplicationGroupWithContext(ctx, elasticache.GenerateModifyGlobalReplicationGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider, g))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyGlobalReplicationGroup)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return errors.New(errNotGlobalReplicationGroup)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.StatusDeleting {
+		return nil
+	}
+	// The primary replication group is managed on its own, so it is kept
+	// when the global replication group is deleted. Secondary members have to
+	// be deleted before the global replication group can be.
+	_, err := e.client.DeleteGlobalReplicationGroupWithContext(ctx, &svcsdk.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      awsv1.String(meta.GetExternalName(cr)),
+		RetainPrimaryReplicationGroup: awsv1.Bool(true),
+	})
+	return awsclient.Wrap(resource.Ignore(elasticache.IsGlobalReplicationGroupNotFound, err), errDeleteGlobalReplicationGroup)
+}
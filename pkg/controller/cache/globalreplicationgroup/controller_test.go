@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	idSuffix      = "orders"
+	globalID      = "ldgnf-orders"
+	primaryID     = "orders-primary"
+	cacheNodeType = "cache.r5.large"
+	engineVersion = "6.0.5"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client elasticache.GlobalReplicationGroupClient
+	cr     *v1alpha1.GlobalReplicationGroup
+}
+
+type grgModifier func(*v1alpha1.GlobalReplicationGroup)
+
+func withExternalName(n string) grgModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) grgModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.GlobalReplicationGroupParameters) grgModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.GlobalReplicationGroupObservation) grgModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { r.Status.AtProvider = o }
+}
+
+func grg(m ...grgModifier) *v1alpha1.GlobalReplicationGroup {
+	cr := &v1alpha1.GlobalReplicationGroup{
+		Spec: v1alpha1.GlobalReplicationGroupSpec{
+			ForProvider: v1alpha1.GlobalReplicationGroupParameters{
+				GlobalReplicationGroupIDSuffix: idSuffix,
+				PrimaryReplicationGroupID:      awsv1.String(primaryID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalReplicationGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: grg(),
+			},
+			want: want{
+				cr: grg(),
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockGlobalReplicationGroupClient{
+					MockDescribeGlobalReplicationGroups: func(*svcsdk.DescribeGlobalReplicationGroupsInput) (*svcsdk.DescribeGlobalReplicationGroupsOutput, error) {
+						return &svcsdk.DescribeGlobalReplicationGroupsOutput{GlobalReplicationGroups: []*svcsdk.GlobalReplicationGroup{{
+							CacheNodeType: awsv1.String(cacheNodeType),
+							EngineVersion: awsv1.String(engineVersion),
+							Status:        awsv1.String(v1alpha1.StatusPrimaryOnly),
+							Members: []*svcsdk.GlobalReplicationGroupMember{{
+								ReplicationGroupId: awsv1.String(primaryID),
+								Role:               awsv1.String("PRIMARY"),
+							}},
+						}}}, nil
+					},
+				},
+				cr: grg(withExternalName(globalID)),
+			},
+			want: want{
+				cr: grg(
+					withExternalName(globalID),
+					withSpec(v1alpha1.GlobalReplicationGroupParameters{
+						GlobalReplicationGroupIDSuffix: idSuffix,
+						PrimaryReplicationGroupID:      awsv1.String(primaryID),
+						CacheNodeType:                  awsv1.String(cacheNodeType),
+						EngineVersion:                  awsv1.String(engineVersion),
+					}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GlobalReplicationGroupObservation{
+						Status: v1alpha1.StatusPrimaryOnly,
+						Members: []v1alpha1.GlobalReplicationGroupMember{{
+							ReplicationGroupID: primaryID,
+							Role:               "PRIMARY",
+						}},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockGlobalReplicationGroupClient{
+					MockDescribeGlobalReplicationGroups: func(*svcsdk.DescribeGlobalReplicationGroupsInput) (*svcsdk.DescribeGlobalReplicationGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: grg(withExternalName(globalID)),
+			},
+			want: want{
+				cr:  grg(withExternalName(globalID)),
+				err: awsclient.Wrap(errBoom, errDescribeGlobalReplicationGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalReplicationGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockGlobalReplicationGroupClient{
+					MockCreateGlobalReplicationGroup: func(*svcsdk.CreateGlobalReplicationGroupInput) (*svcsdk.CreateGlobalReplicationGroupOutput, error) {
+						return &svcsdk.CreateGlobalReplicationGroupOutput{GlobalReplicationGroup: &svcsdk.GlobalReplicationGroup{
+							GlobalReplicationGroupId: awsv1.String(globalID),
+						}}, nil
+					},
+				},
+				cr: grg(),
+			},
+			want: want{
+				cr:     grg(withExternalName(globalID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockGlobalReplicationGroupClient{
+					MockCreateGlobalReplicationGroup: func(*svcsdk.CreateGlobalReplicationGroupInput) (*svcsdk.CreateGlobalReplicationGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: grg(),
+			},
+			want: want{
+				cr:  grg(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateGlobalReplicationGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	elasticacheservice "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -53,6 +55,8 @@ const (
 	errCreateReplicationGroup   = "cannot create ElastiCache replication group"
 	errModifyReplicationGroup   = "cannot modify ElastiCache replication group"
 	errDeleteReplicationGroup   = "cannot delete ElastiCache replication group"
+	errDescribeUserGroups       = "cannot describe ElastiCache replication group user groups"
+	errModifyUserGroups         = "cannot modify ElastiCache replication group user groups"
 )
 
 // SetupReplicationGroup adds a controller that reconciles ReplicationGroups.
@@ -89,12 +93,29 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.kube}, nil
+	e := &external{client: c.newClientFn(*cfg), kube: c.kube}
+	// User groups are only available in aws/aws-sdk-go, so the client is only
+	// created for replication groups that use them.
+	if usesUserGroups(cr) {
+		sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, aws.StringValue(cr.Spec.ForProvider.Region))
+		if err != nil {
+			return nil, err
+		}
+		e.userGroups = elasticache.NewReplicationGroupUserGroupClient(sess)
+	}
+	return e, nil
+}
+
+// usesUserGroups returns true if the supplied ReplicationGroup is, or should
+// be, associated with user groups.
+func usesUserGroups(cr *v1beta1.ReplicationGroup) bool {
+	return len(cr.Spec.ForProvider.UserGroupIDs) > 0 || len(cr.Status.AtProvider.UserGroupIDs) > 0
 }
 
 type external struct {
-	client elasticache.Client
-	kube   client.Client
+	client     elasticache.Client
+	userGroups elasticache.ReplicationGroupUserGroupClient
+	kube       client.Client
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -129,7 +150,18 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateReplicationGroupCR)
 		}
 	}
+	manageUserGroups := usesUserGroups(cr)
 	cr.Status.AtProvider = elasticache.GenerateObservation(rg)
+	userGroupsUpToDate := true
+	if manageUserGroups {
+		ids, err := e.getUserGroupIDs(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeUserGroups)
+		}
+		cr.Status.AtProvider.UserGroupIDs = ids
+		toAdd, toRemove := elasticache.DiffIDs(cr.Spec.ForProvider.UserGroupIDs, ids)
+		userGroupsUpToDate = len(toAdd) == 0 && len(toRemove) == 0
+	}
 
 	switch cr.Status.AtProvider.Status {
 	case v1beta1.StatusAvailable:
@@ -144,7 +176,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList),
+		ResourceUpToDate:  userGroupsUpToDate && !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList),
 		ConnectionDetails: elasticache.ConnectionEndpoint(rg),
 	}, nil
 }
@@ -156,6 +188,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Creating())
+	// Replication groups that use user groups are created with them, so that
+	// they are never reachable with the open default user.
+	if len(cr.Spec.ForProvider.UserGroupIDs) > 0 {
+		_, err := e.userGroups.CreateReplicationGroupWithContext(ctx, elasticache.GenerateCreateReplicationGroupWithUserGroupsInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+		return managed.ExternalCreation{}, awsclient.Wrap(resource.Ignore(elasticache.IsAlreadyExists, err), errCreateReplicationGroup)
+	}
 	// Our create request will fail if auth is enabled but transit encryption is
 	// not. We don't check for the latter here because it's less surprising to
 	// submit the request as the operator intended and let the reconcile fail
@@ -193,6 +231,15 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status != v1beta1.StatusAvailable {
 		return managed.ExternalUpdate{}, nil
 	}
+	// The replication group is modifying once its user groups change, so any
+	// other modification is made on a subsequent reconcile.
+	if usesUserGroups(cr) {
+		in := elasticache.GenerateModifyReplicationGroupUserGroupsInput(meta.GetExternalName(cr), cr.Spec.ForProvider.ApplyModificationsImmediately, cr.Spec.ForProvider.UserGroupIDs, cr.Status.AtProvider.UserGroupIDs)
+		if in != nil {
+			_, err := e.userGroups.ModifyReplicationGroupWithContext(ctx, in)
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyUserGroups)
+		}
+	}
 	mr := e.client.ModifyReplicationGroupRequest(elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	_, err := mr.Send(ctx)
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyReplicationGroup)
@@ -240,6 +287,17 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(t.kube.Update(ctx, cr), errUpdateReplicationGroupCR)
 }
 
+func (e *external) getUserGroupIDs(ctx context.Context, id string) ([]string, error) {
+	rsp, err := e.userGroups.DescribeReplicationGroupsWithContext(ctx, &svcsdk.DescribeReplicationGroupsInput{ReplicationGroupId: awsv1.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.ReplicationGroups) == 0 {
+		return nil, nil
+	}
+	return awsv1.StringValueSlice(rsp.ReplicationGroups[0].UserGroupIds), nil
+}
+
 func getCacheClusterList(ctx context.Context, client elasticache.Client, idList []string) ([]elasticacheservice.CacheCluster, error) {
 	if len(idList) < 1 {
 		return nil, nil
@@ -25,6 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
@@ -119,6 +121,10 @@ func withTags(tagMaps ...map[string]string) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.Tags = tagList }
 }
 
+func withUserGroupIDs(ids ...string) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.UserGroupIDs = ids }
+}
+
 func replicationGroup(rm ...replicationGroupModifier) *v1beta1.ReplicationGroup {
 	r := &v1beta1.ReplicationGroup{
 		ObjectMeta: objectMeta,
@@ -461,6 +467,23 @@ func TestUpdate(t *testing.T) {
 			want:       replicationGroup(withProviderStatus(v1beta1.StatusCreating)),
 			returnsErr: false,
 		},
+		{
+			name: "ModifiedUserGroups",
+			e: &external{
+				client: &fake.MockClient{},
+				userGroups: &fake.MockReplicationGroupUserGroupClient{
+					MockModifyReplicationGroup: func(in *svcsdk.ModifyReplicationGroupInput) (*svcsdk.ModifyReplicationGroupOutput, error) {
+						if diff := cmp.Diff([]string{"app"}, awsv1.StringValueSlice(in.UserGroupIdsToAdd)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &svcsdk.ModifyReplicationGroupOutput{}, nil
+					},
+				},
+			},
+			r:          replicationGroup(withProviderStatus(v1beta1.StatusAvailable), withUserGroupIDs("app")),
+			want:       replicationGroup(withProviderStatus(v1beta1.StatusAvailable), withUserGroupIDs("app")),
+			returnsErr: false,
+		},
 		{
 			name: "FailedModifyReplicationGroup",
 			e: &external{client: &fake.MockClient{
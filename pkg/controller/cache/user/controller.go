@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotUser       = "managed resource is not an ElastiCache user"
+	errDescribeUser  = "cannot describe ElastiCache user"
+	errCreateUser    = "cannot create ElastiCache user"
+	errModifyUser    = "cannot modify ElastiCache user"
+	errDeleteUser    = "cannot delete ElastiCache user"
+	errGetPasswords  = "cannot get ElastiCache user passwords"
+	errUserNotExists = "ElastiCache user does not exist"
+)
+
+// SetupUser adds a controller that reconciles Users.
+func SetupUser(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.UserKindGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.User{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.UserGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewUserClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) elasticache.UserClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client elasticache.UserClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	rsp, err := e.client.DescribeUsersWithContext(ctx, &svcsdk.DescribeUsersInput{UserId: awsv1.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(elasticache.IsUserNotFound, err), errDescribeUser)
+	}
+	// DescribeUsers returns a not found error when asked for a user ID that
+	// does not exist, so we should get a single element list.
+	if len(rsp.Users) == 0 {
+		return managed.ExternalObservation{}, errors.New(errUserNotExists)
+	}
+	u := rsp.Users[0]
+	cr.Status.AtProvider = elasticache.GenerateUserObservation(u)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusActive, v1alpha1.StatusModifying:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	pwds, changed, err := elasticache.GetUserPasswords(ctx, e.kube, cr.Spec.ForProvider.PasswordSecretRefs, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPasswords)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elasticache.IsUserUpToDate(cr.Spec.ForProvider, u, pwds, changed),
+		// Passwords are only published once they are applied in Create or
+		// Update, so that we can tell whether they changed.
+		ConnectionDetails: elasticache.UserConnectionDetails(cr.Spec.ForProvider, nil),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+
+	pwds, _, err := elasticache.GetUserPasswords(ctx, e.kube, cr.Spec.ForProvider.PasswordSecretRefs, nil)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPasswords)
+	}
+	if _, err := e.client.CreateUserWithContext(ctx, elasticache.GenerateCreateUserInput(meta.GetExternalName(cr), cr.Spec.ForProvider, pwds)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateUser)
+	}
+	return managed.ExternalCreation{ConnectionDetails: elasticache.UserConnectionDetails(cr.Spec.ForProvider, pwds)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+	// AWS API rejects modification requests if the user is not active.
+	if cr.Status.AtProvider.Status != v1alpha1.StatusActive {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	pwds, _, err := elasticache.GetUserPasswords(ctx, e.kube, cr.Spec.ForProvider.PasswordSecretRefs, nil)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPasswords)
+	}
+	if _, err := e.client.ModifyUserWithContext(ctx, elasticache.GenerateModifyUserInput(meta.GetExternalName(cr), cr.Spec.ForProvider, pwds)); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyUser)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: elasticache.UserConnectionDetails(cr.Spec.ForProvider, pwds)}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.StatusDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteUserWithContext(ctx, &svcsdk.DeleteUserInput{UserId: awsv1.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(elasticache.IsUserNotFound, err), errDeleteUser)
+}
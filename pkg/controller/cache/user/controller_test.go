@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	userID       = "app"
+	userName     = "app-user"
+	accessString = "on ~* +@all"
+	password     = "secret"
+
+	passwordRef = xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "app-user-password", Namespace: "default"},
+		Key:             "password",
+	}
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client elasticache.UserClient
+	kube   client.Client
+	cr     *v1alpha1.User
+}
+
+type userModifier func(*v1alpha1.User)
+
+func withConditions(c ...xpv1.Condition) userModifier {
+	return func(r *v1alpha1.User) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.UserObservation) userModifier {
+	return func(r *v1alpha1.User) { r.Status.AtProvider = o }
+}
+
+func user(m ...userModifier) *v1alpha1.User {
+	cr := &v1alpha1.User{
+		Spec: v1alpha1.UserSpec{
+			ForProvider: v1alpha1.UserParameters{
+				UserName:           userName,
+				Engine:             "redis",
+				AccessString:       accessString,
+				PasswordSecretRefs: []xpv1.SecretKeySelector{passwordRef},
+			},
+		},
+	}
+	meta.SetExternalName(cr, userID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// passwordKube returns a kube client that serves the password Secret.
+func passwordKube() client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			if s, ok := obj.(*corev1.Secret); ok {
+				s.Data = map[string][]byte{passwordRef.Key: []byte(password)}
+			}
+			return nil
+		}),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.User
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockUserClient{
+					MockDescribeUsers: func(*svcsdk.DescribeUsersInput) (*svcsdk.DescribeUsersOutput, error) {
+						return &svcsdk.DescribeUsersOutput{Users: []*svcsdk.User{{
+							AccessString: awsv1.String(accessString),
+							Status:       awsv1.String(v1alpha1.StatusActive),
+							Authentication: &svcsdk.Authentication{
+								Type:          awsv1.String("password"),
+								PasswordCount: awsv1.Int64(1),
+							},
+						}}}, nil
+					},
+				},
+				kube: passwordKube(),
+				cr:   user(),
+			},
+			want: want{
+				cr: user(
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserObservation{
+						Status:             v1alpha1.StatusActive,
+						AuthenticationType: "password",
+						PasswordCount:      1,
+						UserGroupIDs:       []string{},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey: []byte(userName),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockUserClient{
+					MockDescribeUsers: func(*svcsdk.DescribeUsersInput) (*svcsdk.DescribeUsersOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeUserNotFoundFault, "", nil)
+					},
+				},
+				cr: user(),
+			},
+			want: want{
+				cr: user(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockUserClient{
+					MockDescribeUsers: func(*svcsdk.DescribeUsersInput) (*svcsdk.DescribeUsersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: user(),
+			},
+			want: want{
+				cr:  user(),
+				err: awsclient.Wrap(errBoom, errDescribeUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.User
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockUserClient{
+					MockCreateUser: func(in *svcsdk.CreateUserInput) (*svcsdk.CreateUserOutput, error) {
+						if awsv1.StringValue(in.UserId) != userID || len(in.Passwords) != 1 {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateUserOutput{}, nil
+					},
+				},
+				kube: passwordKube(),
+				cr:   user(),
+			},
+			want: want{
+				cr: user(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(userName),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+					},
+				},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockUserClient{
+					MockCreateUser: func(*svcsdk.CreateUserInput) (*svcsdk.CreateUserOutput, error) {
+						return nil, errBoom
+					},
+				},
+				kube: passwordKube(),
+				cr:   user(),
+			},
+			want: want{
+				cr:  user(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usergroup
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotUserGroup       = "managed resource is not an ElastiCache user group"
+	errDescribeUserGroup  = "cannot describe ElastiCache user group"
+	errCreateUserGroup    = "cannot create ElastiCache user group"
+	errModifyUserGroup    = "cannot modify ElastiCache user group"
+	errDeleteUserGroup    = "cannot delete ElastiCache user group"
+	errUserGroupNotExists = "ElastiCache user group does not exist"
+)
+
+// SetupUserGroup adds a controller that reconciles UserGroups.
+func SetupUserGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.UserGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.UserGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.UserGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewUserGroupClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) elasticache.UserGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UserGroup)
+	if !ok {
+		return nil, errors.New(errNotUserGroup)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client elasticache.UserGroupClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.UserGroup) (*svcsdk.UserGroup, error) {
+	rsp, err := e.client.DescribeUserGroupsWithContext(ctx, &svcsdk.DescribeUserGroupsInput{UserGroupId: awsv1.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return nil, err
+	}
+	// DescribeUserGroups returns a not found error when asked for a user
+	// group ID that does not exist, so we should get a single element list.
+	if len(rsp.UserGroups) == 0 {
+		return nil, errors.New(errUserGroupNotExists)
+	}
+	return rsp.UserGroups[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UserGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserGroup)
+	}
+
+	ug, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(elasticache.IsUserGroupNotFound, err), errDescribeUserGroup)
+	}
+	cr.Status.AtProvider = elasticache.GenerateUserGroupObservation(ug)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusActive, v1alpha1.StatusModifying:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StatusCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.StatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elasticache.IsUserGroupUpToDate(cr.Spec.ForProvider, ug),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UserGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserGroup)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+
+	in := &svcsdk.CreateUserGroupInput{
+		UserGroupId: awsv1.String(meta.GetExternalName(cr)),
+		Engine:      awsv1.String(cr.Spec.ForProvider.Engine),
+	}
+	if len(cr.Spec.ForProvider.UserIDs) > 0 {
+		in.UserIds = awsv1.StringSlice(cr.Spec.ForProvider.UserIDs)
+	}
+	_, err := e.client.CreateUserGroupWithContext(ctx, in)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateUserGroup)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.UserGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUserGroup)
+	}
+	// AWS API rejects modification requests if the user group is not active.
+	if cr.Status.AtProvider.Status != v1alpha1.StatusActive {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	ug, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeUserGroup)
+	}
+	in := elasticache.GenerateModifyUserGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider, ug)
+	if in == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyUserGroupWithContext(ctx, in)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyUserGroup)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.UserGroup)
+	if !ok {
+		return errors.New(errNotUserGroup)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.StatusDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteUserGroupWithContext(ctx, &svcsdk.DeleteUserGroupInput{UserGroupId: awsv1.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(elasticache.IsUserGroupNotFound, err), errDeleteUserGroup)
+}
@@ -0,0 +1,523 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usergroup
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	userGroupID  = "app-group"
+	userGroupARN = "arn:aws:elasticache:us-east-1:123456789012:usergroup:app-group"
+	defaultUser  = "default"
+	appUser      = "app"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client elasticache.UserGroupClient
+	cr     *v1alpha1.UserGroup
+}
+
+type userGroupModifier func(*v1alpha1.UserGroup)
+
+func withUserIDs(ids ...string) userGroupModifier {
+	return func(r *v1alpha1.UserGroup) { r.Spec.ForProvider.UserIDs = ids }
+}
+
+func withConditions(c ...xpv1.Condition) userGroupModifier {
+	return func(r *v1alpha1.UserGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.UserGroupObservation) userGroupModifier {
+	return func(r *v1alpha1.UserGroup) { r.Status.AtProvider = o }
+}
+
+func userGroup(m ...userGroupModifier) *v1alpha1.UserGroup {
+	cr := &v1alpha1.UserGroup{
+		Spec: v1alpha1.UserGroupSpec{
+			ForProvider: v1alpha1.UserGroupParameters{
+				Engine:  "redis",
+				UserIDs: []string{defaultUser, appUser},
+			},
+		},
+	}
+	meta.SetExternalName(cr, userGroupID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// describeUserGroups returns a DescribeUserGroups mock that reports a user
+// group with the supplied status and users.
+func describeUserGroups(status string, ids ...string) func(*svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error) {
+	return func(in *svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error) {
+		if awsv1.StringValue(in.UserGroupId) != userGroupID {
+			return nil, errBoom
+		}
+		return &svcsdk.DescribeUserGroupsOutput{UserGroups: []*svcsdk.UserGroup{{
+			ARN:     awsv1.String(userGroupARN),
+			Status:  awsv1.String(status),
+			UserIds: awsv1.StringSlice(ids),
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.UserGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: describeUserGroups(v1alpha1.StatusActive, defaultUser, appUser),
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserGroupObservation{
+						ARN:               userGroupARN,
+						Status:            v1alpha1.StatusActive,
+						UserIDs:           []string{defaultUser, appUser},
+						ReplicationGroups: []string{},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: describeUserGroups(v1alpha1.StatusCreating, defaultUser, appUser),
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(
+					withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.UserGroupObservation{
+						ARN:               userGroupARN,
+						Status:            v1alpha1.StatusCreating,
+						UserIDs:           []string{defaultUser, appUser},
+						ReplicationGroups: []string{},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingUsersUpToDate": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: func(*svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error) {
+						return &svcsdk.DescribeUserGroupsOutput{UserGroups: []*svcsdk.UserGroup{{
+							ARN:     awsv1.String(userGroupARN),
+							Status:  awsv1.String(v1alpha1.StatusModifying),
+							UserIds: awsv1.StringSlice([]string{defaultUser}),
+							PendingChanges: &svcsdk.UserGroupPendingChanges{
+								UserIdsToAdd: awsv1.StringSlice([]string{appUser}),
+							},
+						}}}, nil
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserGroupObservation{
+						ARN:                    userGroupARN,
+						Status:                 v1alpha1.StatusModifying,
+						UserIDs:                []string{defaultUser},
+						PendingUserIDsToAdd:    []string{appUser},
+						PendingUserIDsToRemove: []string{},
+						ReplicationGroups:      []string{},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: describeUserGroups(v1alpha1.StatusActive, defaultUser),
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserGroupObservation{
+						ARN:               userGroupARN,
+						Status:            v1alpha1.StatusActive,
+						UserIDs:           []string{defaultUser},
+						ReplicationGroups: []string{},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: func(*svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeUserGroupNotFoundFault, "", nil)
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: func(*svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr:  userGroup(),
+				err: awsclient.Wrap(errBoom, errDescribeUserGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.UserGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockCreateUserGroup: func(in *svcsdk.CreateUserGroupInput) (*svcsdk.CreateUserGroupOutput, error) {
+						if diff := cmp.Diff(&svcsdk.CreateUserGroupInput{
+							UserGroupId: awsv1.String(userGroupID),
+							Engine:      awsv1.String("redis"),
+							UserIds:     awsv1.StringSlice([]string{defaultUser, appUser}),
+						}, in); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &svcsdk.CreateUserGroupOutput{}, nil
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(withConditions(xpv1.Creating())),
+			},
+		},
+		"WithoutUsers": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockCreateUserGroup: func(in *svcsdk.CreateUserGroupInput) (*svcsdk.CreateUserGroupOutput, error) {
+						if in.UserIds != nil {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateUserGroupOutput{}, nil
+					},
+				},
+				cr: userGroup(withUserIDs()),
+			},
+			want: want{
+				cr: userGroup(withUserIDs(), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockCreateUserGroup: func(*svcsdk.CreateUserGroupInput) (*svcsdk.CreateUserGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr:  userGroup(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateUserGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.UserGroup
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	active := v1alpha1.UserGroupObservation{Status: v1alpha1.StatusActive}
+	modifying := v1alpha1.UserGroupObservation{Status: v1alpha1.StatusModifying}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: describeUserGroups(v1alpha1.StatusActive, defaultUser, "old"),
+					MockModifyUserGroup: func(in *svcsdk.ModifyUserGroupInput) (*svcsdk.ModifyUserGroupOutput, error) {
+						if diff := cmp.Diff(&svcsdk.ModifyUserGroupInput{
+							UserGroupId:     awsv1.String(userGroupID),
+							UserIdsToAdd:    awsv1.StringSlice([]string{appUser}),
+							UserIdsToRemove: awsv1.StringSlice([]string{"old"}),
+						}, in); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &svcsdk.ModifyUserGroupOutput{}, nil
+					},
+				},
+				cr: userGroup(withStatus(active)),
+			},
+			want: want{
+				cr: userGroup(withStatus(active)),
+			},
+		},
+		"NotActive": {
+			args: args{
+				client: &fake.MockUserGroupClient{},
+				cr:     userGroup(withStatus(modifying)),
+			},
+			want: want{
+				cr: userGroup(withStatus(modifying)),
+			},
+		},
+		"NothingToModify": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: describeUserGroups(v1alpha1.StatusActive, defaultUser, appUser),
+				},
+				cr: userGroup(withStatus(active)),
+			},
+			want: want{
+				cr: userGroup(withStatus(active)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: func(*svcsdk.DescribeUserGroupsInput) (*svcsdk.DescribeUserGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userGroup(withStatus(active)),
+			},
+			want: want{
+				cr:  userGroup(withStatus(active)),
+				err: awsclient.Wrap(errBoom, errDescribeUserGroup),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDescribeUserGroups: describeUserGroups(v1alpha1.StatusActive, defaultUser),
+					MockModifyUserGroup: func(*svcsdk.ModifyUserGroupInput) (*svcsdk.ModifyUserGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userGroup(withStatus(active)),
+			},
+			want: want{
+				cr:  userGroup(withStatus(active)),
+				err: awsclient.Wrap(errBoom, errModifyUserGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.UserGroup
+		err error
+	}
+
+	deleting := v1alpha1.UserGroupObservation{Status: v1alpha1.StatusDeleting}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDeleteUserGroup: func(in *svcsdk.DeleteUserGroupInput) (*svcsdk.DeleteUserGroupOutput, error) {
+						if awsv1.StringValue(in.UserGroupId) != userGroupID {
+							return nil, errBoom
+						}
+						return &svcsdk.DeleteUserGroupOutput{}, nil
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockUserGroupClient{},
+				cr:     userGroup(withStatus(deleting)),
+			},
+			want: want{
+				cr: userGroup(withStatus(deleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDeleteUserGroup: func(*svcsdk.DeleteUserGroupInput) (*svcsdk.DeleteUserGroupOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeUserGroupNotFoundFault, "", nil)
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr: userGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockUserGroupClient{
+					MockDeleteUserGroup: func(*svcsdk.DeleteUserGroupInput) (*svcsdk.DeleteUserGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userGroup(),
+			},
+			want: want{
+				cr:  userGroup(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteUserGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
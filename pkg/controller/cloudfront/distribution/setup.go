@@ -19,9 +19,12 @@ package distribution
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -43,6 +46,15 @@ import (
 // TODO: isn't this defined as an API constant somewhere in aws-sdk-go? Generated zz_enums.go seems not to contain it either
 const stateDeployed = "Deployed"
 
+const (
+	// Lambda@Edge functions have to be created in us-east-1.
+	lambdaEdgeRegion = "us-east-1"
+
+	errInvalidLambdaARNFmt     = "invalid Lambda@Edge function ARN %q"
+	errLambdaEdgeRegionFmt     = "Lambda@Edge function %q must be in region " + lambdaEdgeRegion
+	errLambdaEdgeQualifiersFmt = "Lambda@Edge function %q must be qualified with a published version, e.g. by setting lambdaFunctionVersion"
+)
+
 // SetupDistribution adds a controller that reconciles Distribution.
 func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(svcapitypes.DistributionGroupKind)
@@ -55,6 +67,7 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DistributionGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithExternalConnecter(&connector{
 				kube: mgr.GetClient(),
 				opts: []option{
@@ -77,6 +90,9 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 }
 
 func preCreate(_ context.Context, cr *svcapitypes.Distribution, cdi *svcsdk.CreateDistributionInput) error {
+	if err := validateLambdaFunctionAssociations(cr.Spec.ForProvider.DistributionConfig); err != nil {
+		return err
+	}
 	cdi.DistributionConfig.CallerReference = awsclients.String(string(cr.UID))
 	// if cr.Spec.ForProvider.DistributionConfig.Origins is not nil then cdi.DistributionConfig.Origins is not nil
 	if cr.Spec.ForProvider.DistributionConfig.Origins != nil {
@@ -109,7 +125,9 @@ func lateInitialize(in *svcapitypes.DistributionParameters, gdo *svcsdk.GetDistr
 		replacer("ID", "Id"),
 		replacer("ARN", "Arn"),
 		mapReplacer(map[string]string{
-			"HTTPVersion": "HttpVersion",
+			"HTTPVersion":       "HttpVersion",
+			"FunctionArn":       "FunctionARN",
+			"LambdaFunctionArn": "LambdaFunctionARN",
 		}))
 	return err
 }
@@ -151,6 +169,9 @@ func postUpdate(_ context.Context, cr *svcapitypes.Distribution, resp *svcsdk.Up
 }
 
 func preUpdate(_ context.Context, cr *svcapitypes.Distribution, udi *svcsdk.UpdateDistributionInput) error {
+	if err := validateLambdaFunctionAssociations(cr.Spec.ForProvider.DistributionConfig); err != nil {
+		return err
+	}
 	udi.Id = awsclients.String(meta.GetExternalName(cr))
 	udi.SetIfMatch(awsclients.StringValue(cr.Status.AtProvider.ETag))
 	udi.DistributionConfig.CallerReference = awsclients.String(string(cr.UID))
@@ -159,6 +180,62 @@ func preUpdate(_ context.Context, cr *svcapitypes.Distribution, udi *svcsdk.Upda
 	return nil
 }
 
+// validateLambdaFunctionAssociations returns an error if a Lambda@Edge
+// function of a cache behavior is not in us-east-1 or is not qualified with
+// a published version, which CloudFront would otherwise reject only after
+// the distribution has been sent.
+func validateLambdaFunctionAssociations(cfg *svcapitypes.DistributionConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	var associations []*svcapitypes.LambdaFunctionAssociations
+	if cfg.DefaultCacheBehavior != nil {
+		associations = append(associations, cfg.DefaultCacheBehavior.LambdaFunctionAssociations)
+	}
+	if cfg.CacheBehaviors != nil {
+		for _, b := range cfg.CacheBehaviors.Items {
+			if b != nil {
+				associations = append(associations, b.LambdaFunctionAssociations)
+			}
+		}
+	}
+	for _, la := range associations {
+		if la == nil {
+			continue
+		}
+		for _, a := range la.Items {
+			if a == nil {
+				continue
+			}
+			if err := validateLambdaEdgeARN(awsclients.StringValue(a.LambdaFunctionARN)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateLambdaEdgeARN checks an ARN of the form
+// arn:aws:lambda:us-east-1:123456789012:function:name:1.
+func validateLambdaEdgeARN(s string) error {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return errors.Wrapf(err, errInvalidLambdaARNFmt, s)
+	}
+	if a.Region != lambdaEdgeRegion {
+		return errors.Errorf(errLambdaEdgeRegionFmt, s)
+	}
+	parts := strings.Split(a.Resource, ":")
+	if len(parts) != 3 || parts[0] != "function" {
+		return errors.Errorf(errLambdaEdgeQualifiersFmt, s)
+	}
+	// Only numeric versions are accepted, neither $LATEST nor aliases.
+	if _, err := strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return errors.Errorf(errLambdaEdgeQualifiersFmt, s)
+	}
+	return nil
+}
+
 type deleter struct {
 	external *external
 }
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package distribution
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+const (
+	lambdaARN   = "arn:aws:lambda:us-east-1:123456789012:function:edge"
+	functionARN = "arn:aws:cloudfront::123456789012:function/viewer"
+
+	cachePolicyID        = "658327ea-f89d-4fab-a63d-7e88639e58f6"
+	originAccessIdentity = "origin-access-identity/cloudfront/E2QWRUHAPOMQZL"
+)
+
+func TestValidateLambdaEdgeARN(t *testing.T) {
+	cases := map[string]struct {
+		arn  string
+		want error
+	}{
+		"Versioned": {
+			arn: lambdaARN + ":3",
+		},
+		"Unqualified": {
+			arn:  lambdaARN,
+			want: errors.Errorf(errLambdaEdgeQualifiersFmt, lambdaARN),
+		},
+		"Latest": {
+			arn:  lambdaARN + ":$LATEST",
+			want: errors.Errorf(errLambdaEdgeQualifiersFmt, lambdaARN+":$LATEST"),
+		},
+		"Alias": {
+			arn:  lambdaARN + ":live",
+			want: errors.Errorf(errLambdaEdgeQualifiersFmt, lambdaARN+":live"),
+		},
+		"WrongRegion": {
+			arn:  "arn:aws:lambda:eu-west-1:123456789012:function:edge:3",
+			want: errors.Errorf(errLambdaEdgeRegionFmt, "arn:aws:lambda:eu-west-1:123456789012:function:edge:3"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateLambdaEdgeARN(tc.arn)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr  *svcapitypes.Distribution
+		gdo *svcsdk.GetDistributionOutput
+	}
+
+	observed := &svcsdk.GetDistributionOutput{Distribution: &svcsdk.Distribution{
+		DistributionConfig: &svcsdk.DistributionConfig{
+			CacheBehaviors: &svcsdk.CacheBehaviors{
+				Quantity: aws.Int64(1),
+				Items: []*svcsdk.CacheBehavior{{
+					PathPattern:   aws.String("/edge/*"),
+					CachePolicyId: aws.String(cachePolicyID),
+					FunctionAssociations: &svcsdk.FunctionAssociations{
+						Quantity: aws.Int64(1),
+						Items: []*svcsdk.FunctionAssociation{{
+							EventType:   aws.String(svcsdk.EventTypeViewerRequest),
+							FunctionARN: aws.String(functionARN),
+						}},
+					},
+					LambdaFunctionAssociations: &svcsdk.LambdaFunctionAssociations{
+						Quantity: aws.Int64(1),
+						Items: []*svcsdk.LambdaFunctionAssociation{{
+							EventType:         aws.String(svcsdk.EventTypeOriginRequest),
+							LambdaFunctionARN: aws.String(lambdaARN + ":3"),
+						}},
+					},
+				}},
+			},
+			Origins: &svcsdk.Origins{
+				Quantity: aws.Int64(1),
+				Items: []*svcsdk.Origin{{
+					Id:             aws.String("s3"),
+					S3OriginConfig: &svcsdk.S3OriginConfig{OriginAccessIdentity: aws.String(originAccessIdentity)},
+				}},
+			},
+		},
+	}}
+
+	distribution := func(version string) *svcapitypes.Distribution {
+		return &svcapitypes.Distribution{Spec: svcapitypes.DistributionSpec{
+			ForProvider: svcapitypes.DistributionParameters{
+				DistributionConfig: &svcapitypes.DistributionConfig{
+					CacheBehaviors: &svcapitypes.CacheBehaviors{
+						Quantity: aws.Int64(1),
+						Items: []*svcapitypes.CacheBehavior{{
+							PathPattern:   aws.String("/edge/*"),
+							CachePolicyID: aws.String(cachePolicyID),
+							FunctionAssociations: &svcapitypes.FunctionAssociations{
+								Quantity: aws.Int64(1),
+								Items: []*svcapitypes.FunctionAssociation{{
+									EventType:   aws.String(svcsdk.EventTypeViewerRequest),
+									FunctionARN: aws.String(functionARN),
+								}},
+							},
+							LambdaFunctionAssociations: &svcapitypes.LambdaFunctionAssociations{
+								Quantity: aws.Int64(1),
+								Items: []*svcapitypes.LambdaFunctionAssociation{{
+									EventType:         aws.String(svcsdk.EventTypeOriginRequest),
+									LambdaFunctionARN: aws.String(lambdaARN + ":" + version),
+								}},
+							},
+						}},
+					},
+					Origins: &svcapitypes.Origins{
+						Items: []*svcapitypes.Origin{{
+							ID:             aws.String("s3"),
+							S3OriginConfig: &svcapitypes.S3OriginConfig{OriginAccessIDentity: aws.String(originAccessIdentity)},
+						}},
+					},
+				},
+				CustomDistributionParameters: svcapitypes.CustomDistributionParameters{
+					CacheBehaviorReferences: []svcapitypes.CustomCacheBehaviorParameters{{
+						PathPattern:      aws.String("/edge/*"),
+						CachePolicyIDRef: &xpv1.Reference{Name: "policy"},
+						FunctionAssociations: []svcapitypes.CustomFunctionAssociationParameters{{
+							EventType:      svcsdk.EventTypeViewerRequest,
+							FunctionARNRef: &xpv1.Reference{Name: "viewer"},
+						}},
+						LambdaFunctionAssociations: []svcapitypes.CustomLambdaFunctionAssociationParameters{{
+							EventType:             svcsdk.EventTypeOriginRequest,
+							LambdaFunctionARNRef:  &xpv1.Reference{Name: "edge"},
+							LambdaFunctionVersion: aws.String(version),
+						}},
+					}},
+					OriginReferences: []svcapitypes.CustomOriginParameters{{
+						ID:                      "s3",
+						OriginAccessIdentityRef: &xpv1.Reference{Name: "identity"},
+					}},
+				},
+			},
+		}}
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDateWithReferences": {
+			args: args{cr: distribution("3"), gdo: observed},
+			want: true,
+		},
+		"NewLambdaVersion": {
+			args: args{cr: distribution("4"), gdo: observed},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.args.cr, tc.args.gdo)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
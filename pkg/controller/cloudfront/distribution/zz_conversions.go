@@ -264,29 +264,50 @@ func GenerateDistribution(resp *svcsdk.GetDistributionOutput) *svcapitypes.Distr
 							}
 							f0f4f1f0elem.ForwardedValues = f0f4f1f0elemf5
 						}
-						if f0f4f1f0iter.LambdaFunctionAssociations != nil {
-							f0f4f1f0elemf6 := &svcapitypes.LambdaFunctionAssociations{}
-							if f0f4f1f0iter.LambdaFunctionAssociations.Items != nil {
-								f0f4f1f0elemf6f0 := []*svcapitypes.LambdaFunctionAssociation{}
-								for _, f0f4f1f0elemf6f0iter := range f0f4f1f0iter.LambdaFunctionAssociations.Items {
-									f0f4f1f0elemf6f0elem := &svcapitypes.LambdaFunctionAssociation{}
+						if f0f4f1f0iter.FunctionAssociations != nil {
+							f0f4f1f0elemf6 := &svcapitypes.FunctionAssociations{}
+							if f0f4f1f0iter.FunctionAssociations.Items != nil {
+								f0f4f1f0elemf6f0 := []*svcapitypes.FunctionAssociation{}
+								for _, f0f4f1f0elemf6f0iter := range f0f4f1f0iter.FunctionAssociations.Items {
+									f0f4f1f0elemf6f0elem := &svcapitypes.FunctionAssociation{}
 									if f0f4f1f0elemf6f0iter.EventType != nil {
 										f0f4f1f0elemf6f0elem.EventType = f0f4f1f0elemf6f0iter.EventType
 									}
-									if f0f4f1f0elemf6f0iter.IncludeBody != nil {
-										f0f4f1f0elemf6f0elem.IncludeBody = f0f4f1f0elemf6f0iter.IncludeBody
-									}
-									if f0f4f1f0elemf6f0iter.LambdaFunctionARN != nil {
-										f0f4f1f0elemf6f0elem.LambdaFunctionARN = f0f4f1f0elemf6f0iter.LambdaFunctionARN
+									if f0f4f1f0elemf6f0iter.FunctionARN != nil {
+										f0f4f1f0elemf6f0elem.FunctionARN = f0f4f1f0elemf6f0iter.FunctionARN
 									}
 									f0f4f1f0elemf6f0 = append(f0f4f1f0elemf6f0, f0f4f1f0elemf6f0elem)
 								}
 								f0f4f1f0elemf6.Items = f0f4f1f0elemf6f0
 							}
+							if f0f4f1f0iter.FunctionAssociations.Quantity != nil {
+								f0f4f1f0elemf6.Quantity = f0f4f1f0iter.FunctionAssociations.Quantity
+							}
+							f0f4f1f0elem.FunctionAssociations = f0f4f1f0elemf6
+						}
+						if f0f4f1f0iter.LambdaFunctionAssociations != nil {
+							f0f4f1f0elemf7 := &svcapitypes.LambdaFunctionAssociations{}
+							if f0f4f1f0iter.LambdaFunctionAssociations.Items != nil {
+								f0f4f1f0elemf7f0 := []*svcapitypes.LambdaFunctionAssociation{}
+								for _, f0f4f1f0elemf7f0iter := range f0f4f1f0iter.LambdaFunctionAssociations.Items {
+									f0f4f1f0elemf7f0elem := &svcapitypes.LambdaFunctionAssociation{}
+									if f0f4f1f0elemf7f0iter.EventType != nil {
+										f0f4f1f0elemf7f0elem.EventType = f0f4f1f0elemf7f0iter.EventType
+									}
+									if f0f4f1f0elemf7f0iter.IncludeBody != nil {
+										f0f4f1f0elemf7f0elem.IncludeBody = f0f4f1f0elemf7f0iter.IncludeBody
+									}
+									if f0f4f1f0elemf7f0iter.LambdaFunctionARN != nil {
+										f0f4f1f0elemf7f0elem.LambdaFunctionARN = f0f4f1f0elemf7f0iter.LambdaFunctionARN
+									}
+									f0f4f1f0elemf7f0 = append(f0f4f1f0elemf7f0, f0f4f1f0elemf7f0elem)
+								}
+								f0f4f1f0elemf7.Items = f0f4f1f0elemf7f0
+							}
 							if f0f4f1f0iter.LambdaFunctionAssociations.Quantity != nil {
-								f0f4f1f0elemf6.Quantity = f0f4f1f0iter.LambdaFunctionAssociations.Quantity
+								f0f4f1f0elemf7.Quantity = f0f4f1f0iter.LambdaFunctionAssociations.Quantity
 							}
-							f0f4f1f0elem.LambdaFunctionAssociations = f0f4f1f0elemf6
+							f0f4f1f0elem.LambdaFunctionAssociations = f0f4f1f0elemf7
 						}
 						if f0f4f1f0iter.MaxTTL != nil {
 							f0f4f1f0elem.MaxTTL = f0f4f1f0iter.MaxTTL
@@ -310,42 +331,42 @@ func GenerateDistribution(resp *svcsdk.GetDistributionOutput) *svcapitypes.Distr
 							f0f4f1f0elem.TargetOriginID = f0f4f1f0iter.TargetOriginId
 						}
 						if f0f4f1f0iter.TrustedKeyGroups != nil {
-							f0f4f1f0elemf14 := &svcapitypes.TrustedKeyGroups{}
+							f0f4f1f0elemf15 := &svcapitypes.TrustedKeyGroups{}
 							if f0f4f1f0iter.TrustedKeyGroups.Enabled != nil {
-								f0f4f1f0elemf14.Enabled = f0f4f1f0iter.TrustedKeyGroups.Enabled
+								f0f4f1f0elemf15.Enabled = f0f4f1f0iter.TrustedKeyGroups.Enabled
 							}
 							if f0f4f1f0iter.TrustedKeyGroups.Items != nil {
-								f0f4f1f0elemf14f1 := []*string{}
-								for _, f0f4f1f0elemf14f1iter := range f0f4f1f0iter.TrustedKeyGroups.Items {
-									var f0f4f1f0elemf14f1elem string
-									f0f4f1f0elemf14f1elem = *f0f4f1f0elemf14f1iter
-									f0f4f1f0elemf14f1 = append(f0f4f1f0elemf14f1, &f0f4f1f0elemf14f1elem)
+								f0f4f1f0elemf15f1 := []*string{}
+								for _, f0f4f1f0elemf15f1iter := range f0f4f1f0iter.TrustedKeyGroups.Items {
+									var f0f4f1f0elemf15f1elem string
+									f0f4f1f0elemf15f1elem = *f0f4f1f0elemf15f1iter
+									f0f4f1f0elemf15f1 = append(f0f4f1f0elemf15f1, &f0f4f1f0elemf15f1elem)
 								}
-								f0f4f1f0elemf14.Items = f0f4f1f0elemf14f1
+								f0f4f1f0elemf15.Items = f0f4f1f0elemf15f1
 							}
 							if f0f4f1f0iter.TrustedKeyGroups.Quantity != nil {
-								f0f4f1f0elemf14.Quantity = f0f4f1f0iter.TrustedKeyGroups.Quantity
+								f0f4f1f0elemf15.Quantity = f0f4f1f0iter.TrustedKeyGroups.Quantity
 							}
-							f0f4f1f0elem.TrustedKeyGroups = f0f4f1f0elemf14
+							f0f4f1f0elem.TrustedKeyGroups = f0f4f1f0elemf15
 						}
 						if f0f4f1f0iter.TrustedSigners != nil {
-							f0f4f1f0elemf15 := &svcapitypes.TrustedSigners{}
+							f0f4f1f0elemf16 := &svcapitypes.TrustedSigners{}
 							if f0f4f1f0iter.TrustedSigners.Enabled != nil {
-								f0f4f1f0elemf15.Enabled = f0f4f1f0iter.TrustedSigners.Enabled
+								f0f4f1f0elemf16.Enabled = f0f4f1f0iter.TrustedSigners.Enabled
 							}
 							if f0f4f1f0iter.TrustedSigners.Items != nil {
-								f0f4f1f0elemf15f1 := []*string{}
-								for _, f0f4f1f0elemf15f1iter := range f0f4f1f0iter.TrustedSigners.Items {
-									var f0f4f1f0elemf15f1elem string
-									f0f4f1f0elemf15f1elem = *f0f4f1f0elemf15f1iter
-									f0f4f1f0elemf15f1 = append(f0f4f1f0elemf15f1, &f0f4f1f0elemf15f1elem)
+								f0f4f1f0elemf16f1 := []*string{}
+								for _, f0f4f1f0elemf16f1iter := range f0f4f1f0iter.TrustedSigners.Items {
+									var f0f4f1f0elemf16f1elem string
+									f0f4f1f0elemf16f1elem = *f0f4f1f0elemf16f1iter
+									f0f4f1f0elemf16f1 = append(f0f4f1f0elemf16f1, &f0f4f1f0elemf16f1elem)
 								}
-								f0f4f1f0elemf15.Items = f0f4f1f0elemf15f1
+								f0f4f1f0elemf16.Items = f0f4f1f0elemf16f1
 							}
 							if f0f4f1f0iter.TrustedSigners.Quantity != nil {
-								f0f4f1f0elemf15.Quantity = f0f4f1f0iter.TrustedSigners.Quantity
+								f0f4f1f0elemf16.Quantity = f0f4f1f0iter.TrustedSigners.Quantity
 							}
-							f0f4f1f0elem.TrustedSigners = f0f4f1f0elemf15
+							f0f4f1f0elem.TrustedSigners = f0f4f1f0elemf16
 						}
 						if f0f4f1f0iter.ViewerProtocolPolicy != nil {
 							f0f4f1f0elem.ViewerProtocolPolicy = f0f4f1f0iter.ViewerProtocolPolicy
@@ -497,29 +518,50 @@ func GenerateDistribution(resp *svcsdk.GetDistributionOutput) *svcapitypes.Distr
 					}
 					f0f4f4.ForwardedValues = f0f4f4f5
 				}
-				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations != nil {
-					f0f4f4f6 := &svcapitypes.LambdaFunctionAssociations{}
-					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items != nil {
-						f0f4f4f6f0 := []*svcapitypes.LambdaFunctionAssociation{}
-						for _, f0f4f4f6f0iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items {
-							f0f4f4f6f0elem := &svcapitypes.LambdaFunctionAssociation{}
+				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.FunctionAssociations != nil {
+					f0f4f4f6 := &svcapitypes.FunctionAssociations{}
+					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Items != nil {
+						f0f4f4f6f0 := []*svcapitypes.FunctionAssociation{}
+						for _, f0f4f4f6f0iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Items {
+							f0f4f4f6f0elem := &svcapitypes.FunctionAssociation{}
 							if f0f4f4f6f0iter.EventType != nil {
 								f0f4f4f6f0elem.EventType = f0f4f4f6f0iter.EventType
 							}
-							if f0f4f4f6f0iter.IncludeBody != nil {
-								f0f4f4f6f0elem.IncludeBody = f0f4f4f6f0iter.IncludeBody
-							}
-							if f0f4f4f6f0iter.LambdaFunctionARN != nil {
-								f0f4f4f6f0elem.LambdaFunctionARN = f0f4f4f6f0iter.LambdaFunctionARN
+							if f0f4f4f6f0iter.FunctionARN != nil {
+								f0f4f4f6f0elem.FunctionARN = f0f4f4f6f0iter.FunctionARN
 							}
 							f0f4f4f6f0 = append(f0f4f4f6f0, f0f4f4f6f0elem)
 						}
 						f0f4f4f6.Items = f0f4f4f6f0
 					}
+					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Quantity != nil {
+						f0f4f4f6.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Quantity
+					}
+					f0f4f4.FunctionAssociations = f0f4f4f6
+				}
+				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations != nil {
+					f0f4f4f7 := &svcapitypes.LambdaFunctionAssociations{}
+					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items != nil {
+						f0f4f4f7f0 := []*svcapitypes.LambdaFunctionAssociation{}
+						for _, f0f4f4f7f0iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items {
+							f0f4f4f7f0elem := &svcapitypes.LambdaFunctionAssociation{}
+							if f0f4f4f7f0iter.EventType != nil {
+								f0f4f4f7f0elem.EventType = f0f4f4f7f0iter.EventType
+							}
+							if f0f4f4f7f0iter.IncludeBody != nil {
+								f0f4f4f7f0elem.IncludeBody = f0f4f4f7f0iter.IncludeBody
+							}
+							if f0f4f4f7f0iter.LambdaFunctionARN != nil {
+								f0f4f4f7f0elem.LambdaFunctionARN = f0f4f4f7f0iter.LambdaFunctionARN
+							}
+							f0f4f4f7f0 = append(f0f4f4f7f0, f0f4f4f7f0elem)
+						}
+						f0f4f4f7.Items = f0f4f4f7f0
+					}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity != nil {
-						f0f4f4f6.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity
+						f0f4f4f7.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity
 					}
-					f0f4f4.LambdaFunctionAssociations = f0f4f4f6
+					f0f4f4.LambdaFunctionAssociations = f0f4f4f7
 				}
 				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.MaxTTL != nil {
 					f0f4f4.MaxTTL = resp.Distribution.DistributionConfig.DefaultCacheBehavior.MaxTTL
@@ -540,42 +582,42 @@ func GenerateDistribution(resp *svcsdk.GetDistributionOutput) *svcapitypes.Distr
 					f0f4f4.TargetOriginID = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TargetOriginId
 				}
 				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups != nil {
-					f0f4f4f13 := &svcapitypes.TrustedKeyGroups{}
+					f0f4f4f14 := &svcapitypes.TrustedKeyGroups{}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled != nil {
-						f0f4f4f13.Enabled = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled
+						f0f4f4f14.Enabled = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled
 					}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items != nil {
-						f0f4f4f13f1 := []*string{}
-						for _, f0f4f4f13f1iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items {
-							var f0f4f4f13f1elem string
-							f0f4f4f13f1elem = *f0f4f4f13f1iter
-							f0f4f4f13f1 = append(f0f4f4f13f1, &f0f4f4f13f1elem)
+						f0f4f4f14f1 := []*string{}
+						for _, f0f4f4f14f1iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items {
+							var f0f4f4f14f1elem string
+							f0f4f4f14f1elem = *f0f4f4f14f1iter
+							f0f4f4f14f1 = append(f0f4f4f14f1, &f0f4f4f14f1elem)
 						}
-						f0f4f4f13.Items = f0f4f4f13f1
+						f0f4f4f14.Items = f0f4f4f14f1
 					}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity != nil {
-						f0f4f4f13.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity
+						f0f4f4f14.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity
 					}
-					f0f4f4.TrustedKeyGroups = f0f4f4f13
+					f0f4f4.TrustedKeyGroups = f0f4f4f14
 				}
 				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners != nil {
-					f0f4f4f14 := &svcapitypes.TrustedSigners{}
+					f0f4f4f15 := &svcapitypes.TrustedSigners{}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled != nil {
-						f0f4f4f14.Enabled = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled
+						f0f4f4f15.Enabled = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled
 					}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items != nil {
-						f0f4f4f14f1 := []*string{}
-						for _, f0f4f4f14f1iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items {
-							var f0f4f4f14f1elem string
-							f0f4f4f14f1elem = *f0f4f4f14f1iter
-							f0f4f4f14f1 = append(f0f4f4f14f1, &f0f4f4f14f1elem)
+						f0f4f4f15f1 := []*string{}
+						for _, f0f4f4f15f1iter := range resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items {
+							var f0f4f4f15f1elem string
+							f0f4f4f15f1elem = *f0f4f4f15f1iter
+							f0f4f4f15f1 = append(f0f4f4f15f1, &f0f4f4f15f1elem)
 						}
-						f0f4f4f14.Items = f0f4f4f14f1
+						f0f4f4f15.Items = f0f4f4f15f1
 					}
 					if resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity != nil {
-						f0f4f4f14.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity
+						f0f4f4f15.Quantity = resp.Distribution.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity
 					}
-					f0f4f4.TrustedSigners = f0f4f4f14
+					f0f4f4.TrustedSigners = f0f4f4f15
 				}
 				if resp.Distribution.DistributionConfig.DefaultCacheBehavior.ViewerProtocolPolicy != nil {
 					f0f4f4.ViewerProtocolPolicy = resp.Distribution.DistributionConfig.DefaultCacheBehavior.ViewerProtocolPolicy
@@ -984,29 +1026,50 @@ func GenerateCreateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Creat
 						}
 						f0f1f0elem.SetForwardedValues(f0f1f0elemf5)
 					}
-					if f0f1f0iter.LambdaFunctionAssociations != nil {
-						f0f1f0elemf6 := &svcsdk.LambdaFunctionAssociations{}
-						if f0f1f0iter.LambdaFunctionAssociations.Items != nil {
-							f0f1f0elemf6f0 := []*svcsdk.LambdaFunctionAssociation{}
-							for _, f0f1f0elemf6f0iter := range f0f1f0iter.LambdaFunctionAssociations.Items {
-								f0f1f0elemf6f0elem := &svcsdk.LambdaFunctionAssociation{}
+					if f0f1f0iter.FunctionAssociations != nil {
+						f0f1f0elemf6 := &svcsdk.FunctionAssociations{}
+						if f0f1f0iter.FunctionAssociations.Items != nil {
+							f0f1f0elemf6f0 := []*svcsdk.FunctionAssociation{}
+							for _, f0f1f0elemf6f0iter := range f0f1f0iter.FunctionAssociations.Items {
+								f0f1f0elemf6f0elem := &svcsdk.FunctionAssociation{}
 								if f0f1f0elemf6f0iter.EventType != nil {
 									f0f1f0elemf6f0elem.SetEventType(*f0f1f0elemf6f0iter.EventType)
 								}
-								if f0f1f0elemf6f0iter.IncludeBody != nil {
-									f0f1f0elemf6f0elem.SetIncludeBody(*f0f1f0elemf6f0iter.IncludeBody)
-								}
-								if f0f1f0elemf6f0iter.LambdaFunctionARN != nil {
-									f0f1f0elemf6f0elem.SetLambdaFunctionARN(*f0f1f0elemf6f0iter.LambdaFunctionARN)
+								if f0f1f0elemf6f0iter.FunctionARN != nil {
+									f0f1f0elemf6f0elem.SetFunctionARN(*f0f1f0elemf6f0iter.FunctionARN)
 								}
 								f0f1f0elemf6f0 = append(f0f1f0elemf6f0, f0f1f0elemf6f0elem)
 							}
 							f0f1f0elemf6.SetItems(f0f1f0elemf6f0)
 						}
+						if f0f1f0iter.FunctionAssociations.Quantity != nil {
+							f0f1f0elemf6.SetQuantity(*f0f1f0iter.FunctionAssociations.Quantity)
+						}
+						f0f1f0elem.SetFunctionAssociations(f0f1f0elemf6)
+					}
+					if f0f1f0iter.LambdaFunctionAssociations != nil {
+						f0f1f0elemf7 := &svcsdk.LambdaFunctionAssociations{}
+						if f0f1f0iter.LambdaFunctionAssociations.Items != nil {
+							f0f1f0elemf7f0 := []*svcsdk.LambdaFunctionAssociation{}
+							for _, f0f1f0elemf7f0iter := range f0f1f0iter.LambdaFunctionAssociations.Items {
+								f0f1f0elemf7f0elem := &svcsdk.LambdaFunctionAssociation{}
+								if f0f1f0elemf7f0iter.EventType != nil {
+									f0f1f0elemf7f0elem.SetEventType(*f0f1f0elemf7f0iter.EventType)
+								}
+								if f0f1f0elemf7f0iter.IncludeBody != nil {
+									f0f1f0elemf7f0elem.SetIncludeBody(*f0f1f0elemf7f0iter.IncludeBody)
+								}
+								if f0f1f0elemf7f0iter.LambdaFunctionARN != nil {
+									f0f1f0elemf7f0elem.SetLambdaFunctionARN(*f0f1f0elemf7f0iter.LambdaFunctionARN)
+								}
+								f0f1f0elemf7f0 = append(f0f1f0elemf7f0, f0f1f0elemf7f0elem)
+							}
+							f0f1f0elemf7.SetItems(f0f1f0elemf7f0)
+						}
 						if f0f1f0iter.LambdaFunctionAssociations.Quantity != nil {
-							f0f1f0elemf6.SetQuantity(*f0f1f0iter.LambdaFunctionAssociations.Quantity)
+							f0f1f0elemf7.SetQuantity(*f0f1f0iter.LambdaFunctionAssociations.Quantity)
 						}
-						f0f1f0elem.SetLambdaFunctionAssociations(f0f1f0elemf6)
+						f0f1f0elem.SetLambdaFunctionAssociations(f0f1f0elemf7)
 					}
 					if f0f1f0iter.MaxTTL != nil {
 						f0f1f0elem.SetMaxTTL(*f0f1f0iter.MaxTTL)
@@ -1030,42 +1093,42 @@ func GenerateCreateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Creat
 						f0f1f0elem.SetTargetOriginId(*f0f1f0iter.TargetOriginID)
 					}
 					if f0f1f0iter.TrustedKeyGroups != nil {
-						f0f1f0elemf14 := &svcsdk.TrustedKeyGroups{}
+						f0f1f0elemf15 := &svcsdk.TrustedKeyGroups{}
 						if f0f1f0iter.TrustedKeyGroups.Enabled != nil {
-							f0f1f0elemf14.SetEnabled(*f0f1f0iter.TrustedKeyGroups.Enabled)
+							f0f1f0elemf15.SetEnabled(*f0f1f0iter.TrustedKeyGroups.Enabled)
 						}
 						if f0f1f0iter.TrustedKeyGroups.Items != nil {
-							f0f1f0elemf14f1 := []*string{}
-							for _, f0f1f0elemf14f1iter := range f0f1f0iter.TrustedKeyGroups.Items {
-								var f0f1f0elemf14f1elem string
-								f0f1f0elemf14f1elem = *f0f1f0elemf14f1iter
-								f0f1f0elemf14f1 = append(f0f1f0elemf14f1, &f0f1f0elemf14f1elem)
+							f0f1f0elemf15f1 := []*string{}
+							for _, f0f1f0elemf15f1iter := range f0f1f0iter.TrustedKeyGroups.Items {
+								var f0f1f0elemf15f1elem string
+								f0f1f0elemf15f1elem = *f0f1f0elemf15f1iter
+								f0f1f0elemf15f1 = append(f0f1f0elemf15f1, &f0f1f0elemf15f1elem)
 							}
-							f0f1f0elemf14.SetItems(f0f1f0elemf14f1)
+							f0f1f0elemf15.SetItems(f0f1f0elemf15f1)
 						}
 						if f0f1f0iter.TrustedKeyGroups.Quantity != nil {
-							f0f1f0elemf14.SetQuantity(*f0f1f0iter.TrustedKeyGroups.Quantity)
+							f0f1f0elemf15.SetQuantity(*f0f1f0iter.TrustedKeyGroups.Quantity)
 						}
-						f0f1f0elem.SetTrustedKeyGroups(f0f1f0elemf14)
+						f0f1f0elem.SetTrustedKeyGroups(f0f1f0elemf15)
 					}
 					if f0f1f0iter.TrustedSigners != nil {
-						f0f1f0elemf15 := &svcsdk.TrustedSigners{}
+						f0f1f0elemf16 := &svcsdk.TrustedSigners{}
 						if f0f1f0iter.TrustedSigners.Enabled != nil {
-							f0f1f0elemf15.SetEnabled(*f0f1f0iter.TrustedSigners.Enabled)
+							f0f1f0elemf16.SetEnabled(*f0f1f0iter.TrustedSigners.Enabled)
 						}
 						if f0f1f0iter.TrustedSigners.Items != nil {
-							f0f1f0elemf15f1 := []*string{}
-							for _, f0f1f0elemf15f1iter := range f0f1f0iter.TrustedSigners.Items {
-								var f0f1f0elemf15f1elem string
-								f0f1f0elemf15f1elem = *f0f1f0elemf15f1iter
-								f0f1f0elemf15f1 = append(f0f1f0elemf15f1, &f0f1f0elemf15f1elem)
+							f0f1f0elemf16f1 := []*string{}
+							for _, f0f1f0elemf16f1iter := range f0f1f0iter.TrustedSigners.Items {
+								var f0f1f0elemf16f1elem string
+								f0f1f0elemf16f1elem = *f0f1f0elemf16f1iter
+								f0f1f0elemf16f1 = append(f0f1f0elemf16f1, &f0f1f0elemf16f1elem)
 							}
-							f0f1f0elemf15.SetItems(f0f1f0elemf15f1)
+							f0f1f0elemf16.SetItems(f0f1f0elemf16f1)
 						}
 						if f0f1f0iter.TrustedSigners.Quantity != nil {
-							f0f1f0elemf15.SetQuantity(*f0f1f0iter.TrustedSigners.Quantity)
+							f0f1f0elemf16.SetQuantity(*f0f1f0iter.TrustedSigners.Quantity)
 						}
-						f0f1f0elem.SetTrustedSigners(f0f1f0elemf15)
+						f0f1f0elem.SetTrustedSigners(f0f1f0elemf16)
 					}
 					if f0f1f0iter.ViewerProtocolPolicy != nil {
 						f0f1f0elem.SetViewerProtocolPolicy(*f0f1f0iter.ViewerProtocolPolicy)
@@ -1217,29 +1280,50 @@ func GenerateCreateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Creat
 				}
 				f0f4.SetForwardedValues(f0f4f5)
 			}
-			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations != nil {
-				f0f4f6 := &svcsdk.LambdaFunctionAssociations{}
-				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items != nil {
-					f0f4f6f0 := []*svcsdk.LambdaFunctionAssociation{}
-					for _, f0f4f6f0iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items {
-						f0f4f6f0elem := &svcsdk.LambdaFunctionAssociation{}
+			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations != nil {
+				f0f4f6 := &svcsdk.FunctionAssociations{}
+				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Items != nil {
+					f0f4f6f0 := []*svcsdk.FunctionAssociation{}
+					for _, f0f4f6f0iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Items {
+						f0f4f6f0elem := &svcsdk.FunctionAssociation{}
 						if f0f4f6f0iter.EventType != nil {
 							f0f4f6f0elem.SetEventType(*f0f4f6f0iter.EventType)
 						}
-						if f0f4f6f0iter.IncludeBody != nil {
-							f0f4f6f0elem.SetIncludeBody(*f0f4f6f0iter.IncludeBody)
-						}
-						if f0f4f6f0iter.LambdaFunctionARN != nil {
-							f0f4f6f0elem.SetLambdaFunctionARN(*f0f4f6f0iter.LambdaFunctionARN)
+						if f0f4f6f0iter.FunctionARN != nil {
+							f0f4f6f0elem.SetFunctionARN(*f0f4f6f0iter.FunctionARN)
 						}
 						f0f4f6f0 = append(f0f4f6f0, f0f4f6f0elem)
 					}
 					f0f4f6.SetItems(f0f4f6f0)
 				}
+				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Quantity != nil {
+					f0f4f6.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Quantity)
+				}
+				f0f4.SetFunctionAssociations(f0f4f6)
+			}
+			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations != nil {
+				f0f4f7 := &svcsdk.LambdaFunctionAssociations{}
+				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items != nil {
+					f0f4f7f0 := []*svcsdk.LambdaFunctionAssociation{}
+					for _, f0f4f7f0iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items {
+						f0f4f7f0elem := &svcsdk.LambdaFunctionAssociation{}
+						if f0f4f7f0iter.EventType != nil {
+							f0f4f7f0elem.SetEventType(*f0f4f7f0iter.EventType)
+						}
+						if f0f4f7f0iter.IncludeBody != nil {
+							f0f4f7f0elem.SetIncludeBody(*f0f4f7f0iter.IncludeBody)
+						}
+						if f0f4f7f0iter.LambdaFunctionARN != nil {
+							f0f4f7f0elem.SetLambdaFunctionARN(*f0f4f7f0iter.LambdaFunctionARN)
+						}
+						f0f4f7f0 = append(f0f4f7f0, f0f4f7f0elem)
+					}
+					f0f4f7.SetItems(f0f4f7f0)
+				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity != nil {
-					f0f4f6.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity)
+					f0f4f7.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity)
 				}
-				f0f4.SetLambdaFunctionAssociations(f0f4f6)
+				f0f4.SetLambdaFunctionAssociations(f0f4f7)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.MaxTTL != nil {
 				f0f4.SetMaxTTL(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.MaxTTL)
@@ -1260,42 +1344,42 @@ func GenerateCreateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Creat
 				f0f4.SetTargetOriginId(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TargetOriginID)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups != nil {
-				f0f4f13 := &svcsdk.TrustedKeyGroups{}
+				f0f4f14 := &svcsdk.TrustedKeyGroups{}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled != nil {
-					f0f4f13.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled)
+					f0f4f14.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items != nil {
-					f0f4f13f1 := []*string{}
-					for _, f0f4f13f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items {
-						var f0f4f13f1elem string
-						f0f4f13f1elem = *f0f4f13f1iter
-						f0f4f13f1 = append(f0f4f13f1, &f0f4f13f1elem)
+					f0f4f14f1 := []*string{}
+					for _, f0f4f14f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items {
+						var f0f4f14f1elem string
+						f0f4f14f1elem = *f0f4f14f1iter
+						f0f4f14f1 = append(f0f4f14f1, &f0f4f14f1elem)
 					}
-					f0f4f13.SetItems(f0f4f13f1)
+					f0f4f14.SetItems(f0f4f14f1)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity != nil {
-					f0f4f13.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity)
+					f0f4f14.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity)
 				}
-				f0f4.SetTrustedKeyGroups(f0f4f13)
+				f0f4.SetTrustedKeyGroups(f0f4f14)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners != nil {
-				f0f4f14 := &svcsdk.TrustedSigners{}
+				f0f4f15 := &svcsdk.TrustedSigners{}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled != nil {
-					f0f4f14.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled)
+					f0f4f15.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items != nil {
-					f0f4f14f1 := []*string{}
-					for _, f0f4f14f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items {
-						var f0f4f14f1elem string
-						f0f4f14f1elem = *f0f4f14f1iter
-						f0f4f14f1 = append(f0f4f14f1, &f0f4f14f1elem)
+					f0f4f15f1 := []*string{}
+					for _, f0f4f15f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items {
+						var f0f4f15f1elem string
+						f0f4f15f1elem = *f0f4f15f1iter
+						f0f4f15f1 = append(f0f4f15f1, &f0f4f15f1elem)
 					}
-					f0f4f14.SetItems(f0f4f14f1)
+					f0f4f15.SetItems(f0f4f15f1)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity != nil {
-					f0f4f14.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity)
+					f0f4f15.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity)
 				}
-				f0f4.SetTrustedSigners(f0f4f14)
+				f0f4.SetTrustedSigners(f0f4f15)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.ViewerProtocolPolicy != nil {
 				f0f4.SetViewerProtocolPolicy(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.ViewerProtocolPolicy)
@@ -1680,29 +1764,50 @@ func GenerateUpdateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Updat
 						}
 						f0f1f0elem.SetForwardedValues(f0f1f0elemf5)
 					}
-					if f0f1f0iter.LambdaFunctionAssociations != nil {
-						f0f1f0elemf6 := &svcsdk.LambdaFunctionAssociations{}
-						if f0f1f0iter.LambdaFunctionAssociations.Items != nil {
-							f0f1f0elemf6f0 := []*svcsdk.LambdaFunctionAssociation{}
-							for _, f0f1f0elemf6f0iter := range f0f1f0iter.LambdaFunctionAssociations.Items {
-								f0f1f0elemf6f0elem := &svcsdk.LambdaFunctionAssociation{}
+					if f0f1f0iter.FunctionAssociations != nil {
+						f0f1f0elemf6 := &svcsdk.FunctionAssociations{}
+						if f0f1f0iter.FunctionAssociations.Items != nil {
+							f0f1f0elemf6f0 := []*svcsdk.FunctionAssociation{}
+							for _, f0f1f0elemf6f0iter := range f0f1f0iter.FunctionAssociations.Items {
+								f0f1f0elemf6f0elem := &svcsdk.FunctionAssociation{}
 								if f0f1f0elemf6f0iter.EventType != nil {
 									f0f1f0elemf6f0elem.SetEventType(*f0f1f0elemf6f0iter.EventType)
 								}
-								if f0f1f0elemf6f0iter.IncludeBody != nil {
-									f0f1f0elemf6f0elem.SetIncludeBody(*f0f1f0elemf6f0iter.IncludeBody)
-								}
-								if f0f1f0elemf6f0iter.LambdaFunctionARN != nil {
-									f0f1f0elemf6f0elem.SetLambdaFunctionARN(*f0f1f0elemf6f0iter.LambdaFunctionARN)
+								if f0f1f0elemf6f0iter.FunctionARN != nil {
+									f0f1f0elemf6f0elem.SetFunctionARN(*f0f1f0elemf6f0iter.FunctionARN)
 								}
 								f0f1f0elemf6f0 = append(f0f1f0elemf6f0, f0f1f0elemf6f0elem)
 							}
 							f0f1f0elemf6.SetItems(f0f1f0elemf6f0)
 						}
+						if f0f1f0iter.FunctionAssociations.Quantity != nil {
+							f0f1f0elemf6.SetQuantity(*f0f1f0iter.FunctionAssociations.Quantity)
+						}
+						f0f1f0elem.SetFunctionAssociations(f0f1f0elemf6)
+					}
+					if f0f1f0iter.LambdaFunctionAssociations != nil {
+						f0f1f0elemf7 := &svcsdk.LambdaFunctionAssociations{}
+						if f0f1f0iter.LambdaFunctionAssociations.Items != nil {
+							f0f1f0elemf7f0 := []*svcsdk.LambdaFunctionAssociation{}
+							for _, f0f1f0elemf7f0iter := range f0f1f0iter.LambdaFunctionAssociations.Items {
+								f0f1f0elemf7f0elem := &svcsdk.LambdaFunctionAssociation{}
+								if f0f1f0elemf7f0iter.EventType != nil {
+									f0f1f0elemf7f0elem.SetEventType(*f0f1f0elemf7f0iter.EventType)
+								}
+								if f0f1f0elemf7f0iter.IncludeBody != nil {
+									f0f1f0elemf7f0elem.SetIncludeBody(*f0f1f0elemf7f0iter.IncludeBody)
+								}
+								if f0f1f0elemf7f0iter.LambdaFunctionARN != nil {
+									f0f1f0elemf7f0elem.SetLambdaFunctionARN(*f0f1f0elemf7f0iter.LambdaFunctionARN)
+								}
+								f0f1f0elemf7f0 = append(f0f1f0elemf7f0, f0f1f0elemf7f0elem)
+							}
+							f0f1f0elemf7.SetItems(f0f1f0elemf7f0)
+						}
 						if f0f1f0iter.LambdaFunctionAssociations.Quantity != nil {
-							f0f1f0elemf6.SetQuantity(*f0f1f0iter.LambdaFunctionAssociations.Quantity)
+							f0f1f0elemf7.SetQuantity(*f0f1f0iter.LambdaFunctionAssociations.Quantity)
 						}
-						f0f1f0elem.SetLambdaFunctionAssociations(f0f1f0elemf6)
+						f0f1f0elem.SetLambdaFunctionAssociations(f0f1f0elemf7)
 					}
 					if f0f1f0iter.MaxTTL != nil {
 						f0f1f0elem.SetMaxTTL(*f0f1f0iter.MaxTTL)
@@ -1726,42 +1831,42 @@ func GenerateUpdateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Updat
 						f0f1f0elem.SetTargetOriginId(*f0f1f0iter.TargetOriginID)
 					}
 					if f0f1f0iter.TrustedKeyGroups != nil {
-						f0f1f0elemf14 := &svcsdk.TrustedKeyGroups{}
+						f0f1f0elemf15 := &svcsdk.TrustedKeyGroups{}
 						if f0f1f0iter.TrustedKeyGroups.Enabled != nil {
-							f0f1f0elemf14.SetEnabled(*f0f1f0iter.TrustedKeyGroups.Enabled)
+							f0f1f0elemf15.SetEnabled(*f0f1f0iter.TrustedKeyGroups.Enabled)
 						}
 						if f0f1f0iter.TrustedKeyGroups.Items != nil {
-							f0f1f0elemf14f1 := []*string{}
-							for _, f0f1f0elemf14f1iter := range f0f1f0iter.TrustedKeyGroups.Items {
-								var f0f1f0elemf14f1elem string
-								f0f1f0elemf14f1elem = *f0f1f0elemf14f1iter
-								f0f1f0elemf14f1 = append(f0f1f0elemf14f1, &f0f1f0elemf14f1elem)
+							f0f1f0elemf15f1 := []*string{}
+							for _, f0f1f0elemf15f1iter := range f0f1f0iter.TrustedKeyGroups.Items {
+								var f0f1f0elemf15f1elem string
+								f0f1f0elemf15f1elem = *f0f1f0elemf15f1iter
+								f0f1f0elemf15f1 = append(f0f1f0elemf15f1, &f0f1f0elemf15f1elem)
 							}
-							f0f1f0elemf14.SetItems(f0f1f0elemf14f1)
+							f0f1f0elemf15.SetItems(f0f1f0elemf15f1)
 						}
 						if f0f1f0iter.TrustedKeyGroups.Quantity != nil {
-							f0f1f0elemf14.SetQuantity(*f0f1f0iter.TrustedKeyGroups.Quantity)
+							f0f1f0elemf15.SetQuantity(*f0f1f0iter.TrustedKeyGroups.Quantity)
 						}
-						f0f1f0elem.SetTrustedKeyGroups(f0f1f0elemf14)
+						f0f1f0elem.SetTrustedKeyGroups(f0f1f0elemf15)
 					}
 					if f0f1f0iter.TrustedSigners != nil {
-						f0f1f0elemf15 := &svcsdk.TrustedSigners{}
+						f0f1f0elemf16 := &svcsdk.TrustedSigners{}
 						if f0f1f0iter.TrustedSigners.Enabled != nil {
-							f0f1f0elemf15.SetEnabled(*f0f1f0iter.TrustedSigners.Enabled)
+							f0f1f0elemf16.SetEnabled(*f0f1f0iter.TrustedSigners.Enabled)
 						}
 						if f0f1f0iter.TrustedSigners.Items != nil {
-							f0f1f0elemf15f1 := []*string{}
-							for _, f0f1f0elemf15f1iter := range f0f1f0iter.TrustedSigners.Items {
-								var f0f1f0elemf15f1elem string
-								f0f1f0elemf15f1elem = *f0f1f0elemf15f1iter
-								f0f1f0elemf15f1 = append(f0f1f0elemf15f1, &f0f1f0elemf15f1elem)
+							f0f1f0elemf16f1 := []*string{}
+							for _, f0f1f0elemf16f1iter := range f0f1f0iter.TrustedSigners.Items {
+								var f0f1f0elemf16f1elem string
+								f0f1f0elemf16f1elem = *f0f1f0elemf16f1iter
+								f0f1f0elemf16f1 = append(f0f1f0elemf16f1, &f0f1f0elemf16f1elem)
 							}
-							f0f1f0elemf15.SetItems(f0f1f0elemf15f1)
+							f0f1f0elemf16.SetItems(f0f1f0elemf16f1)
 						}
 						if f0f1f0iter.TrustedSigners.Quantity != nil {
-							f0f1f0elemf15.SetQuantity(*f0f1f0iter.TrustedSigners.Quantity)
+							f0f1f0elemf16.SetQuantity(*f0f1f0iter.TrustedSigners.Quantity)
 						}
-						f0f1f0elem.SetTrustedSigners(f0f1f0elemf15)
+						f0f1f0elem.SetTrustedSigners(f0f1f0elemf16)
 					}
 					if f0f1f0iter.ViewerProtocolPolicy != nil {
 						f0f1f0elem.SetViewerProtocolPolicy(*f0f1f0iter.ViewerProtocolPolicy)
@@ -1913,29 +2018,50 @@ func GenerateUpdateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Updat
 				}
 				f0f4.SetForwardedValues(f0f4f5)
 			}
-			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations != nil {
-				f0f4f6 := &svcsdk.LambdaFunctionAssociations{}
-				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items != nil {
-					f0f4f6f0 := []*svcsdk.LambdaFunctionAssociation{}
-					for _, f0f4f6f0iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items {
-						f0f4f6f0elem := &svcsdk.LambdaFunctionAssociation{}
+			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations != nil {
+				f0f4f6 := &svcsdk.FunctionAssociations{}
+				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Items != nil {
+					f0f4f6f0 := []*svcsdk.FunctionAssociation{}
+					for _, f0f4f6f0iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Items {
+						f0f4f6f0elem := &svcsdk.FunctionAssociation{}
 						if f0f4f6f0iter.EventType != nil {
 							f0f4f6f0elem.SetEventType(*f0f4f6f0iter.EventType)
 						}
-						if f0f4f6f0iter.IncludeBody != nil {
-							f0f4f6f0elem.SetIncludeBody(*f0f4f6f0iter.IncludeBody)
-						}
-						if f0f4f6f0iter.LambdaFunctionARN != nil {
-							f0f4f6f0elem.SetLambdaFunctionARN(*f0f4f6f0iter.LambdaFunctionARN)
+						if f0f4f6f0iter.FunctionARN != nil {
+							f0f4f6f0elem.SetFunctionARN(*f0f4f6f0iter.FunctionARN)
 						}
 						f0f4f6f0 = append(f0f4f6f0, f0f4f6f0elem)
 					}
 					f0f4f6.SetItems(f0f4f6f0)
 				}
+				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Quantity != nil {
+					f0f4f6.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.FunctionAssociations.Quantity)
+				}
+				f0f4.SetFunctionAssociations(f0f4f6)
+			}
+			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations != nil {
+				f0f4f7 := &svcsdk.LambdaFunctionAssociations{}
+				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items != nil {
+					f0f4f7f0 := []*svcsdk.LambdaFunctionAssociation{}
+					for _, f0f4f7f0iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Items {
+						f0f4f7f0elem := &svcsdk.LambdaFunctionAssociation{}
+						if f0f4f7f0iter.EventType != nil {
+							f0f4f7f0elem.SetEventType(*f0f4f7f0iter.EventType)
+						}
+						if f0f4f7f0iter.IncludeBody != nil {
+							f0f4f7f0elem.SetIncludeBody(*f0f4f7f0iter.IncludeBody)
+						}
+						if f0f4f7f0iter.LambdaFunctionARN != nil {
+							f0f4f7f0elem.SetLambdaFunctionARN(*f0f4f7f0iter.LambdaFunctionARN)
+						}
+						f0f4f7f0 = append(f0f4f7f0, f0f4f7f0elem)
+					}
+					f0f4f7.SetItems(f0f4f7f0)
+				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity != nil {
-					f0f4f6.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity)
+					f0f4f7.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations.Quantity)
 				}
-				f0f4.SetLambdaFunctionAssociations(f0f4f6)
+				f0f4.SetLambdaFunctionAssociations(f0f4f7)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.MaxTTL != nil {
 				f0f4.SetMaxTTL(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.MaxTTL)
@@ -1956,42 +2082,42 @@ func GenerateUpdateDistributionInput(cr *svcapitypes.Distribution) *svcsdk.Updat
 				f0f4.SetTargetOriginId(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TargetOriginID)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups != nil {
-				f0f4f13 := &svcsdk.TrustedKeyGroups{}
+				f0f4f14 := &svcsdk.TrustedKeyGroups{}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled != nil {
-					f0f4f13.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled)
+					f0f4f14.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Enabled)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items != nil {
-					f0f4f13f1 := []*string{}
-					for _, f0f4f13f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items {
-						var f0f4f13f1elem string
-						f0f4f13f1elem = *f0f4f13f1iter
-						f0f4f13f1 = append(f0f4f13f1, &f0f4f13f1elem)
+					f0f4f14f1 := []*string{}
+					for _, f0f4f14f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Items {
+						var f0f4f14f1elem string
+						f0f4f14f1elem = *f0f4f14f1iter
+						f0f4f14f1 = append(f0f4f14f1, &f0f4f14f1elem)
 					}
-					f0f4f13.SetItems(f0f4f13f1)
+					f0f4f14.SetItems(f0f4f14f1)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity != nil {
-					f0f4f13.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity)
+					f0f4f14.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedKeyGroups.Quantity)
 				}
-				f0f4.SetTrustedKeyGroups(f0f4f13)
+				f0f4.SetTrustedKeyGroups(f0f4f14)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners != nil {
-				f0f4f14 := &svcsdk.TrustedSigners{}
+				f0f4f15 := &svcsdk.TrustedSigners{}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled != nil {
-					f0f4f14.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled)
+					f0f4f15.SetEnabled(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Enabled)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items != nil {
-					f0f4f14f1 := []*string{}
-					for _, f0f4f14f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items {
-						var f0f4f14f1elem string
-						f0f4f14f1elem = *f0f4f14f1iter
-						f0f4f14f1 = append(f0f4f14f1, &f0f4f14f1elem)
+					f0f4f15f1 := []*string{}
+					for _, f0f4f15f1iter := range cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Items {
+						var f0f4f15f1elem string
+						f0f4f15f1elem = *f0f4f15f1iter
+						f0f4f15f1 = append(f0f4f15f1, &f0f4f15f1elem)
 					}
-					f0f4f14.SetItems(f0f4f14f1)
+					f0f4f15.SetItems(f0f4f15f1)
 				}
 				if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity != nil {
-					f0f4f14.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity)
+					f0f4f15.SetQuantity(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.TrustedSigners.Quantity)
 				}
-				f0f4.SetTrustedSigners(f0f4f14)
+				f0f4.SetTrustedSigners(f0f4f15)
 			}
 			if cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.ViewerProtocolPolicy != nil {
 				f0f4.SetViewerProtocolPolicy(*cr.Spec.ForProvider.DistributionConfig.DefaultCacheBehavior.ViewerProtocolPolicy)
@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+const (
+	errNotFunction     = "managed resource is not a Function custom resource"
+	errGetFailed       = "cannot get Function"
+	errGetCodeFailed   = "cannot get code of Function"
+	errCreateFailed    = "cannot create Function"
+	errUpdateFailed    = "cannot update Function"
+	errDeleteFailed    = "cannot delete Function"
+	errTestFailed      = "cannot test Function"
+	errTestErrorFmt    = "test of Function failed: %s"
+	errPublishFailed   = "cannot publish Function"
+	errGetConfigMap    = "cannot get ConfigMap of Function code"
+	errConfigMapKeyFmt = "ConfigMap %s/%s has no key %s"
+	errNoCodeSource    = "one of inline or configMapRef must be set as code source"
+)
+
+// SetupFunction adds a controller that reconciles CloudFront Functions.
+func SetupFunction(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.FunctionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Function{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FunctionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return nil, errors.New(errNotFunction)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFunction)
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	dev, err := e.client.DescribeFunctionWithContext(ctx, &svcsdk.DescribeFunctionInput{Name: name, Stage: aws.String(svcsdk.FunctionStageDevelopment)})
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(resource.Ignore(svcclient.IsNotFound, err), errGetFailed)
+	}
+	obs := svcclient.GenerateFunctionObservation(dev)
+	obs.TestResult = cr.Status.AtProvider.TestResult
+	cr.Status.AtProvider = obs
+
+	code, err := e.getCode(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCodeFailed)
+	}
+	devCode, err := e.client.GetFunctionWithContext(ctx, &svcsdk.GetFunctionInput{Name: name, Stage: aws.String(svcsdk.FunctionStageDevelopment)})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetFailed)
+	}
+	upToDate := svcclient.IsFunctionUpToDate(cr.Spec.ForProvider, code, dev.FunctionSummary.FunctionConfig, devCode.FunctionCode)
+
+	cr.SetConditions(xpv1.Available())
+	if svcclient.IsPublishEnabled(cr.Spec.ForProvider) {
+		// Only the LIVE stage of a function can be associated with a
+		// distribution, so it is not available until it has been published.
+		live, err := e.client.DescribeFunctionWithContext(ctx, &svcsdk.DescribeFunctionInput{Name: name, Stage: aws.String(svcsdk.FunctionStageLive)})
+		if resource.Ignore(svcclient.IsNotFound, err) != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetFailed)
+		}
+		if err != nil {
+			cr.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+		}
+		liveCode, err := e.client.GetFunctionWithContext(ctx, &svcsdk.GetFunctionInput{Name: name, Stage: aws.String(svcsdk.FunctionStageLive)})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetFailed)
+		}
+		upToDate = upToDate && svcclient.IsFunctionUpToDate(cr.Spec.ForProvider, code, live.FunctionSummary.FunctionConfig, liveCode.FunctionCode)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFunction)
+	}
+	cr.SetConditions(xpv1.Creating())
+	code, err := e.getCode(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetCodeFailed)
+	}
+	rsp, err := e.client.CreateFunctionWithContext(ctx, &svcsdk.CreateFunctionInput{
+		Name:           aws.String(meta.GetExternalName(cr)),
+		FunctionCode:   []byte(code),
+		FunctionConfig: svcclient.GenerateFunctionConfig(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	cr.Status.AtProvider.ETag = rsp.ETag
+	return managed.ExternalCreation{}, e.testAndPublish(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFunction)
+	}
+	code, err := e.getCode(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCodeFailed)
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	if _, err := e.client.UpdateFunctionWithContext(ctx, &svcsdk.UpdateFunctionInput{
+		Name:           name,
+		IfMatch:        cr.Status.AtProvider.ETag,
+		FunctionCode:   []byte(code),
+		FunctionConfig: svcclient.GenerateFunctionConfig(cr.Spec.ForProvider),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
+	}
+	// The ETag of the update response is not populated by the SDK, so the
+	// DEVELOPMENT stage is described again to test and publish it.
+	dev, err := e.client.DescribeFunctionWithContext(ctx, &svcsdk.DescribeFunctionInput{Name: name, Stage: aws.String(svcsdk.FunctionStageDevelopment)})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetFailed)
+	}
+	cr.Status.AtProvider.ETag = dev.ETag
+	return managed.ExternalUpdate{}, e.testAndPublish(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return errors.New(errNotFunction)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteFunctionWithContext(ctx, &svcsdk.DeleteFunctionInput{
+		Name:    aws.String(meta.GetExternalName(cr)),
+		IfMatch: cr.Status.AtProvider.ETag,
+	})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsNotFound, err), errDeleteFailed)
+}
+
+// testAndPublish tests the DEVELOPMENT stage of the function with the test
+// event object, if any, and publishes it to the LIVE stage if the test
+// succeeded.
+func (e *external) testAndPublish(ctx context.Context, cr *v1alpha1.Function) error {
+	name := aws.String(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.TestEventObject != nil {
+		rsp, err := e.client.TestFunctionWithContext(ctx, &svcsdk.TestFunctionInput{
+			Name:        name,
+			IfMatch:     cr.Status.AtProvider.ETag,
+			Stage:       aws.String(svcsdk.FunctionStageDevelopment),
+			EventObject: []byte(aws.StringValue(cr.Spec.ForProvider.TestEventObject)),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, errTestFailed)
+		}
+		cr.Status.AtProvider.TestResult = svcclient.GenerateFunctionTestResult(rsp.TestResult)
+		if r := cr.Status.AtProvider.TestResult; r != nil && aws.StringValue(r.FunctionErrorMessage) != "" {
+			return errors.Errorf(errTestErrorFmt, aws.StringValue(r.FunctionErrorMessage))
+		}
+	}
+	if !svcclient.IsPublishEnabled(cr.Spec.ForProvider) {
+		return nil
+	}
+	_, err := e.client.PublishFunctionWithContext(ctx, &svcsdk.PublishFunctionInput{
+		Name:    name,
+		IfMatch: cr.Status.AtProvider.ETag,
+	})
+	return awsclient.Wrap(err, errPublishFailed)
+}
+
+// getCode returns the code of the function from its source.
+func (e *external) getCode(ctx context.Context, cr *v1alpha1.Function) (string, error) {
+	c := cr.Spec.ForProvider.Code
+	switch {
+	case c.Inline != nil:
+		return aws.StringValue(c.Inline), nil
+	case c.ConfigMapRef != nil:
+		ref := c.ConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		v, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.Errorf(errConfigMapKeyFmt, ref.Namespace, ref.Name, ref.Key)
+		}
+		return v, nil
+	}
+	return "", errors.New(errNoCodeSource)
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+const (
+	functionName = "viewer"
+	functionARN  = "arn:aws:cloudfront::123456789012:function/viewer"
+	devETag      = "E1"
+	code         = "function handler(event) { return event.request; }"
+	newCode      = "function handler(event) { return event.response; }"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(svcsdk.ErrCodeNoSuchFunctionExists, "not found", nil)
+)
+
+type args struct {
+	client svcclient.Client
+	kube   client.Client
+	cr     *v1alpha1.Function
+}
+
+type functionModifier func(*v1alpha1.Function)
+
+func withCode(c string) functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider.Code = v1alpha1.FunctionCode{Inline: aws.String(c)} }
+}
+
+func withConfigMapRef(name string) functionModifier {
+	return func(r *v1alpha1.Function) {
+		r.Spec.ForProvider.Code = v1alpha1.FunctionCode{
+			ConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: name, Namespace: "default", Key: "index.js"},
+		}
+	}
+}
+
+func withTestEventObject(o string) functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider.TestEventObject = aws.String(o) }
+}
+
+func withConditions(c ...xpv1.Condition) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.FunctionObservation) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.AtProvider = o }
+}
+
+func function(m ...functionModifier) *v1alpha1.Function {
+	cr := &v1alpha1.Function{}
+	meta.SetExternalName(cr, functionName)
+	cr.Spec.ForProvider.Runtime = svcsdk.FunctionRuntimeCloudfrontJs10
+	withCode(code)(cr)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(live bool) func(*svcsdk.DescribeFunctionInput) (*svcsdk.DescribeFunctionOutput, error) {
+	return func(in *svcsdk.DescribeFunctionInput) (*svcsdk.DescribeFunctionOutput, error) {
+		if aws.StringValue(in.Stage) == svcsdk.FunctionStageLive && !live {
+			return nil, errNotFound
+		}
+		return &svcsdk.DescribeFunctionOutput{
+			ETag: aws.String(devETag),
+			FunctionSummary: &svcsdk.FunctionSummary{
+				Name:   aws.String(functionName),
+				Status: aws.String("UNASSOCIATED"),
+				FunctionConfig: &svcsdk.FunctionConfig{
+					Comment: aws.String(""),
+					Runtime: aws.String(svcsdk.FunctionRuntimeCloudfrontJs10),
+				},
+				FunctionMetadata: &svcsdk.FunctionMetadata{FunctionARN: aws.String(functionARN)},
+			},
+		}, nil
+	}
+}
+
+func getCode(c string) func(*svcsdk.GetFunctionInput) (*svcsdk.GetFunctionOutput, error) {
+	return func(_ *svcsdk.GetFunctionInput) (*svcsdk.GetFunctionOutput, error) {
+		return &svcsdk.GetFunctionOutput{ETag: aws.String(devETag), FunctionCode: []byte(c)}, nil
+	}
+}
+
+var observation = v1alpha1.FunctionObservation{
+	FunctionARN: aws.String(functionARN),
+	Status:      aws.String("UNASSOCIATED"),
+	ETag:        aws.String(devETag),
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Function
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeFunction: func(_ *svcsdk.DescribeFunctionInput) (*svcsdk.DescribeFunctionOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(),
+			},
+		},
+		"Published": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeFunction: describe(true),
+					MockGetFunction:      getCode(code),
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:     function(withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotPublished": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeFunction: describe(false),
+					MockGetFunction:      getCode(code),
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:     function(withObservation(observation), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CodeChangedInConfigMap": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeFunction: describe(true),
+					MockGetFunction:      getCode(code),
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"index.js": newCode}
+						return nil
+					},
+				},
+				cr: function(withConfigMapRef("code")),
+			},
+			want: want{
+				cr: function(withConfigMapRef("code"), withObservation(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeFunction: func(_ *svcsdk.DescribeFunctionInput) (*svcsdk.DescribeFunctionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:  function(),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client, kube: tc.args.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	create := func(_ *svcsdk.CreateFunctionInput) (*svcsdk.CreateFunctionOutput, error) {
+		return &svcsdk.CreateFunctionOutput{ETag: aws.String(devETag)}, nil
+	}
+	publish := func(_ *svcsdk.PublishFunctionInput) (*svcsdk.PublishFunctionOutput, error) {
+		return &svcsdk.PublishFunctionOutput{}, nil
+	}
+
+	type want struct {
+		cr  *v1alpha1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreatedAndPublished": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateFunction:  create,
+					MockPublishFunction: publish,
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(withObservation(v1alpha1.FunctionObservation{ETag: aws.String(devETag)}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"TestFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateFunction: create,
+					MockTestFunction: func(_ *svcsdk.TestFunctionInput) (*svcsdk.TestFunctionOutput, error) {
+						return &svcsdk.TestFunctionOutput{TestResult: &svcsdk.TestResult{
+							FunctionErrorMessage: aws.String("ReferenceError"),
+						}}, nil
+					},
+				},
+				cr: function(withTestEventObject("{}")),
+			},
+			want: want{
+				cr: function(withTestEventObject("{}"),
+					withObservation(v1alpha1.FunctionObservation{
+						ETag: aws.String(devETag),
+						TestResult: &v1alpha1.FunctionTestResult{
+							FunctionErrorMessage:  aws.String("ReferenceError"),
+							FunctionExecutionLogs: []string{},
+						},
+					}),
+					withConditions(xpv1.Creating())),
+				err: errors.Errorf(errTestErrorFmt, "ReferenceError"),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateFunction: func(_ *svcsdk.CreateFunctionInput) (*svcsdk.CreateFunctionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:  function(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client, kube: tc.args.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invalidation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+const (
+	errNotInvalidation    = "managed resource is not an Invalidation custom resource"
+	errNoDistributionID   = "distributionId of Invalidation must be set"
+	errGetFailed          = "cannot get Invalidation"
+	errCreateFailed       = "cannot create Invalidation"
+	errKubeUpdateFailed   = "cannot update Invalidation custom resource"
+	invalidationCompleted = "Completed"
+)
+
+// SetupInvalidation adds a controller that reconciles Invalidations.
+func SetupInvalidation(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.InvalidationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Invalidation{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InvalidationGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Invalidation)
+	if !ok {
+		return nil, errors.New(errNotInvalidation)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Invalidation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInvalidation)
+	}
+	// The ID of the invalidation is assigned by AWS on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.DistributionID == nil {
+		return managed.ExternalObservation{}, errors.New(errNoDistributionID)
+	}
+	rsp, err := e.client.GetInvalidationWithContext(ctx, &svcsdk.GetInvalidationInput{
+		DistributionId: cr.Spec.ForProvider.DistributionID,
+		Id:             aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(svcclient.IsNotFound, err), errGetFailed)
+	}
+	cr.Status.AtProvider = svcclient.GenerateInvalidationObservation(rsp.Invalidation)
+
+	if aws.StringValue(rsp.Invalidation.Status) == invalidationCompleted {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	// A new invalidation is issued when the paths or the trigger annotation
+	// no longer match the latest invalidation.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: aws.StringValue(cr.Status.AtProvider.CallerReference) == svcclient.InvalidationCallerReference(cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Invalidation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInvalidation)
+	}
+	cr.SetConditions(xpv1.Creating())
+	if err := e.invalidate(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Invalidation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInvalidation)
+	}
+	if err := e.invalidate(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The external name is only persisted by the managed reconciler after
+	// creation, so we need to store the ID of the new invalidation here. The
+	// update overwrites the status, which is saved after the update.
+	status := cr.Status.DeepCopy()
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+	cr.Status = *status
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) invalidate(ctx context.Context, cr *v1alpha1.Invalidation) error {
+	if cr.Spec.ForProvider.DistributionID == nil {
+		return errors.New(errNoDistributionID)
+	}
+	rsp, err := e.client.CreateInvalidationWithContext(ctx, svcclient.GenerateCreateInvalidationInput(cr))
+	if err != nil {
+		return awsclient.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.Invalidation.Id))
+	cr.Status.AtProvider = svcclient.GenerateInvalidationObservation(rsp.Invalidation)
+	return nil
+}
+
+// Delete does nothing since invalidations cannot be cancelled.
+func (e *external) Delete(_ context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Invalidation)
+	if !ok {
+		return errors.New(errNotInvalidation)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invalidation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+const (
+	distributionID = "E1234567890"
+	invalidationID = "I1234567890"
+	newID          = "I0987654321"
+)
+
+var errBoom = errors.New("boom")
+
+type args struct {
+	client svcclient.Client
+	kube   client.Client
+	cr     *v1alpha1.Invalidation
+}
+
+type invalidationModifier func(*v1alpha1.Invalidation)
+
+func withExternalName(n string) invalidationModifier {
+	return func(r *v1alpha1.Invalidation) { meta.SetExternalName(r, n) }
+}
+
+func withTrigger(v string) invalidationModifier {
+	return func(r *v1alpha1.Invalidation) {
+		meta.AddAnnotations(r, map[string]string{v1alpha1.AnnotationKeyInvalidationTrigger: v})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) invalidationModifier {
+	return func(r *v1alpha1.Invalidation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(ref, status string) invalidationModifier {
+	return func(r *v1alpha1.Invalidation) {
+		r.Status.AtProvider = v1alpha1.InvalidationObservation{
+			CallerReference: aws.String(ref),
+			Status:          aws.String(status),
+		}
+	}
+}
+
+func invalidation(m ...invalidationModifier) *v1alpha1.Invalidation {
+	cr := &v1alpha1.Invalidation{}
+	cr.SetUID("uid")
+	cr.Spec.ForProvider.DistributionID = aws.String(distributionID)
+	cr.Spec.ForProvider.Paths = []string{"/*"}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// callerReference returns the caller reference of an invalidation issued for
+// the Invalidation with the given modifiers.
+func callerReference(m ...invalidationModifier) string {
+	return svcclient.InvalidationCallerReference(invalidation(m...))
+}
+
+func get(ref, status string) func(*svcsdk.GetInvalidationInput) (*svcsdk.GetInvalidationOutput, error) {
+	return func(_ *svcsdk.GetInvalidationInput) (*svcsdk.GetInvalidationOutput, error) {
+		return &svcsdk.GetInvalidationOutput{Invalidation: &svcsdk.Invalidation{
+			Id:                aws.String(invalidationID),
+			Status:            aws.String(status),
+			InvalidationBatch: &svcsdk.InvalidationBatch{CallerReference: aws.String(ref)},
+		}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Invalidation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotCreated": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     invalidation(),
+			},
+			want: want{
+				cr: invalidation(),
+			},
+		},
+		"Completed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetInvalidation: get(callerReference(), invalidationCompleted),
+				},
+				cr: invalidation(withExternalName(invalidationID)),
+			},
+			want: want{
+				cr: invalidation(withExternalName(invalidationID),
+					withObservation(callerReference(), invalidationCompleted),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TriggerChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetInvalidation: get(callerReference(), "InProgress"),
+				},
+				cr: invalidation(withExternalName(invalidationID), withTrigger("2")),
+			},
+			want: want{
+				cr: invalidation(withExternalName(invalidationID), withTrigger("2"),
+					withObservation(callerReference(), "InProgress"),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetInvalidation: func(_ *svcsdk.GetInvalidationInput) (*svcsdk.GetInvalidationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: invalidation(withExternalName(invalidationID)),
+			},
+			want: want{
+				cr:  invalidation(withExternalName(invalidationID)),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client, kube: tc.args.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	create := func(in *svcsdk.CreateInvalidationInput) (*svcsdk.CreateInvalidationOutput, error) {
+		return &svcsdk.CreateInvalidationOutput{Invalidation: &svcsdk.Invalidation{
+			Id:                aws.String(newID),
+			Status:            aws.String("InProgress"),
+			InvalidationBatch: in.InvalidationBatch,
+		}}, nil
+	}
+
+	type want struct {
+		cr  *v1alpha1.Invalidation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{MockCreateInvalidation: create},
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:     invalidation(withExternalName(invalidationID), withTrigger("2")),
+			},
+			want: want{
+				cr: invalidation(withExternalName(newID), withTrigger("2"),
+					withObservation(callerReference(withTrigger("2")), "InProgress")),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateInvalidation: func(_ *svcsdk.CreateInvalidationInput) (*svcsdk.CreateInvalidationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: invalidation(withExternalName(invalidationID)),
+			},
+			want: want{
+				cr:  invalidation(withExternalName(invalidationID)),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"KubeUpdateFailed": {
+			args: args{
+				client: &fake.MockClient{MockCreateInvalidation: create},
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:     invalidation(withExternalName(invalidationID)),
+			},
+			want: want{
+				cr: invalidation(withExternalName(newID),
+					withObservation(callerReference(), "InProgress")),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client, kube: tc.args.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originrequestpolicy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+const (
+	errNotOriginRequestPolicy = "managed resource is not an OriginRequestPolicy custom resource"
+	errGetFailed              = "cannot get OriginRequestPolicy"
+	errCreateFailed           = "cannot create OriginRequestPolicy"
+	errUpdateFailed           = "cannot update OriginRequestPolicy"
+	errDeleteFailed           = "cannot delete OriginRequestPolicy"
+	errKubeUpdateFailed       = "cannot update OriginRequestPolicy custom resource"
+)
+
+// SetupOriginRequestPolicy adds a controller that reconciles OriginRequestPolicies.
+func SetupOriginRequestPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.OriginRequestPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.OriginRequestPolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OriginRequestPolicyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OriginRequestPolicy)
+	if !ok {
+		return nil, errors.New(errNotOriginRequestPolicy)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OriginRequestPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOriginRequestPolicy)
+	}
+	// The ID of the origin request policy is assigned by AWS on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.GetOriginRequestPolicyWithContext(ctx, &svcsdk.GetOriginRequestPolicyInput{Id: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(svcclient.IsNotFound, err), errGetFailed)
+	}
+	cr.Status.AtProvider = v1alpha1.OriginRequestPolicyObservation{
+		ETag:             rsp.ETag,
+		LastModifiedTime: awsclient.LateInitializeTimePtr(nil, rsp.OriginRequestPolicy.LastModifiedTime),
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	svcclient.LateInitializeOriginRequestPolicy(&cr.Spec.ForProvider, rsp.OriginRequestPolicy.OriginRequestPolicyConfig)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: svcclient.IsOriginRequestPolicyUpToDate(cr.Spec.ForProvider, rsp.OriginRequestPolicy.OriginRequestPolicyConfig),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OriginRequestPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOriginRequestPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := e.client.CreateOriginRequestPolicyWithContext(ctx, &svcsdk.CreateOriginRequestPolicyInput{
+		OriginRequestPolicyConfig: svcclient.GenerateOriginRequestPolicyConfig(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.OriginRequestPolicy.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OriginRequestPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOriginRequestPolicy)
+	}
+	rsp, err := e.client.UpdateOriginRequestPolicyWithContext(ctx, &svcsdk.UpdateOriginRequestPolicyInput{
+		Id:                        aws.String(meta.GetExternalName(cr)),
+		IfMatch:                   cr.Status.AtProvider.ETag,
+		OriginRequestPolicyConfig: svcclient.GenerateOriginRequestPolicyConfig(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
+	}
+	cr.Status.AtProvider.ETag = rsp.ETag
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OriginRequestPolicy)
+	if !ok {
+		return errors.New(errNotOriginRequestPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteOriginRequestPolicyWithContext(ctx, &svcsdk.DeleteOriginRequestPolicyInput{
+		Id:      aws.String(meta.GetExternalName(cr)),
+		IfMatch: cr.Status.AtProvider.ETag,
+	})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsNotFound, err), errDeleteFailed)
+}
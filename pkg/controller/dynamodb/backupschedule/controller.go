@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupschedule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errNotBackupSchedule = "managed resource is not a BackupSchedule custom resource"
+	errParseSchedule     = "cannot parse schedule of BackupSchedule"
+	errNoNextSchedule    = "schedule of BackupSchedule never matches"
+	errNoTableName       = "tableName of BackupSchedule is not set"
+	errListBackups       = "cannot list Backups of BackupSchedule"
+	errCreateBackup      = "cannot create Backup of BackupSchedule"
+	errDeleteBackup      = "cannot delete Backup of BackupSchedule"
+)
+
+// SetupBackupSchedule adds a controller that reconciles BackupSchedules.
+func SetupBackupSchedule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.BackupScheduleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.BackupSchedule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackupScheduleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// connector does not connect to AWS since a BackupSchedule only manages
+// Backup resources, which are reconciled by their own controller.
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(_ context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.BackupSchedule); !ok {
+		return nil, errors.New(errNotBackupSchedule)
+	}
+	return &external{kube: c.kube, now: time.Now}, nil
+}
+
+type external struct {
+	kube client.Client
+	now  func() time.Time
+}
+
+// state is what a BackupSchedule has to do at a given time. Only the most
+// recent of the schedule times that passed since the last Backup is due, so
+// that a Backup is not created for each schedule time that was missed while
+// the controller was not running.
+type state struct {
+	last   time.Time
+	due    time.Time
+	next   time.Time
+	kept   []v1alpha1.Backup
+	pruned []v1alpha1.Backup
+}
+
+func (e *external) observe(ctx context.Context, cr *v1alpha1.BackupSchedule) (*state, error) {
+	s, err := parseSchedule(cr.Spec.ForProvider.Schedule)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseSchedule)
+	}
+	l := &v1alpha1.BackupList{}
+	if err := e.kube.List(ctx, l, client.MatchingLabels{v1alpha1.LabelKeyBackupSchedule: cr.GetName()}); err != nil {
+		return nil, errors.Wrap(err, errListBackups)
+	}
+	backups := l.Items
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[j].CreationTimestamp.Before(&backups[i].CreationTimestamp)
+	})
+
+	st := &state{last: cr.GetCreationTimestamp().Time}
+	if t := cr.Status.AtProvider.LastScheduleTime; t != nil && t.After(st.last) {
+		st.last = t.Time
+	}
+	if len(backups) > 0 && backups[0].GetCreationTimestamp().After(st.last) {
+		st.last = backups[0].GetCreationTimestamp().Time
+	}
+	now := e.now()
+	for st.next = s.next(st.last); !st.next.IsZero() && !st.next.After(now); st.next = s.next(st.next) {
+		st.due = st.next
+	}
+	if st.due.IsZero() && st.next.IsZero() {
+		return nil, errors.New(errNoNextSchedule)
+	}
+
+	r := cr.Spec.ForProvider.Retention
+	for i := range backups {
+		switch {
+		case meta.WasDeleted(&backups[i]):
+			continue
+		case r != nil && r.MaxCount != nil && int64(len(st.kept)) >= *r.MaxCount,
+			r != nil && r.MaxAge != nil && now.Sub(backups[i].CreationTimestamp.Time) > r.MaxAge.Duration:
+			st.pruned = append(st.pruned, backups[i])
+		default:
+			st.kept = append(st.kept, backups[i])
+		}
+	}
+	return st, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackupSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackupSchedule)
+	}
+	// There is nothing to delete in AWS; the Backups are garbage collected
+	// with their BackupSchedule.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+	st, err := e.observe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.NextScheduleTime = nil
+	if !st.next.IsZero() {
+		cr.Status.AtProvider.NextScheduleTime = &metav1.Time{Time: st.next}
+	}
+	cr.Status.AtProvider.Backups = nil
+	for _, b := range st.kept {
+		cr.Status.AtProvider.Backups = append(cr.Status.AtProvider.Backups, b.GetName())
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: st.due.IsZero() && len(st.pruned) == 0,
+	}, nil
+}
+
+// Create is never called since a BackupSchedule has no external resource.
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BackupSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackupSchedule)
+	}
+	st, err := e.observe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !st.due.IsZero() {
+		if d := cr.Spec.ForProvider.StartingDeadlineSeconds; d == nil || e.now().Sub(st.due) <= time.Duration(*d)*time.Second {
+			if err := e.createBackup(ctx, cr, st.due); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		cr.Status.AtProvider.LastScheduleTime = &metav1.Time{Time: st.due}
+	}
+	for i := range st.pruned {
+		if err := e.kube.Delete(ctx, &st.pruned[i]); resource.IgnoreNotFound(err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteBackup)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
+
+func (e *external) createBackup(ctx context.Context, cr *v1alpha1.BackupSchedule, t time.Time) error {
+	if cr.Spec.ForProvider.TableName == "" {
+		return errors.New(errNoTableName)
+	}
+	err := e.kube.Create(ctx, generateBackup(cr, t))
+	return errors.Wrap(resource.Ignore(kerrors.IsAlreadyExists, err), errCreateBackup)
+}
+
+// generateBackup returns the Backup of the given schedule time. Its name is
+// derived from the time so that a schedule time is never backed up twice.
+func generateBackup(cr *v1alpha1.BackupSchedule, t time.Time) *v1alpha1.Backup {
+	name := fmt.Sprintf("%s-%d", cr.GetName(), t.Unix())
+	b := &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{v1alpha1.LabelKeyBackupSchedule: cr.GetName()},
+		},
+		Spec: v1alpha1.BackupSpec{
+			ForProvider: v1alpha1.BackupParameters{
+				Region:     cr.Spec.ForProvider.Region,
+				BackupName: awsclient.String(name),
+				CustomBackupParameters: v1alpha1.CustomBackupParameters{
+					TableName: cr.Spec.ForProvider.TableName,
+				},
+			},
+		},
+	}
+	b.SetProviderConfigReference(cr.GetProviderConfigReference())
+	b.SetDeletionPolicy(cr.GetDeletionPolicy())
+	meta.AddOwnerReference(b, meta.AsController(meta.TypedReferenceTo(cr, v1alpha1.BackupScheduleGroupVersionKind)))
+	return b
+}
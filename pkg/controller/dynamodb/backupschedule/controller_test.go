@@ -0,0 +1,251 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupschedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+var (
+	scheduleName = "nightly"
+	tableName    = "orders"
+	created      = time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	errBoom      = errors.New("boom")
+)
+
+type scheduleModifier func(*v1alpha1.BackupSchedule)
+
+func withRetention(count int64) scheduleModifier {
+	return func(cr *v1alpha1.BackupSchedule) {
+		cr.Spec.ForProvider.Retention = &v1alpha1.BackupRetention{MaxCount: &count}
+	}
+}
+
+func withStartingDeadline(seconds int64) scheduleModifier {
+	return func(cr *v1alpha1.BackupSchedule) {
+		cr.Spec.ForProvider.StartingDeadlineSeconds = &seconds
+	}
+}
+
+func backupSchedule(m ...scheduleModifier) *v1alpha1.BackupSchedule {
+	cr := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              scheduleName,
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+		Spec: v1alpha1.BackupScheduleSpec{
+			ForProvider: v1alpha1.BackupScheduleParameters{
+				Region:    "us-east-1",
+				TableName: tableName,
+				Schedule:  "0 3 * * *",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func backup(name string, t time.Time) v1alpha1.Backup {
+	return v1alpha1.Backup{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.Time{Time: t}}}
+}
+
+func listBackups(backups ...v1alpha1.Backup) test.MockListFn {
+	return func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+		list.(*v1alpha1.BackupList).Items = backups
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		cr   *v1alpha1.BackupSchedule
+		now  time.Time
+		want want
+	}{
+		"NotDue": {
+			kube: &test.MockClient{MockList: listBackups()},
+			cr:   backupSchedule(),
+			now:  created.Add(time.Hour),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Due": {
+			kube: &test.MockClient{MockList: listBackups()},
+			cr:   backupSchedule(),
+			now:  created.Add(15 * time.Hour),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"MissedSchedules": {
+			kube: &test.MockClient{MockList: listBackups()},
+			cr:   backupSchedule(),
+			now:  created.Add(77 * time.Hour),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"DueSinceLastBackup": {
+			kube: &test.MockClient{MockList: listBackups(backup("a", created.Add(15*time.Hour)))},
+			cr:   backupSchedule(),
+			now:  created.Add(20 * time.Hour),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Prune": {
+			kube: &test.MockClient{MockList: listBackups(backup("a", created), backup("b", created.Add(time.Hour)))},
+			cr:   backupSchedule(withRetention(1)),
+			now:  created.Add(2 * time.Hour),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"ListFailed": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			cr:   backupSchedule(),
+			now:  created,
+			want: want{err: errors.Wrap(errBoom, errListBackups)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, now: func() time.Time { return tc.now }}
+			obs, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		created      []string
+		deleted      []string
+		lastSchedule *metav1.Time
+		err          error
+	}
+
+	cases := map[string]struct {
+		backups   []v1alpha1.Backup
+		createErr error
+		cr        *v1alpha1.BackupSchedule
+		now       time.Time
+		want      want
+	}{
+		"CreateDueBackup": {
+			cr:  backupSchedule(),
+			now: created.Add(15 * time.Hour),
+			want: want{
+				created:      []string{"nightly-1614654000"},
+				lastSchedule: &metav1.Time{Time: time.Date(2021, time.March, 2, 3, 0, 0, 0, time.UTC)},
+			},
+		},
+		"CreateMostRecentMissedBackup": {
+			cr:  backupSchedule(),
+			now: created.Add(77 * time.Hour),
+			want: want{
+				created:      []string{"nightly-1614826800"},
+				lastSchedule: &metav1.Time{Time: time.Date(2021, time.March, 4, 3, 0, 0, 0, time.UTC)},
+			},
+		},
+		"WithinStartingDeadline": {
+			cr:  backupSchedule(withStartingDeadline(3600)),
+			now: created.Add(63*time.Hour + 30*time.Minute),
+			want: want{
+				created:      []string{"nightly-1614826800"},
+				lastSchedule: &metav1.Time{Time: time.Date(2021, time.March, 4, 3, 0, 0, 0, time.UTC)},
+			},
+		},
+		"MissedStartingDeadline": {
+			cr:  backupSchedule(withStartingDeadline(3600)),
+			now: created.Add(77 * time.Hour),
+			want: want{
+				lastSchedule: &metav1.Time{Time: time.Date(2021, time.March, 4, 3, 0, 0, 0, time.UTC)},
+			},
+		},
+		"PruneOldest": {
+			backups: []v1alpha1.Backup{backup("a", created), backup("b", created.Add(time.Hour))},
+			cr:      backupSchedule(withRetention(1)),
+			now:     created.Add(2 * time.Hour),
+			want:    want{deleted: []string{"a"}},
+		},
+		"NoTableName": {
+			cr: backupSchedule(func(cr *v1alpha1.BackupSchedule) {
+				cr.Spec.ForProvider.TableName = ""
+			}),
+			now:  created.Add(15 * time.Hour),
+			want: want{err: errors.New(errNoTableName)},
+		},
+		"CreateFailed": {
+			createErr: errBoom,
+			cr:        backupSchedule(),
+			now:       created.Add(15 * time.Hour),
+			want:      want{err: errors.Wrap(errBoom, errCreateBackup)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotCreated, gotDeleted []string
+			kube := &test.MockClient{
+				MockList: listBackups(tc.backups...),
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					if tc.createErr != nil {
+						return tc.createErr
+					}
+					gotCreated = append(gotCreated, obj.GetName())
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+					gotDeleted = append(gotDeleted, obj.GetName())
+					return nil
+				},
+			}
+			e := &external{kube: kube, now: func() time.Time { return tc.now }}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, gotCreated); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, gotDeleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.lastSchedule, tc.cr.Status.AtProvider.LastScheduleTime); diff != "" {
+				t.Errorf("lastScheduleTime: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
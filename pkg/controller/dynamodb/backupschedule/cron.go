@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupschedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errFieldCountFmt = "cron expression must have 5 fields, got %d"
+	errFieldFmt      = "invalid cron field %q"
+)
+
+// A schedule is a parsed cron expression. Each field is a bit set of the
+// values it matches.
+type schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// Days match if both the day of month and the day of week match when
+	// either of them is unrestricted, and if any of them matches otherwise.
+	domStar, dowStar bool
+}
+
+// parseSchedule parses a cron expression with the five fields minute, hour,
+// day of month, month and day of week. Each field is a comma-separated list
+// of values, ranges and "*", optionally followed by a step, e.g. "*/15" or
+// "1-5". Both 0 and 7 are Sunday.
+func parseSchedule(expr string) (*schedule, error) {
+	f := strings.Fields(expr)
+	if len(f) != 5 {
+		return nil, errors.Errorf(errFieldCountFmt, len(f))
+	}
+	s := &schedule{domStar: strings.HasPrefix(f[2], "*"), dowStar: strings.HasPrefix(f[4], "*")}
+	var err error
+	for _, p := range []struct {
+		field    string
+		min, max int
+		out      *uint64
+	}{
+		{field: f[0], min: 0, max: 59, out: &s.minute},
+		{field: f[1], min: 0, max: 23, out: &s.hour},
+		{field: f[2], min: 1, max: 31, out: &s.dom},
+		{field: f[3], min: 1, max: 12, out: &s.month},
+		{field: f[4], min: 0, max: 7, out: &s.dow},
+	} {
+		if *p.out, err = parseField(p.field, p.min, p.max); err != nil {
+			return nil, err
+		}
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+func parseField(field string, min, max int) (uint64, error) { // nolint:gocyclo
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, errors.Errorf(errFieldFmt, field)
+			}
+			rng, step = part[:i], n
+		}
+		lo, hi := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			b := strings.SplitN(rng, "-", 2)
+			l, err1 := strconv.Atoi(b[0])
+			h, err2 := strconv.Atoi(b[1])
+			if err1 != nil || err2 != nil {
+				return 0, errors.Errorf(errFieldFmt, field)
+			}
+			lo, hi = l, h
+		default:
+			n, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, errors.Errorf(errFieldFmt, field)
+			}
+			lo, hi = n, n
+			if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, errors.Errorf(errFieldFmt, field)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// next returns the first time after t that matches the schedule, or the zero
+// time if there is none within the next five years.
+func (s *schedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *schedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestNext(t *testing.T) {
+	from := time.Date(2021, time.March, 10, 14, 7, 30, 0, time.UTC) // Wednesday
+
+	type want struct {
+		next time.Time
+		err  error
+	}
+
+	cases := map[string]struct {
+		expr string
+		want want
+	}{
+		"EveryMinute": {
+			expr: "* * * * *",
+			want: want{next: time.Date(2021, time.March, 10, 14, 8, 0, 0, time.UTC)},
+		},
+		"Step": {
+			expr: "*/15 * * * *",
+			want: want{next: time.Date(2021, time.March, 10, 14, 15, 0, 0, time.UTC)},
+		},
+		"Daily": {
+			expr: "0 3 * * *",
+			want: want{next: time.Date(2021, time.March, 11, 3, 0, 0, 0, time.UTC)},
+		},
+		"Weekdays": {
+			expr: "30 2 * * 6,7",
+			want: want{next: time.Date(2021, time.March, 13, 2, 30, 0, 0, time.UTC)},
+		},
+		"Monthly": {
+			expr: "0 0 1 * *",
+			want: want{next: time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		"DayOfMonthOrWeek": {
+			expr: "0 0 15 * 5",
+			want: want{next: time.Date(2021, time.March, 12, 0, 0, 0, 0, time.UTC)},
+		},
+		"Never": {
+			expr: "0 0 31 2 *",
+			want: want{},
+		},
+		"WrongFieldCount": {
+			expr: "0 3 * *",
+			want: want{err: errors.Errorf(errFieldCountFmt, 4)},
+		},
+		"OutOfRange": {
+			expr: "0 24 * * *",
+			want: want{err: errors.Errorf(errFieldFmt, "24")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := parseSchedule(tc.expr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.next, s.next(from)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseField(t *testing.T) {
+	type args struct {
+		field    string
+		min, max int
+	}
+	type want struct {
+		values []int
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Star": {
+			args: args{field: "*", min: 1, max: 12},
+			want: want{values: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}},
+		},
+		"StarStep": {
+			args: args{field: "*/20", min: 0, max: 59},
+			want: want{values: []int{0, 20, 40}},
+		},
+		"StepFromValue": {
+			args: args{field: "5/20", min: 0, max: 59},
+			want: want{values: []int{5, 25, 45}},
+		},
+		"Range": {
+			args: args{field: "9-11", min: 0, max: 23},
+			want: want{values: []int{9, 10, 11}},
+		},
+		"RangeStep": {
+			args: args{field: "1-10/4", min: 1, max: 31},
+			want: want{values: []int{1, 5, 9}},
+		},
+		"StepLargerThanRange": {
+			args: args{field: "3-5/10", min: 0, max: 59},
+			want: want{values: []int{3}},
+		},
+		"SingleValueRange": {
+			args: args{field: "4-4", min: 0, max: 23},
+			want: want{values: []int{4}},
+		},
+		"List": {
+			args: args{field: "1,15-16,*/30", min: 0, max: 59},
+			want: want{values: []int{0, 1, 15, 16, 30}},
+		},
+		"Bounds": {
+			args: args{field: "0,59", min: 0, max: 59},
+			want: want{values: []int{0, 59}},
+		},
+		"ZeroStep": {
+			args: args{field: "*/0", min: 0, max: 59},
+			want: want{err: errors.Errorf(errFieldFmt, "*/0")},
+		},
+		"NegativeStep": {
+			args: args{field: "*/-1", min: 0, max: 59},
+			want: want{err: errors.Errorf(errFieldFmt, "*/-1")},
+		},
+		"EmptyStep": {
+			args: args{field: "*/", min: 0, max: 59},
+			want: want{err: errors.Errorf(errFieldFmt, "*/")},
+		},
+		"ReversedRange": {
+			args: args{field: "5-1", min: 0, max: 23},
+			want: want{err: errors.Errorf(errFieldFmt, "5-1")},
+		},
+		"OpenRange": {
+			args: args{field: "5-", min: 0, max: 23},
+			want: want{err: errors.Errorf(errFieldFmt, "5-")},
+		},
+		"RangeAboveMax": {
+			args: args{field: "20-24", min: 0, max: 23},
+			want: want{err: errors.Errorf(errFieldFmt, "20-24")},
+		},
+		"BelowMin": {
+			args: args{field: "0", min: 1, max: 31},
+			want: want{err: errors.Errorf(errFieldFmt, "0")},
+		},
+		"EmptyListItem": {
+			args: args{field: "1,,2", min: 0, max: 59},
+			want: want{err: errors.Errorf(errFieldFmt, "1,,2")},
+		},
+		"Name": {
+			args: args{field: "MON", min: 0, max: 7},
+			want: want{err: errors.Errorf(errFieldFmt, "MON")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			bits, err := parseField(tc.args.field, tc.args.min, tc.args.max)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			var values []int
+			for v := 0; v < 64; v++ {
+				if bits&(1<<uint(v)) != 0 {
+					values = append(values, v)
+				}
+			}
+			if diff := cmp.Diff(tc.want.values, values); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDayOfWeek(t *testing.T) {
+	cases := map[string]struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		"SundayAsZero": {
+			expr: "0 0 * * 0",
+			from: time.Date(2021, time.March, 10, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.March, 14, 0, 0, 0, 0, time.UTC),
+		},
+		"SundayAsSeven": {
+			expr: "0 0 * * 7",
+			from: time.Date(2021, time.March, 10, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.March, 14, 0, 0, 0, 0, time.UTC),
+		},
+		"RangeEndingOnSunday": {
+			expr: "0 0 * * 5-7",
+			from: time.Date(2021, time.March, 14, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.March, 19, 0, 0, 0, 0, time.UTC),
+		},
+		"WorkingDaysOverWeekend": {
+			expr: "0 9 * * 1-5",
+			from: time.Date(2021, time.March, 12, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.March, 15, 9, 0, 0, 0, time.UTC),
+		},
+		"EveryOtherDay": {
+			expr: "0 0 * * */2",
+			from: time.Date(2021, time.March, 11, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.March, 13, 0, 0, 0, 0, time.UTC),
+		},
+		"StarStepIsUnrestricted": {
+			// A day of month starting with a star is unrestricted, so both
+			// the day of month and the day of week have to match.
+			expr: "0 0 */10 * 1",
+			from: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.May, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"DayOfMonthAndWeekRestricted": {
+			expr: "0 0 1 * 1",
+			from: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2021, time.March, 8, 0, 0, 0, 0, time.UTC),
+		},
+		"LeapDay": {
+			expr: "0 0 29 2 *",
+			from: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := parseSchedule(tc.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, s.next(tc.from)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
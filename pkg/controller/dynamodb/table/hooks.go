@@ -49,11 +49,12 @@ func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) er
 	name := managed.ControllerName(svcapitypes.TableGroupKind)
 	opts := []option{
 		func(e *external) {
-			e.preObserve = preObserve
+			l := &lateInitializer{}
+			e.preObserve = l.preObserve
 			e.postObserve = postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
-			e.lateInitialize = lateInitialize
+			e.lateInitialize = l.lateInitialize
 			e.isUpToDate = isUpToDate
 			u := &updateClient{client: e.client}
 			e.preUpdate = u.preUpdate
@@ -67,7 +68,7 @@ func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) er
 		For(&svcapitypes.Table{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.TableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(&settingsConnector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(
 				managed.NewNameAsExternalName(mgr.GetClient()),
 				managed.NewDefaultProviderConfig(mgr.GetClient()),
@@ -136,6 +137,29 @@ func (e *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(e.kube.Update(ctx, cr), "cannot update Table Spec")
 }
 
+// lateInitializer late-initializes the global secondary indexes of a table
+// only when it is observed for the first time, i.e. when it is imported or
+// restored, so that the last index can later be removed from the spec.
+type lateInitializer struct {
+	observed bool
+}
+
+func (l *lateInitializer) preObserve(ctx context.Context, cr *svcapitypes.Table, obj *svcsdk.DescribeTableInput) error {
+	l.observed = cr.Status.AtProvider.TableARN != nil
+	return preObserve(ctx, cr, obj)
+}
+
+func (l *lateInitializer) lateInitialize(in *svcapitypes.TableParameters, t *svcsdk.DescribeTableOutput) error {
+	indexes := in.GlobalSecondaryIndexes
+	if err := lateInitialize(in, t); err != nil {
+		return err
+	}
+	if l.observed {
+		in.GlobalSecondaryIndexes = indexes
+	}
+	return nil
+}
+
 // NOTE(muvaf): The rest is taken from manually written controller.
 
 func lateInitialize(in *svcapitypes.TableParameters, t *svcsdk.DescribeTableOutput) error { // nolint:gocyclo,unparam
@@ -272,9 +296,18 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 	if err != nil {
 		return false, err
 	}
-	return cmp.Equal(&svcapitypes.TableParameters{}, patch,
+	ignored := []string{"Region", "Tags", "GlobalSecondaryIndexes", "KeySchema", "LocalSecondaryIndexes", "CustomTableParameters"}
+	// Application Auto Scaling changes the provisioned throughput, so it is
+	// not compared while auto scaling is configured.
+	if cr.Spec.ForProvider.AutoScaling != nil {
+		ignored = append(ignored, "ProvisionedThroughput")
+	}
+	if !cmp.Equal(&svcapitypes.TableParameters{}, patch,
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(svcapitypes.TableParameters{}, "Region", "Tags", "GlobalSecondaryIndexes", "KeySchema", "LocalSecondaryIndexes", "CustomTableParameters")), nil
+		cmpopts.IgnoreFields(svcapitypes.TableParameters{}, ignored...)) {
+		return false, nil
+	}
+	return nextIndexUpdate(&cr.Spec.ForProvider, resp.Table) == nil, nil
 }
 
 type updateClient struct {
@@ -294,10 +327,11 @@ func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *sv
 	newUpdateObj := &svcsdk.UpdateTableInput{
 		TableName: aws.String(meta.GetExternalName(cr)),
 	}
+	gsi := nextIndexUpdate(&cr.Spec.ForProvider, t.Table)
 	// NOTE(muvaf): AWS API prohibits doing those calls in the same call.
 	// See https://github.com/aws/aws-sdk-go/blob/v1.34.32/service/dynamodb/api.go#L5605
 	switch {
-	case cr.Spec.ForProvider.ProvisionedThroughput != nil &&
+	case cr.Spec.ForProvider.ProvisionedThroughput != nil && cr.Spec.ForProvider.AutoScaling == nil &&
 		(aws.Int64Value(t.Table.ProvisionedThroughput.ReadCapacityUnits) != aws.Int64Value(cr.Spec.ForProvider.ProvisionedThroughput.ReadCapacityUnits) ||
 			aws.Int64Value(t.Table.ProvisionedThroughput.WriteCapacityUnits) != aws.Int64Value(cr.Spec.ForProvider.ProvisionedThroughput.WriteCapacityUnits)):
 		newUpdateObj.ProvisionedThroughput = u.ProvisionedThroughput
@@ -306,11 +340,16 @@ func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *sv
 	case cr.Spec.ForProvider.StreamSpecification != nil &&
 		(awsgo.BoolValue(t.Table.StreamSpecification.StreamEnabled) != awsgo.BoolValue(cr.Spec.ForProvider.StreamSpecification.StreamEnabled)):
 		newUpdateObj.StreamSpecification = u.StreamSpecification
+	case gsi != nil:
+		newUpdateObj.GlobalSecondaryIndexUpdates = []*svcsdk.GlobalSecondaryIndexUpdate{gsi}
+		// The key attributes of a new index have to be defined.
+		if gsi.Create != nil {
+			newUpdateObj.AttributeDefinitions = u.AttributeDefinitions
+		}
 	default:
-		return errors.New("only provisionedThroughput and streamSpecification updates are supported")
+		return errors.New("only provisionedThroughput, streamSpecification and globalSecondaryIndexes updates are supported")
 	}
-	// TODO(muvaf): ReplicationGroupUpdate and GlobalSecondaryIndexUpdate features
-	// are not implemented yet.
+	// TODO(muvaf): ReplicationGroupUpdate feature is not implemented yet.
 
 	*u = *newUpdateObj
 	return nil
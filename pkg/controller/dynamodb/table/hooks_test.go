@@ -17,6 +17,7 @@ limitations under the License.
 package table
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -198,6 +199,34 @@ func TestIsUpToDate(t *testing.T) {
 				result: false,
 			},
 		},
+		"AutoScaledThroughput": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{
+						ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+							ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+							WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+						},
+					},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+								ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits + 1)),
+								WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits + 1)),
+							},
+							CustomTableParameters: v1alpha1.CustomTableParameters{
+								AutoScaling: &v1alpha1.TableAutoScaling{},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -276,3 +305,59 @@ func TestLateInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestLateInitializer(t *testing.T) {
+	gsi := &svcsdk.GlobalSecondaryIndexDescription{
+		IndexName: aws.String("gsi1"),
+		KeySchema: []*svcsdk.KeySchemaElement{{AttributeName: aws.String("a"), KeyType: aws.String(svcsdk.KeyTypeHash)}},
+	}
+	withIndexes := func(p *v1alpha1.TableParameters) {
+		p.GlobalSecondaryIndexes = buildGlobalIndexes([]*svcsdk.GlobalSecondaryIndexDescription{gsi})
+	}
+
+	type args struct {
+		cr *v1alpha1.Table
+		in *svcsdk.DescribeTableOutput
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.TableParameters
+	}{
+		"FirstObservation": {
+			args: args{
+				cr: &v1alpha1.Table{Spec: v1alpha1.TableSpec{ForProvider: *tableParams()}},
+				in: &svcsdk.DescribeTableOutput{Table: table(func(t *svcsdk.TableDescription) {
+					t.GlobalSecondaryIndexes = []*svcsdk.GlobalSecondaryIndexDescription{gsi}
+				})},
+			},
+			want: tableParams(withIndexes),
+		},
+		"IndexesRemoved": {
+			args: args{
+				cr: &v1alpha1.Table{
+					Spec:   v1alpha1.TableSpec{ForProvider: *tableParams()},
+					Status: v1alpha1.TableStatus{AtProvider: v1alpha1.TableObservation{TableARN: &arn}},
+				},
+				in: &svcsdk.DescribeTableOutput{Table: table(func(t *svcsdk.TableDescription) {
+					t.GlobalSecondaryIndexes = []*svcsdk.GlobalSecondaryIndexDescription{gsi}
+				})},
+			},
+			want: tableParams(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &lateInitializer{}
+			if err := l.preObserve(context.Background(), tc.args.cr, &svcsdk.DescribeTableInput{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := l.lateInitialize(&tc.args.cr.Spec.ForProvider, tc.args.in); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, &tc.args.cr.Spec.ForProvider); diff != "" {
+				t.Errorf("lateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
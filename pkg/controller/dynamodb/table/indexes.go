@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// nextIndexUpdate returns the next change that brings the global secondary
+// indexes of the table in line with the spec, or nil if they are up to date.
+// DynamoDB allows only one index to be created or deleted per UpdateTable
+// call, so indexes are migrated one at a time: removed indexes and the ones
+// whose key schema or projection changed are deleted first, then the missing
+// ones are created and finally the provisioned throughput is updated.
+func nextIndexUpdate(p *svcapitypes.TableParameters, t *svcsdk.TableDescription) *svcsdk.GlobalSecondaryIndexUpdate {
+	desired := map[string]*svcapitypes.GlobalSecondaryIndex{}
+	for _, i := range p.GlobalSecondaryIndexes {
+		desired[aws.StringValue(i.IndexName)] = i
+	}
+	observed := map[string]*svcsdk.GlobalSecondaryIndexDescription{}
+	for _, o := range t.GlobalSecondaryIndexes {
+		observed[aws.StringValue(o.IndexName)] = o
+		if d, ok := desired[aws.StringValue(o.IndexName)]; !ok || !isIndexSchemaUpToDate(d, o) {
+			return &svcsdk.GlobalSecondaryIndexUpdate{
+				Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{IndexName: o.IndexName},
+			}
+		}
+	}
+	for _, d := range p.GlobalSecondaryIndexes {
+		if _, ok := observed[aws.StringValue(d.IndexName)]; !ok {
+			return &svcsdk.GlobalSecondaryIndexUpdate{Create: generateCreateIndexAction(d)}
+		}
+	}
+	for _, d := range p.GlobalSecondaryIndexes {
+		o := observed[aws.StringValue(d.IndexName)]
+		if d.ProvisionedThroughput == nil || isIndexAutoScaled(p.AutoScaling, aws.StringValue(d.IndexName)) {
+			continue
+		}
+		if o.ProvisionedThroughput == nil ||
+			aws.Int64Value(o.ProvisionedThroughput.ReadCapacityUnits) != aws.Int64Value(d.ProvisionedThroughput.ReadCapacityUnits) ||
+			aws.Int64Value(o.ProvisionedThroughput.WriteCapacityUnits) != aws.Int64Value(d.ProvisionedThroughput.WriteCapacityUnits) {
+			return &svcsdk.GlobalSecondaryIndexUpdate{
+				Update: &svcsdk.UpdateGlobalSecondaryIndexAction{
+					IndexName:             d.IndexName,
+					ProvisionedThroughput: generateProvisionedThroughput(d.ProvisionedThroughput),
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// areIndexesActive returns true if none of the global secondary indexes of
+// the table is being created, updated or deleted.
+func areIndexesActive(t *svcsdk.TableDescription) bool {
+	for _, o := range t.GlobalSecondaryIndexes {
+		if aws.StringValue(o.IndexStatus) != svcsdk.IndexStatusActive {
+			return false
+		}
+	}
+	return true
+}
+
+func isIndexSchemaUpToDate(d *svcapitypes.GlobalSecondaryIndex, o *svcsdk.GlobalSecondaryIndexDescription) bool {
+	if !cmp.Equal(d.KeySchema, buildAlphaKeyElements(o.KeySchema), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if d.Projection == nil || o.Projection == nil {
+		return true
+	}
+	return aws.StringValue(d.Projection.ProjectionType) == aws.StringValue(o.Projection.ProjectionType) &&
+		cmp.Equal(d.Projection.NonKeyAttributes, o.Projection.NonKeyAttributes, cmpopts.EquateEmpty(),
+			cmpopts.SortSlices(func(a, b *string) bool { return aws.StringValue(a) < aws.StringValue(b) }))
+}
+
+func isIndexAutoScaled(a *svcapitypes.TableAutoScaling, index string) bool {
+	if a == nil {
+		return false
+	}
+	for _, i := range a.GlobalSecondaryIndexes {
+		if i.IndexName == index {
+			return true
+		}
+	}
+	return false
+}
+
+func generateCreateIndexAction(d *svcapitypes.GlobalSecondaryIndex) *svcsdk.CreateGlobalSecondaryIndexAction {
+	a := &svcsdk.CreateGlobalSecondaryIndexAction{
+		IndexName:             d.IndexName,
+		ProvisionedThroughput: generateProvisionedThroughput(d.ProvisionedThroughput),
+	}
+	for _, k := range d.KeySchema {
+		a.KeySchema = append(a.KeySchema, &svcsdk.KeySchemaElement{
+			AttributeName: k.AttributeName,
+			KeyType:       k.KeyType,
+		})
+	}
+	if d.Projection != nil {
+		a.Projection = &svcsdk.Projection{
+			NonKeyAttributes: d.Projection.NonKeyAttributes,
+			ProjectionType:   d.Projection.ProjectionType,
+		}
+	}
+	return a
+}
+
+func generateProvisionedThroughput(p *svcapitypes.ProvisionedThroughput) *svcsdk.ProvisionedThroughput {
+	if p == nil {
+		return nil
+	}
+	return &svcsdk.ProvisionedThroughput{
+		ReadCapacityUnits:  p.ReadCapacityUnits,
+		WriteCapacityUnits: p.WriteCapacityUnits,
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+func index(name, key string) *v1alpha1.GlobalSecondaryIndex {
+	return &v1alpha1.GlobalSecondaryIndex{
+		IndexName:             aws.String(name),
+		KeySchema:             []*v1alpha1.KeySchemaElement{{AttributeName: aws.String(key), KeyType: aws.String("HASH")}},
+		Projection:            &v1alpha1.Projection{ProjectionType: aws.String("ALL")},
+		ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+	}
+}
+
+func indexDescription(name, key string, capacity int64) *svcsdk.GlobalSecondaryIndexDescription {
+	return &svcsdk.GlobalSecondaryIndexDescription{
+		IndexName:             aws.String(name),
+		IndexStatus:           aws.String(svcsdk.IndexStatusActive),
+		KeySchema:             []*svcsdk.KeySchemaElement{{AttributeName: aws.String(key), KeyType: aws.String("HASH")}},
+		Projection:            &svcsdk.Projection{ProjectionType: aws.String("ALL")},
+		ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{ReadCapacityUnits: aws.Int64(capacity), WriteCapacityUnits: aws.Int64(capacity)},
+	}
+}
+
+func TestNextIndexUpdate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.TableParameters
+		t    *svcsdk.TableDescription
+		want *svcsdk.GlobalSecondaryIndexUpdate
+	}{
+		"UpToDate": {
+			p: &v1alpha1.TableParameters{GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{index("gsi1", "a")}},
+			t: &svcsdk.TableDescription{GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{indexDescription("gsi1", "a", 1)}},
+		},
+		"DeleteRemovedFirst": {
+			p: &v1alpha1.TableParameters{GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{index("gsi2", "b")}},
+			t: &svcsdk.TableDescription{GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{indexDescription("gsi1", "a", 1)}},
+			want: &svcsdk.GlobalSecondaryIndexUpdate{
+				Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{IndexName: aws.String("gsi1")},
+			},
+		},
+		"DeleteChangedKeySchema": {
+			p: &v1alpha1.TableParameters{GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{index("gsi1", "b")}},
+			t: &svcsdk.TableDescription{GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{indexDescription("gsi1", "a", 1)}},
+			want: &svcsdk.GlobalSecondaryIndexUpdate{
+				Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{IndexName: aws.String("gsi1")},
+			},
+		},
+		"CreateMissing": {
+			p: &v1alpha1.TableParameters{GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{index("gsi1", "a")}},
+			t: &svcsdk.TableDescription{},
+			want: &svcsdk.GlobalSecondaryIndexUpdate{
+				Create: &svcsdk.CreateGlobalSecondaryIndexAction{
+					IndexName:             aws.String("gsi1"),
+					KeySchema:             []*svcsdk.KeySchemaElement{{AttributeName: aws.String("a"), KeyType: aws.String("HASH")}},
+					Projection:            &svcsdk.Projection{ProjectionType: aws.String("ALL")},
+					ProvisionedThroughput: &svcsdk.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+				},
+			},
+		},
+		"UpdateThroughput": {
+			p: &v1alpha1.TableParameters{GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{index("gsi1", "a")}},
+			t: &svcsdk.TableDescription{GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{indexDescription("gsi1", "a", 5)}},
+			want: &svcsdk.GlobalSecondaryIndexUpdate{
+				Update: &svcsdk.UpdateGlobalSecondaryIndexAction{
+					IndexName:             aws.String("gsi1"),
+					ProvisionedThroughput: &svcsdk.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+				},
+			},
+		},
+		"AutoScaledThroughput": {
+			p: &v1alpha1.TableParameters{
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{index("gsi1", "a")},
+				CustomTableParameters: v1alpha1.CustomTableParameters{
+					AutoScaling: &v1alpha1.TableAutoScaling{
+						GlobalSecondaryIndexes: []v1alpha1.IndexAutoScaling{{IndexName: "gsi1"}},
+					},
+				},
+			},
+			t: &svcsdk.TableDescription{GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{indexDescription("gsi1", "a", 5)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := nextIndexUpdate(tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errRestoreFromBackup     = "cannot restore Table from backup"
+	errRestoreToPointInTime  = "cannot restore Table to point in time"
+	errNoRestoreSource       = "either backupARN or pointInTime has to be given to restore a Table"
+	errMultipleRestoreSource = "only one of backupARN and pointInTime can be given to restore a Table"
+)
+
+func (s *settings) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok || cr.Spec.ForProvider.RestoreFrom == nil {
+		return s.ExternalClient.Create(ctx, mg)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	src := cr.Spec.ForProvider.RestoreFrom
+	switch {
+	case src.BackupARN != nil && src.PointInTime != nil:
+		return managed.ExternalCreation{}, errors.New(errMultipleRestoreSource)
+	case src.BackupARN != nil:
+		_, err := s.client.RestoreTableFromBackupWithContext(ctx, generateRestoreTableFromBackupInput(cr))
+		return managed.ExternalCreation{}, aws.Wrap(err, errRestoreFromBackup)
+	case src.PointInTime != nil:
+		_, err := s.client.RestoreTableToPointInTimeWithContext(ctx, generateRestoreTableToPointInTimeInput(cr))
+		return managed.ExternalCreation{}, aws.Wrap(err, errRestoreToPointInTime)
+	}
+	return managed.ExternalCreation{}, errors.New(errNoRestoreSource)
+}
+
+// restoreOverrides are the settings of the restored table that can differ
+// from the ones of its source.
+type restoreOverrides struct {
+	billingMode           *string
+	provisionedThroughput *svcsdk.ProvisionedThroughput
+	globalIndexes         []*svcsdk.GlobalSecondaryIndex
+	localIndexes          []*svcsdk.LocalSecondaryIndex
+	sse                   *svcsdk.SSESpecification
+}
+
+func generateRestoreOverrides(cr *svcapitypes.Table) restoreOverrides {
+	in := GenerateCreateTableInput(cr)
+	return restoreOverrides{
+		billingMode:           in.BillingMode,
+		provisionedThroughput: in.ProvisionedThroughput,
+		globalIndexes:         in.GlobalSecondaryIndexes,
+		localIndexes:          in.LocalSecondaryIndexes,
+		sse:                   in.SSESpecification,
+	}
+}
+
+func generateRestoreTableFromBackupInput(cr *svcapitypes.Table) *svcsdk.RestoreTableFromBackupInput {
+	o := generateRestoreOverrides(cr)
+	return &svcsdk.RestoreTableFromBackupInput{
+		BackupArn:                     cr.Spec.ForProvider.RestoreFrom.BackupARN,
+		TargetTableName:               awsgo.String(meta.GetExternalName(cr)),
+		BillingModeOverride:           o.billingMode,
+		ProvisionedThroughputOverride: o.provisionedThroughput,
+		GlobalSecondaryIndexOverride:  o.globalIndexes,
+		LocalSecondaryIndexOverride:   o.localIndexes,
+		SSESpecificationOverride:      o.sse,
+	}
+}
+
+func generateRestoreTableToPointInTimeInput(cr *svcapitypes.Table) *svcsdk.RestoreTableToPointInTimeInput {
+	o := generateRestoreOverrides(cr)
+	p := cr.Spec.ForProvider.RestoreFrom.PointInTime
+	in := &svcsdk.RestoreTableToPointInTimeInput{
+		SourceTableName:               p.SourceTableName,
+		TargetTableName:               awsgo.String(meta.GetExternalName(cr)),
+		UseLatestRestorableTime:       awsgo.Bool(p.RestoreDateTime == nil),
+		BillingModeOverride:           o.billingMode,
+		ProvisionedThroughputOverride: o.provisionedThroughput,
+		GlobalSecondaryIndexOverride:  o.globalIndexes,
+		LocalSecondaryIndexOverride:   o.localIndexes,
+		SSESpecificationOverride:      o.sse,
+	}
+	if p.RestoreDateTime != nil {
+		in.RestoreDateTime = &p.RestoreDateTime.Time
+	}
+	return in
+}
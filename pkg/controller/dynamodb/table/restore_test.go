@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+var (
+	backupARN   = "arn:aws:dynamodb:us-east-1:123456789012:table/source/backup/01"
+	restoreTime = metav1.NewTime(time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC))
+)
+
+func (m *mockDynamoDB) RestoreTableFromBackupWithContext(_ context.Context, in *svcsdk.RestoreTableFromBackupInput, _ ...request.Option) (*svcsdk.RestoreTableFromBackupOutput, error) {
+	m.restoredFromBackup = in
+	return &svcsdk.RestoreTableFromBackupOutput{}, m.err
+}
+
+func (m *mockDynamoDB) RestoreTableToPointInTimeWithContext(_ context.Context, in *svcsdk.RestoreTableToPointInTimeInput, _ ...request.Option) (*svcsdk.RestoreTableToPointInTimeOutput, error) {
+	m.restoredToPointInTime = in
+	return &svcsdk.RestoreTableToPointInTimeOutput{}, m.err
+}
+
+func restoredTable(src *v1alpha1.TableRestoreSource, m ...func(*v1alpha1.TableParameters)) *v1alpha1.Table {
+	cr := &v1alpha1.Table{Spec: v1alpha1.TableSpec{ForProvider: *tableParams(m...)}}
+	cr.Spec.ForProvider.RestoreFrom = src
+	meta.SetExternalName(cr, "orders")
+	return cr
+}
+
+func withGlobalIndex(p *v1alpha1.TableParameters) {
+	p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{{
+		IndexName: aws.String("gsi1"),
+		KeySchema: []*v1alpha1.KeySchemaElement{{AttributeName: aws.String("a"), KeyType: aws.String(svcsdk.KeyTypeHash)}},
+	}}
+}
+
+func TestRestoreCreate(t *testing.T) {
+	type want struct {
+		created               bool
+		restoredFromBackup    bool
+		restoredToPointInTime bool
+		cr                    *v1alpha1.Table
+		err                   error
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.Table
+		err  error
+		want want
+	}{
+		"NoRestoreSource": {
+			cr: restoredTable(nil),
+			want: want{
+				created: true,
+				cr:      restoredTable(nil),
+			},
+		},
+		"FromBackup": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN}),
+			want: want{
+				restoredFromBackup: true,
+				cr: func() *v1alpha1.Table {
+					cr := restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN})
+					cr.SetConditions(xpv1.Creating())
+					return cr
+				}(),
+			},
+		},
+		"FromBackupFailed": {
+			cr:  restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN}),
+			err: errBoom,
+			want: want{
+				restoredFromBackup: true,
+				cr: func() *v1alpha1.Table {
+					cr := restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN})
+					cr.SetConditions(xpv1.Creating())
+					return cr
+				}(),
+				err: errors.Wrap(errBoom, errRestoreFromBackup),
+			},
+		},
+		"ToPointInTime": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}}),
+			want: want{
+				restoredToPointInTime: true,
+				cr: func() *v1alpha1.Table {
+					cr := restoredTable(&v1alpha1.TableRestoreSource{PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}})
+					cr.SetConditions(xpv1.Creating())
+					return cr
+				}(),
+			},
+		},
+		"ToPointInTimeFailed": {
+			cr:  restoredTable(&v1alpha1.TableRestoreSource{PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}}),
+			err: errBoom,
+			want: want{
+				restoredToPointInTime: true,
+				cr: func() *v1alpha1.Table {
+					cr := restoredTable(&v1alpha1.TableRestoreSource{PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}})
+					cr.SetConditions(xpv1.Creating())
+					return cr
+				}(),
+				err: errors.Wrap(errBoom, errRestoreToPointInTime),
+			},
+		},
+		"MultipleSources": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN, PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}}),
+			want: want{
+				cr: func() *v1alpha1.Table {
+					cr := restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN, PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}})
+					cr.SetConditions(xpv1.Creating())
+					return cr
+				}(),
+				err: errors.New(errMultipleRestoreSource),
+			},
+		},
+		"NoSource": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{}),
+			want: want{
+				cr: func() *v1alpha1.Table {
+					cr := restoredTable(&v1alpha1.TableRestoreSource{})
+					cr.SetConditions(xpv1.Creating())
+					return cr
+				}(),
+				err: errors.New(errNoRestoreSource),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			c := &mockDynamoDB{err: tc.err}
+			s := &settings{
+				ExternalClient: &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						created = true
+						return managed.ExternalCreation{}, nil
+					},
+				},
+				client: c,
+			}
+			_, err := s.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("cr: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.restoredFromBackup, c.restoredFromBackup != nil); diff != "" {
+				t.Errorf("restoredFromBackup: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.restoredToPointInTime, c.restoredToPointInTime != nil); diff != "" {
+				t.Errorf("restoredToPointInTime: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRestoreTableFromBackupInput(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.Table
+		want *svcsdk.RestoreTableFromBackupInput
+	}{
+		"Overrides": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN}, withGlobalIndex, func(p *v1alpha1.TableParameters) {
+				p.BillingMode = aws.String(svcsdk.BillingModeProvisioned)
+				p.SSESpecification = &v1alpha1.SSESpecification{Enabled: aws.Bool(true)}
+			}),
+			want: &svcsdk.RestoreTableFromBackupInput{
+				BackupArn:           &backupARN,
+				TargetTableName:     aws.String("orders"),
+				BillingModeOverride: aws.String(svcsdk.BillingModeProvisioned),
+				ProvisionedThroughputOverride: &svcsdk.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+					WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+				},
+				GlobalSecondaryIndexOverride: []*svcsdk.GlobalSecondaryIndex{{
+					IndexName: aws.String("gsi1"),
+					KeySchema: []*svcsdk.KeySchemaElement{{AttributeName: aws.String("a"), KeyType: aws.String(svcsdk.KeyTypeHash)}},
+				}},
+				SSESpecificationOverride: &svcsdk.SSESpecification{Enabled: aws.Bool(true)},
+			},
+		},
+		"NoOverrides": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{BackupARN: &backupARN}, func(p *v1alpha1.TableParameters) {
+				p.ProvisionedThroughput = nil
+			}),
+			want: &svcsdk.RestoreTableFromBackupInput{
+				BackupArn:       &backupARN,
+				TargetTableName: aws.String("orders"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateRestoreTableFromBackupInput(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRestoreTableToPointInTimeInput(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.Table
+		want *svcsdk.RestoreTableToPointInTimeInput
+	}{
+		"LatestRestorableTime": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{PointInTime: &v1alpha1.PointInTimeRestore{SourceTableName: aws.String("source")}}, func(p *v1alpha1.TableParameters) {
+				p.ProvisionedThroughput = nil
+			}),
+			want: &svcsdk.RestoreTableToPointInTimeInput{
+				SourceTableName:         aws.String("source"),
+				TargetTableName:         aws.String("orders"),
+				UseLatestRestorableTime: aws.Bool(true),
+			},
+		},
+		"RestoreDateTime": {
+			cr: restoredTable(&v1alpha1.TableRestoreSource{PointInTime: &v1alpha1.PointInTimeRestore{
+				SourceTableName: aws.String("source"),
+				RestoreDateTime: &restoreTime,
+			}}, withGlobalIndex),
+			want: &svcsdk.RestoreTableToPointInTimeInput{
+				SourceTableName:         aws.String("source"),
+				TargetTableName:         aws.String("orders"),
+				UseLatestRestorableTime: aws.Bool(false),
+				RestoreDateTime:         &restoreTime.Time,
+				ProvisionedThroughputOverride: &svcsdk.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+					WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+				},
+				GlobalSecondaryIndexOverride: []*svcsdk.GlobalSecondaryIndex{{
+					IndexName: aws.String("gsi1"),
+					KeySchema: []*svcsdk.KeySchemaElement{{AttributeName: aws.String("a"), KeyType: aws.String(svcsdk.KeyTypeHash)}},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateRestoreTableToPointInTimeInput(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,651 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errDescribeTimeToLive          = "cannot describe time to live of Table"
+	errUpdateTimeToLive            = "cannot update time to live of Table"
+	errDescribeContinuousBackups   = "cannot describe continuous backups of Table"
+	errUpdateContinuousBackups     = "cannot update continuous backups of Table"
+	errDescribeContributorInsights = "cannot describe contributor insights of Table"
+	errUpdateContributorInsights   = "cannot update contributor insights of Table"
+	errDescribeScalableTargets     = "cannot describe scalable targets of Table"
+	errDescribeScalingPolicies     = "cannot describe scaling policies of Table"
+	errRegisterScalableTarget      = "cannot register scalable target of Table"
+	errPutScalingPolicy            = "cannot put scaling policy of Table"
+	errDeregisterScalableTarget    = "cannot deregister scalable target of Table"
+	errDescribeKinesisDestination  = "cannot describe Kinesis streaming destinations of Table"
+	errEnableKinesisDestination    = "cannot enable Kinesis streaming destination of Table"
+	errDisableKinesisDestination   = "cannot disable Kinesis streaming destination of Table"
+	errRegisteredScalableTargets   = "cannot parse the registered scalable targets of Table"
+	errKubeUpdate                  = "cannot update Table custom resource"
+)
+
+// settingsConnector connects to DynamoDB like the generated connector, and
+// wraps its client with one that manages the settings of the table that have
+// dedicated APIs.
+type settingsConnector struct {
+	kube client.Client
+	opts []option
+}
+
+func (c *settingsConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := aws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	client := svcsdk.New(sess)
+	return &settings{
+		ExternalClient: newExternal(c.kube, client, c.opts),
+		kube:           c.kube,
+		client:         client,
+		autoscaling:    applicationautoscaling.New(sess),
+	}, nil
+}
+
+// settings manages the time to live, point-in-time recovery, contributor
+// insights, auto scaling and Kinesis streaming destination of an active
+// table. All other operations are handled by the generated client.
+type settings struct {
+	managed.ExternalClient
+	kube        client.Client
+	client      svcsdkapi.DynamoDBAPI
+	autoscaling applicationautoscalingiface.ApplicationAutoScalingAPI
+}
+
+func (s *settings) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := s.ExternalClient.Observe(ctx, mg)
+	cr, ok := mg.(*svcapitypes.Table)
+	if err != nil || !ok || !obs.ResourceExists || !obs.ResourceUpToDate ||
+		aws.StringValue(cr.Status.AtProvider.TableStatus) != string(svcapitypes.TableStatus_SDK_ACTIVE) {
+		return obs, err
+	}
+	c, err := s.observe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs.ResourceUpToDate = c.isUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider.CustomTableParameters)
+	return obs, nil
+}
+
+func (s *settings) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	t, err := s.client.DescribeTableWithContext(ctx, &svcsdk.DescribeTableInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalUpdate{}, aws.Wrap(err, errDescribe)
+	}
+	upToDate, err := isUpToDate(cr, t)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// DynamoDB rejects most of the calls until the table and its indexes are
+	// active, so the next change waits for them. The settings are applied
+	// once the table itself is up to date.
+	if awsgo.StringValue(t.Table.TableStatus) != svcsdk.TableStatusActive || !areIndexesActive(t.Table) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if !upToDate {
+		return s.ExternalClient.Update(ctx, mg)
+	}
+	c, err := s.observe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, s.update(ctx, cr, c)
+}
+
+// currentSettings are the settings of a table as observed in AWS.
+type currentSettings struct {
+	timeToLive          *svcsdk.TimeToLiveDescription
+	pointInTimeRecovery *svcsdk.PointInTimeRecoveryDescription
+	contributorInsights *string
+	scalableTargets     []*applicationautoscaling.ScalableTarget
+	scalingPolicies     []*applicationautoscaling.ScalingPolicy
+	kinesisDestinations []*svcsdk.KinesisDataStreamDestination
+
+	// registered are the scalable targets that the controller registered
+	// for the table.
+	registered []scalableTargetRef
+}
+
+// observe fetches only the settings that are configured in the spec.
+func (s *settings) observe(ctx context.Context, cr *svcapitypes.Table) (*currentSettings, error) { // nolint:gocyclo
+	p := cr.Spec.ForProvider.CustomTableParameters
+	name := aws.String(meta.GetExternalName(cr))
+	c := &currentSettings{}
+	if p.TimeToLive != nil {
+		resp, err := s.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: name})
+		if err != nil {
+			return nil, aws.Wrap(err, errDescribeTimeToLive)
+		}
+		c.timeToLive = resp.TimeToLiveDescription
+	}
+	if p.PointInTimeRecoveryEnabled != nil {
+		resp, err := s.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: name})
+		if err != nil {
+			return nil, aws.Wrap(err, errDescribeContinuousBackups)
+		}
+		if resp.ContinuousBackupsDescription != nil {
+			c.pointInTimeRecovery = resp.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+		}
+	}
+	if p.ContributorInsightsEnabled != nil {
+		resp, err := s.client.DescribeContributorInsightsWithContext(ctx, &svcsdk.DescribeContributorInsightsInput{TableName: name})
+		if err != nil {
+			return nil, aws.Wrap(err, errDescribeContributorInsights)
+		}
+		c.contributorInsights = resp.ContributorInsightsStatus
+	}
+	if p.KinesisStreamingDestination != nil {
+		resp, err := s.client.DescribeKinesisStreamingDestinationWithContext(ctx, &svcsdk.DescribeKinesisStreamingDestinationInput{TableName: name})
+		if err != nil {
+			return nil, aws.Wrap(err, errDescribeKinesisDestination)
+		}
+		c.kinesisDestinations = resp.KinesisDataStreamDestinations
+	}
+	registered, err := registeredScalableTargets(cr)
+	if err != nil {
+		return nil, err
+	}
+	c.registered = registered
+	if p.AutoScaling == nil && len(registered) == 0 {
+		return c, nil
+	}
+	var ids []*string
+	if p.AutoScaling != nil {
+		ids = append(ids, awsgo.String(tableResourceID(*name)))
+		for _, i := range cr.Spec.ForProvider.GlobalSecondaryIndexes {
+			ids = append(ids, awsgo.String(indexResourceID(*name, aws.StringValue(i.IndexName))))
+		}
+		for _, i := range p.AutoScaling.GlobalSecondaryIndexes {
+			ids = append(ids, awsgo.String(indexResourceID(*name, i.IndexName)))
+		}
+	}
+	for _, r := range registered {
+		ids = append(ids, awsgo.String(r.ResourceID))
+	}
+	err = s.autoscaling.DescribeScalableTargetsPagesWithContext(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: awsgo.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceIds:      ids,
+	}, func(page *applicationautoscaling.DescribeScalableTargetsOutput, _ bool) bool {
+		c.scalableTargets = append(c.scalableTargets, page.ScalableTargets...)
+		return true
+	})
+	if err != nil {
+		return nil, aws.Wrap(err, errDescribeScalableTargets)
+	}
+	if p.AutoScaling == nil {
+		// Without auto scaling in the spec only the scalable targets that
+		// were registered by the controller are deregistered.
+		c.scalableTargets = filterScalableTargets(c.scalableTargets, registered)
+		return c, nil
+	}
+	var names []*string
+	for _, t := range scalingTargets(*name, p.AutoScaling) {
+		names = append(names, awsgo.String(t.policyName()))
+	}
+	if len(names) == 0 {
+		return c, nil
+	}
+	err = s.autoscaling.DescribeScalingPoliciesPagesWithContext(ctx, &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace: awsgo.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		PolicyNames:      names,
+	}, func(page *applicationautoscaling.DescribeScalingPoliciesOutput, _ bool) bool {
+		c.scalingPolicies = append(c.scalingPolicies, page.ScalingPolicies...)
+		return true
+	})
+	return c, aws.Wrap(err, errDescribeScalingPolicies)
+}
+
+func (s *settings) update(ctx context.Context, cr *svcapitypes.Table, c *currentSettings) error { // nolint:gocyclo
+	p := cr.Spec.ForProvider.CustomTableParameters
+	name := aws.String(meta.GetExternalName(cr))
+	if !isTimeToLiveUpToDate(p.TimeToLive, c.timeToLive) {
+		_, err := s.client.UpdateTimeToLiveWithContext(ctx, &svcsdk.UpdateTimeToLiveInput{
+			TableName:               name,
+			TimeToLiveSpecification: timeToLiveUpdate(p.TimeToLive, c.timeToLive),
+		})
+		if err != nil {
+			return aws.Wrap(err, errUpdateTimeToLive)
+		}
+	}
+	if !isPointInTimeRecoveryUpToDate(p.PointInTimeRecoveryEnabled, c.pointInTimeRecovery) {
+		_, err := s.client.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+			TableName: name,
+			PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{
+				PointInTimeRecoveryEnabled: p.PointInTimeRecoveryEnabled,
+			},
+		})
+		if err != nil {
+			return aws.Wrap(err, errUpdateContinuousBackups)
+		}
+	}
+	if !isContributorInsightsUpToDate(p.ContributorInsightsEnabled, c.contributorInsights) {
+		action := svcsdk.ContributorInsightsActionDisable
+		if awsgo.BoolValue(p.ContributorInsightsEnabled) {
+			action = svcsdk.ContributorInsightsActionEnable
+		}
+		_, err := s.client.UpdateContributorInsightsWithContext(ctx, &svcsdk.UpdateContributorInsightsInput{
+			TableName:                 name,
+			ContributorInsightsAction: awsgo.String(action),
+		})
+		if err != nil {
+			return aws.Wrap(err, errUpdateContributorInsights)
+		}
+	}
+	if !isKinesisDestinationUpToDate(p.KinesisStreamingDestination, c.kinesisDestinations) {
+		if err := s.updateKinesisDestination(ctx, name, p.KinesisStreamingDestination); err != nil {
+			return err
+		}
+	}
+	if p.AutoScaling == nil && len(c.registered) == 0 {
+		return nil
+	}
+	desired := scalingTargets(*name, p.AutoScaling)
+	// The targets are recorded before they are registered so that they can
+	// be deregistered later even if the last record below is lost.
+	if err := s.recordScalableTargets(ctx, cr, mergeScalableTargetRefs(c.registered, scalableTargetRefs(desired))); err != nil {
+		return err
+	}
+	if err := s.updateAutoScaling(ctx, desired, c); err != nil {
+		return err
+	}
+	return s.recordScalableTargets(ctx, cr, scalableTargetRefs(desired))
+}
+
+// recordScalableTargets stores the given scalable targets in an annotation
+// of the table.
+func (s *settings) recordScalableTargets(ctx context.Context, cr *svcapitypes.Table, refs []scalableTargetRef) error {
+	val := ""
+	if len(refs) != 0 {
+		b, err := json.Marshal(refs)
+		if err != nil {
+			return errors.Wrap(err, errRegisteredScalableTargets)
+		}
+		val = string(b)
+	}
+	if cr.GetAnnotations()[svcapitypes.AnnotationKeyRegisteredScalableTargets] == val {
+		return nil
+	}
+	if val == "" {
+		meta.RemoveAnnotations(cr, svcapitypes.AnnotationKeyRegisteredScalableTargets)
+	} else {
+		meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyRegisteredScalableTargets: val})
+	}
+	return errors.Wrap(s.kube.Update(ctx, cr), errKubeUpdate)
+}
+
+func (s *settings) updateKinesisDestination(ctx context.Context, name *string, spec *svcapitypes.KinesisStreamingDestination) error {
+	if spec.Enabled {
+		_, err := s.client.EnableKinesisStreamingDestinationWithContext(ctx, &svcsdk.EnableKinesisStreamingDestinationInput{
+			TableName: name,
+			StreamArn: awsgo.String(spec.StreamARN),
+		})
+		return aws.Wrap(err, errEnableKinesisDestination)
+	}
+	_, err := s.client.DisableKinesisStreamingDestinationWithContext(ctx, &svcsdk.DisableKinesisStreamingDestinationInput{
+		TableName: name,
+		StreamArn: awsgo.String(spec.StreamARN),
+	})
+	return aws.Wrap(err, errDisableKinesisDestination)
+}
+
+func (s *settings) updateAutoScaling(ctx context.Context, desired []scalingTarget, c *currentSettings) error {
+	for _, t := range desired {
+		if !t.isTargetUpToDate(c.scalableTargets) {
+			_, err := s.autoscaling.RegisterScalableTargetWithContext(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+				ServiceNamespace:  awsgo.String(applicationautoscaling.ServiceNamespaceDynamodb),
+				ResourceId:        awsgo.String(t.resourceID),
+				ScalableDimension: awsgo.String(t.dimension),
+				MinCapacity:       awsgo.Int64(t.spec.MinCapacity),
+				MaxCapacity:       awsgo.Int64(t.spec.MaxCapacity),
+			})
+			if err != nil {
+				return aws.Wrap(err, errRegisterScalableTarget)
+			}
+		}
+		if !t.isPolicyUpToDate(c.scalingPolicies) {
+			_, err := s.autoscaling.PutScalingPolicyWithContext(ctx, &applicationautoscaling.PutScalingPolicyInput{
+				ServiceNamespace:                         awsgo.String(applicationautoscaling.ServiceNamespaceDynamodb),
+				ResourceId:                               awsgo.String(t.resourceID),
+				ScalableDimension:                        awsgo.String(t.dimension),
+				PolicyName:                               awsgo.String(t.policyName()),
+				PolicyType:                               awsgo.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+				TargetTrackingScalingPolicyConfiguration: t.policyConfiguration(),
+			})
+			if err != nil {
+				return aws.Wrap(err, errPutScalingPolicy)
+			}
+		}
+	}
+	for _, t := range unwantedScalableTargets(desired, c.scalableTargets) {
+		_, err := s.autoscaling.DeregisterScalableTargetWithContext(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+			ServiceNamespace:  t.ServiceNamespace,
+			ResourceId:        t.ResourceId,
+			ScalableDimension: t.ScalableDimension,
+		})
+		if err != nil {
+			return aws.Wrap(resource.Ignore(isScalableTargetNotFound, err), errDeregisterScalableTarget)
+		}
+	}
+	return nil
+}
+
+func (c *currentSettings) isUpToDate(table string, p *svcapitypes.CustomTableParameters) bool {
+	if !isTimeToLiveUpToDate(p.TimeToLive, c.timeToLive) ||
+		!isPointInTimeRecoveryUpToDate(p.PointInTimeRecoveryEnabled, c.pointInTimeRecovery) ||
+		!isContributorInsightsUpToDate(p.ContributorInsightsEnabled, c.contributorInsights) ||
+		!isKinesisDestinationUpToDate(p.KinesisStreamingDestination, c.kinesisDestinations) {
+		return false
+	}
+	return isAutoScalingUpToDate(scalingTargets(table, p.AutoScaling), c)
+}
+
+// isTimeToLiveUpToDate reports whether the time to live of the table matches
+// the spec. A time to live that is being enabled or disabled is considered up
+// to date since DynamoDB rejects updates until the change settles.
+func isTimeToLiveUpToDate(spec *svcapitypes.TimeToLive, d *svcsdk.TimeToLiveDescription) bool {
+	if spec == nil {
+		return true
+	}
+	if d == nil {
+		return !spec.Enabled
+	}
+	switch awsgo.StringValue(d.TimeToLiveStatus) {
+	case svcsdk.TimeToLiveStatusEnabling, svcsdk.TimeToLiveStatusDisabling:
+		return true
+	case svcsdk.TimeToLiveStatusEnabled:
+		return spec.Enabled && spec.AttributeName == awsgo.StringValue(d.AttributeName)
+	default:
+		return !spec.Enabled
+	}
+}
+
+// timeToLiveUpdate returns the next step towards the time to live in the
+// spec. The time to live has to be disabled before it can be enabled on
+// another attribute.
+func timeToLiveUpdate(spec *svcapitypes.TimeToLive, d *svcsdk.TimeToLiveDescription) *svcsdk.TimeToLiveSpecification {
+	if d != nil && awsgo.StringValue(d.TimeToLiveStatus) == svcsdk.TimeToLiveStatusEnabled {
+		return &svcsdk.TimeToLiveSpecification{
+			AttributeName: d.AttributeName,
+			Enabled:       awsgo.Bool(false),
+		}
+	}
+	return &svcsdk.TimeToLiveSpecification{
+		AttributeName: awsgo.String(spec.AttributeName),
+		Enabled:       awsgo.Bool(spec.Enabled),
+	}
+}
+
+func isPointInTimeRecoveryUpToDate(spec *bool, d *svcsdk.PointInTimeRecoveryDescription) bool {
+	if spec == nil {
+		return true
+	}
+	enabled := d != nil && awsgo.StringValue(d.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return *spec == enabled
+}
+
+func isContributorInsightsUpToDate(spec *bool, status *string) bool {
+	if spec == nil {
+		return true
+	}
+	switch awsgo.StringValue(status) {
+	case svcsdk.ContributorInsightsStatusEnabling, svcsdk.ContributorInsightsStatusDisabling:
+		return true
+	case svcsdk.ContributorInsightsStatusEnabled:
+		return *spec
+	default:
+		return !*spec
+	}
+}
+
+// isKinesisDestinationUpToDate reports whether the data stream in the spec is
+// enabled or disabled as desired. Other destinations of the table are left
+// untouched.
+func isKinesisDestinationUpToDate(spec *svcapitypes.KinesisStreamingDestination, observed []*svcsdk.KinesisDataStreamDestination) bool {
+	if spec == nil {
+		return true
+	}
+	status := ""
+	for _, d := range observed {
+		if awsgo.StringValue(d.StreamArn) == spec.StreamARN {
+			status = awsgo.StringValue(d.DestinationStatus)
+		}
+	}
+	switch status {
+	case svcsdk.DestinationStatusEnabling, svcsdk.DestinationStatusDisabling:
+		return true
+	case svcsdk.DestinationStatusActive:
+		return spec.Enabled
+	default:
+		return !spec.Enabled
+	}
+}
+
+// scalingTarget is a scalable dimension of the table or one of its indexes
+// together with its desired target tracking configuration.
+type scalingTarget struct {
+	resourceID string
+	dimension  string
+	metric     string
+	spec       *svcapitypes.CapacityAutoScaling
+}
+
+func tableResourceID(table string) string {
+	return "table/" + table
+}
+
+func indexResourceID(table, index string) string {
+	return fmt.Sprintf("table/%s/index/%s", table, index)
+}
+
+func (t scalingTarget) policyName() string {
+	return fmt.Sprintf("%s-%s", strings.ReplaceAll(t.resourceID, "/", "-"), t.metric)
+}
+
+func (t scalingTarget) policyConfiguration() *applicationautoscaling.TargetTrackingScalingPolicyConfiguration {
+	return &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: awsgo.String(t.metric),
+		},
+		TargetValue:      awsgo.Float64(float64(t.spec.TargetUtilizationPercent)),
+		ScaleInCooldown:  t.spec.ScaleInCooldown,
+		ScaleOutCooldown: t.spec.ScaleOutCooldown,
+		DisableScaleIn:   t.spec.DisableScaleIn,
+	}
+}
+
+func (t scalingTarget) isTargetUpToDate(observed []*applicationautoscaling.ScalableTarget) bool {
+	for _, o := range observed {
+		if awsgo.StringValue(o.ResourceId) == t.resourceID && awsgo.StringValue(o.ScalableDimension) == t.dimension {
+			return awsgo.Int64Value(o.MinCapacity) == t.spec.MinCapacity && awsgo.Int64Value(o.MaxCapacity) == t.spec.MaxCapacity
+		}
+	}
+	return false
+}
+
+func (t scalingTarget) isPolicyUpToDate(observed []*applicationautoscaling.ScalingPolicy) bool {
+	for _, o := range observed {
+		if awsgo.StringValue(o.PolicyName) != t.policyName() || awsgo.StringValue(o.ResourceId) != t.resourceID {
+			continue
+		}
+		c := o.TargetTrackingScalingPolicyConfiguration
+		if c == nil {
+			return false
+		}
+		return awsgo.Float64Value(c.TargetValue) == float64(t.spec.TargetUtilizationPercent) &&
+			(t.spec.ScaleInCooldown == nil || awsgo.Int64Value(c.ScaleInCooldown) == *t.spec.ScaleInCooldown) &&
+			(t.spec.ScaleOutCooldown == nil || awsgo.Int64Value(c.ScaleOutCooldown) == *t.spec.ScaleOutCooldown) &&
+			awsgo.BoolValue(c.DisableScaleIn) == awsgo.BoolValue(t.spec.DisableScaleIn)
+	}
+	return false
+}
+
+// scalingTargets lists the scalable dimensions configured in the given auto
+// scaling spec.
+func scalingTargets(table string, a *svcapitypes.TableAutoScaling) []scalingTarget {
+	var out []scalingTarget
+	if a == nil {
+		return nil
+	}
+	add := func(id, read, write string, r, w *svcapitypes.CapacityAutoScaling) {
+		if r != nil {
+			out = append(out, scalingTarget{resourceID: id, dimension: read, metric: applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization, spec: r})
+		}
+		if w != nil {
+			out = append(out, scalingTarget{resourceID: id, dimension: write, metric: applicationautoscaling.MetricTypeDynamoDbwriteCapacityUtilization, spec: w})
+		}
+	}
+	add(tableResourceID(table),
+		applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+		applicationautoscaling.ScalableDimensionDynamodbTableWriteCapacityUnits,
+		a.ReadCapacity, a.WriteCapacity)
+	for _, i := range a.GlobalSecondaryIndexes {
+		add(indexResourceID(table, i.IndexName),
+			applicationautoscaling.ScalableDimensionDynamodbIndexReadCapacityUnits,
+			applicationautoscaling.ScalableDimensionDynamodbIndexWriteCapacityUnits,
+			i.ReadCapacity, i.WriteCapacity)
+	}
+	return out
+}
+
+// unwantedScalableTargets returns the observed scalable targets that are not
+// configured in the spec.
+func unwantedScalableTargets(desired []scalingTarget, observed []*applicationautoscaling.ScalableTarget) []*applicationautoscaling.ScalableTarget {
+	var out []*applicationautoscaling.ScalableTarget
+	for _, o := range observed {
+		found := false
+		for _, t := range desired {
+			if awsgo.StringValue(o.ResourceId) == t.resourceID && awsgo.StringValue(o.ScalableDimension) == t.dimension {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// scalableTargetRef identifies a scalable target that the controller
+// registered for the table.
+type scalableTargetRef struct {
+	ResourceID        string `json:"resourceId"`
+	ScalableDimension string `json:"scalableDimension"`
+}
+
+func (r scalableTargetRef) matches(t *applicationautoscaling.ScalableTarget) bool {
+	return awsgo.StringValue(t.ResourceId) == r.ResourceID && awsgo.StringValue(t.ScalableDimension) == r.ScalableDimension
+}
+
+// registeredScalableTargets returns the scalable targets recorded in the
+// annotations of the table.
+func registeredScalableTargets(cr *svcapitypes.Table) ([]scalableTargetRef, error) {
+	val := cr.GetAnnotations()[svcapitypes.AnnotationKeyRegisteredScalableTargets]
+	if val == "" {
+		return nil, nil
+	}
+	var out []scalableTargetRef
+	return out, errors.Wrap(json.Unmarshal([]byte(val), &out), errRegisteredScalableTargets)
+}
+
+func scalableTargetRefs(targets []scalingTarget) []scalableTargetRef {
+	var out []scalableTargetRef
+	for _, t := range targets {
+		out = append(out, scalableTargetRef{ResourceID: t.resourceID, ScalableDimension: t.dimension})
+	}
+	return out
+}
+
+// mergeScalableTargetRefs returns a followed by the references of b that are
+// not in a.
+func mergeScalableTargetRefs(a, b []scalableTargetRef) []scalableTargetRef {
+	out := append([]scalableTargetRef{}, a...)
+	for _, r := range b {
+		found := false
+		for _, o := range a {
+			if o == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// filterScalableTargets returns the observed scalable targets that are among
+// the given references.
+func filterScalableTargets(observed []*applicationautoscaling.ScalableTarget, refs []scalableTargetRef) []*applicationautoscaling.ScalableTarget {
+	var out []*applicationautoscaling.ScalableTarget
+	for _, o := range observed {
+		for _, r := range refs {
+			if r.matches(o) {
+				out = append(out, o)
+				break
+			}
+		}
+	}
+	return out
+}
+
+func isAutoScalingUpToDate(desired []scalingTarget, c *currentSettings) bool {
+	for _, t := range desired {
+		if !t.isTargetUpToDate(c.scalableTargets) || !t.isPolicyUpToDate(c.scalingPolicies) {
+			return false
+		}
+	}
+	return len(unwantedScalableTargets(desired, c.scalableTargets)) == 0
+}
+
+func isScalableTargetNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == applicationautoscaling.ErrCodeObjectNotFoundException
+}
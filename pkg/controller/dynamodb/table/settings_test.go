@@ -0,0 +1,414 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+func TestTimeToLive(t *testing.T) {
+	type want struct {
+		upToDate bool
+		update   *svcsdk.TimeToLiveSpecification
+	}
+
+	cases := map[string]struct {
+		spec *v1alpha1.TimeToLive
+		d    *svcsdk.TimeToLiveDescription
+		want want
+	}{
+		"Enable": {
+			spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+			d:    &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			want: want{
+				update: &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expiry"), Enabled: aws.Bool(true)},
+			},
+		},
+		"Enabled": {
+			spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+			d:    &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			want: want{
+				upToDate: true,
+				update:   &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expiry"), Enabled: aws.Bool(false)},
+			},
+		},
+		"Transitioning": {
+			spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+			d:    &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabling)},
+			want: want{
+				upToDate: true,
+				update:   &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expiry"), Enabled: aws.Bool(true)},
+			},
+		},
+		"DisableBeforeChangingAttribute": {
+			spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+			d:    &svcsdk.TimeToLiveDescription{AttributeName: aws.String("ttl"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			want: want{
+				update: &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("ttl"), Enabled: aws.Bool(false)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.upToDate, isTimeToLiveUpToDate(tc.spec, tc.d)); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, timeToLiveUpdate(tc.spec, tc.d)); diff != "" {
+				t.Errorf("update: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAutoScalingUpToDate(t *testing.T) {
+	spec := &v1alpha1.TableAutoScaling{
+		ReadCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilizationPercent: 70},
+	}
+	target := &applicationautoscaling.ScalableTarget{
+		ResourceId:        aws.String("table/orders"),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits),
+		MinCapacity:       aws.Int64(1),
+		MaxCapacity:       aws.Int64(10),
+	}
+	policy := &applicationautoscaling.ScalingPolicy{
+		PolicyName: aws.String("table-orders-DynamoDBReadCapacityUtilization"),
+		ResourceId: aws.String("table/orders"),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			TargetValue: aws.Float64(70),
+		},
+	}
+
+	cases := map[string]struct {
+		c    *currentSettings
+		want bool
+	}{
+		"UpToDate": {
+			c: &currentSettings{
+				scalableTargets: []*applicationautoscaling.ScalableTarget{target},
+				scalingPolicies: []*applicationautoscaling.ScalingPolicy{policy},
+			},
+			want: true,
+		},
+		"MissingPolicy": {
+			c: &currentSettings{
+				scalableTargets: []*applicationautoscaling.ScalableTarget{target},
+			},
+			want: false,
+		},
+		"DifferentCapacity": {
+			c: &currentSettings{
+				scalableTargets: []*applicationautoscaling.ScalableTarget{{
+					ResourceId:        target.ResourceId,
+					ScalableDimension: target.ScalableDimension,
+					MinCapacity:       aws.Int64(1),
+					MaxCapacity:       aws.Int64(20),
+				}},
+				scalingPolicies: []*applicationautoscaling.ScalingPolicy{policy},
+			},
+			want: false,
+		},
+		"UnwantedTarget": {
+			c: &currentSettings{
+				scalableTargets: []*applicationautoscaling.ScalableTarget{target, {
+					ResourceId:        aws.String("table/orders/index/by-customer"),
+					ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionDynamodbIndexReadCapacityUnits),
+				}},
+				scalingPolicies: []*applicationautoscaling.ScalingPolicy{policy},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isAutoScalingUpToDate(scalingTargets("orders", spec), tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockAutoScaling struct {
+	applicationautoscalingiface.ApplicationAutoScalingAPI
+
+	registered   []string
+	deregistered []string
+}
+
+func (m *mockAutoScaling) RegisterScalableTargetWithContext(_ context.Context, in *applicationautoscaling.RegisterScalableTargetInput, _ ...request.Option) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	m.registered = append(m.registered, aws.StringValue(in.ResourceId)+" "+aws.StringValue(in.ScalableDimension))
+	return &applicationautoscaling.RegisterScalableTargetOutput{}, nil
+}
+
+func (m *mockAutoScaling) PutScalingPolicyWithContext(_ context.Context, _ *applicationautoscaling.PutScalingPolicyInput, _ ...request.Option) (*applicationautoscaling.PutScalingPolicyOutput, error) {
+	return &applicationautoscaling.PutScalingPolicyOutput{}, nil
+}
+
+func (m *mockAutoScaling) DeregisterScalableTargetWithContext(_ context.Context, in *applicationautoscaling.DeregisterScalableTargetInput, _ ...request.Option) (*applicationautoscaling.DeregisterScalableTargetOutput, error) {
+	m.deregistered = append(m.deregistered, aws.StringValue(in.ResourceId)+" "+aws.StringValue(in.ScalableDimension))
+	return &applicationautoscaling.DeregisterScalableTargetOutput{}, nil
+}
+
+func TestUpdateAutoScaling(t *testing.T) {
+	tableRead := &applicationautoscaling.ScalableTarget{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceId:        aws.String("table/orders"),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits),
+		MinCapacity:       aws.Int64(1),
+		MaxCapacity:       aws.Int64(10),
+	}
+	tableReadRef := scalableTargetRef{ResourceID: "table/orders", ScalableDimension: applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits}
+	recorded := `[{"resourceId":"table/orders","scalableDimension":"dynamodb:table:ReadCapacityUnits"}]`
+
+	type want struct {
+		annotation   string
+		registered   []string
+		deregistered []string
+		upToDate     bool
+		err          error
+	}
+
+	cases := map[string]struct {
+		autoScaling *v1alpha1.TableAutoScaling
+		annotation  string
+		observed    []*applicationautoscaling.ScalableTarget
+		kubeErr     error
+		want        want
+	}{
+		"Unmanaged": {
+			observed: []*applicationautoscaling.ScalableTarget{tableRead},
+			want: want{
+				upToDate: true,
+			},
+		},
+		"Added": {
+			autoScaling: &v1alpha1.TableAutoScaling{
+				ReadCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilizationPercent: 70},
+			},
+			want: want{
+				annotation: recorded,
+				registered: []string{"table/orders dynamodb:table:ReadCapacityUnits"},
+			},
+		},
+		"Removed": {
+			annotation: recorded,
+			observed:   []*applicationautoscaling.ScalableTarget{tableRead},
+			want: want{
+				deregistered: []string{"table/orders dynamodb:table:ReadCapacityUnits"},
+			},
+		},
+		"RecordFailed": {
+			autoScaling: &v1alpha1.TableAutoScaling{
+				ReadCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilizationPercent: 70},
+			},
+			kubeErr: errBoom,
+			want: want{
+				annotation: recorded,
+				err:        errors.Wrap(errBoom, errKubeUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Table{}
+			meta.SetExternalName(cr, "orders")
+			cr.Spec.ForProvider.AutoScaling = tc.autoScaling
+			c := &currentSettings{scalableTargets: tc.observed}
+			if tc.annotation != "" {
+				meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRegisteredScalableTargets: tc.annotation})
+				c.registered = []scalableTargetRef{tableReadRef}
+			}
+			if tc.autoScaling == nil {
+				c.scalableTargets = filterScalableTargets(c.scalableTargets, c.registered)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, c.isUpToDate("orders", &cr.Spec.ForProvider.CustomTableParameters)); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			as := &mockAutoScaling{}
+			s := &settings{
+				kube:        &test.MockClient{MockUpdate: test.NewMockUpdateFn(tc.kubeErr)},
+				autoscaling: as,
+			}
+			err := s.update(context.Background(), cr, c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.annotation, cr.GetAnnotations()[v1alpha1.AnnotationKeyRegisteredScalableTargets]); diff != "" {
+				t.Errorf("annotation: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.registered, as.registered); diff != "" {
+				t.Errorf("registered: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deregistered, as.deregistered); diff != "" {
+				t.Errorf("deregistered: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsKinesisDestinationUpToDate(t *testing.T) {
+	streamARN := "arn:aws:kinesis:us-east-1:123456789012:stream/orders"
+	destination := func(arn, status string) *svcsdk.KinesisDataStreamDestination {
+		return &svcsdk.KinesisDataStreamDestination{StreamArn: aws.String(arn), DestinationStatus: aws.String(status)}
+	}
+
+	cases := map[string]struct {
+		spec     *v1alpha1.KinesisStreamingDestination
+		observed []*svcsdk.KinesisDataStreamDestination
+		want     bool
+	}{
+		"Unmanaged": {
+			observed: []*svcsdk.KinesisDataStreamDestination{destination(streamARN, svcsdk.DestinationStatusActive)},
+			want:     true,
+		},
+		"Enabled": {
+			spec:     &v1alpha1.KinesisStreamingDestination{StreamARN: streamARN, Enabled: true},
+			observed: []*svcsdk.KinesisDataStreamDestination{destination(streamARN, svcsdk.DestinationStatusActive)},
+			want:     true,
+		},
+		"NotEnabled": {
+			spec: &v1alpha1.KinesisStreamingDestination{StreamARN: streamARN, Enabled: true},
+			want: false,
+		},
+		"OtherStreamEnabled": {
+			spec:     &v1alpha1.KinesisStreamingDestination{StreamARN: streamARN, Enabled: true},
+			observed: []*svcsdk.KinesisDataStreamDestination{destination("other", svcsdk.DestinationStatusActive)},
+			want:     false,
+		},
+		"Enabling": {
+			spec:     &v1alpha1.KinesisStreamingDestination{StreamARN: streamARN},
+			observed: []*svcsdk.KinesisDataStreamDestination{destination(streamARN, svcsdk.DestinationStatusEnabling)},
+			want:     true,
+		},
+		"Disabled": {
+			spec:     &v1alpha1.KinesisStreamingDestination{StreamARN: streamARN},
+			observed: []*svcsdk.KinesisDataStreamDestination{destination(streamARN, svcsdk.DestinationStatusDisabled)},
+			want:     true,
+		},
+		"NotDisabled": {
+			spec:     &v1alpha1.KinesisStreamingDestination{StreamARN: streamARN},
+			observed: []*svcsdk.KinesisDataStreamDestination{destination(streamARN, svcsdk.DestinationStatusActive)},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isKinesisDestinationUpToDate(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockDynamoDB struct {
+	svcsdkapi.DynamoDBAPI
+
+	err      error
+	enabled  []string
+	disabled []string
+
+	restoredFromBackup    *svcsdk.RestoreTableFromBackupInput
+	restoredToPointInTime *svcsdk.RestoreTableToPointInTimeInput
+}
+
+func (m *mockDynamoDB) EnableKinesisStreamingDestinationWithContext(_ context.Context, in *svcsdk.EnableKinesisStreamingDestinationInput, _ ...request.Option) (*svcsdk.EnableKinesisStreamingDestinationOutput, error) {
+	m.enabled = append(m.enabled, aws.StringValue(in.TableName)+" "+aws.StringValue(in.StreamArn))
+	return &svcsdk.EnableKinesisStreamingDestinationOutput{}, m.err
+}
+
+func (m *mockDynamoDB) DisableKinesisStreamingDestinationWithContext(_ context.Context, in *svcsdk.DisableKinesisStreamingDestinationInput, _ ...request.Option) (*svcsdk.DisableKinesisStreamingDestinationOutput, error) {
+	m.disabled = append(m.disabled, aws.StringValue(in.TableName)+" "+aws.StringValue(in.StreamArn))
+	return &svcsdk.DisableKinesisStreamingDestinationOutput{}, m.err
+}
+
+func TestUpdateKinesisDestination(t *testing.T) {
+	type want struct {
+		enabled  []string
+		disabled []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		spec *v1alpha1.KinesisStreamingDestination
+		err  error
+		want want
+	}{
+		"Enable": {
+			spec: &v1alpha1.KinesisStreamingDestination{StreamARN: "stream", Enabled: true},
+			want: want{enabled: []string{"orders stream"}},
+		},
+		"Disable": {
+			spec: &v1alpha1.KinesisStreamingDestination{StreamARN: "stream"},
+			want: want{disabled: []string{"orders stream"}},
+		},
+		"EnableFailed": {
+			spec: &v1alpha1.KinesisStreamingDestination{StreamARN: "stream", Enabled: true},
+			err:  errBoom,
+			want: want{
+				enabled: []string{"orders stream"},
+				err:     errors.Wrap(errBoom, errEnableKinesisDestination),
+			},
+		},
+		"DisableFailed": {
+			spec: &v1alpha1.KinesisStreamingDestination{StreamARN: "stream"},
+			err:  errBoom,
+			want: want{
+				disabled: []string{"orders stream"},
+				err:      errors.Wrap(errBoom, errDisableKinesisDestination),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &mockDynamoDB{err: tc.err}
+			s := &settings{client: c}
+			err := s.updateKinesisDestination(context.Background(), aws.String("orders"), tc.spec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.enabled, c.enabled); diff != "" {
+				t.Errorf("enabled: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disabled, c.disabled); diff != "" {
+				t.Errorf("disabled: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
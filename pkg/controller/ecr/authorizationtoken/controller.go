@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorizationtoken
+
+import (
+	"context"
+	"time"
+
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an authorization token resource"
+
+	errGet       = "failed to get authorization token"
+	errNoToken   = "no authorization data returned"
+	errGetSecret = "failed to get connection secret"
+	errPublish   = "failed to publish docker config connection secret"
+)
+
+// SetupAuthorizationToken adds a controller that reconciles ECR
+// authorization tokens.
+func SetupAuthorizationToken(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.AuthorizationTokenGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.AuthorizationToken{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AuthorizationTokenGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(&dockerConfigPublisher{
+				secret: resource.NewAPIPatchingApplicator(mgr.GetClient()),
+				typer:  mgr.GetScheme(),
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// A dockerConfigPublisher publishes connection details to a connection
+// secret of type kubernetes.io/dockerconfigjson, so that it can be used as
+// an image pull secret.
+type dockerConfigPublisher struct {
+	secret resource.Applicator
+	typer  runtime.ObjectTyper
+}
+
+func (p *dockerConfigPublisher) PublishConnection(ctx context.Context, mg resource.Managed, c managed.ConnectionDetails) error {
+	// NOTE: Secrets of this type must contain a docker config, which is only
+	// known after the token has been fetched.
+	if mg.GetWriteConnectionSecretToReference() == nil || len(c) == 0 {
+		return nil
+	}
+	s := resource.ConnectionSecretFor(mg, resource.MustGetKind(mg, p.typer))
+	s.Type = corev1.SecretTypeDockerConfigJson
+	s.Data = c
+	return errors.Wrap(p.secret.Apply(ctx, s, resource.ConnectionSecretMustBeControllableBy(mg.GetUID())), errPublish)
+}
+
+func (p *dockerConfigPublisher) UnpublishConnection(_ context.Context, _ resource.Managed, _ managed.ConnectionDetails) error {
+	return nil
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AuthorizationToken)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: awsecr.New(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ecr.AuthorizationTokenClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AuthorizationToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// NOTE: Authorization tokens only exist in the connection secret, they
+	// cannot be looked up or revoked.
+	if meta.WasDeleted(cr) || cr.Status.AtProvider.ExpiresAt == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	upToDate := ecr.IsAuthorizationTokenUpToDate(&cr.Spec.ForProvider, cr.Status.AtProvider, time.Now())
+	if ref := cr.GetWriteConnectionSecretToReference(); upToDate && ref != nil {
+		err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &corev1.Secret{})
+		if resource.IgnoreNotFound(err) != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSecret)
+		}
+		upToDate = !kerrors.IsNotFound(err)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AuthorizationToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	conn, err := e.refresh(ctx, cr)
+	return managed.ExternalCreation{ConnectionDetails: conn}, err
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.AuthorizationToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	conn, err := e.refresh(ctx, cr)
+	return managed.ExternalUpdate{ConnectionDetails: conn}, err
+}
+
+func (e *external) Delete(_ context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AuthorizationToken)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// refresh fetches a new authorization token and returns it as docker config.
+func (e *external) refresh(ctx context.Context, cr *v1alpha1.AuthorizationToken) (managed.ConnectionDetails, error) {
+	rsp, err := e.client.GetAuthorizationTokenRequest(ecr.GenerateGetAuthorizationTokenInput(&cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errGet)
+	}
+	if len(rsp.AuthorizationData) == 0 {
+		return nil, errors.New(errNoToken)
+	}
+	data := rsp.AuthorizationData[0]
+	obs := ecr.GenerateAuthorizationTokenObservation(&cr.Spec.ForProvider, data)
+	conn, err := ecr.GenerateDockerConfigConnectionDetails(obs.Registry, data)
+	if err != nil {
+		return nil, errors.Wrap(err, errGet)
+	}
+	cr.Status.AtProvider = obs
+	return conn, nil
+}
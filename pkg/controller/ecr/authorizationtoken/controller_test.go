@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorizationtoken
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	repositoryURI = "123456789012.dkr.ecr.us-east-1.amazonaws.com/repo"
+	registry      = "123456789012.dkr.ecr.us-east-1.amazonaws.com"
+	proxyEndpoint = "https://" + registry
+	token         = base64.StdEncoding.EncodeToString([]byte("AWS:secret"))
+
+	errBoom = errors.New("boom")
+)
+
+type tokenModifier func(*v1alpha1.AuthorizationToken)
+
+func withConditions(c ...xpv1.Condition) tokenModifier {
+	return func(r *v1alpha1.AuthorizationToken) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExpiresAt(t time.Time) tokenModifier {
+	return func(r *v1alpha1.AuthorizationToken) {
+		r.Status.AtProvider = v1alpha1.AuthorizationTokenObservation{
+			Registry:      registry,
+			ProxyEndpoint: proxyEndpoint,
+			ExpiresAt:     &metav1.Time{Time: t},
+		}
+	}
+}
+
+func withConnectionSecret() tokenModifier {
+	return func(r *v1alpha1.AuthorizationToken) {
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "pull-secret", Namespace: "default"}
+	}
+}
+
+func authorizationToken(m ...tokenModifier) *v1alpha1.AuthorizationToken {
+	cr := &v1alpha1.AuthorizationToken{
+		Spec: v1alpha1.AuthorizationTokenSpec{
+			ForProvider: v1alpha1.AuthorizationTokenParameters{
+				RepositoryURI: &repositoryURI,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	fresh := time.Now().Add(12 * time.Hour).Truncate(time.Second)
+	due := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		cr   resource.Managed
+		want
+	}{
+		"NotFetched": {
+			cr: authorizationToken(),
+			want: want{
+				cr: authorizationToken(),
+			},
+		},
+		"Fresh": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			cr:   authorizationToken(withExpiresAt(fresh), withConnectionSecret()),
+			want: want{
+				cr: authorizationToken(withExpiresAt(fresh), withConnectionSecret(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RefreshDue": {
+			cr: authorizationToken(withExpiresAt(due)),
+			want: want{
+				cr: authorizationToken(withExpiresAt(due), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"SecretMissing": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, ""))},
+			cr:   authorizationToken(withExpiresAt(fresh), withConnectionSecret()),
+			want: want{
+				cr: authorizationToken(withExpiresAt(fresh), withConnectionSecret(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"GetSecretError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			cr:   authorizationToken(withExpiresAt(fresh), withConnectionSecret()),
+			want: want{
+				cr:  authorizationToken(withExpiresAt(fresh), withConnectionSecret(), withConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	expiresAt := time.Now().Add(12 * time.Hour).Truncate(time.Second)
+	getToken := func(out *awsecr.GetAuthorizationTokenOutput, err error) func(*awsecr.GetAuthorizationTokenInput) awsecr.GetAuthorizationTokenRequest {
+		return func(_ *awsecr.GetAuthorizationTokenInput) awsecr.GetAuthorizationTokenRequest {
+			return awsecr.GetAuthorizationTokenRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+			}
+		}
+	}
+	conn, _ := ecr.GenerateDockerConfigConnectionDetails(registry, awsecr.AuthorizationData{AuthorizationToken: &token})
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client ecr.AuthorizationTokenClient
+		cr     resource.Managed
+		want
+	}{
+		"Successful": {
+			client: &fake.MockAuthorizationTokenClient{
+				MockGetAuthorizationToken: getToken(&awsecr.GetAuthorizationTokenOutput{
+					AuthorizationData: []awsecr.AuthorizationData{{
+						AuthorizationToken: &token,
+						ExpiresAt:          &expiresAt,
+						ProxyEndpoint:      &proxyEndpoint,
+					}},
+				}, nil),
+			},
+			cr: authorizationToken(),
+			want: want{
+				cr: authorizationToken(withExpiresAt(expiresAt), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"NoAuthorizationData": {
+			client: &fake.MockAuthorizationTokenClient{
+				MockGetAuthorizationToken: getToken(&awsecr.GetAuthorizationTokenOutput{}, nil),
+			},
+			cr: authorizationToken(),
+			want: want{
+				cr:  authorizationToken(withConditions(xpv1.Creating())),
+				err: errors.New(errNoToken),
+			},
+		},
+		"ClientError": {
+			client: &fake.MockAuthorizationTokenClient{
+				MockGetAuthorizationToken: getToken(nil, errBoom),
+			},
+			cr: authorizationToken(),
+			want: want{
+				cr:  authorizationToken(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
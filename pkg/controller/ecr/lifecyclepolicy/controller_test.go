@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecyclepolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	repositoryName = "testRepo"
+	registryID     = "123456789012"
+	policy         = `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`
+	countUnit      = "days"
+
+	rules = []v1alpha1.LifecyclePolicyRule{{
+		RulePriority: 1,
+		Selection: v1alpha1.LifecyclePolicySelection{
+			TagStatus:   "untagged",
+			CountType:   "sinceImagePushed",
+			CountUnit:   &countUnit,
+			CountNumber: 14,
+		},
+		Action: v1alpha1.LifecyclePolicyAction{Type: "expire"},
+	}}
+
+	errBoom = errors.New("boom")
+)
+
+type lifecyclePolicyModifier func(*v1alpha1.LifecyclePolicy)
+
+func withConditions(c ...xpv1.Condition) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicyText(p string) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Status.AtProvider.PolicyText = p }
+}
+
+func withRegistryID(id string) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Spec.ForProvider.RegistryID = &id }
+}
+
+func lifecyclePolicy(m ...lifecyclePolicyModifier) *v1alpha1.LifecyclePolicy {
+	cr := &v1alpha1.LifecyclePolicy{
+		Spec: v1alpha1.LifecyclePolicySpec{
+			ForProvider: v1alpha1.LifecyclePolicyParameters{
+				RepositoryName: &repositoryName,
+				Rules:          rules,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRequest(out *awsecr.GetLifecyclePolicyOutput, err error) func(*awsecr.GetLifecyclePolicyInput) awsecr.GetLifecyclePolicyRequest {
+	return func(_ *awsecr.GetLifecyclePolicyInput) awsecr.GetLifecyclePolicyRequest {
+		return awsecr.GetLifecyclePolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client ecr.LifecyclePolicyClient
+		cr     resource.Managed
+		want
+	}{
+		"UpToDateAndLateInitialized": {
+			client: &fake.MockLifecyclePolicyClient{
+				MockGet: getRequest(&awsecr.GetLifecyclePolicyOutput{
+					LifecyclePolicyText: &policy,
+					RegistryId:          &registryID,
+				}, nil),
+			},
+			cr: lifecyclePolicy(),
+			want: want{
+				cr: lifecyclePolicy(
+					withRegistryID(registryID),
+					withPolicyText(policy),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			client: &fake.MockLifecyclePolicyClient{
+				MockGet: getRequest(&awsecr.GetLifecyclePolicyOutput{
+					LifecyclePolicyText: aws.String(`{"rules":[]}`),
+				}, nil),
+			},
+			cr: lifecyclePolicy(),
+			want: want{
+				cr: lifecyclePolicy(
+					withPolicyText(`{"rules":[]}`),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"PolicyNotFound": {
+			client: &fake.MockLifecyclePolicyClient{
+				MockGet: getRequest(nil, awserr.New(ecr.LifecyclePolicyNotFoundException, "", nil)),
+			},
+			cr: lifecyclePolicy(),
+			want: want{
+				cr: lifecyclePolicy(),
+			},
+		},
+		"ClientError": {
+			client: &fake.MockLifecyclePolicyClient{
+				MockGet: getRequest(nil, errBoom),
+			},
+			cr: lifecyclePolicy(),
+			want: want{
+				cr:  lifecyclePolicy(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrypolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	registryID = "123456789012"
+	policy     = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":["ecr:CreateRepository","ecr:ReplicateImage"],"Resource":"arn:aws:ecr:us-east-1:123456789012:repository/*"}]}`
+	// the same policy with its actions in a different order
+	reordered = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":["ecr:ReplicateImage","ecr:CreateRepository"],"Resource":"arn:aws:ecr:us-east-1:123456789012:repository/*"}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type registryPolicyModifier func(*v1alpha1.RegistryPolicy)
+
+func withConditions(c ...xpv1.Condition) registryPolicyModifier {
+	return func(r *v1alpha1.RegistryPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRawPolicy(p *string) registryPolicyModifier {
+	return func(r *v1alpha1.RegistryPolicy) { r.Spec.ForProvider.RawPolicy = p }
+}
+
+func withObservation(p string) registryPolicyModifier {
+	return func(r *v1alpha1.RegistryPolicy) {
+		r.Status.AtProvider = v1alpha1.RegistryPolicyObservation{PolicyText: p, RegistryID: registryID}
+	}
+}
+
+func registryPolicy(m ...registryPolicyModifier) *v1alpha1.RegistryPolicy {
+	cr := &v1alpha1.RegistryPolicy{
+		Spec: v1alpha1.RegistryPolicySpec{
+			ForProvider: v1alpha1.RegistryPolicyParameters{
+				RawPolicy: &policy,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockRegistryClient
+		cr     resource.Managed
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockRegistryClient{
+				MockGetRegistryPolicy: func(*svcsdk.GetRegistryPolicyInput) (*svcsdk.GetRegistryPolicyOutput, error) {
+					return &svcsdk.GetRegistryPolicyOutput{PolicyText: &reordered, RegistryId: &registryID}, nil
+				},
+			},
+			cr: registryPolicy(),
+			want: want{
+				cr: registryPolicy(
+					withObservation(reordered),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			client: &fake.MockRegistryClient{
+				MockGetRegistryPolicy: func(*svcsdk.GetRegistryPolicyInput) (*svcsdk.GetRegistryPolicyOutput, error) {
+					return &svcsdk.GetRegistryPolicyOutput{PolicyText: awsclient.String(`{"Version":"2012-10-17","Statement":[]}`), RegistryId: &registryID}, nil
+				},
+			},
+			cr: registryPolicy(),
+			want: want{
+				cr: registryPolicy(
+					withObservation(`{"Version":"2012-10-17","Statement":[]}`),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"PolicyNotFound": {
+			client: &fake.MockRegistryClient{
+				MockGetRegistryPolicy: func(*svcsdk.GetRegistryPolicyInput) (*svcsdk.GetRegistryPolicyOutput, error) {
+					return nil, awserr.New(svcsdk.ErrCodeRegistryPolicyNotFoundException, "", nil)
+				},
+			},
+			cr: registryPolicy(),
+			want: want{
+				cr: registryPolicy(),
+			},
+		},
+		"ClientError": {
+			client: &fake.MockRegistryClient{
+				MockGetRegistryPolicy: func(*svcsdk.GetRegistryPolicyInput) (*svcsdk.GetRegistryPolicyOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: registryPolicy(),
+			want: want{
+				cr:  registryPolicy(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"NoPolicy": {
+			client: &fake.MockRegistryClient{
+				MockGetRegistryPolicy: func(*svcsdk.GetRegistryPolicyInput) (*svcsdk.GetRegistryPolicyOutput, error) {
+					return &svcsdk.GetRegistryPolicyOutput{PolicyText: &policy, RegistryId: &registryID}, nil
+				},
+			},
+			cr: registryPolicy(withRawPolicy(nil)),
+			want: want{
+				cr:  registryPolicy(withRawPolicy(nil)),
+				err: errors.Wrap(errors.New("failed to format Registry Policy, no rawPolicy or policy specified"), errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		in  *svcsdk.PutRegistryPolicyInput
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.RegistryPolicy
+		want
+	}{
+		"Successful": {
+			cr: registryPolicy(),
+			want: want{
+				in: &svcsdk.PutRegistryPolicyInput{PolicyText: &policy},
+			},
+		},
+		"ClientError": {
+			err: errBoom,
+			cr:  registryPolicy(),
+			want: want{
+				in:  &svcsdk.PutRegistryPolicyInput{PolicyText: &policy},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"NoPolicy": {
+			cr: registryPolicy(withRawPolicy(nil)),
+			want: want{
+				err: errors.Wrap(errors.New("failed to format Registry Policy, no rawPolicy or policy specified"), errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var in *svcsdk.PutRegistryPolicyInput
+			e := &external{client: &fake.MockRegistryClient{
+				MockPutRegistryPolicy: func(input *svcsdk.PutRegistryPolicyInput) (*svcsdk.PutRegistryPolicyOutput, error) {
+					in = input
+					return &svcsdk.PutRegistryPolicyOutput{}, tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		in  *svcsdk.PutRegistryPolicyInput
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.RegistryPolicy
+		want
+	}{
+		"Successful": {
+			cr: registryPolicy(withRawPolicy(&reordered)),
+			want: want{
+				in: &svcsdk.PutRegistryPolicyInput{PolicyText: &reordered},
+			},
+		},
+		"ClientError": {
+			err: errBoom,
+			cr:  registryPolicy(),
+			want: want{
+				in:  &svcsdk.PutRegistryPolicyInput{PolicyText: &policy},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var in *svcsdk.PutRegistryPolicyInput
+			e := &external{client: &fake.MockRegistryClient{
+				MockPutRegistryPolicy: func(input *svcsdk.PutRegistryPolicyInput) (*svcsdk.PutRegistryPolicyOutput, error) {
+					in = input
+					return &svcsdk.PutRegistryPolicyOutput{}, tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		cr   *v1alpha1.RegistryPolicy
+		want error
+	}{
+		"Successful": {
+			cr: registryPolicy(),
+		},
+		"AlreadyDeleted": {
+			err: awserr.New(svcsdk.ErrCodeRegistryPolicyNotFoundException, "", nil),
+			cr:  registryPolicy(),
+		},
+		"ClientError": {
+			err:  errBoom,
+			cr:   registryPolicy(),
+			want: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockRegistryClient{
+				MockDeleteRegistryPolicy: func(*svcsdk.DeleteRegistryPolicyInput) (*svcsdk.DeleteRegistryPolicyOutput, error) {
+					return &svcsdk.DeleteRegistryPolicyOutput{}, tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(registryPolicy(withConditions(xpv1.Deleting())), tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
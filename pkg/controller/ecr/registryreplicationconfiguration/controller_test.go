@@ -0,0 +1,372 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryreplicationconfiguration
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	registryID            = "123456789012"
+	destinationRegion     = "eu-west-1"
+	destinationRegistryID = "210987654321"
+
+	rules = []v1alpha1.ReplicationRule{{
+		Destinations: []v1alpha1.ReplicationDestination{{
+			Region:     destinationRegion,
+			RegistryID: destinationRegistryID,
+		}},
+	}}
+	replicationConfiguration = &svcsdk.ReplicationConfiguration{
+		Rules: []*svcsdk.ReplicationRule{{
+			Destinations: []*svcsdk.ReplicationDestination{{
+				Region:     &destinationRegion,
+				RegistryId: &destinationRegistryID,
+			}},
+		}},
+	}
+
+	errBoom = errors.New("boom")
+)
+
+type replicationConfigurationModifier func(*v1alpha1.RegistryReplicationConfiguration)
+
+func withConditions(c ...xpv1.Condition) replicationConfigurationModifier {
+	return func(r *v1alpha1.RegistryReplicationConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRules(rules []v1alpha1.ReplicationRule) replicationConfigurationModifier {
+	return func(r *v1alpha1.RegistryReplicationConfiguration) { r.Spec.ForProvider.Rules = rules }
+}
+
+func withRegistryID(id string) replicationConfigurationModifier {
+	return func(r *v1alpha1.RegistryReplicationConfiguration) { r.Status.AtProvider.RegistryID = id }
+}
+
+func withDeletionTimestamp() replicationConfigurationModifier {
+	return func(r *v1alpha1.RegistryReplicationConfiguration) {
+		now := metav1.Now()
+		r.SetDeletionTimestamp(&now)
+	}
+}
+
+func replicationConfig(m ...replicationConfigurationModifier) *v1alpha1.RegistryReplicationConfiguration {
+	cr := &v1alpha1.RegistryReplicationConfiguration{
+		Spec: v1alpha1.RegistryReplicationConfigurationSpec{
+			ForProvider: v1alpha1.RegistryReplicationConfigurationParameters{
+				Rules: rules,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRegistry(c *svcsdk.ReplicationConfiguration, err error) func(*svcsdk.DescribeRegistryInput) (*svcsdk.DescribeRegistryOutput, error) {
+	return func(*svcsdk.DescribeRegistryInput) (*svcsdk.DescribeRegistryOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &svcsdk.DescribeRegistryOutput{RegistryId: &registryID, ReplicationConfiguration: c}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	deleted := replicationConfig(withRules(nil), withDeletionTimestamp())
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockRegistryClient
+		cr     resource.Managed
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(replicationConfiguration, nil),
+			},
+			cr: replicationConfig(),
+			want: want{
+				cr: replicationConfig(
+					withRegistryID(registryID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(replicationConfiguration, nil),
+			},
+			cr: replicationConfig(withRules(append(rules, rules...))),
+			want: want{
+				cr: replicationConfig(
+					withRules(append(rules, rules...)),
+					withRegistryID(registryID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoRules": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(&svcsdk.ReplicationConfiguration{}, nil),
+			},
+			cr: replicationConfig(),
+			want: want{
+				cr: replicationConfig(),
+			},
+		},
+		"NoRulesDesired": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(&svcsdk.ReplicationConfiguration{}, nil),
+			},
+			cr: replicationConfig(withRules(nil)),
+			want: want{
+				cr: replicationConfig(
+					withRules(nil),
+					withRegistryID(registryID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RulesToRemove": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(replicationConfiguration, nil),
+			},
+			cr: replicationConfig(withRules(nil)),
+			want: want{
+				cr: replicationConfig(
+					withRules(nil),
+					withRegistryID(registryID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoRulesDeleted": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(nil, nil),
+			},
+			cr: deleted,
+			want: want{
+				cr: deleted,
+			},
+		},
+		"ClientError": {
+			client: &fake.MockRegistryClient{
+				MockDescribeRegistry: describeRegistry(nil, errBoom),
+			},
+			cr: replicationConfig(),
+			want: want{
+				cr:  replicationConfig(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		in  *svcsdk.PutReplicationConfigurationInput
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.RegistryReplicationConfiguration
+		want
+	}{
+		"Successful": {
+			cr: replicationConfig(),
+			want: want{
+				in: &svcsdk.PutReplicationConfigurationInput{ReplicationConfiguration: replicationConfiguration},
+			},
+		},
+		"ClientError": {
+			err: errBoom,
+			cr:  replicationConfig(),
+			want: want{
+				in:  &svcsdk.PutReplicationConfigurationInput{ReplicationConfiguration: replicationConfiguration},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var in *svcsdk.PutReplicationConfigurationInput
+			e := &external{client: &fake.MockRegistryClient{
+				MockPutReplicationConfiguration: func(input *svcsdk.PutReplicationConfigurationInput) (*svcsdk.PutReplicationConfigurationOutput, error) {
+					in = input
+					return &svcsdk.PutReplicationConfigurationOutput{}, tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		in  *svcsdk.PutReplicationConfigurationInput
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.RegistryReplicationConfiguration
+		want
+	}{
+		"Successful": {
+			cr: replicationConfig(),
+			want: want{
+				in: &svcsdk.PutReplicationConfigurationInput{ReplicationConfiguration: replicationConfiguration},
+			},
+		},
+		"RulesRemoved": {
+			cr: replicationConfig(withRules(nil)),
+			want: want{
+				in: &svcsdk.PutReplicationConfigurationInput{
+					ReplicationConfiguration: &svcsdk.ReplicationConfiguration{Rules: []*svcsdk.ReplicationRule{}},
+				},
+			},
+		},
+		"ClientError": {
+			err: errBoom,
+			cr:  replicationConfig(),
+			want: want{
+				in:  &svcsdk.PutReplicationConfigurationInput{ReplicationConfiguration: replicationConfiguration},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var in *svcsdk.PutReplicationConfigurationInput
+			e := &external{client: &fake.MockRegistryClient{
+				MockPutReplicationConfiguration: func(input *svcsdk.PutReplicationConfigurationInput) (*svcsdk.PutReplicationConfigurationOutput, error) {
+					in = input
+					return &svcsdk.PutReplicationConfigurationOutput{}, tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		cr   *v1alpha1.RegistryReplicationConfiguration
+		want error
+	}{
+		"Successful": {
+			cr: replicationConfig(),
+		},
+		"ClientError": {
+			err:  errBoom,
+			cr:   replicationConfig(),
+			want: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var in *svcsdk.PutReplicationConfigurationInput
+			e := &external{client: &fake.MockRegistryClient{
+				MockPutReplicationConfiguration: func(input *svcsdk.PutReplicationConfigurationInput) (*svcsdk.PutReplicationConfigurationOutput, error) {
+					in = input
+					return &svcsdk.PutReplicationConfigurationOutput{}, tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(&svcsdk.PutReplicationConfigurationInput{
+				ReplicationConfiguration: &svcsdk.ReplicationConfiguration{Rules: []*svcsdk.ReplicationRule{}},
+			}, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(replicationConfig(withConditions(xpv1.Deleting())), tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -92,12 +92,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: awsecr.New(*cfg), kube: c.kube}, nil
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: awsecr.New(*cfg), encryption: ecr.NewRepositoryEncryptionClient(sess), kube: c.kube}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client ecr.RepositoryClient
+	kube       client.Client
+	client     ecr.RepositoryClient
+	encryption ecr.RepositoryEncryptionClient
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -161,7 +166,12 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
 	}
 
-	_, err := e.client.CreateRepositoryRequest(ecr.GenerateCreateRepositoryInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+	var err error
+	if cr.Spec.ForProvider.EncryptionConfiguration != nil {
+		_, err = e.encryption.CreateRepositoryWithContext(ctx, ecr.GenerateCreateEncryptedRepositoryInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	} else {
+		_, err = e.client.CreateRepositoryRequest(ecr.GenerateCreateRepositoryInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+	}
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
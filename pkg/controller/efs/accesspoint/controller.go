@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/efs"
+)
+
+const (
+	errNotAccessPoint   = "managed resource is not an AccessPoint custom resource"
+	errDescribeFailed   = "cannot describe AccessPoint"
+	errCreateFailed     = "cannot create AccessPoint"
+	errDeleteFailed     = "cannot delete AccessPoint"
+	errAddTagsFailed    = "cannot add tags to AccessPoint"
+	errRemoveTagsFailed = "cannot remove tags from AccessPoint"
+	errKubeUpdateFailed = "cannot update AccessPoint custom resource"
+	errNoFileSystemID   = "fileSystemId of AccessPoint is not set"
+)
+
+// SetupAccessPoint adds a controller that reconciles AccessPoints.
+func SetupAccessPoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.AccessPointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.AccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccessPointGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return nil, errors.New(errNotAccessPoint)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.AccessPoint) (*svcsdk.AccessPointDescription, error) {
+	rsp, err := e.client.DescribeAccessPointsWithContext(ctx, &svcsdk.DescribeAccessPointsInput{AccessPointId: aws.String(meta.GetExternalName(cr))})
+	if err != nil || len(rsp.AccessPoints) == 0 {
+		return nil, err
+	}
+	return rsp.AccessPoints[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccessPoint)
+	}
+	// The ID of the access point is assigned by AWS on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	ap, err := e.describe(ctx, cr)
+	if ap == nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(svcclient.IsAccessPointNotFound, err), errDescribeFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	svcclient.LateInitializeAccessPoint(&cr.Spec.ForProvider, ap)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = svcclient.GenerateAccessPointObservation(ap)
+	switch aws.StringValue(ap.LifeCycleState) {
+	case svcsdk.LifeCycleStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.LifeCycleStateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.LifeCycleStateDeleting, svcsdk.LifeCycleStateDeleted:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	add, remove := svcclient.DiffTags(cr.Spec.ForProvider.Tags, ap.Tags)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccessPoint)
+	}
+	if cr.Spec.ForProvider.FileSystemID == nil {
+		return managed.ExternalCreation{}, errors.New(errNoFileSystemID)
+	}
+	cr.SetConditions(xpv1.Creating())
+	in := svcclient.GenerateCreateAccessPointInput(cr.Spec.ForProvider)
+	in.ClientToken = aws.String(string(cr.UID))
+	rsp, err := e.client.CreateAccessPointWithContext(ctx, in)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.AccessPointId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update only updates the tags since the rest of the access point cannot be
+// changed after creation.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessPoint)
+	}
+	ap, err := e.describe(ctx, cr)
+	if ap == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	add, remove := svcclient.DiffTags(cr.Spec.ForProvider.Tags, ap.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceId: ap.AccessPointId, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceId: ap.AccessPointId, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return errors.New(errNotAccessPoint)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteAccessPointWithContext(ctx, &svcsdk.DeleteAccessPointInput{AccessPointId: aws.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsAccessPointNotFound, err), errDeleteFailed)
+}
@@ -0,0 +1,454 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/efs"
+	"github.com/crossplane/provider-aws/pkg/clients/efs/fake"
+)
+
+const (
+	accessPointID  = "fsap-12345678"
+	accessPointARN = "arn:aws:elasticfilesystem:us-east-1:123456789012:access-point/fsap-12345678"
+	rootPath       = "/data"
+)
+
+var errBoom = errors.New("boom")
+
+type args struct {
+	client svcclient.Client
+	kube   client.Client
+	cr     *v1alpha1.AccessPoint
+}
+
+type accessPointModifier func(*v1alpha1.AccessPoint)
+
+func withExternalName(n string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { meta.SetExternalName(r, n) }
+}
+
+func withRootDirectory() accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) {
+		r.Spec.ForProvider.RootDirectory = &v1alpha1.RootDirectory{Path: aws.String(rootPath)}
+	}
+}
+
+func withTags(kv ...string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) {
+		for i := 0; i < len(kv); i += 2 {
+			r.Spec.ForProvider.Tags = append(r.Spec.ForProvider.Tags, &v1alpha1.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(state string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) {
+		r.Status.AtProvider = v1alpha1.AccessPointObservation{
+			AccessPointARN: aws.String(accessPointARN),
+			LifeCycleState: aws.String(state),
+		}
+	}
+}
+
+func accessPoint(m ...accessPointModifier) *v1alpha1.AccessPoint {
+	cr := &v1alpha1.AccessPoint{}
+	cr.Spec.ForProvider.FileSystemID = aws.String("fs-12345678")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state string, kv ...string) func(*svcsdk.DescribeAccessPointsInput) (*svcsdk.DescribeAccessPointsOutput, error) {
+	return func(_ *svcsdk.DescribeAccessPointsInput) (*svcsdk.DescribeAccessPointsOutput, error) {
+		ap := &svcsdk.AccessPointDescription{
+			AccessPointId:  aws.String(accessPointID),
+			AccessPointArn: aws.String(accessPointARN),
+			LifeCycleState: aws.String(state),
+			RootDirectory:  &svcsdk.RootDirectory{Path: aws.String(rootPath)},
+		}
+		for i := 0; i < len(kv); i += 2 {
+			ap.Tags = append(ap.Tags, &svcsdk.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+		}
+		return &svcsdk.DescribeAccessPointsOutput{AccessPoints: []*svcsdk.AccessPointDescription{ap}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccessPoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: func(_ *svcsdk.DescribeAccessPointsInput) (*svcsdk.DescribeAccessPointsOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeAccessPointNotFound, "", nil)
+					},
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: func(_ *svcsdk.DescribeAccessPointsInput) (*svcsdk.DescribeAccessPointsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr:  accessPoint(withExternalName(accessPointID)),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: describe(svcsdk.LifeCycleStateAvailable, "team", "a"),
+				},
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory(), withTags("team", "a")),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory(), withTags("team", "a"),
+					withObservation(svcsdk.LifeCycleStateAvailable), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: describe(svcsdk.LifeCycleStateCreating),
+				},
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory()),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory(),
+					withObservation(svcsdk.LifeCycleStateCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: describe(svcsdk.LifeCycleStateAvailable),
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory(),
+					withObservation(svcsdk.LifeCycleStateAvailable), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"KubeUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: describe(svcsdk.LifeCycleStateAvailable),
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr:  accessPoint(withExternalName(accessPointID), withRootDirectory()),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAccessPoints: describe(svcsdk.LifeCycleStateAvailable, "team", "b"),
+				},
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory(), withTags("team", "a")),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID), withRootDirectory(), withTags("team", "a"),
+					withObservation(svcsdk.LifeCycleStateAvailable), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccessPoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAccessPoint: func(_ *svcsdk.CreateAccessPointInput) (*svcsdk.CreateAccessPointOutput, error) {
+						return &svcsdk.CreateAccessPointOutput{AccessPointId: aws.String(accessPointID)}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:     accessPoint(withExternalName(accessPointID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"NoFileSystemID": {
+			args: args{
+				client: &fake.MockClient{},
+				cr: accessPoint(func(r *v1alpha1.AccessPoint) {
+					r.Spec.ForProvider.FileSystemID = nil
+				}),
+			},
+			want: want{
+				cr: accessPoint(func(r *v1alpha1.AccessPoint) {
+					r.Spec.ForProvider.FileSystemID = nil
+				}),
+				err: errors.New(errNoFileSystemID),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAccessPoint: func(_ *svcsdk.CreateAccessPointInput) (*svcsdk.CreateAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		tagged   []*svcsdk.Tag
+		untagged []*string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		current []string
+		tagErr  error
+		want
+	}{
+		"TagsChanged": {
+			args: args{
+				cr: accessPoint(withExternalName(accessPointID), withTags("team", "a")),
+			},
+			current: []string{"team", "b", "env", "dev"},
+			want: want{
+				tagged:   []*svcsdk.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				untagged: []*string{aws.String("env")},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: accessPoint(withExternalName(accessPointID), withTags("team", "a")),
+			},
+			current: []string{"team", "a"},
+		},
+		"TagFailed": {
+			args: args{
+				cr: accessPoint(withExternalName(accessPointID), withTags("team", "a")),
+			},
+			tagErr: errBoom,
+			want: want{
+				tagged: []*svcsdk.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				err:    awsclient.Wrap(errBoom, errAddTagsFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var tagged []*svcsdk.Tag
+			var untagged []*string
+			c := &fake.MockClient{
+				MockDescribeAccessPoints: describe(svcsdk.LifeCycleStateAvailable, tc.current...),
+				MockTagResource: func(in *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+					tagged = append(tagged, in.Tags...)
+					return &svcsdk.TagResourceOutput{}, tc.tagErr
+				},
+				MockUntagResource: func(in *svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error) {
+					untagged = append(untagged, in.TagKeys...)
+					return &svcsdk.UntagResourceOutput{}, nil
+				},
+			}
+			e := &external{kube: tc.kube, client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untagged, untagged); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.AccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAccessPoint: func(_ *svcsdk.DeleteAccessPointInput) (*svcsdk.DeleteAccessPointOutput, error) {
+						return &svcsdk.DeleteAccessPointOutput{}, nil
+					},
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAccessPoint: func(_ *svcsdk.DeleteAccessPointInput) (*svcsdk.DeleteAccessPointOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeAccessPointNotFound, "", nil)
+					},
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr: accessPoint(withExternalName(accessPointID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAccessPoint: func(_ *svcsdk.DeleteAccessPointInput) (*svcsdk.DeleteAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(withExternalName(accessPointID)),
+			},
+			want: want{
+				cr:  accessPoint(withExternalName(accessPointID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errDescribeLifecycleConfiguration = "cannot describe lifecycle configuration of FileSystem"
+	errPutLifecycleConfiguration      = "cannot put lifecycle configuration of FileSystem"
+	errDescribeBackupPolicy           = "cannot describe backup policy of FileSystem"
+	errPutBackupPolicy                = "cannot put backup policy of FileSystem"
+	errDescribeFileSystemPolicy       = "cannot describe policy of FileSystem"
+	errPutFileSystemPolicy            = "cannot put policy of FileSystem"
+)
+
+// settingsConnector connects to EFS like the generated connector, and wraps
+// its client with one that manages the settings of the file system that have
+// dedicated APIs.
+type settingsConnector struct {
+	kube client.Client
+	opts []option
+}
+
+func (c *settingsConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.FileSystem)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclients.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	client := svcsdk.New(sess)
+	return &settings{
+		ExternalClient: newExternal(c.kube, client, c.opts),
+		client:         client,
+	}, nil
+}
+
+// settings manages the lifecycle policies, backup policy and resource policy
+// of an available file system. All other operations are handled by the
+// generated client.
+type settings struct {
+	managed.ExternalClient
+	client svcsdkapi.EFSAPI
+}
+
+func (s *settings) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := s.ExternalClient.Observe(ctx, mg)
+	cr, ok := mg.(*svcapitypes.FileSystem)
+	if err != nil || !ok || !obs.ResourceExists || !obs.ResourceUpToDate ||
+		awsclients.StringValue(cr.Status.AtProvider.LifeCycleState) != string(svcapitypes.LifeCycleState_available) {
+		return obs, err
+	}
+	c, err := s.observe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs.ResourceUpToDate = c.isUpToDate(&cr.Spec.ForProvider.CustomFileSystemParameters)
+	return obs, nil
+}
+
+func (s *settings) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.FileSystem)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	fs, err := s.client.DescribeFileSystemsWithContext(ctx, &svcsdk.DescribeFileSystemsInput{FileSystemId: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribe)
+	}
+	if len(fs.FileSystems) == 0 || aws.StringValue(fs.FileSystems[0].LifeCycleState) != svcsdk.LifeCycleStateAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+	upToDate, err := isUpToDate(cr, fs)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !upToDate {
+		return s.ExternalClient.Update(ctx, mg)
+	}
+	c, err := s.observe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, s.update(ctx, cr, c)
+}
+
+// currentSettings are the settings of a file system as observed in AWS.
+type currentSettings struct {
+	lifecyclePolicies []*svcsdk.LifecyclePolicy
+	backupPolicy      *svcsdk.BackupPolicy
+	policy            *string
+}
+
+// observe fetches only the settings that are configured in the spec.
+func (s *settings) observe(ctx context.Context, cr *svcapitypes.FileSystem) (*currentSettings, error) {
+	id := aws.String(meta.GetExternalName(cr))
+	c := &currentSettings{}
+
+	if len(cr.Spec.ForProvider.LifecyclePolicies) != 0 {
+		lc, err := s.client.DescribeLifecycleConfigurationWithContext(ctx, &svcsdk.DescribeLifecycleConfigurationInput{FileSystemId: id})
+		if err != nil {
+			return nil, awsclients.Wrap(err, errDescribeLifecycleConfiguration)
+		}
+		c.lifecyclePolicies = lc.LifecyclePolicies
+	}
+
+	if cr.Spec.ForProvider.BackupPolicyEnabled != nil {
+		bp, err := s.client.DescribeBackupPolicyWithContext(ctx, &svcsdk.DescribeBackupPolicyInput{FileSystemId: id})
+		if resource.Ignore(isPolicyNotFound, err) != nil {
+			return nil, awsclients.Wrap(err, errDescribeBackupPolicy)
+		}
+		if err == nil {
+			c.backupPolicy = bp.BackupPolicy
+		}
+	}
+
+	if cr.Spec.ForProvider.Policy != nil {
+		p, err := s.client.DescribeFileSystemPolicyWithContext(ctx, &svcsdk.DescribeFileSystemPolicyInput{FileSystemId: id})
+		if resource.Ignore(isPolicyNotFound, err) != nil {
+			return nil, awsclients.Wrap(err, errDescribeFileSystemPolicy)
+		}
+		if err == nil {
+			c.policy = p.Policy
+		}
+	}
+	return c, nil
+}
+
+func (c *currentSettings) isUpToDate(p *svcapitypes.CustomFileSystemParameters) bool {
+	return c.isLifecycleUpToDate(p) && c.isBackupPolicyUpToDate(p) && isPolicyUpToDate(p.Policy, c.policy)
+}
+
+func (c *currentSettings) isLifecycleUpToDate(p *svcapitypes.CustomFileSystemParameters) bool {
+	if len(p.LifecyclePolicies) == 0 {
+		return true
+	}
+	if len(p.LifecyclePolicies) != len(c.lifecyclePolicies) {
+		return false
+	}
+	for i := range p.LifecyclePolicies {
+		if p.LifecyclePolicies[i].TransitionToIA != aws.StringValue(c.lifecyclePolicies[i].TransitionToIA) {
+			return false
+		}
+	}
+	return true
+}
+
+// isBackupPolicyUpToDate considers a backup policy that is being enabled or
+// disabled as up to date, since there is nothing to do but wait.
+func (c *currentSettings) isBackupPolicyUpToDate(p *svcapitypes.CustomFileSystemParameters) bool {
+	if p.BackupPolicyEnabled == nil {
+		return true
+	}
+	status := svcsdk.StatusDisabled
+	if c.backupPolicy != nil {
+		status = aws.StringValue(c.backupPolicy.Status)
+	}
+	if aws.BoolValue(p.BackupPolicyEnabled) {
+		return status == svcsdk.StatusEnabled || status == svcsdk.StatusEnabling
+	}
+	return status == svcsdk.StatusDisabled || status == svcsdk.StatusDisabling
+}
+
+// isPolicyUpToDate compares the policies as JSON documents so that
+// formatting differences are ignored.
+func isPolicyUpToDate(desired, current *string) bool {
+	if desired == nil {
+		return true
+	}
+	if current == nil {
+		return false
+	}
+	var d, c interface{}
+	if err := json.Unmarshal([]byte(*desired), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(*current), &c); err != nil {
+		return false
+	}
+	return cmp.Equal(d, c)
+}
+
+func (s *settings) update(ctx context.Context, cr *svcapitypes.FileSystem, c *currentSettings) error {
+	id := aws.String(meta.GetExternalName(cr))
+	p := &cr.Spec.ForProvider.CustomFileSystemParameters
+
+	if !c.isLifecycleUpToDate(p) {
+		in := &svcsdk.PutLifecycleConfigurationInput{FileSystemId: id}
+		for _, lp := range p.LifecyclePolicies {
+			in.LifecyclePolicies = append(in.LifecyclePolicies, &svcsdk.LifecyclePolicy{TransitionToIA: aws.String(lp.TransitionToIA)})
+		}
+		if _, err := s.client.PutLifecycleConfigurationWithContext(ctx, in); err != nil {
+			return awsclients.Wrap(err, errPutLifecycleConfiguration)
+		}
+	}
+
+	if !c.isBackupPolicyUpToDate(p) {
+		status := svcsdk.StatusDisabled
+		if aws.BoolValue(p.BackupPolicyEnabled) {
+			status = svcsdk.StatusEnabled
+		}
+		if _, err := s.client.PutBackupPolicyWithContext(ctx, &svcsdk.PutBackupPolicyInput{
+			FileSystemId: id,
+			BackupPolicy: &svcsdk.BackupPolicy{Status: aws.String(status)},
+		}); err != nil {
+			return awsclients.Wrap(err, errPutBackupPolicy)
+		}
+	}
+
+	if !isPolicyUpToDate(p.Policy, c.policy) {
+		if _, err := s.client.PutFileSystemPolicyWithContext(ctx, &svcsdk.PutFileSystemPolicyInput{FileSystemId: id, Policy: p.Policy}); err != nil {
+			return awsclients.Wrap(err, errPutFileSystemPolicy)
+		}
+	}
+	return nil
+}
+
+func isPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodePolicyNotFound
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+)
+
+func TestSettingsIsUpToDate(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"elasticfilesystem:ClientMount"}]}`
+
+	cases := map[string]struct {
+		p    svcapitypes.CustomFileSystemParameters
+		c    currentSettings
+		want bool
+	}{
+		"Empty": {
+			want: true,
+		},
+		"LifecyclePolicyAdded": {
+			p:    svcapitypes.CustomFileSystemParameters{LifecyclePolicies: []svcapitypes.LifecyclePolicy{{TransitionToIA: "AFTER_30_DAYS"}}},
+			want: false,
+		},
+		"LifecyclePolicyChanged": {
+			p: svcapitypes.CustomFileSystemParameters{LifecyclePolicies: []svcapitypes.LifecyclePolicy{{TransitionToIA: "AFTER_30_DAYS"}}},
+			c: currentSettings{lifecyclePolicies: []*svcsdk.LifecyclePolicy{{TransitionToIA: aws.String("AFTER_7_DAYS")}}},
+		},
+		"BackupPolicyUnmanaged": {
+			c:    currentSettings{backupPolicy: &svcsdk.BackupPolicy{Status: aws.String(svcsdk.StatusEnabled)}},
+			want: true,
+		},
+		"BackupPolicyEnabling": {
+			p:    svcapitypes.CustomFileSystemParameters{BackupPolicyEnabled: aws.Bool(true)},
+			c:    currentSettings{backupPolicy: &svcsdk.BackupPolicy{Status: aws.String(svcsdk.StatusEnabling)}},
+			want: true,
+		},
+		"BackupPolicyMissing": {
+			p: svcapitypes.CustomFileSystemParameters{BackupPolicyEnabled: aws.Bool(true)},
+		},
+		"PolicyFormatting": {
+			p: svcapitypes.CustomFileSystemParameters{Policy: aws.String(policy)},
+			c: currentSettings{policy: aws.String(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "elasticfilesystem:ClientMount"}]
+}`)},
+			want: true,
+		},
+		"PolicyUnmanaged": {
+			c:    currentSettings{policy: aws.String(policy)},
+			want: true,
+		},
+		"PolicyMissing": {
+			p: svcapitypes.CustomFileSystemParameters{Policy: aws.String(policy)},
+		},
+		"LifecyclePoliciesUnmanaged": {
+			c:    currentSettings{lifecyclePolicies: []*svcsdk.LifecyclePolicy{{TransitionToIA: aws.String("AFTER_7_DAYS")}}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.c.isUpToDate(&tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -44,13 +44,16 @@ func SetupFileSystem(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateL
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.FileSystemGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(&settingsConnector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
 func isUpToDate(cr *svcapitypes.FileSystem, obj *svcsdk.DescribeFileSystemsOutput) (bool, error) {
 	for _, res := range obj.FileSystems {
+		if cr.Spec.ForProvider.ThroughputMode != nil && awsclients.StringValue(cr.Spec.ForProvider.ThroughputMode) != aws.StringValue(res.ThroughputMode) {
+			return false, nil
+		}
 		if awsclients.Int64Value(cr.Spec.ForProvider.ProvisionedThroughputInMibps) != int64(aws.Float64Value(res.ProvisionedThroughputInMibps)) {
 			return false, nil
 		}
@@ -119,6 +119,11 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
 
+	if resp.AvailabilityZoneId != nil {
+		cr.Status.AtProvider.AvailabilityZoneID = resp.AvailabilityZoneId
+	} else {
+		cr.Status.AtProvider.AvailabilityZoneID = nil
+	}
 	if resp.CreationTime != nil {
 		cr.Status.AtProvider.CreationTime = &metav1.Time{*resp.CreationTime}
 	} else {
@@ -160,20 +165,20 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 		cr.Status.AtProvider.OwnerID = nil
 	}
 	if resp.SizeInBytes != nil {
-		f11 := &svcapitypes.FileSystemSize{}
+		f13 := &svcapitypes.FileSystemSize{}
 		if resp.SizeInBytes.Timestamp != nil {
-			f11.Timestamp = &metav1.Time{*resp.SizeInBytes.Timestamp}
+			f13.Timestamp = &metav1.Time{*resp.SizeInBytes.Timestamp}
 		}
 		if resp.SizeInBytes.Value != nil {
-			f11.Value = resp.SizeInBytes.Value
+			f13.Value = resp.SizeInBytes.Value
 		}
 		if resp.SizeInBytes.ValueInIA != nil {
-			f11.ValueInIA = resp.SizeInBytes.ValueInIA
+			f13.ValueInIA = resp.SizeInBytes.ValueInIA
 		}
 		if resp.SizeInBytes.ValueInStandard != nil {
-			f11.ValueInStandard = resp.SizeInBytes.ValueInStandard
+			f13.ValueInStandard = resp.SizeInBytes.ValueInStandard
 		}
-		cr.Status.AtProvider.SizeInBytes = f11
+		cr.Status.AtProvider.SizeInBytes = f13
 	} else {
 		cr.Status.AtProvider.SizeInBytes = nil
 	}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mounttarget
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/efs"
+)
+
+const (
+	errNotMountTarget           = "managed resource is not a MountTarget custom resource"
+	errDescribeFailed           = "cannot describe MountTarget"
+	errDescribeSecurityGroups   = "cannot describe security groups of MountTarget"
+	errCreateFailed             = "cannot create MountTarget"
+	errModifySecurityGroups     = "cannot modify security groups of MountTarget"
+	errDeleteFailed             = "cannot delete MountTarget"
+	errKubeUpdateFailed         = "cannot update MountTarget custom resource"
+	errNoFileSystemIDOrSubnetID = "fileSystemId and subnetId of MountTarget must be set"
+)
+
+// SetupMountTarget adds a controller that reconciles MountTargets.
+func SetupMountTarget(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.MountTargetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.MountTarget{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MountTargetGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.MountTarget)
+	if !ok {
+		return nil, errors.New(errNotMountTarget)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MountTarget)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMountTarget)
+	}
+	// The ID of the mount target is assigned by AWS on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.DescribeMountTargetsWithContext(ctx, &svcsdk.DescribeMountTargetsInput{MountTargetId: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(svcclient.IsMountTargetNotFound, err), errDescribeFailed)
+	}
+	if len(rsp.MountTargets) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	mt := rsp.MountTargets[0]
+	cr.Status.AtProvider = svcclient.GenerateMountTargetObservation(mt)
+
+	switch aws.StringValue(mt.LifeCycleState) {
+	case svcsdk.LifeCycleStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.LifeCycleStateCreating, svcsdk.LifeCycleStateUpdating:
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case svcsdk.LifeCycleStateDeleting, svcsdk.LifeCycleStateDeleted:
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	// Security groups can only be described and modified once the mount
+	// target is available.
+	sg, err := e.client.DescribeMountTargetSecurityGroupsWithContext(ctx, &svcsdk.DescribeMountTargetSecurityGroupsInput{MountTargetId: mt.MountTargetId})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeSecurityGroups)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	svcclient.LateInitializeMountTarget(&cr.Spec.ForProvider, mt, sg.SecurityGroups)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: svcclient.AreSecurityGroupsUpToDate(cr.Spec.ForProvider.SecurityGroups, sg.SecurityGroups),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MountTarget)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMountTarget)
+	}
+	if cr.Spec.ForProvider.FileSystemID == nil || cr.Spec.ForProvider.SubnetID == nil {
+		return managed.ExternalCreation{}, errors.New(errNoFileSystemIDOrSubnetID)
+	}
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := e.client.CreateMountTargetWithContext(ctx, svcclient.GenerateCreateMountTargetInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.MountTargetId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MountTarget)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMountTarget)
+	}
+	_, err := e.client.ModifyMountTargetSecurityGroupsWithContext(ctx, &svcsdk.ModifyMountTargetSecurityGroupsInput{
+		MountTargetId:  aws.String(meta.GetExternalName(cr)),
+		SecurityGroups: aws.StringSlice(cr.Spec.ForProvider.SecurityGroups),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifySecurityGroups)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.MountTarget)
+	if !ok {
+		return errors.New(errNotMountTarget)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if aws.StringValue(cr.Status.AtProvider.LifeCycleState) == svcsdk.LifeCycleStateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteMountTargetWithContext(ctx, &svcsdk.DeleteMountTargetInput{MountTargetId: aws.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsMountTargetNotFound, err), errDeleteFailed)
+}
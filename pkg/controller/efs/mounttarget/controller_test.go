@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mounttarget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/efs"
+	"github.com/crossplane/provider-aws/pkg/clients/efs/fake"
+)
+
+const (
+	mountTargetID = "fsmt-12345678"
+	ipAddress     = "10.0.0.10"
+)
+
+var errBoom = errors.New("boom")
+
+type args struct {
+	client svcclient.Client
+	kube   client.Client
+	cr     *v1alpha1.MountTarget
+}
+
+type mountTargetModifier func(*v1alpha1.MountTarget)
+
+func withSecurityGroups(sg ...string) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Spec.ForProvider.SecurityGroups = sg }
+}
+
+func withIPAddress() mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Spec.ForProvider.IPAddress = aws.String(ipAddress) }
+}
+
+func withConditions(c ...xpv1.Condition) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(state string) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) {
+		r.Status.AtProvider = v1alpha1.MountTargetObservation{
+			IPAddress:      aws.String(ipAddress),
+			LifeCycleState: aws.String(state),
+		}
+	}
+}
+
+func mountTarget(m ...mountTargetModifier) *v1alpha1.MountTarget {
+	cr := &v1alpha1.MountTarget{}
+	cr.Spec.ForProvider.FileSystemID = aws.String("fs-12345678")
+	cr.Spec.ForProvider.SubnetID = aws.String("subnet-12345678")
+	meta.SetExternalName(cr, mountTargetID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state string) func(*svcsdk.DescribeMountTargetsInput) (*svcsdk.DescribeMountTargetsOutput, error) {
+	return func(_ *svcsdk.DescribeMountTargetsInput) (*svcsdk.DescribeMountTargetsOutput, error) {
+		return &svcsdk.DescribeMountTargetsOutput{MountTargets: []*svcsdk.MountTargetDescription{{
+			MountTargetId:  aws.String(mountTargetID),
+			IpAddress:      aws.String(ipAddress),
+			LifeCycleState: aws.String(state),
+		}}}, nil
+	}
+}
+
+func securityGroups(sg ...string) func(*svcsdk.DescribeMountTargetSecurityGroupsInput) (*svcsdk.DescribeMountTargetSecurityGroupsOutput, error) {
+	return func(_ *svcsdk.DescribeMountTargetSecurityGroupsInput) (*svcsdk.DescribeMountTargetSecurityGroupsOutput, error) {
+		return &svcsdk.DescribeMountTargetSecurityGroupsOutput{SecurityGroups: aws.StringSlice(sg)}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MountTarget
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeMountTargets: func(_ *svcsdk.DescribeMountTargetsInput) (*svcsdk.DescribeMountTargetsOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeMountTargetNotFound, "", nil)
+					},
+				},
+				cr: mountTarget(),
+			},
+			want: want{
+				cr: mountTarget(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeMountTargets: func(_ *svcsdk.DescribeMountTargetsInput) (*svcsdk.DescribeMountTargetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mountTarget(),
+			},
+			want: want{
+				cr:  mountTarget(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockClient{MockDescribeMountTargets: describe(svcsdk.LifeCycleStateCreating)},
+				cr:     mountTarget(),
+			},
+			want: want{
+				cr:     mountTarget(withObservation(svcsdk.LifeCycleStateCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockDescribeMountTargets:              describe(svcsdk.LifeCycleStateAvailable),
+					MockDescribeMountTargetSecurityGroups: securityGroups("sg-1"),
+				},
+				cr: mountTarget(),
+			},
+			want: want{
+				cr: mountTarget(withIPAddress(), withSecurityGroups("sg-1"),
+					withObservation(svcsdk.LifeCycleStateAvailable), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SecurityGroupsChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeMountTargets:              describe(svcsdk.LifeCycleStateAvailable),
+					MockDescribeMountTargetSecurityGroups: securityGroups("sg-2", "sg-1"),
+				},
+				cr: mountTarget(withIPAddress(), withSecurityGroups("sg-1", "sg-3")),
+			},
+			want: want{
+				cr: mountTarget(withIPAddress(), withSecurityGroups("sg-1", "sg-3"),
+					withObservation(svcsdk.LifeCycleStateAvailable), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MountTarget
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateMountTarget: func(_ *svcsdk.CreateMountTargetInput) (*svcsdk.MountTargetDescription, error) {
+						return &svcsdk.MountTargetDescription{MountTargetId: aws.String(mountTargetID)}, nil
+					},
+				},
+				cr: mountTarget(func(r *v1alpha1.MountTarget) { meta.SetExternalName(r, "") }),
+			},
+			want: want{
+				cr:     mountTarget(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"NoSubnet": {
+			args: args{
+				cr: mountTarget(func(r *v1alpha1.MountTarget) { r.Spec.ForProvider.SubnetID = nil }),
+			},
+			want: want{
+				cr:  mountTarget(func(r *v1alpha1.MountTarget) { r.Spec.ForProvider.SubnetID = nil }),
+				err: errors.New(errNoFileSystemIDOrSubnetID),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateMountTarget: func(_ *svcsdk.CreateMountTargetInput) (*svcsdk.MountTargetDescription, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mountTarget(),
+			},
+			want: want{
+				cr:  mountTarget(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	errNotEKSAddon      = "managed resource is not an EKS addon custom resource"
+	errKubeUpdateFailed = "cannot update EKS addon custom resource"
+	errCreateFailed     = "cannot create EKS addon"
+	errUpdateFailed     = "cannot update EKS addon"
+	errAddTagsFailed    = "cannot add tags to EKS addon"
+	errRemoveTagsFailed = "cannot remove tags from EKS addon"
+	errDeleteFailed     = "cannot delete EKS addon"
+	errDescribeFailed   = "cannot describe EKS addon"
+)
+
+// SetupAddon adds a controller that reconciles Addons.
+func SetupAddon(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.AddonGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Addon{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AddonGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewAddonClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) eks.AddonClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Addon)
+	if !ok {
+		return nil, errors.New(errNotEKSAddon)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client eks.AddonClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Addon)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSAddon)
+	}
+
+	rsp, err := e.client.DescribeAddonWithContext(ctx, &svcsdk.DescribeAddonInput{AddonName: aws.String(meta.GetExternalName(cr)), ClusterName: aws.String(cr.Spec.ForProvider.ClusterName)})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDescribeFailed)
+	}
+	if rsp.Addon == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	eks.LateInitializeAddon(&cr.Spec.ForProvider, rsp.Addon)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = eks.GenerateAddonObservation(rsp.Addon)
+	// Any of the statuses we don't explicitly address should be considered as
+	// the addon being unavailable.
+	switch cr.Status.AtProvider.Status { // nolint:exhaustive
+	case v1alpha1.AddonStatusActive:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.AddonStatusCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.AddonStatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: eks.IsAddonUpToDate(cr.Spec.ForProvider, rsp.Addon),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Addon)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSAddon)
+	}
+	cr.SetConditions(xpv1.Creating())
+	if cr.Status.AtProvider.Status == v1alpha1.AddonStatusCreating {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err := e.client.CreateAddonWithContext(ctx, eks.GenerateCreateAddonInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Addon)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSAddon)
+	}
+	switch cr.Status.AtProvider.Status { // nolint:exhaustive
+	case v1alpha1.AddonStatusUpdating, v1alpha1.AddonStatusCreating:
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// We have to describe the addon again because tags and the rest of the
+	// fields are updated by different calls.
+	rsp, err := e.client.DescribeAddonWithContext(ctx, &svcsdk.DescribeAddonInput{AddonName: aws.String(meta.GetExternalName(cr)), ClusterName: aws.String(cr.Spec.ForProvider.ClusterName)})
+	if err != nil || rsp.Addon == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	add, remove := awsclient.DiffTags(cr.Spec.ForProvider.Tags, aws.StringValueMap(rsp.Addon.Tags))
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceArn: rsp.Addon.AddonArn, TagKeys: aws.StringSlice(remove)}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceArn: rsp.Addon.AddonArn, Tags: aws.StringMap(add)}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	if aws.StringValue(cr.Spec.ForProvider.AddonVersion) == aws.StringValue(rsp.Addon.AddonVersion) &&
+		aws.StringValue(cr.Spec.ForProvider.ServiceAccountRoleARN) == aws.StringValue(rsp.Addon.ServiceAccountRoleArn) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateAddonWithContext(ctx, eks.GenerateUpdateAddonInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Addon)
+	if !ok {
+		return errors.New(errNotEKSAddon)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.AddonStatusDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteAddonWithContext(ctx, &svcsdk.DeleteAddonInput{AddonName: aws.String(meta.GetExternalName(cr)), ClusterName: aws.String(cr.Spec.ForProvider.ClusterName)})
+	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Addon)
+	if !ok {
+		return errors.New(errNotEKSAddon)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		cr.Spec.ForProvider.Tags[k] = v
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -0,0 +1,553 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	addonName   = "vpc-cni"
+	clusterName = "cool-cluster"
+	addonARN    = "arn:aws:eks:us-east-1:123456789012:addon/cool-cluster/vpc-cni/abc"
+	version     = "v1.7.5-eksbuild.1"
+	newVersion  = "v1.7.5-eksbuild.2"
+	roleARN     = "arn:aws:iam::123456789012:role/vpc-cni"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	eks  eks.AddonClient
+	kube client.Client
+	cr   *v1alpha1.Addon
+}
+
+type addonModifier func(*v1alpha1.Addon)
+
+func withConditions(c ...xpv1.Condition) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withVersion(v string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.AddonVersion = aws.String(v) }
+}
+
+func withRoleARN(a string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.ServiceAccountRoleARN = aws.String(a) }
+}
+
+func withTags(tagMaps ...map[string]string) addonModifier {
+	tags := map[string]string{}
+	for _, tagMap := range tagMaps {
+		for k, v := range tagMap {
+			tags[k] = v
+		}
+	}
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withStatus(s v1alpha1.AddonStatusType) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.Status = s }
+}
+
+func withARN(a string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.AddonARN = a }
+}
+
+func addon(m ...addonModifier) *v1alpha1.Addon {
+	cr := &v1alpha1.Addon{}
+	cr.Spec.ForProvider.ClusterName = clusterName
+	meta.SetExternalName(cr, addonName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonVersion: aws.String(version),
+							Status:       aws.String(svcsdk.AddonStatusActive),
+						}}, nil
+					},
+				},
+				cr: addon(withVersion(version)),
+			},
+			want: want{
+				cr: addon(withVersion(version),
+					withConditions(xpv1.Available()),
+					withARN(addonARN),
+					withStatus(v1alpha1.AddonStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"VersionChanged": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonVersion: aws.String(version),
+							Status:       aws.String(svcsdk.AddonStatusActive),
+						}}, nil
+					},
+				},
+				cr: addon(withVersion(newVersion)),
+			},
+			want: want{
+				cr: addon(withVersion(newVersion),
+					withConditions(xpv1.Available()),
+					withARN(addonARN),
+					withStatus(v1alpha1.AddonStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonVersion:          aws.String(version),
+							ServiceAccountRoleArn: aws.String(roleARN),
+							Status:                aws.String(svcsdk.AddonStatusCreating),
+						}}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withVersion(version),
+					withRoleARN(roleARN),
+					withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.AddonStatusCreating)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DegradedState": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonVersion: aws.String(version),
+							Status:       aws.String(svcsdk.AddonStatusDegraded),
+						}}, nil
+					},
+				},
+				cr: addon(withVersion(version)),
+			},
+			want: want{
+				cr: addon(withVersion(version),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha1.AddonStatusDegraded)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return nil, errors.New(svcsdk.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddon: func(i *svcsdk.CreateAddonInput) (*svcsdk.CreateAddonOutput, error) {
+						if aws.StringValue(i.AddonName) != addonName || aws.StringValue(i.ClusterName) != clusterName {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateAddonOutput{}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Creating())),
+			},
+		},
+		"AlreadyCreating": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddon: func(_ *svcsdk.CreateAddonInput) (*svcsdk.CreateAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(withStatus(v1alpha1.AddonStatusCreating)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusCreating), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddon: func(_ *svcsdk.CreateAddonInput) (*svcsdk.CreateAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulVersionUpdate": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonVersion: aws.String(version),
+						}}, nil
+					},
+					MockUpdateAddon: func(i *svcsdk.UpdateAddonInput) (*svcsdk.UpdateAddonOutput, error) {
+						if aws.StringValue(i.AddonVersion) != newVersion {
+							return nil, errBoom
+						}
+						return &svcsdk.UpdateAddonOutput{}, nil
+					},
+				},
+				cr: addon(withVersion(newVersion)),
+			},
+			want: want{
+				cr: addon(withVersion(newVersion)),
+			},
+		},
+		"SuccessfulTagsUpdate": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonVersion: aws.String(version),
+							Tags:         aws.StringMap(map[string]string{"old": "tag"}),
+						}}, nil
+					},
+					MockUntagResource: func(i *svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error) {
+						if diff := cmp.Diff([]string{"old"}, aws.StringValueSlice(i.TagKeys)); diff != "" {
+							return nil, errBoom
+						}
+						return &svcsdk.UntagResourceOutput{}, nil
+					},
+					MockTagResource: func(i *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+						if diff := cmp.Diff(map[string]string{"new": "tag"}, aws.StringValueMap(i.Tags)); diff != "" {
+							return nil, errBoom
+						}
+						return &svcsdk.TagResourceOutput{}, nil
+					},
+				},
+				cr: addon(withVersion(version), withTags(map[string]string{"new": "tag"})),
+			},
+			want: want{
+				cr: addon(withVersion(version), withTags(map[string]string{"new": "tag"})),
+			},
+		},
+		"AlreadyUpdating": {
+			args: args{
+				eks: &fake.MockAddonClient{},
+				cr:  addon(withVersion(newVersion), withStatus(v1alpha1.AddonStatusUpdating)),
+			},
+			want: want{
+				cr: addon(withVersion(newVersion), withStatus(v1alpha1.AddonStatusUpdating)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *svcsdk.DescribeAddonInput) (*svcsdk.DescribeAddonOutput, error) {
+						return &svcsdk.DescribeAddonOutput{Addon: &svcsdk.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonVersion: aws.String(version),
+						}}, nil
+					},
+					MockUpdateAddon: func(_ *svcsdk.UpdateAddonInput) (*svcsdk.UpdateAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(withVersion(newVersion)),
+			},
+			want: want{
+				cr:  addon(withVersion(newVersion)),
+				err: awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddon: func(_ *svcsdk.DeleteAddonInput) (*svcsdk.DeleteAddonOutput, error) {
+						return &svcsdk.DeleteAddonOutput{}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				eks: &fake.MockAddonClient{},
+				cr:  addon(withStatus(v1alpha1.AddonStatusDeleting)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusDeleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddon: func(_ *svcsdk.DeleteAddonInput) (*svcsdk.DeleteAddonOutput, error) {
+						return nil, errors.New(svcsdk.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddon: func(_ *svcsdk.DeleteAddonInput) (*svcsdk.DeleteAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   addon(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: addon(withTags(resource.GetExternalTags(addon()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:   addon(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package eks
 import (
 	"context"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
@@ -36,6 +37,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
@@ -53,6 +55,9 @@ const (
 	errDescribeFailed      = "cannot describe EKS cluster"
 	errPatchCreationFailed = "cannot create a patch object"
 	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errDescribeUpdate      = "cannot describe EKS cluster update"
+	errListNodeGroups      = "cannot list EKS node groups"
+	errUpgradeBlocked      = "cannot upgrade EKS cluster version until node groups are at most one minor version behind version %s: %s"
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
@@ -117,7 +122,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
+	update := cr.Status.AtProvider.VersionUpdate
+	if eks.IsClusterUpdateInProgress(update) {
+		u, err := e.client.DescribeUpdateRequest(&awseks.DescribeUpdateInput{Name: aws.String(meta.GetExternalName(cr)), UpdateId: aws.String(update.ID)}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeUpdate)
+		}
+		update = eks.GenerateClusterUpdate(u.Update)
+	}
 	cr.Status.AtProvider = eks.GenerateObservation(rsp.Cluster)
+	cr.Status.AtProvider.VersionUpdate = update
 	switch cr.Status.AtProvider.Status { //nolint:exhaustive
 	case v1beta1.ClusterStatusActive:
 		cr.Status.SetConditions(xpv1.Available())
@@ -185,13 +199,32 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPatchCreationFailed)
 	}
 	if patch.Version != nil {
-		_, err := e.client.UpdateClusterVersionRequest(&awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: patch.Version}).Send(ctx)
-		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		return managed.ExternalUpdate{}, e.upgrade(ctx, cr, rsp.Cluster, patch.Version)
 	}
 	_, err = e.client.UpdateClusterConfigRequest(eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch)).Send(ctx)
 	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
 }
 
+// upgrade updates the Kubernetes version of the control plane once all node
+// groups of the cluster run its current minor version or the one before.
+func (e *external) upgrade(ctx context.Context, cr *v1beta1.Cluster, cluster *awseks.Cluster, version *string) error {
+	if cluster.Version != nil {
+		ngs := &v1alpha1.NodeGroupList{}
+		if err := e.kube.List(ctx, ngs); err != nil {
+			return errors.Wrap(err, errListNodeGroups)
+		}
+		if blocking := eks.NodeGroupsBlockingUpgrade(meta.GetExternalName(cr), aws.StringValue(cluster.Version), ngs.Items); len(blocking) != 0 {
+			return errors.Errorf(errUpgradeBlocked, aws.StringValue(cluster.Version), strings.Join(blocking, ", "))
+		}
+	}
+	rsp, err := e.client.UpdateClusterVersionRequest(&awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: version}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+	}
+	cr.Status.AtProvider.VersionUpdate = eks.GenerateClusterUpdate(rsp.Update)
+	return nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.Cluster)
 	if !ok {
@@ -29,10 +29,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
@@ -40,7 +42,8 @@ import (
 )
 
 var (
-	version = "1.16"
+	version    = "1.16"
+	oldVersion = "1.15"
 
 	errBoom = errors.New("boom")
 )
@@ -79,6 +82,14 @@ func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
 
+func withExternalName(n string) clusterModifier {
+	return func(r *v1beta1.Cluster) { meta.SetExternalName(r, n) }
+}
+
+func withVersionUpdate(u *v1beta1.ClusterUpdate) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Status.AtProvider.VersionUpdate = u }
+}
+
 func cluster(m ...clusterModifier) *v1beta1.Cluster {
 	cr := &v1beta1.Cluster{}
 	for _, f := range m {
@@ -450,6 +461,72 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withVersion(&version)),
 			},
 		},
+		"SuccessfulUpgrade": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						ng := v1alpha1.NodeGroup{}
+						ng.SetName("cool-nodes")
+						ng.Spec.ForProvider.ClusterName = "cool-cluster"
+						ng.Spec.ForProvider.Version = &oldVersion
+						obj.(*v1alpha1.NodeGroupList).Items = []v1alpha1.NodeGroup{ng}
+						return nil
+					},
+				},
+				eks: &fake.MockClient{
+					MockUpdateClusterVersionRequest: func(input *awseks.UpdateClusterVersionInput) awseks.UpdateClusterVersionRequest {
+						return awseks.UpdateClusterVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateClusterVersionOutput{
+								Update: &awseks.Update{Id: aws.String("update"), Status: awseks.UpdateStatusInProgress, Type: awseks.UpdateTypeVersionUpdate},
+							}},
+						}
+					},
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{Version: &oldVersion},
+							}},
+						}
+					},
+				},
+				cr: cluster(withExternalName("cool-cluster"), withVersion(&version)),
+			},
+			want: want{
+				cr: cluster(withExternalName("cool-cluster"), withVersion(&version), withVersionUpdate(&v1beta1.ClusterUpdate{
+					ID:     "update",
+					Status: string(awseks.UpdateStatusInProgress),
+					Type:   string(awseks.UpdateTypeVersionUpdate),
+				})),
+			},
+		},
+		"UpgradeBlocked": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						ng := v1alpha1.NodeGroup{}
+						ng.SetName("old-nodes")
+						ng.Spec.ForProvider.ClusterName = "cool-cluster"
+						ng.Spec.ForProvider.Version = aws.String("1.13")
+						obj.(*v1alpha1.NodeGroupList).Items = []v1alpha1.NodeGroup{ng}
+						return nil
+					},
+				},
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{Version: &oldVersion},
+							}},
+						}
+					},
+				},
+				cr: cluster(withExternalName("cool-cluster"), withVersion(&version)),
+			},
+			want: want{
+				cr:  cluster(withExternalName("cool-cluster"), withVersion(&version)),
+				err: errors.Errorf(errUpgradeBlocked, oldVersion, "old-nodes"),
+			},
+		},
 		"SuccessfulUpdateCluster": {
 			args: args{
 				eks: &fake.MockClient{
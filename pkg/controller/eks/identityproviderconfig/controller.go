@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityproviderconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	errNotEKSIdentityProviderConfig = "managed resource is not an EKS identity provider config custom resource"
+	errKubeUpdateFailed             = "cannot update EKS identity provider config custom resource"
+	errAssociateFailed              = "cannot associate EKS identity provider config"
+	errAddTagsFailed                = "cannot add tags to EKS identity provider config"
+	errRemoveTagsFailed             = "cannot remove tags from EKS identity provider config"
+	errDisassociateFailed           = "cannot disassociate EKS identity provider config"
+	errDescribeFailed               = "cannot describe EKS identity provider config"
+)
+
+// SetupIdentityProviderConfig adds a controller that reconciles
+// IdentityProviderConfigs.
+func SetupIdentityProviderConfig(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.IdentityProviderConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.IdentityProviderConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IdentityProviderConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewIdentityProviderConfigClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) eks.IdentityProviderConfigClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProviderConfig)
+	if !ok {
+		return nil, errors.New(errNotEKSIdentityProviderConfig)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client eks.IdentityProviderConfigClient
+	kube   client.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.IdentityProviderConfig) (*svcsdk.OidcIdentityProviderConfig, error) {
+	rsp, err := e.client.DescribeIdentityProviderConfigWithContext(ctx, &svcsdk.DescribeIdentityProviderConfigInput{
+		ClusterName:            aws.String(cr.Spec.ForProvider.ClusterName),
+		IdentityProviderConfig: eks.GenerateIdentityProviderConfig(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.IdentityProviderConfig == nil {
+		return nil, nil
+	}
+	return rsp.IdentityProviderConfig.Oidc, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProviderConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSIdentityProviderConfig)
+	}
+
+	oidc, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDescribeFailed)
+	}
+	if oidc == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	eks.LateInitializeIdentityProviderConfig(&cr.Spec.ForProvider, oidc)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = eks.GenerateIdentityProviderConfigObservation(oidc)
+	// Any of the statuses we don't explicitly address should be considered as
+	// the identity provider config being unavailable.
+	switch cr.Status.AtProvider.Status { // nolint:exhaustive
+	case v1alpha1.IdentityProviderConfigStatusActive:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.IdentityProviderConfigStatusCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.IdentityProviderConfigStatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: eks.IsIdentityProviderConfigUpToDate(cr.Spec.ForProvider, oidc),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProviderConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSIdentityProviderConfig)
+	}
+	cr.SetConditions(xpv1.Creating())
+	if cr.Status.AtProvider.Status == v1alpha1.IdentityProviderConfigStatusCreating {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err := e.client.AssociateIdentityProviderConfigWithContext(ctx, eks.GenerateAssociateIdentityProviderConfigInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errAssociateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProviderConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSIdentityProviderConfig)
+	}
+
+	// Only the tags of an identity provider config can be updated.
+	oidc, err := e.describe(ctx, cr)
+	if err != nil || oidc == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	add, remove := awsclient.DiffTags(cr.Spec.ForProvider.Tags, aws.StringValueMap(oidc.Tags))
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceArn: oidc.IdentityProviderConfigArn, TagKeys: aws.StringSlice(remove)}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceArn: oidc.IdentityProviderConfigArn, Tags: aws.StringMap(add)}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IdentityProviderConfig)
+	if !ok {
+		return errors.New(errNotEKSIdentityProviderConfig)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.IdentityProviderConfigStatusDeleting {
+		return nil
+	}
+	_, err := e.client.DisassociateIdentityProviderConfigWithContext(ctx, &svcsdk.DisassociateIdentityProviderConfigInput{
+		ClusterName:            aws.String(cr.Spec.ForProvider.ClusterName),
+		IdentityProviderConfig: eks.GenerateIdentityProviderConfig(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDisassociateFailed)
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IdentityProviderConfig)
+	if !ok {
+		return errors.New(errNotEKSIdentityProviderConfig)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		cr.Spec.ForProvider.Tags[k] = v
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
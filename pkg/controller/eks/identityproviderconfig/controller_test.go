@@ -0,0 +1,459 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityproviderconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	configName  = "dex"
+	clusterName = "cool-cluster"
+	configARN   = "arn:aws:eks:us-east-1:123456789012:identityproviderconfig/cool-cluster/oidc/dex/abc"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	eks  eks.IdentityProviderConfigClient
+	kube client.Client
+	cr   *v1alpha1.IdentityProviderConfig
+}
+
+type configModifier func(*v1alpha1.IdentityProviderConfig)
+
+func withConditions(c ...xpv1.Condition) configModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tagMaps ...map[string]string) configModifier {
+	tags := map[string]string{}
+	for _, tagMap := range tagMaps {
+		for k, v := range tagMap {
+			tags[k] = v
+		}
+	}
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withStatus(s v1alpha1.IdentityProviderConfigStatusType) configModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Status.AtProvider.Status = s }
+}
+
+func withARN(a string) configModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Status.AtProvider.IdentityProviderConfigARN = a }
+}
+
+func identityProviderConfig(m ...configModifier) *v1alpha1.IdentityProviderConfig {
+	cr := &v1alpha1.IdentityProviderConfig{}
+	cr.Spec.ForProvider.ClusterName = clusterName
+	meta.SetExternalName(cr, configName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeOutput(status string, tags map[string]string) *svcsdk.DescribeIdentityProviderConfigOutput {
+	return &svcsdk.DescribeIdentityProviderConfigOutput{IdentityProviderConfig: &svcsdk.IdentityProviderConfigResponse{
+		Oidc: &svcsdk.OidcIdentityProviderConfig{
+			IdentityProviderConfigArn: aws.String(configARN),
+			Status:                    aws.String(status),
+			Tags:                      aws.StringMap(tags),
+		},
+	}}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IdentityProviderConfig
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(i *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						if aws.StringValue(i.IdentityProviderConfig.Name) != configName {
+							return nil, errBoom
+						}
+						return describeOutput(svcsdk.ConfigStatusActive, nil), nil
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withConditions(xpv1.Available()),
+					withARN(configARN),
+					withStatus(v1alpha1.IdentityProviderConfigStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						return describeOutput(svcsdk.ConfigStatusActive, map[string]string{"old": "tag"}), nil
+					},
+				},
+				cr: identityProviderConfig(withTags(map[string]string{"new": "tag"})),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withTags(map[string]string{"new": "tag"}),
+					withConditions(xpv1.Available()),
+					withARN(configARN),
+					withStatus(v1alpha1.IdentityProviderConfigStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						return describeOutput(svcsdk.ConfigStatusCreating, map[string]string{"foo": "bar"}), nil
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withTags(map[string]string{"foo": "bar"}),
+					withConditions(xpv1.Creating()),
+					withARN(configARN),
+					withStatus(v1alpha1.IdentityProviderConfigStatusCreating)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr:  identityProviderConfig(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						return nil, errors.New(svcsdk.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr: identityProviderConfig(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IdentityProviderConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockAssociateIdentityProviderConfig: func(i *svcsdk.AssociateIdentityProviderConfigInput) (*svcsdk.AssociateIdentityProviderConfigOutput, error) {
+						if aws.StringValue(i.Oidc.IdentityProviderConfigName) != configName {
+							return nil, errBoom
+						}
+						return &svcsdk.AssociateIdentityProviderConfigOutput{}, nil
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr: identityProviderConfig(withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockAssociateIdentityProviderConfig: func(_ *svcsdk.AssociateIdentityProviderConfigInput) (*svcsdk.AssociateIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr:  identityProviderConfig(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IdentityProviderConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulTagsUpdate": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						return describeOutput(svcsdk.ConfigStatusActive, map[string]string{"old": "tag"}), nil
+					},
+					MockUntagResource: func(i *svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error) {
+						if diff := cmp.Diff([]string{"old"}, aws.StringValueSlice(i.TagKeys)); diff != "" {
+							return nil, errBoom
+						}
+						return &svcsdk.UntagResourceOutput{}, nil
+					},
+					MockTagResource: func(i *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+						if aws.StringValue(i.ResourceArn) != configARN {
+							return nil, errBoom
+						}
+						return &svcsdk.TagResourceOutput{}, nil
+					},
+				},
+				cr: identityProviderConfig(withTags(map[string]string{"new": "tag"})),
+			},
+			want: want{
+				cr: identityProviderConfig(withTags(map[string]string{"new": "tag"})),
+			},
+		},
+		"FailedTag": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *svcsdk.DescribeIdentityProviderConfigInput) (*svcsdk.DescribeIdentityProviderConfigOutput, error) {
+						return describeOutput(svcsdk.ConfigStatusActive, nil), nil
+					},
+					MockTagResource: func(_ *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identityProviderConfig(withTags(map[string]string{"new": "tag"})),
+			},
+			want: want{
+				cr:  identityProviderConfig(withTags(map[string]string{"new": "tag"})),
+				err: awsclient.Wrap(errBoom, errAddTagsFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IdentityProviderConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDisassociateIdentityProviderConfig: func(_ *svcsdk.DisassociateIdentityProviderConfigInput) (*svcsdk.DisassociateIdentityProviderConfigOutput, error) {
+						return &svcsdk.DisassociateIdentityProviderConfigOutput{}, nil
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr: identityProviderConfig(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{},
+				cr:  identityProviderConfig(withStatus(v1alpha1.IdentityProviderConfigStatusDeleting)),
+			},
+			want: want{
+				cr: identityProviderConfig(withStatus(v1alpha1.IdentityProviderConfigStatusDeleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDisassociateIdentityProviderConfig: func(_ *svcsdk.DisassociateIdentityProviderConfigInput) (*svcsdk.DisassociateIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr:  identityProviderConfig(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IdentityProviderConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   identityProviderConfig(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: identityProviderConfig(withTags(resource.GetExternalTags(identityProviderConfig()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:   identityProviderConfig(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,12 @@ package iamaccesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,6 +48,10 @@ const (
 	errCreate           = "failed to create the IAMAccessKey resource"
 	errDelete           = "failed to delete the IAMAccessKey resource"
 	errUpdate           = "failed to update the IAMAccessKey resource"
+	errRotate           = "failed to rotate the IAMAccessKey resource"
+	errRetire           = "failed to retire a rotated access key"
+	errKubeUpdate       = "failed to update the IAMAccessKey custom resource"
+	errRetiredKeys      = "failed to read the retired access keys of the IAMAccessKey resource"
 )
 
 // SetupIAMAccessKey adds a controller that reconciles IAMAccessKeys.
@@ -98,15 +104,15 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil || len(keys.AccessKeyMetadata) == 0 {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
 	}
-	found := false
-	var accessKey awsiam.AccessKeyMetadata
-	for _, key := range keys.AccessKeyMetadata {
+	var accessKey *awsiam.AccessKeyMetadata
+	existing := map[string]awsiam.StatusType{}
+	for i, key := range keys.AccessKeyMetadata {
+		existing[aws.StringValue(key.AccessKeyId)] = key.Status
 		if aws.StringValue(key.AccessKeyId) == meta.GetExternalName(cr) {
-			found = true
-			accessKey = key
+			accessKey = &keys.AccessKeyMetadata[i]
 		}
 	}
-	if !found {
+	if accessKey == nil {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 	switch accessKey.Status {
@@ -117,10 +123,48 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 	current := cr.Spec.ForProvider.Status
 	cr.Spec.ForProvider.Status = awsclient.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
+	lateInitialized := current != cr.Spec.ForProvider.Status
+
+	recorded, err := iam.GetRetiredAccessKeys(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRetiredKeys)
+	}
+	now := time.Now()
+	policy := cr.Spec.ForProvider.RotationPolicy
+	upToDate := string(accessKey.Status) == cr.Spec.ForProvider.Status
+	var retired []v1alpha1.RetiredAccessKey
+	for _, k := range recorded {
+		status, ok := existing[k.AccessKeyID]
+		if !ok {
+			continue
+		}
+		retired = append(retired, k)
+		if iam.GetRetiredAccessKeyAction(policy, k, status, now) != iam.RetiredAccessKeyKeep {
+			upToDate = false
+		}
+	}
+	// Access keys that were deleted are forgotten. Reporting the change as a
+	// late initialization persists the annotation.
+	if len(retired) != len(recorded) {
+		if err := iam.SetRetiredAccessKeys(cr, retired); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRetiredKeys)
+		}
+		lateInitialized = true
+	}
+	cr.Status.AtProvider.RetiredAccessKeys = retired
+	if accessKey.CreateDate != nil {
+		t := metav1.NewTime(*accessKey.CreateDate)
+		cr.Status.AtProvider.CreateDate = &t
+	}
+	// IAM users can have at most two access keys, so the access key is not
+	// rotated again until the previously rotated one is deleted.
+	if accessKey.CreateDate != nil && len(retired) == 0 && iam.IsRotationDue(policy, *accessKey.CreateDate, now) {
+		upToDate = false
+	}
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
-		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
@@ -157,8 +201,102 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Status:      awsiam.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+
+	now := time.Now()
+	if err := e.retire(ctx, cr, now); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	created := cr.Status.AtProvider.CreateDate
+	if created == nil || len(cr.Status.AtProvider.RetiredAccessKeys) != 0 || !iam.IsRotationDue(cr.Spec.ForProvider.RotationPolicy, created.Time, now) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return e.rotate(ctx, cr, now)
+}
+
+// retire deactivates and deletes the rotated access keys whose grace period
+// has passed.
+func (e *external) retire(ctx context.Context, cr *v1alpha1.IAMAccessKey, now time.Time) error {
+	if len(cr.Status.AtProvider.RetiredAccessKeys) == 0 {
+		return nil
+	}
+	keys, err := e.client.ListAccessKeysRequest(&awsiam.ListAccessKeysInput{UserName: aws.String(cr.Spec.ForProvider.IAMUsername)}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(err, errList)
+	}
+	existing := map[string]awsiam.StatusType{}
+	for _, key := range keys.AccessKeyMetadata {
+		existing[aws.StringValue(key.AccessKeyId)] = key.Status
+	}
+	var remaining []v1alpha1.RetiredAccessKey
+	for _, k := range cr.Status.AtProvider.RetiredAccessKeys {
+		status, ok := existing[k.AccessKeyID]
+		if !ok {
+			continue
+		}
+		switch iam.GetRetiredAccessKeyAction(cr.Spec.ForProvider.RotationPolicy, k, status, now) {
+		case iam.RetiredAccessKeyDeactivate:
+			if _, err := e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
+				AccessKeyId: aws.String(k.AccessKeyID),
+				Status:      awsiam.StatusTypeInactive,
+				UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+			}).Send(ctx); err != nil {
+				return awsclient.Wrap(err, errRetire)
+			}
+		case iam.RetiredAccessKeyDelete:
+			if _, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+				AccessKeyId: aws.String(k.AccessKeyID),
+				UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+			}).Send(ctx); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+				return awsclient.Wrap(err, errRetire)
+			}
+			continue
+		case iam.RetiredAccessKeyKeep:
+		}
+		remaining = append(remaining, k)
+	}
+	cr.Status.AtProvider.RetiredAccessKeys = remaining
+	return nil
+}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+// rotate replaces the current access key with a new one and publishes the new
+// one as the connection details. The replaced access key is recorded in an
+// annotation so that it can be retired once its grace period has passed.
+func (e *external) rotate(ctx context.Context, cr *v1alpha1.IAMAccessKey, now time.Time) (managed.ExternalUpdate, error) {
+	response, err := e.client.CreateAccessKeyRequest(&awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.IAMUsername)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errRotate)
+	}
+	old := meta.GetExternalName(cr)
+	retired := append(cr.Status.AtProvider.RetiredAccessKeys, v1alpha1.RetiredAccessKey{AccessKeyID: old, RotatedAt: metav1.NewTime(now)})
+	meta.SetExternalName(cr, aws.StringValue(response.AccessKey.AccessKeyId))
+	if err := iam.SetRetiredAccessKeys(cr, retired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotate)
+	}
+	// The new external name and the replaced access key have to be persisted
+	// together before anything else, since either access key would be leaked
+	// otherwise. Updating the object resets its status, so it is set
+	// afterwards.
+	if err := e.kube.Update(ctx, cr); err != nil {
+		_, _ = e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+			AccessKeyId: response.AccessKey.AccessKeyId,
+			UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+		}).Send(ctx)
+		meta.SetExternalName(cr, old)
+		_ = iam.SetRetiredAccessKeys(cr, cr.Status.AtProvider.RetiredAccessKeys)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdate)
+	}
+	cr.Status.AtProvider.RetiredAccessKeys = retired
+	if response.AccessKey.CreateDate != nil {
+		t := metav1.NewTime(*response.AccessKey.CreateDate)
+		cr.Status.AtProvider.CreateDate = &t
+	}
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(response.AccessKey.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(response.AccessKey.SecretAccessKey)),
+	}}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -169,7 +307,21 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
-	_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+	retired, err := iam.GetRetiredAccessKeys(cr)
+	if err != nil {
+		return errors.Wrap(err, errRetiredKeys)
+	}
+	for _, k := range retired {
+		_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+			UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+			AccessKeyId: aws.String(k.AccessKeyID),
+		}).Send(ctx)
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errDelete)
+		}
+	}
+
+	_, err = e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
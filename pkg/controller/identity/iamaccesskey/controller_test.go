@@ -20,12 +20,15 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -48,6 +51,8 @@ var (
 	inactiveStatus = awsiam.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newKeyID       = "newKeyID"
+	monthAgo       = time.Now().Add(-30 * 24 * time.Hour)
 
 	errBoom = errors.New("boom")
 )
@@ -82,6 +87,26 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withRotationPolicy(period time.Duration) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		r.Spec.ForProvider.RotationPolicy = &v1alpha1.AccessKeyRotationPolicy{RotationPeriod: metav1.Duration{Duration: period}}
+	}
+}
+
+func withCreateDate(t time.Time) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		mt := metav1.NewTime(t)
+		r.Status.AtProvider.CreateDate = &mt
+	}
+}
+
+func withRetiredAccessKeys(k ...v1alpha1.RetiredAccessKey) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		_ = iam.SetRetiredAccessKeys(r, k)
+		r.Status.AtProvider.RetiredAccessKeys = k
+	}
+}
+
 func accesskey(m ...accessModifier) *v1alpha1.IAMAccessKey {
 	cr := &v1alpha1.IAMAccessKey{}
 	for _, f := range m {
@@ -157,6 +182,67 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDue": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+									CreateDate:  &monthAgo,
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotationPolicy(24*time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour),
+					withCreateDate(monthAgo),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RetiredAccessKeyDeleted": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRetiredAccessKeys(v1alpha1.RetiredAccessKey{AccessKeyID: "old", RotatedAt: metav1.NewTime(monthAgo)})),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
 		"ValidInputNotExists": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -392,9 +478,21 @@ func TestDelete(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			// The retired access keys are compared without their rotation
+			// time, which is part of the annotation.
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreTypes(metav1.Time{}),
+				cmpopts.IgnoreMapEntries(func(k, _ string) bool { return k == v1alpha1.AnnotationKeyRetiredAccessKeys })); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if cr, ok := tc.args.cr.(*v1alpha1.IAMAccessKey); ok {
+				retired, err := iam.GetRetiredAccessKeys(cr)
+				if err != nil {
+					t.Errorf("GetRetiredAccessKeys: %s", err)
+				}
+				if diff := cmp.Diff(cr.Status.AtProvider.RetiredAccessKeys, retired, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(metav1.Time{})); diff != "" {
+					t.Errorf("retired access keys: -status, +annotation:\n%s", diff)
+				}
+			}
 		})
 	}
 }
@@ -434,6 +532,79 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+								AccessKey: &awsiam.AccessKey{
+									AccessKeyId:     aws.String(newKeyID),
+									SecretAccessKey: aws.String(secretKeyID),
+									Status:          activeStatus,
+									UserName:        aws.String(userName),
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour), withCreateDate(monthAgo)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour), withCreateDate(monthAgo),
+					withRetiredAccessKeys(v1alpha1.RetiredAccessKey{AccessKeyID: accessKeyID})),
+				update: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(newKeyID),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+				}},
+			},
+		},
+		"RotateKubeUpdateError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+								AccessKey: &awsiam.AccessKey{
+									AccessKeyId:     aws.String(newKeyID),
+									SecretAccessKey: aws.String(secretKeyID),
+								},
+							}},
+						}
+					},
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour), withCreateDate(monthAgo)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour), withCreateDate(monthAgo)),
+				err: errors.Wrap(errBoom, errKubeUpdate),
+			},
+		},
 		"ClientError": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -454,7 +625,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -463,9 +634,21 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.update, update, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			// The retired access keys are compared without their rotation
+			// time, which is part of the annotation.
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreTypes(metav1.Time{}),
+				cmpopts.IgnoreMapEntries(func(k, _ string) bool { return k == v1alpha1.AnnotationKeyRetiredAccessKeys })); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if cr, ok := tc.args.cr.(*v1alpha1.IAMAccessKey); ok {
+				retired, err := iam.GetRetiredAccessKeys(cr)
+				if err != nil {
+					t.Errorf("GetRetiredAccessKeys: %s", err)
+				}
+				if diff := cmp.Diff(cr.Status.AtProvider.RetiredAccessKeys, retired, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(metav1.Time{})); diff != "" {
+					t.Errorf("retired access keys: -status, +annotation:\n%s", diff)
+				}
+			}
 		})
 	}
 }
@@ -48,6 +48,8 @@ const (
 	errUpdate           = "failed to update the IAM Group resource"
 	errSDK              = "empty IAM Group received from IAM API"
 
+	errInlinePolicies = "failed to update the inline policies of the IAM Group resource"
+
 	errKubeUpdateFailed = "cannot late initialize IAM Group"
 )
 
@@ -123,10 +125,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		GroupID: aws.StringValue(group.GroupId),
 	}
 
+	upToDate := aws.StringValue(cr.Spec.ForProvider.Path) == aws.StringValue(group.Path) &&
+		meta.GetExternalName(cr) == aws.StringValue(group.GroupName)
+	if upToDate && cr.Spec.ForProvider.InlinePolicies != nil {
+		policies, err := iam.ObserveInlinePolicies(ctx, iam.NewGroupInlinePolicies(e.client, meta.GetExternalName(cr)))
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if upToDate, err = iam.IsInlinePoliciesUpToDate(cr.Spec.ForProvider.InlinePolicies, policies); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errInlinePolicies)
+		}
+	}
+
 	return managed.ExternalObservation{
-		ResourceExists: true,
-		ResourceUpToDate: aws.StringValue(cr.Spec.ForProvider.Path) == aws.StringValue(group.Path) &&
-			meta.GetExternalName(cr) == aws.StringValue(group.GroupName),
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
@@ -151,11 +164,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	_, err := e.client.UpdateGroupRequest(&awsiam.UpdateGroupInput{
+		GroupName:    aws.String(meta.GetExternalName(cr)),
 		NewPath:      cr.Spec.ForProvider.Path,
 		NewGroupName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	err = iam.UpdateInlinePolicies(ctx, iam.NewGroupInlinePolicies(e.client, meta.GetExternalName(cr)), cr.Spec.ForProvider.InlinePolicies)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errInlinePolicies)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -166,6 +184,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if cr.Spec.ForProvider.InlinePolicies != nil {
+		if err := iam.DeleteInlinePolicies(ctx, iam.NewGroupInlinePolicies(e.client, meta.GetExternalName(cr))); err != nil {
+			return errors.Wrap(err, errDelete)
+		}
+	}
+
 	_, err := e.client.DeleteGroupRequest(&awsiam.DeleteGroupInput{
 		GroupName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
@@ -19,6 +19,7 @@ package iamgroup
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -43,6 +44,17 @@ var (
 	unexpecedItem resource.Managed
 	groupName     = "some group"
 
+	policy = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			"Effect": "Allow",
+			"Action": "s3:ListBucket",
+			"Resource": "*"
+		  }
+		]
+	   }`
+
 	errBoom = errors.New("boom")
 )
 
@@ -69,6 +81,10 @@ func withGroupPath(groupPath string) groupModifier {
 	return func(r *v1alpha1.IAMGroup) { r.Spec.ForProvider.Path = &groupPath }
 }
 
+func withInlinePolicies(p map[string]string) groupModifier {
+	return func(r *v1alpha1.IAMGroup) { r.Spec.ForProvider.InlinePolicies = p }
+}
+
 func group(m ...groupModifier) *v1alpha1.IAMGroup {
 	cr := &v1alpha1.IAMGroup{}
 	for _, f := range m {
@@ -116,6 +132,80 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InlinePolicyUpToDate": {
+			args: args{
+				iam: &fake.MockGroupClient{
+					MockGetGroup: func(input *awsiam.GetGroupInput) awsiam.GetGroupRequest {
+						return awsiam.GetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetGroupOutput{
+								Group: &awsiam.Group{GroupName: aws.String(groupName), Path: aws.String(groupPath)},
+							}},
+						}
+					},
+					MockListGroupPolicies: func(input *awsiam.ListGroupPoliciesInput) awsiam.ListGroupPoliciesRequest {
+						return awsiam.ListGroupPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListGroupPoliciesOutput{
+								PolicyNames: []string{"s3"},
+							}},
+						}
+					},
+					MockGetGroupPolicy: func(input *awsiam.GetGroupPolicyInput) awsiam.GetGroupPolicyRequest {
+						return awsiam.GetGroupPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetGroupPolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+				},
+				cr: group(withExternalName(groupName), withGroupPath(groupPath), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withGroupPath(groupPath),
+					withInlinePolicies(map[string]string{"s3": policy}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InlinePolicyNotUpToDate": {
+			args: args{
+				iam: &fake.MockGroupClient{
+					MockGetGroup: func(input *awsiam.GetGroupInput) awsiam.GetGroupRequest {
+						return awsiam.GetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetGroupOutput{
+								Group: &awsiam.Group{GroupName: aws.String(groupName), Path: aws.String(groupPath)},
+							}},
+						}
+					},
+					MockListGroupPolicies: func(input *awsiam.ListGroupPoliciesInput) awsiam.ListGroupPoliciesRequest {
+						return awsiam.ListGroupPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListGroupPoliciesOutput{
+								PolicyNames: []string{"unknown"},
+							}},
+						}
+					},
+					MockGetGroupPolicy: func(input *awsiam.GetGroupPolicyInput) awsiam.GetGroupPolicyRequest {
+						return awsiam.GetGroupPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetGroupPolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+				},
+				cr: group(withExternalName(groupName), withGroupPath(groupPath), withInlinePolicies(map[string]string{})),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withGroupPath(groupPath),
+					withInlinePolicies(map[string]string{}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -260,6 +350,72 @@ func TestUpdate(t *testing.T) {
 				cr: group(withExternalName(groupName)),
 			},
 		},
+		"InlinePolicies": {
+			args: args{
+				iam: &fake.MockGroupClient{
+					MockUpdateGroup: func(input *awsiam.UpdateGroupInput) awsiam.UpdateGroupRequest {
+						return awsiam.UpdateGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateGroupOutput{}},
+						}
+					},
+					MockListGroupPolicies: func(input *awsiam.ListGroupPoliciesInput) awsiam.ListGroupPoliciesRequest {
+						return awsiam.ListGroupPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListGroupPoliciesOutput{
+								PolicyNames: []string{"stale"},
+							}},
+						}
+					},
+					MockGetGroupPolicy: func(input *awsiam.GetGroupPolicyInput) awsiam.GetGroupPolicyRequest {
+						return awsiam.GetGroupPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetGroupPolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+					MockPutGroupPolicy: func(input *awsiam.PutGroupPolicyInput) awsiam.PutGroupPolicyRequest {
+						if aws.StringValue(input.PolicyName) != "s3" || aws.StringValue(input.PolicyDocument) != policy {
+							return awsiam.PutGroupPolicyRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom}}
+						}
+						return awsiam.PutGroupPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.PutGroupPolicyOutput{}},
+						}
+					},
+					MockDeleteGroupPolicy: func(input *awsiam.DeleteGroupPolicyInput) awsiam.DeleteGroupPolicyRequest {
+						if aws.StringValue(input.PolicyName) != "stale" {
+							return awsiam.DeleteGroupPolicyRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom}}
+						}
+						return awsiam.DeleteGroupPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteGroupPolicyOutput{}},
+						}
+					},
+				},
+				cr: group(withExternalName(groupName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+		},
+		"InlinePolicyError": {
+			args: args{
+				iam: &fake.MockGroupClient{
+					MockUpdateGroup: func(input *awsiam.UpdateGroupInput) awsiam.UpdateGroupRequest {
+						return awsiam.UpdateGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateGroupOutput{}},
+						}
+					},
+					MockListGroupPolicies: func(input *awsiam.ListGroupPoliciesInput) awsiam.ListGroupPoliciesRequest {
+						return awsiam.ListGroupPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withExternalName(groupName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+			want: want{
+				cr:  group(withExternalName(groupName), withInlinePolicies(map[string]string{"s3": policy})),
+				err: errors.Wrap(awsclient.Wrap(errBoom, "cannot list inline policies"), errInlinePolicies),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -50,6 +50,8 @@ const (
 	errSDK              = "empty IAMRole received from IAM API"
 	errCreatePatch      = "failed to create patch object for comparison"
 
+	errInlinePolicies = "failed to update the inline policies of the IAMRole resource"
+
 	errKubeUpdateFailed = "cannot late initialize IAMRole"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
 )
@@ -127,6 +129,16 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	if upToDate && cr.Spec.ForProvider.InlinePolicies != nil {
+		policies, err := iam.ObserveInlinePolicies(ctx, iam.NewRoleInlinePolicies(e.client, meta.GetExternalName(cr)))
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if upToDate, err = iam.IsInlinePoliciesUpToDate(cr.Spec.ForProvider.InlinePolicies, policies); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
@@ -205,7 +217,9 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+
+	err = iam.UpdateInlinePolicies(ctx, iam.NewRoleInlinePolicies(e.client, meta.GetExternalName(cr)), cr.Spec.ForProvider.InlinePolicies)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errInlinePolicies)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -216,6 +230,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if cr.Spec.ForProvider.InlinePolicies != nil {
+		if err := iam.DeleteInlinePolicies(ctx, iam.NewRoleInlinePolicies(e.client, meta.GetExternalName(cr))); err != nil {
+			return errors.Wrap(err, errDelete)
+		}
+	}
+
 	_, err := e.client.DeleteRoleRequest(&awsiam.DeleteRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
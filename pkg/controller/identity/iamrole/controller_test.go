@@ -19,6 +19,7 @@ package iamrole
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -91,6 +92,12 @@ func withDescription() roleModifier {
 	}
 }
 
+func withInlinePolicies(p map[string]string) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.InlinePolicies = p
+	}
+}
+
 func role(m ...roleModifier) *v1beta1.IAMRole {
 	cr := &v1beta1.IAMRole{}
 	for _, f := range m {
@@ -134,6 +141,44 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InlinePolicyNotUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockListRolePoliciesRequest: func(input *awsiam.ListRolePoliciesInput) awsiam.ListRolePoliciesRequest {
+						return awsiam.ListRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListRolePoliciesOutput{
+								PolicyNames: []string{"unknown"},
+							}},
+						}
+					},
+					MockGetRolePolicyRequest: func(input *awsiam.GetRolePolicyInput) awsiam.GetRolePolicyRequest {
+						return awsiam.GetRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRolePolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{})),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInlinePolicies(map[string]string{}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -50,6 +50,8 @@ const (
 	errUpdate = "cannot update the IAM User resource"
 	errSDK    = "empty IAM User received from IAM API"
 
+	errInlinePolicies = "cannot update the inline policies of the IAM User resource"
+
 	errKubeUpdateFailed = "cannot late initialize IAM User"
 )
 
@@ -123,9 +125,20 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		UserID: aws.StringValue(user.UserId),
 	}
 
+	upToDate := aws.StringValue(cr.Spec.ForProvider.Path) == aws.StringValue(user.Path)
+	if upToDate && cr.Spec.ForProvider.InlinePolicies != nil {
+		policies, err := iam.ObserveInlinePolicies(ctx, iam.NewUserInlinePolicies(e.client, meta.GetExternalName(cr)))
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if upToDate, err = iam.IsInlinePoliciesUpToDate(cr.Spec.ForProvider.InlinePolicies, policies); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errInlinePolicies)
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: aws.StringValue(cr.Spec.ForProvider.Path) == aws.StringValue(user.Path),
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
@@ -156,8 +169,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		NewPath:  cr.Spec.ForProvider.Path,
 		UserName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	err = iam.UpdateInlinePolicies(ctx, iam.NewUserInlinePolicies(e.client, meta.GetExternalName(cr)), cr.Spec.ForProvider.InlinePolicies)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errInlinePolicies)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -168,6 +185,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if cr.Spec.ForProvider.InlinePolicies != nil {
+		if err := iam.DeleteInlinePolicies(ctx, iam.NewUserInlinePolicies(e.client, meta.GetExternalName(cr))); err != nil {
+			return errors.Wrap(err, errDelete)
+		}
+	}
+
 	_, err := e.client.DeleteUserRequest(&awsiam.DeleteUserInput{
 		UserName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
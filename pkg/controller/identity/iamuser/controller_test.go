@@ -19,6 +19,7 @@ package iamuser
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -43,6 +44,17 @@ var (
 	unexpecedItem resource.Managed
 	userName      = "some user"
 
+	policy = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			"Effect": "Allow",
+			"Action": "s3:ListBucket",
+			"Resource": "*"
+		  }
+		]
+	   }`
+
 	errBoom = errors.New("boom")
 )
 
@@ -61,6 +73,10 @@ func withExternalName(name string) userModifier {
 	return func(r *v1alpha1.IAMUser) { meta.SetExternalName(r, name) }
 }
 
+func withInlinePolicies(p map[string]string) userModifier {
+	return func(r *v1alpha1.IAMUser) { r.Spec.ForProvider.InlinePolicies = p }
+}
+
 func user(m ...userModifier) *v1alpha1.IAMUser {
 	cr := &v1alpha1.IAMUser{}
 	for _, f := range m {
@@ -103,6 +119,80 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InlinePolicyUpToDate": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(input *awsiam.GetUserInput) awsiam.GetUserRequest {
+						return awsiam.GetUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetUserOutput{
+								User: &awsiam.User{},
+							}},
+						}
+					},
+					MockListUserPolicies: func(input *awsiam.ListUserPoliciesInput) awsiam.ListUserPoliciesRequest {
+						return awsiam.ListUserPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListUserPoliciesOutput{
+								PolicyNames: []string{"s3"},
+							}},
+						}
+					},
+					MockGetUserPolicy: func(input *awsiam.GetUserPolicyInput) awsiam.GetUserPolicyRequest {
+						return awsiam.GetUserPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetUserPolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withInlinePolicies(map[string]string{"s3": policy}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InlinePolicyNotUpToDate": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(input *awsiam.GetUserInput) awsiam.GetUserRequest {
+						return awsiam.GetUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetUserOutput{
+								User: &awsiam.User{},
+							}},
+						}
+					},
+					MockListUserPolicies: func(input *awsiam.ListUserPoliciesInput) awsiam.ListUserPoliciesRequest {
+						return awsiam.ListUserPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListUserPoliciesOutput{
+								PolicyNames: []string{"unknown"},
+							}},
+						}
+					},
+					MockGetUserPolicy: func(input *awsiam.GetUserPolicyInput) awsiam.GetUserPolicyRequest {
+						return awsiam.GetUserPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetUserPolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withInlinePolicies(map[string]string{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withInlinePolicies(map[string]string{}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -249,6 +339,72 @@ func TestUpdate(t *testing.T) {
 				cr: user(withExternalName(userName)),
 			},
 		},
+		"InlinePolicies": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockUpdateUser: func(input *awsiam.UpdateUserInput) awsiam.UpdateUserRequest {
+						return awsiam.UpdateUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateUserOutput{}},
+						}
+					},
+					MockListUserPolicies: func(input *awsiam.ListUserPoliciesInput) awsiam.ListUserPoliciesRequest {
+						return awsiam.ListUserPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListUserPoliciesOutput{
+								PolicyNames: []string{"stale"},
+							}},
+						}
+					},
+					MockGetUserPolicy: func(input *awsiam.GetUserPolicyInput) awsiam.GetUserPolicyRequest {
+						return awsiam.GetUserPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetUserPolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(policy)),
+							}},
+						}
+					},
+					MockPutUserPolicy: func(input *awsiam.PutUserPolicyInput) awsiam.PutUserPolicyRequest {
+						if aws.StringValue(input.PolicyName) != "s3" || aws.StringValue(input.PolicyDocument) != policy {
+							return awsiam.PutUserPolicyRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom}}
+						}
+						return awsiam.PutUserPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.PutUserPolicyOutput{}},
+						}
+					},
+					MockDeleteUserPolicy: func(input *awsiam.DeleteUserPolicyInput) awsiam.DeleteUserPolicyRequest {
+						if aws.StringValue(input.PolicyName) != "stale" {
+							return awsiam.DeleteUserPolicyRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom}}
+						}
+						return awsiam.DeleteUserPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteUserPolicyOutput{}},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+		},
+		"InlinePolicyError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockUpdateUser: func(input *awsiam.UpdateUserInput) awsiam.UpdateUserRequest {
+						return awsiam.UpdateUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateUserOutput{}},
+						}
+					},
+					MockListUserPolicies: func(input *awsiam.ListUserPoliciesInput) awsiam.ListUserPoliciesRequest {
+						return awsiam.ListUserPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withInlinePolicies(map[string]string{"s3": policy})),
+			},
+			want: want{
+				cr:  user(withExternalName(userName), withInlinePolicies(map[string]string{"s3": policy})),
+				err: errors.Wrap(awsclient.Wrap(errBoom, "cannot list inline policies"), errInlinePolicies),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamuserloginprofile
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	// ConnectionSecretEncryptedPasswordKey is the connection secret key of
+	// the PGP encrypted password.
+	ConnectionSecretEncryptedPasswordKey = "encryptedPassword"
+	// ConnectionSecretKeyFingerprintKey is the connection secret key of the
+	// fingerprint of the PGP key the password was encrypted with.
+	ConnectionSecretKeyFingerprintKey = "keyFingerprint"
+
+	errUnexpectedObject = "The managed resource is not an IAMUserLoginProfile resource"
+	errGet              = "failed to get the IAMUserLoginProfile resource"
+	errCreate           = "failed to create the IAMUserLoginProfile resource"
+	errDelete           = "failed to delete the IAMUserLoginProfile resource"
+	errUpdate           = "failed to update the IAMUserLoginProfile resource"
+	errPassword         = "failed to generate the password"
+	errKubeUpdateFailed = "cannot late initialize IAMUserLoginProfile"
+)
+
+// SetupIAMUserLoginProfile adds a controller that reconciles
+// IAMUserLoginProfiles.
+func SetupIAMUserLoginProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.IAMUserLoginProfileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.IAMUserLoginProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IAMUserLoginProfileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewLoginProfileClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.LoginProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.LoginProfileClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.IAMUserLoginProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.GetLoginProfileRequest(&awsiam.GetLoginProfileInput{UserName: aws.String(cr.Spec.ForProvider.UserName)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if res.LoginProfile == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	profile := *res.LoginProfile
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeLoginProfile(&cr.Spec.ForProvider, &profile)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+	if profile.CreateDate != nil {
+		t := metav1.NewTime(*profile.CreateDate)
+		cr.Status.AtProvider.CreateDate = &t
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsLoginProfileUpToDate(cr.Spec.ForProvider, profile),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.IAMUserLoginProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	length := iam.DefaultLoginProfilePasswordLength
+	if cr.Spec.ForProvider.PasswordLength != nil {
+		length = *cr.Spec.ForProvider.PasswordLength
+	}
+	pw, err := iam.GenerateLoginProfilePassword(length)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+	}
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(cr.Spec.ForProvider.UserName),
+	}
+	// The password is encrypted before the login profile is created so that
+	// a malformed key does not leave a login profile with an unknown
+	// password behind.
+	if cr.Spec.ForProvider.PGPKey != nil {
+		encrypted, fingerprint, err := iam.EncryptLoginProfilePassword(pw, aws.StringValue(cr.Spec.ForProvider.PGPKey))
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+		}
+		conn[ConnectionSecretEncryptedPasswordKey] = []byte(encrypted)
+		conn[ConnectionSecretKeyFingerprintKey] = []byte(fingerprint)
+	} else {
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
+
+	_, err = e.client.CreateLoginProfileRequest(&awsiam.CreateLoginProfileInput{
+		UserName:              aws.String(cr.Spec.ForProvider.UserName),
+		Password:              aws.String(pw),
+		PasswordResetRequired: cr.Spec.ForProvider.PasswordResetRequired,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.IAMUserLoginProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.UpdateLoginProfileRequest(&awsiam.UpdateLoginProfileInput{
+		UserName:              aws.String(cr.Spec.ForProvider.UserName),
+		PasswordResetRequired: cr.Spec.ForProvider.PasswordResetRequired,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.IAMUserLoginProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteLoginProfileRequest(&awsiam.DeleteLoginProfileInput{UserName: aws.String(cr.Spec.ForProvider.UserName)}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
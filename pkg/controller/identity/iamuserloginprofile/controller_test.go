@@ -0,0 +1,394 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamuserloginprofile
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	userName       = "some-user"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  iam.LoginProfileClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type profileModifier func(*v1alpha1.IAMUserLoginProfile)
+
+func withConditions(c ...xpv1.Condition) profileModifier {
+	return func(r *v1alpha1.IAMUserLoginProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPasswordResetRequired(b bool) profileModifier {
+	return func(r *v1alpha1.IAMUserLoginProfile) { r.Spec.ForProvider.PasswordResetRequired = aws.Bool(b) }
+}
+
+func profile(m ...profileModifier) *v1alpha1.IAMUserLoginProfile {
+	cr := &v1alpha1.IAMUserLoginProfile{}
+	cr.Spec.ForProvider.UserName = userName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getLoginProfile(p *awsiam.LoginProfile, err error) func(*awsiam.GetLoginProfileInput) awsiam.GetLoginProfileRequest {
+	return func(*awsiam.GetLoginProfileInput) awsiam.GetLoginProfileRequest {
+		return awsiam.GetLoginProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsiam.GetLoginProfileOutput{LoginProfile: p}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfileRequest: getLoginProfile(&awsiam.LoginProfile{UserName: aws.String(userName), PasswordResetRequired: aws.Bool(true)}, nil),
+				},
+				cr: profile(withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr:     profile(withPasswordResetRequired(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfileRequest: getLoginProfile(&awsiam.LoginProfile{UserName: aws.String(userName), PasswordResetRequired: aws.Bool(false)}, nil),
+				},
+				cr: profile(withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr:     profile(withPasswordResetRequired(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfileRequest: getLoginProfile(&awsiam.LoginProfile{UserName: aws.String(userName), PasswordResetRequired: aws.Bool(true)}, nil),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   profile(),
+			},
+			want: want{
+				cr:     profile(withPasswordResetRequired(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfileRequest: getLoginProfile(nil, awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)),
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr: profile(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfileRequest: getLoginProfile(nil, errBoom),
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr:  profile(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr       resource.Managed
+		password bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfileRequest: func(input *awsiam.CreateLoginProfileInput) awsiam.CreateLoginProfileRequest {
+						return awsiam.CreateLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateLoginProfileOutput{}},
+						}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr:       profile(withConditions(xpv1.Creating())),
+				password: true,
+			},
+		},
+		"InvalidPGPKey": {
+			args: args{
+				cr: profile(func(r *v1alpha1.IAMUserLoginProfile) { r.Spec.ForProvider.PGPKey = aws.String("!") }),
+			},
+			want: want{
+				cr: profile(withConditions(xpv1.Creating()), func(r *v1alpha1.IAMUserLoginProfile) { r.Spec.ForProvider.PGPKey = aws.String("!") }),
+				err: errors.Wrap(errors.Wrap(errors.New("illegal base64 data at input byte 0"), "cannot decode base64 encoded PGP key"),
+					errPassword),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfileRequest: func(input *awsiam.CreateLoginProfileInput) awsiam.CreateLoginProfileRequest {
+						return awsiam.CreateLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr:  profile(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.password, len(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) == iam.DefaultLoginProfilePasswordLength); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfileRequest: func(input *awsiam.UpdateLoginProfileInput) awsiam.UpdateLoginProfileRequest {
+						return awsiam.UpdateLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateLoginProfileOutput{}},
+						}
+					},
+				},
+				cr: profile(withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: profile(withPasswordResetRequired(true)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfileRequest: func(input *awsiam.UpdateLoginProfileInput) awsiam.UpdateLoginProfileRequest {
+						return awsiam.UpdateLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr:  profile(),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfileRequest: func(input *awsiam.DeleteLoginProfileInput) awsiam.DeleteLoginProfileRequest {
+						return awsiam.DeleteLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteLoginProfileOutput{}},
+						}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr: profile(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfileRequest: func(input *awsiam.DeleteLoginProfileInput) awsiam.DeleteLoginProfileRequest {
+						return awsiam.DeleteLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr: profile(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfileRequest: func(input *awsiam.DeleteLoginProfileInput) awsiam.DeleteLoginProfileRequest {
+						return awsiam.DeleteLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr:  profile(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an InstanceProfile resource"
+	errGet              = "failed to get InstanceProfile with name"
+	errCreate           = "failed to create the InstanceProfile resource"
+	errDelete           = "failed to delete the InstanceProfile resource"
+	errAddRole          = "failed to add the role to the InstanceProfile resource"
+	errRemoveRole       = "failed to remove a role from the InstanceProfile resource"
+	errSDK              = "empty InstanceProfile received from IAM API"
+
+	errKubeUpdateFailed = "cannot late initialize InstanceProfile"
+	errResolveRoleName  = "cannot resolve spec.forProvider.roleName"
+	errUpdateCR         = "cannot update the InstanceProfile custom resource"
+)
+
+// SetupInstanceProfile adds a controller that reconciles InstanceProfiles.
+func SetupInstanceProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.InstanceProfileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.InstanceProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceProfileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewInstanceProfileClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithReferenceResolver(&referenceResolver{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// referenceResolver resolves the reference to the IAMRole of an
+// InstanceProfile. It lives here rather than in the API package because
+// v1beta1, which holds the IAMRole type, already imports v1alpha1.
+type referenceResolver struct {
+	kube client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	existing := cr.DeepCopy()
+
+	rsp, err := reference.NewAPIResolver(r.kube, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.RoleName),
+		Reference:    cr.Spec.ForProvider.RoleNameRef,
+		Selector:     cr.Spec.ForProvider.RoleNameSelector,
+		To:           reference.To{Managed: &v1beta1.IAMRole{}, List: &v1beta1.IAMRoleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errResolveRoleName)
+	}
+	cr.Spec.ForProvider.RoleName = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.RoleNameRef = rsp.ResolvedReference
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errUpdateCR)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.InstanceProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.InstanceProfileClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if observed.InstanceProfile == nil {
+		return managed.ExternalObservation{}, errors.New(errSDK)
+	}
+
+	profile := *observed.InstanceProfile
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeInstanceProfile(&cr.Spec.ForProvider, &profile)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider = iam.GenerateInstanceProfileObservation(profile)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsInstanceProfileUpToDate(cr.Spec.ForProvider, profile),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateInstanceProfileRequest(&awsiam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+		Path:                cr.Spec.ForProvider.Path,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	if cr.Spec.ForProvider.RoleName == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err = e.client.AddRoleToInstanceProfileRequest(&awsiam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+		RoleName:            cr.Spec.ForProvider.RoleName,
+	}).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errAddRole)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if observed.InstanceProfile == nil {
+		return managed.ExternalUpdate{}, errors.New(errSDK)
+	}
+
+	// An instance profile can contain only one role, so the current one has
+	// to be removed before the desired one can be added.
+	add, remove := iam.DiffInstanceProfileRoles(cr.Spec.ForProvider, *observed.InstanceProfile)
+	if err := e.removeRoles(ctx, cr, remove); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if add == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.AddRoleToInstanceProfileRequest(&awsiam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+		RoleName:            add,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddRole)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	observed, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	// IAM refuses to delete an instance profile that still contains a role.
+	if observed.InstanceProfile != nil {
+		_, remove := iam.DiffInstanceProfileRoles(v1alpha1.InstanceProfileParameters{}, *observed.InstanceProfile)
+		if err := e.removeRoles(ctx, cr, remove); err != nil {
+			return err
+		}
+	}
+
+	_, err = e.client.DeleteInstanceProfileRequest(&awsiam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) removeRoles(ctx context.Context, cr *v1alpha1.InstanceProfile, roles []string) error {
+	for _, r := range roles {
+		_, err := e.client.RemoveRoleFromInstanceProfileRequest(&awsiam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(r),
+		}).Send(ctx)
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errRemoveRole)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,474 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+	profileName   = "some-profile"
+	profileARN    = "arn:aws:iam::123456789012:instance-profile/some-profile"
+	roleName      = "some-role"
+	otherRoleName = "other-role"
+	roleARN       = "arn:aws:iam::123456789012:role/some-role"
+	path          = "/"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.InstanceProfileClient
+	cr  resource.Managed
+}
+
+type profileModifier func(*v1alpha1.InstanceProfile)
+
+func withConditions(c ...xpv1.Condition) profileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) profileModifier {
+	return func(r *v1alpha1.InstanceProfile) { meta.SetExternalName(r, s) }
+}
+
+func withRoleName(s string) profileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Spec.ForProvider.RoleName = aws.String(s) }
+}
+
+func withPath(s string) profileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Spec.ForProvider.Path = aws.String(s) }
+}
+
+func withStatus(o v1alpha1.InstanceProfileObservation) profileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Status.AtProvider = o }
+}
+
+func instanceProfile(m ...profileModifier) *v1alpha1.InstanceProfile {
+	cr := &v1alpha1.InstanceProfile{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getInstanceProfile(roles ...string) func(*awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+	return func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+		p := &awsiam.InstanceProfile{Arn: aws.String(profileARN), Path: aws.String(path)}
+		for _, r := range roles {
+			p.Roles = append(p.Roles, awsiam.Role{RoleName: aws.String(r), Arn: aws.String(roleARN)})
+		}
+		return awsiam.GetInstanceProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetInstanceProfileOutput{
+				InstanceProfile: p,
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(roleName),
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName), withPath(path)),
+			},
+			want: want{
+				cr: instanceProfile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withPath(path),
+					withStatus(v1alpha1.InstanceProfileObservation{ARN: profileARN, RoleARN: roleARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RoleChanged": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(otherRoleName),
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName), withPath(path)),
+			},
+			want: want{
+				cr: instanceProfile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withPath(path),
+					withStatus(v1alpha1.InstanceProfileObservation{ARN: profileARN, RoleARN: roleARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName)),
+			},
+			want: want{
+				cr:  instanceProfile(withExternalName(profileName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfileRequest: func(input *awsiam.CreateInstanceProfileInput) awsiam.CreateInstanceProfileRequest {
+						return awsiam.CreateInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateInstanceProfileOutput{}},
+						}
+					},
+					MockAddRoleToInstanceProfileRequest: func(input *awsiam.AddRoleToInstanceProfileInput) awsiam.AddRoleToInstanceProfileRequest {
+						return awsiam.AddRoleToInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AddRoleToInstanceProfileOutput{}},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"AddRoleError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfileRequest: func(input *awsiam.CreateInstanceProfileInput) awsiam.CreateInstanceProfileRequest {
+						return awsiam.CreateInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateInstanceProfileOutput{}},
+						}
+					},
+					MockAddRoleToInstanceProfileRequest: func(input *awsiam.AddRoleToInstanceProfileInput) awsiam.AddRoleToInstanceProfileRequest {
+						return awsiam.AddRoleToInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAddRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(otherRoleName),
+					MockRemoveRoleFromInstanceProfileRequest: func(input *awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+						return awsiam.RemoveRoleFromInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveRoleFromInstanceProfileOutput{}},
+						}
+					},
+					MockAddRoleToInstanceProfileRequest: func(input *awsiam.AddRoleToInstanceProfileInput) awsiam.AddRoleToInstanceProfileRequest {
+						return awsiam.AddRoleToInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AddRoleToInstanceProfileOutput{}},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"RemoveRoleError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(otherRoleName),
+					MockRemoveRoleFromInstanceProfileRequest: func(input *awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+						return awsiam.RemoveRoleFromInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr:  instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+				err: awsclient.Wrap(errBoom, errRemoveRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(roleName),
+					MockRemoveRoleFromInstanceProfileRequest: func(input *awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+						return awsiam.RemoveRoleFromInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveRoleFromInstanceProfileOutput{}},
+						}
+					},
+					MockDeleteInstanceProfileRequest: func(input *awsiam.DeleteInstanceProfileInput) awsiam.DeleteInstanceProfileRequest {
+						return awsiam.DeleteInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteInstanceProfileOutput{}},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(),
+					MockDeleteInstanceProfileRequest: func(input *awsiam.DeleteInstanceProfileInput) awsiam.DeleteInstanceProfileRequest {
+						return awsiam.DeleteInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName)),
+			},
+			want: want{
+				cr:  instanceProfile(withExternalName(profileName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+				},
+				cr: instanceProfile(withExternalName(profileName)),
+			},
+			want: want{
+				cr: instanceProfile(withExternalName(profileName), withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
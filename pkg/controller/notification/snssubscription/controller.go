@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +50,8 @@ const (
 	errCreate              = "failed to create the SNS Subscription"
 	errDelete              = "failed to delete the SNS Subscription"
 	errUpdate              = "failed to update the SNS Subscription"
+	errGetTokenSecret      = "failed to get the confirmation token secret"
+	errConfirm             = "failed to confirm the SNS Subscription"
 )
 
 // SetupSubscription adds a controller than reconciles SNSSubscription
@@ -122,12 +126,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// Set Status for SNS Subcription
 	switch *cr.Status.AtProvider.Status { //nolint:exhaustive
 	case v1alpha1.ConfirmationSuccessful:
-		cr.Status.SetConditions(xpv1.Available())
+		cr.Status.SetConditions(xpv1.Available(), v1alpha1.Confirmed())
 	default:
-		cr.Status.SetConditions(xpv1.Creating())
+		cr.Status.SetConditions(xpv1.Creating(), v1alpha1.PendingConfirmation())
 	}
 
-	upToDate := snsclient.IsSNSSubscriptionAttributesUpToDate(cr.Spec.ForProvider, res.Attributes)
+	upToDate := snsclient.IsSNSSubscriptionAttributesUpToDate(cr.Spec.ForProvider, res.Attributes) &&
+		!needsConfirmation(cr)
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        upToDate,
@@ -158,6 +163,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	if needsConfirmation(cr) {
+		if err := e.confirm(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	// Fetch Subscription Attributes again
 	resp, err := e.client.GetSubscriptionAttributesRequest(&awssns.GetSubscriptionAttributesInput{
 		SubscriptionArn: aws.String(meta.GetExternalName(cr)),
@@ -196,3 +207,21 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	}).Send(ctx)
 	return awsclient.Wrap(resource.Ignore(sns.IsSubscriptionNotFound, err), errDelete)
 }
+
+// needsConfirmation returns true if the subscription is pending confirmation
+// and a confirmation token is given.
+func needsConfirmation(cr *v1alpha1.SNSSubscription) bool {
+	return cr.Spec.ForProvider.ConfirmationTokenSecretRef != nil &&
+		cr.Status.AtProvider.Status != nil && *cr.Status.AtProvider.Status == v1alpha1.ConfirmationPending
+}
+
+// confirm confirms the subscription with the token of the referenced secret.
+func (e *external) confirm(ctx context.Context, cr *v1alpha1.SNSSubscription) error {
+	ref := cr.Spec.ForProvider.ConfirmationTokenSecretRef
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return errors.Wrap(err, errGetTokenSecret)
+	}
+	_, err := e.client.ConfirmSubscriptionRequest(snsclient.GenerateConfirmSubscriptionInput(&cr.Spec.ForProvider, string(s.Data[ref.Key]))).Send(ctx)
+	return awsclient.Wrap(err, errConfirm)
+}
@@ -10,6 +10,8 @@ import (
 	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -31,8 +33,9 @@ var (
 )
 
 type args struct {
-	sub sns.SubscriptionClient
-	cr  resource.Managed
+	sub  sns.SubscriptionClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 func makeARN(s string) string {
@@ -56,6 +59,24 @@ func subscription(m ...subModifier) *v1alpha1.SNSSubscription {
 	return cr
 }
 
+func withConfirmationToken() subModifier {
+	return func(r *v1alpha1.SNSSubscription) {
+		r.Spec.ForProvider.TopicARN = makeARN(subName)
+		r.Spec.ForProvider.ConfirmationTokenSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "token", Namespace: "default"},
+			Key:             "token",
+		}
+	}
+}
+
+func withStatus(s v1alpha1.ConfirmationStatus) subModifier {
+	return func(r *v1alpha1.SNSSubscription) { r.Status.AtProvider.Status = &s }
+}
+
+func withOwner(o string) subModifier {
+	return func(r *v1alpha1.SNSSubscription) { r.Status.AtProvider.Owner = &o }
+}
+
 func withSubARN(s *string) subModifier {
 	return func(t *v1alpha1.SNSSubscription) {
 		meta.SetExternalName(t, makeARN(*s))
@@ -82,6 +103,40 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"PendingConfirmationWithToken": {
+			args: args{
+				sub: &fake.MockSubscriptionClient{
+					MockGetSubscriptionAttributesRequest: func(input *awssns.GetSubscriptionAttributesInput) awssns.GetSubscriptionAttributesRequest {
+						return awssns.GetSubscriptionAttributesRequest{
+							Request: &aws.Request{
+								HTTPRequest: &http.Request{},
+								Data: &awssns.GetSubscriptionAttributesOutput{
+									Attributes: map[string]string{
+										"PendingConfirmation": "true",
+										"Owner":               "owner",
+									},
+								},
+								Retryer: aws.NoOpRetryer{},
+							},
+						}
+					},
+				},
+				cr: subscription(withSubARN(&subName), withConfirmationToken()),
+			},
+			want: want{
+				cr: subscription(
+					withSubARN(&subName),
+					withConfirmationToken(),
+					withStatus(v1alpha1.ConfirmationPending),
+					withOwner("owner"),
+					withConditions(xpv1.Creating(), v1alpha1.PendingConfirmation()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -253,6 +308,55 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"ConfirmWithToken": {
+			args: args{
+				sub: &fake.MockSubscriptionClient{
+					MockGetSubscriptionAttributesRequest: func(input *awssns.GetSubscriptionAttributesInput) awssns.GetSubscriptionAttributesRequest {
+						return awssns.GetSubscriptionAttributesRequest{
+							Request: &aws.Request{
+								HTTPRequest: &http.Request{},
+								Data:        &awssns.GetSubscriptionAttributesOutput{},
+								Retryer:     aws.NoOpRetryer{},
+							},
+						}
+					},
+					MockConfirmSubscriptionRequest: func(input *awssns.ConfirmSubscriptionInput) awssns.ConfirmSubscriptionRequest {
+						if diff := cmp.Diff("secret-token", aws.StringValue(input.Token)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssns.ConfirmSubscriptionRequest{
+							Request: &aws.Request{
+								HTTPRequest: &http.Request{},
+								Data:        &awssns.ConfirmSubscriptionOutput{},
+								Retryer:     aws.NoOpRetryer{},
+							},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("secret-token")}
+						return nil
+					},
+				},
+				cr: subscription(withSubARN(&subName), withConfirmationToken(), withStatus(v1alpha1.ConfirmationPending)),
+			},
+			want: want{
+				cr: subscription(withSubARN(&subName), withConfirmationToken(), withStatus(v1alpha1.ConfirmationPending)),
+			},
+		},
+		"ConfirmSecretError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: subscription(withSubARN(&subName), withConfirmationToken(), withStatus(v1alpha1.ConfirmationPending)),
+			},
+			want: want{
+				cr:  subscription(withSubARN(&subName), withConfirmationToken(), withStatus(v1alpha1.ConfirmationPending)),
+				err: errors.Wrap(errBoom, errGetTokenSecret),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -327,7 +431,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.sub}
+			e := &external{client: tc.sub, kube: tc.kube}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -48,6 +48,9 @@ const (
 	errCreate           = "failed to create the SNS Topic"
 	errDelete           = "failed to delete the SNS Topic"
 	errUpdate           = "failed to update the SNS Topic"
+	errListTags         = "failed to list tags of the SNS Topic"
+	errTag              = "failed to tag the SNS Topic"
+	errUntag            = "failed to untag the SNS Topic"
 )
 
 // SetupSNSTopic adds a controller that reconciles SNSTopic.
@@ -111,6 +114,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 			awsclient.Wrap(resource.Ignore(sns.IsTopicNotFound, err), errGetTopicAttr)
 	}
 
+	tags, err := e.client.ListTagsForResourceRequest(&awssns.ListTagsForResourceInput{
+		ResourceArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	snsclient.LateInitializeTopicAttr(&cr.Spec.ForProvider, res.Attributes)
 
@@ -119,9 +129,11 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// GenerateObservation for SNS Topic
 	cr.Status.AtProvider = snsclient.GenerateTopicObservation(res.Attributes)
 
+	add, remove := snsclient.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        snsclient.IsSNSTopicUpToDate(cr.Spec.ForProvider, res.Attributes),
+		ResourceUpToDate:        snsclient.IsSNSTopicUpToDate(cr.Spec.ForProvider, res.Attributes) && len(add) == 0 && len(remove) == 0,
 		ResourceLateInitialized: !reflect.DeepEqual(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -164,9 +176,36 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			AttributeValue: aws.String(v),
 			TopicArn:       aws.String(meta.GetExternalName(cr)),
 		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
 
+	// Update Topic Tags
+	tags, err := e.client.ListTagsForResourceRequest(&awssns.ListTagsForResourceInput{
+		ResourceArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := snsclient.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceRequest(&awssns.UntagResourceInput{
+			ResourceArn: aws.String(meta.GetExternalName(cr)),
+			TagKeys:     remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceRequest(&awssns.TagResourceInput{
+			ResourceArn: aws.String(meta.GetExternalName(cr)),
+			Tags:        add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errTag)
+		}
 	}
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
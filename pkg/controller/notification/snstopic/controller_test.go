@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -101,6 +102,22 @@ func withConditions(c ...xpv1.Condition) topicModifier {
 	return func(r *v1alpha1.SNSTopic) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func withTags(tags ...v1alpha1.Tag) topicModifier {
+	return func(t *v1alpha1.SNSTopic) { t.Spec.ForProvider.Tags = tags }
+}
+
+func listTags(tags ...awssns.Tag) func(*awssns.ListTagsForResourceInput) awssns.ListTagsForResourceRequest {
+	return func(_ *awssns.ListTagsForResourceInput) awssns.ListTagsForResourceRequest {
+		return awssns.ListTagsForResourceRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Retryer:     aws.NoOpRetryer{},
+				Data:        &awssns.ListTagsForResourceOutput{Tags: tags},
+			},
+		}
+	}
+}
+
 func topic(m ...topicModifier) *v1alpha1.SNSTopic {
 	cr := &v1alpha1.SNSTopic{}
 
@@ -207,6 +224,7 @@ func TestObserve(t *testing.T) {
 		"ValidInputResourceNotUpToDate": {
 			args: args{
 				topic: &fake.MockTopicClient{
+					MockListTagsForResource: listTags(),
 					MockGetTopicAttributesRequest: func(input *awssns.GetTopicAttributesInput) awssns.GetTopicAttributesRequest {
 						return awssns.GetTopicAttributesRequest{
 							Request: &aws.Request{
@@ -371,6 +389,7 @@ func TestUpdate(t *testing.T) {
 		"VaildInput": {
 			args: args{
 				topic: &fake.MockTopicClient{
+					MockListTagsForResource: listTags(),
 					MockGetTopicAttributesRequest: func(input *awssns.GetTopicAttributesInput) awssns.GetTopicAttributesRequest {
 						return awssns.GetTopicAttributesRequest{
 							Request: &aws.Request{
@@ -390,6 +409,7 @@ func TestUpdate(t *testing.T) {
 		"VaildInputWithChangedAttributes": {
 			args: args{
 				topic: &fake.MockTopicClient{
+					MockListTagsForResource: listTags(),
 					MockGetTopicAttributesRequest: func(input *awssns.GetTopicAttributesInput) awssns.GetTopicAttributesRequest {
 						return awssns.GetTopicAttributesRequest{
 							Request: &aws.Request{
@@ -421,6 +441,51 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"VaildInputWithChangedTags": {
+			args: args{
+				topic: &fake.MockTopicClient{
+					MockGetTopicAttributesRequest: func(input *awssns.GetTopicAttributesInput) awssns.GetTopicAttributesRequest {
+						return awssns.GetTopicAttributesRequest{
+							Request: &aws.Request{
+								HTTPRequest: &http.Request{},
+								Retryer:     aws.NoOpRetryer{},
+								Data:        &awssns.GetTopicAttributesOutput{},
+							},
+						}
+					},
+					MockListTagsForResource: listTags(
+						awssns.Tag{Key: aws.String("team"), Value: aws.String("old")},
+						awssns.Tag{Key: aws.String("stale"), Value: aws.String("value")},
+					),
+					MockUntagResource: func(input *awssns.UntagResourceInput) awssns.UntagResourceRequest {
+						if diff := cmp.Diff([]string{"stale"}, input.TagKeys, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssns.UntagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssns.UntagResourceOutput{}},
+						}
+					},
+					MockTagResource: func(input *awssns.TagResourceInput) awssns.TagResourceRequest {
+						if diff := cmp.Diff([]awssns.Tag{{Key: aws.String("team"), Value: aws.String("new")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssns.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssns.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: topic(
+					withTopicName(&topicName),
+					withTags(v1alpha1.Tag{Key: "team", Value: aws.String("new")}),
+				),
+			},
+			want: want{
+				cr: topic(
+					withTopicName(&topicName),
+					withTags(v1alpha1.Tag{Key: "team", Value: aws.String("new")}),
+				),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -2,9 +2,14 @@ package dbcluster
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -24,18 +29,31 @@ import (
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
 )
 
+const (
+	errGetPassword    = "cannot get password from the given secret"
+	errModifyCapacity = "cannot modify the current capacity of the cluster"
+
+	engineModeServerless = "serverless"
+
+	connectionSecretReaderEndpointKey = "readerEndpoint"
+)
+
 // SetupDBCluster adds a controller that reconciles DbCluster.
 func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(svcapitypes.DBClusterGroupKind)
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
-			e.postObserve = postObserve
 			c := &custom{client: e.client, kube: e.kube}
+			e.postObserve = c.postObserve
 			e.preCreate = c.preCreate
 			e.postCreate = c.postCreate
 			e.preDelete = preDelete
 			e.filterList = filterList
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = c.isUpToDate
+			e.preUpdate = c.preUpdate
+			e.postUpdate = c.postUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -47,6 +65,9 @@ func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(
+				managed.NewNameAsExternalName(mgr.GetClient()),
+				managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -60,7 +81,7 @@ func preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.Descri
 // described here https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Aurora.Status.html
 // Need to get help from community on how to deal with this. Ideally the status should reflect
 // the true status value as described by the provider.
-func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *custom) postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -72,9 +93,30 @@ func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.Desc
 	case "creating":
 		cr.SetConditions(xpv1.Creating())
 	}
+	obs.ConnectionDetails = getConnectionDetails(cr, resp.DBClusters[0])
 	return obs, nil
 }
 
+// getConnectionDetails returns the endpoints, the port and the master user
+// name of the cluster. They are published on every observation since the
+// endpoints are only known once the cluster is created.
+func getConnectionDetails(cr *svcapitypes.DBCluster, cluster *svcsdk.DBCluster) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if cluster.Endpoint != nil {
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(aws.StringValue(cluster.Endpoint))
+	}
+	if cluster.ReaderEndpoint != nil {
+		conn[connectionSecretReaderEndpointKey] = []byte(aws.StringValue(cluster.ReaderEndpoint))
+	}
+	if cluster.Port != nil {
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.FormatInt(aws.Int64Value(cluster.Port), 10))
+	}
+	if u := aws.StringValue(cr.Spec.ForProvider.MasterUsername); u != "" {
+		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(u)
+	}
+	return conn
+}
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
@@ -83,7 +125,7 @@ type custom struct {
 func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.CreateDBClusterInput) error {
 	pw, _, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
-		return errors.Wrap(err, "cannot get password from the given secret")
+		return errors.Wrap(err, errGetPassword)
 	}
 	obj.MasterUserPassword = aws.String(pw)
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
@@ -99,12 +141,11 @@ func (e *custom) postCreate(ctx context.Context, cr *svcapitypes.DBCluster, _ *s
 		return managed.ExternalCreation{}, err
 	}
 	conn := managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(cr.Status.AtProvider.Endpoint)),
-		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
+		xpv1.ResourceCredentialsSecretUserKey: []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
 	}
 	pw, _, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "cannot get password from the given secret")
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
 	}
 	if pw != "" {
 		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
@@ -114,6 +155,246 @@ func (e *custom) postCreate(ctx context.Context, cr *svcapitypes.DBCluster, _ *s
 	}, nil
 }
 
+func lateInitialize(in *svcapitypes.DBClusterParameters, out *svcsdk.DescribeDBClustersOutput) error { // nolint:gocyclo
+	c := out.DBClusters[0]
+	in.BacktrackWindow = aws.LateInitializeInt64Ptr(in.BacktrackWindow, c.BacktrackWindow)
+	in.BackupRetentionPeriod = aws.LateInitializeInt64Ptr(in.BackupRetentionPeriod, c.BackupRetentionPeriod)
+	in.CopyTagsToSnapshot = aws.LateInitializeBoolPtr(in.CopyTagsToSnapshot, c.CopyTagsToSnapshot)
+	in.DBClusterParameterGroupName = aws.LateInitializeStringPtr(in.DBClusterParameterGroupName, c.DBClusterParameterGroup)
+	in.DBSubnetGroupName = aws.LateInitializeStringPtr(in.DBSubnetGroupName, c.DBSubnetGroup)
+	in.DeletionProtection = aws.LateInitializeBoolPtr(in.DeletionProtection, c.DeletionProtection)
+	in.EnableIAMDatabaseAuthentication = aws.LateInitializeBoolPtr(in.EnableIAMDatabaseAuthentication, c.IAMDatabaseAuthenticationEnabled)
+	in.EngineMode = aws.LateInitializeStringPtr(in.EngineMode, c.EngineMode)
+	in.EngineVersion = aws.LateInitializeStringPtr(in.EngineVersion, c.EngineVersion)
+	// When version 5.7 is chosen, AWS creates 5.7.mysql_aurora.2.07.2 and
+	// that's valid. We assign the actual full version to the spec to avoid
+	// unnecessary update signals.
+	if strings.HasPrefix(aws.StringValue(c.EngineVersion), aws.StringValue(in.EngineVersion)) {
+		in.EngineVersion = c.EngineVersion
+	}
+	in.KMSKeyID = aws.LateInitializeStringPtr(in.KMSKeyID, c.KmsKeyId)
+	in.MasterUsername = aws.LateInitializeStringPtr(in.MasterUsername, c.MasterUsername)
+	in.Port = aws.LateInitializeInt64Ptr(in.Port, c.Port)
+	in.PreferredBackupWindow = aws.LateInitializeStringPtr(in.PreferredBackupWindow, c.PreferredBackupWindow)
+	in.PreferredMaintenanceWindow = aws.LateInitializeStringPtr(in.PreferredMaintenanceWindow, c.PreferredMaintenanceWindow)
+	in.StorageEncrypted = aws.LateInitializeBoolPtr(in.StorageEncrypted, c.StorageEncrypted)
+	in.EnableHTTPEndpoint = aws.LateInitializeBoolPtr(in.EnableHTTPEndpoint, c.HttpEndpointEnabled)
+	if in.ScalingConfiguration == nil && c.ScalingConfigurationInfo != nil {
+		in.ScalingConfiguration = &svcapitypes.ScalingConfiguration{
+			AutoPause:             c.ScalingConfigurationInfo.AutoPause,
+			MaxCapacity:           c.ScalingConfigurationInfo.MaxCapacity,
+			MinCapacity:           c.ScalingConfigurationInfo.MinCapacity,
+			SecondsUntilAutoPause: c.ScalingConfigurationInfo.SecondsUntilAutoPause,
+			TimeoutAction:         c.ScalingConfigurationInfo.TimeoutAction,
+		}
+	}
+	if in.AvailabilityZones == nil {
+		in.AvailabilityZones = c.AvailabilityZones
+	}
+	if in.EnableCloudwatchLogsExports == nil {
+		in.EnableCloudwatchLogsExports = c.EnabledCloudwatchLogsExports
+	}
+	if len(in.VPCSecurityGroupIDs) == 0 {
+		for _, sg := range c.VpcSecurityGroups {
+			in.VPCSecurityGroupIDs = append(in.VPCSecurityGroupIDs, aws.StringValue(sg.VpcSecurityGroupId))
+		}
+	}
+	return nil
+}
+
+func (e *custom) isUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput) (bool, error) {
+	// TODO(muvaf): We need isUpToDate to have context.
+	ctx := context.TODO()
+	_, pwdChanged, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return false, errors.Wrap(err, errGetPassword)
+	}
+	if pwdChanged {
+		return false, nil
+	}
+	c := out.DBClusters[0]
+	p := cr.Spec.ForProvider
+	switch {
+	case aws.Int64Value(p.BacktrackWindow) != aws.Int64Value(c.BacktrackWindow),
+		aws.Int64Value(p.BackupRetentionPeriod) != aws.Int64Value(c.BackupRetentionPeriod),
+		aws.BoolValue(p.CopyTagsToSnapshot) != aws.BoolValue(c.CopyTagsToSnapshot),
+		aws.StringValue(p.DBClusterParameterGroupName) != aws.StringValue(c.DBClusterParameterGroup),
+		aws.BoolValue(p.DeletionProtection) != aws.BoolValue(c.DeletionProtection),
+		aws.BoolValue(p.EnableIAMDatabaseAuthentication) != aws.BoolValue(c.IAMDatabaseAuthenticationEnabled),
+		aws.StringValue(p.EngineVersion) != aws.StringValue(c.EngineVersion),
+		aws.Int64Value(p.Port) != aws.Int64Value(c.Port),
+		aws.StringValue(p.PreferredBackupWindow) != aws.StringValue(c.PreferredBackupWindow),
+		aws.StringValue(p.PreferredMaintenanceWindow) != aws.StringValue(c.PreferredMaintenanceWindow),
+		aws.BoolValue(p.EnableHTTPEndpoint) != aws.BoolValue(c.HttpEndpointEnabled),
+		!isScalingConfigurationUpToDate(p.ScalingConfiguration, c.ScalingConfigurationInfo):
+		return false, nil
+	}
+	if _, scale := desiredCapacity(p.ScalingConfiguration, c); scale {
+		return false, nil
+	}
+	enable, disable := diffCloudwatchLogsExports(p.EnableCloudwatchLogsExports, c.EnabledCloudwatchLogsExports)
+	if len(enable) != 0 || len(disable) != 0 {
+		return false, nil
+	}
+	return cmp.Equal(sortedStrings(p.VPCSecurityGroupIDs), sortedStrings(securityGroupIDs(c.VpcSecurityGroups)), cmpopts.EquateEmpty()), nil
+}
+
+func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.ModifyDBClusterInput) error {
+	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
+	obj.ApplyImmediately = cr.Spec.ForProvider.ApplyImmediately
+	obj.AllowMajorVersionUpgrade = cr.Spec.ForProvider.AllowMajorVersionUpgrade
+	obj.VpcSecurityGroupIds = make([]*string, len(cr.Spec.ForProvider.VPCSecurityGroupIDs))
+	for i, v := range cr.Spec.ForProvider.VPCSecurityGroupIDs {
+		obj.VpcSecurityGroupIds[i] = aws.String(v)
+	}
+
+	// Some of the fields cannot be sent unchanged, and enabling and disabling
+	// of log exports is computed from the current state of the cluster.
+	out, err := e.client.DescribeDBClustersWithContext(ctx, &svcsdk.DescribeDBClustersInput{DBClusterIdentifier: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return aws.Wrap(err, errDescribe)
+	}
+	if len(out.DBClusters) == 0 {
+		return errors.New(errDescribe)
+	}
+	c := out.DBClusters[0]
+	if aws.StringValue(obj.EngineVersion) == aws.StringValue(c.EngineVersion) {
+		obj.EngineVersion = nil
+	}
+	if aws.StringValue(obj.DBClusterParameterGroupName) == aws.StringValue(c.DBClusterParameterGroup) {
+		obj.DBClusterParameterGroupName = nil
+	}
+	if aws.Int64Value(obj.BacktrackWindow) == aws.Int64Value(c.BacktrackWindow) {
+		obj.BacktrackWindow = nil
+	}
+	if aws.BoolValue(obj.EnableHttpEndpoint) == aws.BoolValue(c.HttpEndpointEnabled) {
+		obj.EnableHttpEndpoint = nil
+	}
+	// The current capacity is only moved into the desired range once that
+	// range is in effect, otherwise the new range is applied first and the
+	// capacity follows in the next reconciliation.
+	if isScalingConfigurationUpToDate(cr.Spec.ForProvider.ScalingConfiguration, c.ScalingConfigurationInfo) {
+		obj.ScalingConfiguration = nil
+		if capacity, scale := desiredCapacity(cr.Spec.ForProvider.ScalingConfiguration, c); scale {
+			if _, err := e.client.ModifyCurrentDBClusterCapacityWithContext(ctx, &svcsdk.ModifyCurrentDBClusterCapacityInput{
+				DBClusterIdentifier: aws.String(meta.GetExternalName(cr)),
+				Capacity:            &capacity,
+				TimeoutAction:       cr.Spec.ForProvider.ScalingConfiguration.TimeoutAction,
+			}); err != nil {
+				return aws.Wrap(err, errModifyCapacity)
+			}
+		}
+	}
+	enable, disable := diffCloudwatchLogsExports(cr.Spec.ForProvider.EnableCloudwatchLogsExports, c.EnabledCloudwatchLogsExports)
+	if len(enable) != 0 || len(disable) != 0 {
+		obj.CloudwatchLogsExportConfiguration = &svcsdk.CloudwatchLogsExportConfiguration{
+			EnableLogTypes:  enable,
+			DisableLogTypes: disable,
+		}
+	}
+
+	pw, changed, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return errors.Wrap(err, errGetPassword)
+	}
+	if changed {
+		obj.MasterUserPassword = aws.String(pw)
+	}
+	return nil
+}
+
+func (e *custom) postUpdate(_ context.Context, cr *svcapitypes.DBCluster, _ *svcsdk.ModifyDBClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The password in the connection secret is replaced only once it is
+	// changed in the cluster.
+	pw, changed, err := rds.GetPassword(context.TODO(), e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	if changed {
+		upd.ConnectionDetails = managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw)}
+	}
+	return upd, nil
+}
+
+// diffCloudwatchLogsExports returns the log types that need to be enabled and
+// disabled so that the current log exports match the desired ones.
+func diffCloudwatchLogsExports(desired, current []*string) (enable, disable []*string) {
+	want := map[string]bool{}
+	for _, l := range desired {
+		want[aws.StringValue(l)] = true
+	}
+	have := map[string]bool{}
+	for _, l := range current {
+		have[aws.StringValue(l)] = true
+		if !want[aws.StringValue(l)] {
+			disable = append(disable, l)
+		}
+	}
+	for _, l := range desired {
+		if !have[aws.StringValue(l)] {
+			enable = append(enable, l)
+		}
+	}
+	return enable, disable
+}
+
+// isScalingConfigurationUpToDate returns whether the fields of the desired
+// scaling configuration that are set match the current one.
+func isScalingConfigurationUpToDate(desired *svcapitypes.ScalingConfiguration, current *svcsdk.ScalingConfigurationInfo) bool {
+	if desired == nil {
+		return true
+	}
+	if current == nil {
+		return false
+	}
+	switch {
+	case desired.AutoPause != nil && aws.BoolValue(desired.AutoPause) != aws.BoolValue(current.AutoPause),
+		desired.MaxCapacity != nil && aws.Int64Value(desired.MaxCapacity) != aws.Int64Value(current.MaxCapacity),
+		desired.MinCapacity != nil && aws.Int64Value(desired.MinCapacity) != aws.Int64Value(current.MinCapacity),
+		desired.SecondsUntilAutoPause != nil && aws.Int64Value(desired.SecondsUntilAutoPause) != aws.Int64Value(current.SecondsUntilAutoPause),
+		desired.TimeoutAction != nil && aws.StringValue(desired.TimeoutAction) != aws.StringValue(current.TimeoutAction):
+		return false
+	}
+	return true
+}
+
+// desiredCapacity returns the capacity a serverless cluster needs to be scaled
+// to when its current capacity is outside of the desired range. A paused
+// cluster has no capacity and is left as is.
+func desiredCapacity(desired *svcapitypes.ScalingConfiguration, c *svcsdk.DBCluster) (int64, bool) {
+	if desired == nil || aws.StringValue(c.EngineMode) != engineModeServerless {
+		return 0, false
+	}
+	current := aws.Int64Value(c.Capacity)
+	switch {
+	case current == 0:
+		return 0, false
+	case desired.MinCapacity != nil && current < aws.Int64Value(desired.MinCapacity):
+		return aws.Int64Value(desired.MinCapacity), true
+	case desired.MaxCapacity != nil && current > aws.Int64Value(desired.MaxCapacity):
+		return aws.Int64Value(desired.MaxCapacity), true
+	}
+	return 0, false
+}
+
+func securityGroupIDs(in []*svcsdk.VpcSecurityGroupMembership) []string {
+	out := make([]string, len(in))
+	for i, sg := range in {
+		out[i] = aws.StringValue(sg.VpcSecurityGroupId)
+	}
+	return out
+}
+
+func sortedStrings(in []string) []string {
+	out := make([]string, len(in))
+	copy(out, in)
+	sort.Strings(out)
+	return out
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.DeleteDBClusterInput) (bool, error) {
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
 	obj.FinalDBSnapshotIdentifier = aws.String(cr.Spec.ForProvider.FinalDBSnapshotIdentifier)
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+)
+
+func TestDesiredCapacity(t *testing.T) {
+	type want struct {
+		capacity int64
+		scale    bool
+	}
+	scaling := &svcapitypes.ScalingConfiguration{
+		MinCapacity: awsgo.Int64(4),
+		MaxCapacity: awsgo.Int64(16),
+	}
+
+	cases := map[string]struct {
+		desired *svcapitypes.ScalingConfiguration
+		cluster *svcsdk.DBCluster
+		want    want
+	}{
+		"InRange": {
+			desired: scaling,
+			cluster: &svcsdk.DBCluster{EngineMode: awsgo.String(engineModeServerless), Capacity: awsgo.Int64(8)},
+		},
+		"BelowMin": {
+			desired: scaling,
+			cluster: &svcsdk.DBCluster{EngineMode: awsgo.String(engineModeServerless), Capacity: awsgo.Int64(2)},
+			want:    want{capacity: 4, scale: true},
+		},
+		"AboveMax": {
+			desired: scaling,
+			cluster: &svcsdk.DBCluster{EngineMode: awsgo.String(engineModeServerless), Capacity: awsgo.Int64(32)},
+			want:    want{capacity: 16, scale: true},
+		},
+		"Paused": {
+			desired: scaling,
+			cluster: &svcsdk.DBCluster{EngineMode: awsgo.String(engineModeServerless), Capacity: awsgo.Int64(0)},
+		},
+		"Provisioned": {
+			desired: scaling,
+			cluster: &svcsdk.DBCluster{EngineMode: awsgo.String("provisioned"), Capacity: awsgo.Int64(2)},
+		},
+		"NoScalingConfiguration": {
+			cluster: &svcsdk.DBCluster{EngineMode: awsgo.String(engineModeServerless), Capacity: awsgo.Int64(2)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			capacity, scale := desiredCapacity(tc.desired, tc.cluster)
+			if diff := cmp.Diff(tc.want, want{capacity: capacity, scale: scale}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsScalingConfigurationUpToDate(t *testing.T) {
+	current := &svcsdk.ScalingConfigurationInfo{
+		AutoPause:   awsgo.Bool(true),
+		MinCapacity: awsgo.Int64(2),
+		MaxCapacity: awsgo.Int64(8),
+	}
+
+	cases := map[string]struct {
+		desired *svcapitypes.ScalingConfiguration
+		current *svcsdk.ScalingConfigurationInfo
+		want    bool
+	}{
+		"NotSet": {
+			current: current,
+			want:    true,
+		},
+		"Partial": {
+			desired: &svcapitypes.ScalingConfiguration{MaxCapacity: awsgo.Int64(8)},
+			current: current,
+			want:    true,
+		},
+		"MaxChanged": {
+			desired: &svcapitypes.ScalingConfiguration{MinCapacity: awsgo.Int64(2), MaxCapacity: awsgo.Int64(16)},
+			current: current,
+			want:    false,
+		},
+		"NotServerless": {
+			desired: &svcapitypes.ScalingConfiguration{MaxCapacity: awsgo.Int64(8)},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isScalingConfigurationUpToDate(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
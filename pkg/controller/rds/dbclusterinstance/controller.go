@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclusterinstance
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+)
+
+const (
+	errNotDBClusterInstance = "managed resource is not a DBClusterInstance custom resource"
+	errKubeUpdateFailed     = "cannot update DBClusterInstance custom resource"
+	errDescribeFailed       = "cannot describe DB instance"
+	errDescribeCluster      = "cannot describe DB cluster of the DB instance"
+	errCreateFailed         = "cannot create DB instance"
+	errModifyFailed         = "cannot modify DB instance"
+	errAddTagsFailed        = "cannot add tags to DB instance"
+	errRemoveTagsFailed     = "cannot remove tags from DB instance"
+	errDeleteFailed         = "cannot delete DB instance"
+)
+
+// Statuses of a DB instance that the controller acts on.
+const (
+	statusAvailable = "available"
+	statusCreating  = "creating"
+	statusDeleting  = "deleting"
+	statusModifying = "modifying"
+)
+
+// SetupDBClusterInstance adds a controller that reconciles DBClusterInstances.
+func SetupDBClusterInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.DBClusterInstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.DBClusterInstance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DBClusterInstanceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewDBClusterInstanceClient}),
+			managed.WithInitializers(
+				managed.NewNameAsExternalName(mgr.GetClient()),
+				managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) rds.DBClusterInstanceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DBClusterInstance)
+	if !ok {
+		return nil, errors.New(errNotDBClusterInstance)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client rds.DBClusterInstanceClient
+	kube   client.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.DBClusterInstance) (*svcsdk.DBInstance, error) {
+	rsp, err := e.client.DescribeDBInstancesWithContext(ctx, &svcsdk.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.DBInstances) == 0 {
+		return nil, nil
+	}
+	return rsp.DBInstances[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DBClusterInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBClusterInstance)
+	}
+
+	db, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(rds.IsDBInstanceNotFound, err), errDescribeFailed)
+	}
+	if db == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	rds.LateInitializeDBClusterInstance(&cr.Spec.ForProvider, db)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = rds.GenerateDBClusterInstanceObservation(db)
+	switch cr.Status.AtProvider.DBInstanceStatus {
+	case statusAvailable, statusModifying:
+		cr.Status.SetConditions(xpv1.Available())
+	case statusCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case statusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	add, remove := rds.DiffTags(cr.Spec.ForProvider.Tags, db.TagList)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  rds.IsDBClusterInstanceUpToDate(cr.Spec.ForProvider, db) && len(add) == 0 && len(remove) == 0,
+		ConnectionDetails: getConnectionDetails(cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DBClusterInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBClusterInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+	input := rds.GenerateCreateDBClusterInstanceInput(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	// The engine of an instance of a cluster has to match the engine of the
+	// cluster, so we don't require it to be specified.
+	if input.Engine == nil {
+		rsp, err := e.client.DescribeDBClustersWithContext(ctx, &svcsdk.DescribeDBClustersInput{DBClusterIdentifier: cr.Spec.ForProvider.DBClusterIdentifier})
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errDescribeCluster)
+		}
+		if len(rsp.DBClusters) == 0 {
+			return managed.ExternalCreation{}, errors.New(errDescribeCluster)
+		}
+		input.Engine = rsp.DBClusters[0].Engine
+	}
+	_, err := e.client.CreateDBInstanceWithContext(ctx, input)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DBClusterInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBClusterInstance)
+	}
+	switch cr.Status.AtProvider.DBInstanceStatus {
+	case statusCreating, statusModifying:
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// We have to describe the instance again because tags and the rest of
+	// the fields are updated by different calls.
+	db, err := e.describe(ctx, cr)
+	if err != nil || db == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	add, remove := rds.DiffTags(cr.Spec.ForProvider.Tags, db.TagList)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsFromResourceWithContext(ctx, &svcsdk.RemoveTagsFromResourceInput{ResourceName: db.DBInstanceArn, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsToResourceWithContext(ctx, &svcsdk.AddTagsToResourceInput{ResourceName: db.DBInstanceArn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	if rds.IsDBClusterInstanceUpToDate(cr.Spec.ForProvider, db) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyDBInstanceWithContext(ctx, rds.GenerateModifyDBClusterInstanceInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DBClusterInstance)
+	if !ok {
+		return errors.New(errNotDBClusterInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.DBInstanceStatus == statusDeleting {
+		return nil
+	}
+	// Backups of the instances of a cluster are taken on the cluster level,
+	// so there is no final snapshot of the instance.
+	_, err := e.client.DeleteDBInstanceWithContext(ctx, &svcsdk.DeleteDBInstanceInput{DBInstanceIdentifier: aws.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(rds.IsDBInstanceNotFound, err), errDeleteFailed)
+}
+
+func getConnectionDetails(cr *v1alpha1.DBClusterInstance) managed.ConnectionDetails {
+	if cr.Status.AtProvider.Endpoint == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.Endpoint),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(cr.Status.AtProvider.Port, 10)),
+	}
+}
@@ -0,0 +1,440 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclusterinstance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+var (
+	instanceName = "cool-instance"
+	clusterName  = "cool-cluster"
+	instanceARN  = "arn:aws:rds:us-east-1:123456789012:db:cool-instance"
+	class        = "db.r5.large"
+	newClass     = "db.r5.xlarge"
+	engine       = "aurora-postgresql"
+	address      = "cool-instance.abc.us-east-1.rds.amazonaws.com"
+	port         = int64(5432)
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	rds  rds.DBClusterInstanceClient
+	kube client.Client
+	cr   *v1alpha1.DBClusterInstance
+}
+
+type instanceModifier func(*v1alpha1.DBClusterInstance)
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(r *v1alpha1.DBClusterInstance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withClass(c string) instanceModifier {
+	return func(r *v1alpha1.DBClusterInstance) { r.Spec.ForProvider.DBInstanceClass = c }
+}
+
+func withEngine(e string) instanceModifier {
+	return func(r *v1alpha1.DBClusterInstance) { r.Spec.ForProvider.Engine = aws.String(e) }
+}
+
+func withStatus(s string) instanceModifier {
+	return func(r *v1alpha1.DBClusterInstance) { r.Status.AtProvider.DBInstanceStatus = s }
+}
+
+func withObservation(o v1alpha1.DBClusterInstanceObservation) instanceModifier {
+	return func(r *v1alpha1.DBClusterInstance) { r.Status.AtProvider = o }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.DBClusterInstance {
+	cr := &v1alpha1.DBClusterInstance{}
+	cr.Spec.ForProvider.DBClusterIdentifier = aws.String(clusterName)
+	meta.SetExternalName(cr, instanceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func dbInstance(class, status string) *svcsdk.DBInstance {
+	return &svcsdk.DBInstance{
+		DBInstanceArn:       aws.String(instanceARN),
+		DBClusterIdentifier: aws.String(clusterName),
+		DBInstanceClass:     aws.String(class),
+		DBInstanceStatus:    aws.String(status),
+		Engine:              aws.String(engine),
+		Endpoint:            &svcsdk.Endpoint{Address: aws.String(address), Port: aws.Int64(port)},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DBClusterInstance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{dbInstance(class, statusAvailable)}}, nil
+					},
+				},
+				cr: instance(withClass(class), withEngine(engine)),
+			},
+			want: want{
+				cr: instance(withClass(class), withEngine(engine),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DBClusterInstanceObservation{
+						DBInstanceARN:    instanceARN,
+						DBInstanceStatus: statusAvailable,
+						Endpoint:         address,
+						Port:             port,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+					},
+				},
+			},
+		},
+		"ClassChanged": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{dbInstance(class, statusAvailable)}}, nil
+					},
+				},
+				cr: instance(withClass(newClass), withEngine(engine)),
+			},
+			want: want{
+				cr: instance(withClass(newClass), withEngine(engine),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DBClusterInstanceObservation{
+						DBInstanceARN:    instanceARN,
+						DBInstanceStatus: statusAvailable,
+						Endpoint:         address,
+						Port:             port,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+					},
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{dbInstance(class, statusCreating)}}, nil
+					},
+				},
+				cr: instance(withClass(class)),
+			},
+			want: want{
+				cr: instance(withClass(class), withEngine(engine),
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.DBClusterInstanceObservation{
+						DBInstanceARN:    instanceARN,
+						DBInstanceStatus: statusCreating,
+						Endpoint:         address,
+						Port:             port,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeDBInstanceNotFoundFault, "", nil)
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DBClusterInstance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"EngineOfCluster": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBClusters: func(_ *svcsdk.DescribeDBClustersInput) (*svcsdk.DescribeDBClustersOutput, error) {
+						return &svcsdk.DescribeDBClustersOutput{DBClusters: []*svcsdk.DBCluster{{Engine: aws.String(engine)}}}, nil
+					},
+					MockCreateDBInstance: func(in *svcsdk.CreateDBInstanceInput) (*svcsdk.CreateDBInstanceOutput, error) {
+						if aws.StringValue(in.Engine) != engine {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(withClass(class)),
+			},
+			want: want{
+				cr: instance(withClass(class), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockCreateDBInstance: func(_ *svcsdk.CreateDBInstanceInput) (*svcsdk.CreateDBInstanceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withClass(class), withEngine(engine)),
+			},
+			want: want{
+				cr:  instance(withClass(class), withEngine(engine), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Modify": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{dbInstance(class, statusAvailable)}}, nil
+					},
+					MockModifyDBInstance: func(in *svcsdk.ModifyDBInstanceInput) (*svcsdk.ModifyDBInstanceOutput, error) {
+						if aws.StringValue(in.DBInstanceClass) != newClass {
+							return nil, errBoom
+						}
+						return &svcsdk.ModifyDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(withClass(newClass), withStatus(statusAvailable)),
+			},
+		},
+		"OnlyTags": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{dbInstance(class, statusAvailable)}}, nil
+					},
+					MockAddTagsToResource: func(in *svcsdk.AddTagsToResourceInput) (*svcsdk.AddTagsToResourceOutput, error) {
+						return &svcsdk.AddTagsToResourceOutput{}, nil
+					},
+				},
+				cr: instance(withClass(class), withStatus(statusAvailable), func(r *v1alpha1.DBClusterInstance) {
+					r.Spec.ForProvider.Tags = []*v1alpha1.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+				}),
+			},
+		},
+		"Modifying": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{},
+				cr:  instance(withClass(newClass), withStatus(statusModifying)),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDescribeDBInstances: func(_ *svcsdk.DescribeDBInstancesInput) (*svcsdk.DescribeDBInstancesOutput, error) {
+						return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{dbInstance(class, statusAvailable)}}, nil
+					},
+					MockModifyDBInstance: func(_ *svcsdk.ModifyDBInstanceInput) (*svcsdk.ModifyDBInstanceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withClass(newClass), withStatus(statusAvailable)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModifyFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DBClusterInstance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDeleteDBInstance: func(_ *svcsdk.DeleteDBInstanceInput) (*svcsdk.DeleteDBInstanceOutput, error) {
+						return &svcsdk.DeleteDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{},
+				cr:  instance(withStatus(statusDeleting)),
+			},
+			want: want{
+				cr: instance(withStatus(statusDeleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBClusterInstanceClient{
+					MockDeleteDBInstance: func(_ *svcsdk.DeleteDBInstanceInput) (*svcsdk.DeleteDBInstanceOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeDBInstanceNotFoundFault, "", nil)
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
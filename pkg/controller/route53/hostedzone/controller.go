@@ -21,6 +21,11 @@ import (
 	"fmt"
 	"strings"
 
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/route53"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/google/go-cmp/cmp"
@@ -50,6 +55,22 @@ const (
 	errDelete = "failed to delete the Hosted Zone resource"
 	errUpdate = "failed to update the Hosted Zone resource"
 	errGet    = "failed to get the Hosted Zone resource"
+
+	errListQueryLogging   = "failed to list the query logging configurations of the Hosted Zone resource"
+	errCreateQueryLogging = "failed to create the query logging configuration of the Hosted Zone resource"
+	errDeleteQueryLogging = "failed to delete the query logging configuration of the Hosted Zone resource"
+	errGetDNSSEC          = "failed to get the DNSSEC status of the Hosted Zone resource"
+	errEnableDNSSEC       = "failed to enable DNSSEC signing of the Hosted Zone resource"
+	errDisableDNSSEC      = "failed to disable DNSSEC signing of the Hosted Zone resource"
+	errCreateKSK          = "failed to create a key-signing key of the Hosted Zone resource"
+	errActivateKSK        = "failed to activate a key-signing key of the Hosted Zone resource"
+	errDeactivateKSK      = "failed to deactivate a key-signing key of the Hosted Zone resource"
+	errDeleteKSK          = "failed to delete a key-signing key of the Hosted Zone resource"
+	errAuthorizeVPC       = "failed to authorize the association of a VPC with the Hosted Zone resource"
+	errDeauthorizeVPC     = "failed to delete the VPC association authorization of the Hosted Zone resource"
+	errAssociateVPC       = "failed to associate a VPC with the Hosted Zone resource"
+	errDisassociateVPC    = "failed to disassociate a VPC from the Hosted Zone resource"
+	errVPCProviderConfig  = "failed to get the config of the VPC owner account"
 )
 
 // SetupHostedZone adds a controller that reconciles Hosted Zones.
@@ -63,7 +84,7 @@ func SetupHostedZone(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&v1alpha1.HostedZone{}).
 		Complete(managed.NewReconciler(
 			mgr, resource.ManagedKind(v1alpha1.HostedZoneGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: hostedzone.NewClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: hostedzone.NewClient, newDNSSECClientFn: hostedzone.NewDNSSECClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
@@ -73,8 +94,9 @@ func SetupHostedZone(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 }
 
 type connector struct {
-	kube        client.Client
-	newClientFn func(config aws.Config) hostedzone.Client
+	kube              client.Client
+	newClientFn       func(config aws.Config) hostedzone.Client
+	newDNSSECClientFn func(sess *session.Session) hostedzone.DNSSECClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -82,12 +104,18 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), dnssec: c.newDNSSECClientFn(sess), kube: c.kube, newClientFn: c.newClientFn}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client hostedzone.Client
+	kube        client.Client
+	client      hostedzone.Client
+	dnssec      hostedzone.DNSSECClient
+	newClientFn func(config aws.Config) hostedzone.Client
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -109,14 +137,40 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(hostedzone.IsNotFound, err), errGet)
 	}
 
+	ql, err := e.client.ListQueryLoggingConfigsRequest(&route53.ListQueryLoggingConfigsInput{
+		HostedZoneId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListQueryLogging)
+	}
+
+	var dnssec *svcsdk.GetDNSSECOutput
+	if cr.Spec.ForProvider.DNSSEC != nil {
+		dnssec, err = e.dnssec.GetDNSSECWithContext(ctx, &svcsdk.GetDNSSECInput{
+			HostedZoneId: awsv1.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetDNSSEC)
+		}
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	hostedzone.LateInitialize(&cr.Spec.ForProvider, res)
 
 	cr.Status.AtProvider = hostedzone.GenerateObservation(res)
+	cr.Status.AtProvider.QueryLogging = hostedzone.GenerateQueryLoggingObservation(ql.QueryLoggingConfigs)
+	cr.Status.AtProvider.DNSSEC = hostedzone.GenerateDNSSECObservation(dnssec)
 	cr.Status.SetConditions(xpv1.Available())
+
+	associate, disassociate := hostedzone.DiffVPCs(cr.Spec.ForProvider, cr.Status.AtProvider.VPCs)
+	upToDate := hostedzone.IsUpToDate(cr.Spec.ForProvider, *res.HostedZone) &&
+		len(associate) == 0 && len(disassociate) == 0 &&
+		hostedzone.IsQueryLoggingUpToDate(cr.Spec.ForProvider.QueryLogging, cr.Status.AtProvider.QueryLogging) &&
+		hostedzone.IsDNSSECUpToDate(cr.Spec.ForProvider.DNSSEC, cr.Status.AtProvider.DNSSEC)
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        hostedzone.IsUpToDate(cr.Spec.ForProvider, *res.HostedZone),
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -148,8 +202,173 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	_, err := e.client.UpdateHostedZoneCommentRequest(
 		hostedzone.GenerateUpdateHostedZoneCommentInput(cr.Spec.ForProvider, fmt.Sprintf("%s%s", hostedzone.IDPrefix, meta.GetExternalName(cr))),
 	).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+
+	// The status was populated by the preceding Observe call.
+	if err := e.updateVPCs(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateQueryLogging(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.updateDNSSEC(ctx, cr)
+}
+
+func (e *external) updateVPCs(ctx context.Context, cr *v1alpha1.HostedZone) error {
+	id := aws.String(meta.GetExternalName(cr))
+	associate, disassociate := hostedzone.DiffVPCs(cr.Spec.ForProvider, cr.Status.AtProvider.VPCs)
+	for _, a := range associate {
+		if err := e.associateVPC(ctx, cr, a); err != nil {
+			return err
+		}
+	}
+	for _, v := range disassociate {
+		if _, err := e.client.DisassociateVPCFromHostedZoneRequest(&route53.DisassociateVPCFromHostedZoneInput{
+			HostedZoneId: id,
+			VPC:          hostedzone.GenerateVPC(v),
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDisassociateVPC)
+		}
+	}
+	return nil
+}
+
+// associateVPC associates the supplied VPC with the hosted zone. A VPC that
+// belongs to another account is associated using the credentials of that
+// account after the account of the hosted zone authorized the association.
+func (e *external) associateVPC(ctx context.Context, cr *v1alpha1.HostedZone, a v1alpha1.VPCAssociation) error {
+	id := aws.String(meta.GetExternalName(cr))
+	vpc := hostedzone.GenerateVPC(a.VPC)
+	c := e.client
+	if a.ProviderConfigReference != nil {
+		if _, err := e.client.CreateVPCAssociationAuthorizationRequest(&route53.CreateVPCAssociationAuthorizationInput{
+			HostedZoneId: id,
+			VPC:          vpc,
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errAuthorizeVPC)
+		}
+		cfg, err := awsclient.UseProviderConfigReference(ctx, e.kube, cr, *a.ProviderConfigReference, awsclient.GlobalRegion)
+		if err != nil {
+			return errors.Wrap(err, errVPCProviderConfig)
+		}
+		c = e.newClientFn(*cfg)
+	}
+	if _, err := c.AssociateVPCWithHostedZoneRequest(&route53.AssociateVPCWithHostedZoneInput{
+		HostedZoneId: id,
+		VPC:          vpc,
+	}).Send(ctx); err != nil {
+		return awsclient.Wrap(err, errAssociateVPC)
+	}
+	if a.ProviderConfigReference == nil {
+		return nil
+	}
+	// The authorization is no longer needed once the VPC is associated.
+	_, err := e.client.DeleteVPCAssociationAuthorizationRequest(&route53.DeleteVPCAssociationAuthorizationInput{
+		HostedZoneId: id,
+		VPC:          vpc,
+	}).Send(ctx)
+	return awsclient.Wrap(err, errDeauthorizeVPC)
+}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+func (e *external) updateQueryLogging(ctx context.Context, cr *v1alpha1.HostedZone) error {
+	spec, obs := cr.Spec.ForProvider.QueryLogging, cr.Status.AtProvider.QueryLogging
+	if hostedzone.IsQueryLoggingUpToDate(spec, obs) {
+		return nil
+	}
+	// A hosted zone can have only one query logging configuration, so we
+	// replace it.
+	if obs != nil {
+		if _, err := e.client.DeleteQueryLoggingConfigRequest(&route53.DeleteQueryLoggingConfigInput{
+			Id: aws.String(obs.ID),
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDeleteQueryLogging)
+		}
+	}
+	if !hostedzone.IsQueryLoggingEnabled(*spec) {
+		return nil
+	}
+	_, err := e.client.CreateQueryLoggingConfigRequest(&route53.CreateQueryLoggingConfigInput{
+		HostedZoneId:              aws.String(meta.GetExternalName(cr)),
+		CloudWatchLogsLogGroupArn: aws.String(spec.CloudWatchLogsLogGroupARN),
+	}).Send(ctx)
+	return awsclient.Wrap(err, errCreateQueryLogging)
+}
+
+func (e *external) updateDNSSEC(ctx context.Context, cr *v1alpha1.HostedZone) error { // nolint:gocyclo
+	spec, obs := cr.Spec.ForProvider.DNSSEC, cr.Status.AtProvider.DNSSEC
+	if spec == nil || obs == nil {
+		return nil
+	}
+	id := awsv1.String(meta.GetExternalName(cr))
+
+	current := map[string]string{}
+	for _, k := range obs.KeySigningKeys {
+		current[k.Name] = k.Status
+	}
+	desired := map[string]bool{}
+	for _, k := range spec.KeySigningKeys {
+		desired[k.Name] = true
+		status, exists := current[k.Name]
+		var err error
+		switch {
+		case !exists:
+			_, err = e.dnssec.CreateKeySigningKeyWithContext(ctx, &svcsdk.CreateKeySigningKeyInput{
+				CallerReference:         awsv1.String(hostedzone.KeySigningKeyCallerReference(cr.GetResourceVersion(), k)),
+				HostedZoneId:            id,
+				KeyManagementServiceArn: k.KeyManagementServiceARN,
+				Name:                    awsv1.String(k.Name),
+				Status:                  awsv1.String(hostedzone.KeySigningKeyStatus(k)),
+			})
+			err = awsclient.Wrap(err, errCreateKSK)
+		case status == hostedzone.KeySigningKeyStatus(k):
+		case hostedzone.KeySigningKeyStatus(k) == hostedzone.KeySigningKeyStatusActive:
+			_, err = e.dnssec.ActivateKeySigningKeyWithContext(ctx, &svcsdk.ActivateKeySigningKeyInput{HostedZoneId: id, Name: awsv1.String(k.Name)})
+			err = awsclient.Wrap(err, errActivateKSK)
+		default:
+			_, err = e.dnssec.DeactivateKeySigningKeyWithContext(ctx, &svcsdk.DeactivateKeySigningKeyInput{HostedZoneId: id, Name: awsv1.String(k.Name)})
+			err = awsclient.Wrap(err, errDeactivateKSK)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	signing := obs.ServeSignature == hostedzone.DNSSECStatusSigning
+	switch {
+	case hostedzone.IsSigningEnabled(*spec) && !signing:
+		if _, err := e.dnssec.EnableHostedZoneDNSSECWithContext(ctx, &svcsdk.EnableHostedZoneDNSSECInput{HostedZoneId: id}); err != nil {
+			return awsclient.Wrap(err, errEnableDNSSEC)
+		}
+	case !hostedzone.IsSigningEnabled(*spec) && signing:
+		if _, err := e.dnssec.DisableHostedZoneDNSSECWithContext(ctx, &svcsdk.DisableHostedZoneDNSSECInput{HostedZoneId: id}); err != nil {
+			return awsclient.Wrap(err, errDisableDNSSEC)
+		}
+	}
+
+	for _, k := range obs.KeySigningKeys {
+		if desired[k.Name] {
+			continue
+		}
+		if err := e.deleteKeySigningKey(ctx, cr, k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteKeySigningKey deletes the supplied KSK, deactivating it first if
+// necessary.
+func (e *external) deleteKeySigningKey(ctx context.Context, cr *v1alpha1.HostedZone, k v1alpha1.KeySigningKeyObservation) error {
+	id := awsv1.String(meta.GetExternalName(cr))
+	if k.Status == hostedzone.KeySigningKeyStatusActive {
+		if _, err := e.dnssec.DeactivateKeySigningKeyWithContext(ctx, &svcsdk.DeactivateKeySigningKeyInput{HostedZoneId: id, Name: awsv1.String(k.Name)}); err != nil {
+			return awsclient.Wrap(err, errDeactivateKSK)
+		}
+	}
+	_, err := e.dnssec.DeleteKeySigningKeyWithContext(ctx, &svcsdk.DeleteKeySigningKeyInput{HostedZoneId: id, Name: awsv1.String(k.Name)})
+	return awsclient.Wrap(err, errDeleteKSK)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -160,6 +379,30 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	// Route53 refuses to delete a hosted zone that is signed or has
+	// key-signing keys.
+	if obs := cr.Status.AtProvider.DNSSEC; obs != nil {
+		if obs.ServeSignature == hostedzone.DNSSECStatusSigning {
+			if _, err := e.dnssec.DisableHostedZoneDNSSECWithContext(ctx, &svcsdk.DisableHostedZoneDNSSECInput{
+				HostedZoneId: awsv1.String(meta.GetExternalName(cr)),
+			}); err != nil {
+				return awsclient.Wrap(err, errDisableDNSSEC)
+			}
+		}
+		for _, k := range obs.KeySigningKeys {
+			if err := e.deleteKeySigningKey(ctx, cr, k); err != nil {
+				return err
+			}
+		}
+	}
+	if obs := cr.Status.AtProvider.QueryLogging; obs != nil {
+		if _, err := e.client.DeleteQueryLoggingConfigRequest(&route53.DeleteQueryLoggingConfigInput{
+			Id: aws.String(obs.ID),
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDeleteQueryLogging)
+		}
+	}
+
 	_, err := e.client.DeleteHostedZoneRequest(&route53.DeleteHostedZoneInput{
 		Id: aws.String(fmt.Sprintf("%s%s", hostedzone.IDPrefix, meta.GetExternalName(cr))),
 	}).Send(ctx)
@@ -26,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	svcsdk "github.com/aws/aws-sdk-go/service/route53"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,6 +51,8 @@ var (
 	rrCount        int64 = 2
 	c                    = new(string)
 	b                    = new(bool)
+	logGroup             = "arn:aws:logs:us-east-1:123456789012:log-group:/aws/route53/example.com"
+	ksk                  = "ksk"
 )
 
 type zoneModifier func(*v1alpha1.HostedZone)
@@ -57,6 +60,7 @@ type zoneModifier func(*v1alpha1.HostedZone)
 type args struct {
 	kube    client.Client
 	route53 hostedzone.Client
+	dnssec  hostedzone.DNSSECClient
 	cr      resource.Managed
 }
 
@@ -88,6 +92,63 @@ func withStatus(id string, rr int64) zoneModifier {
 	}
 }
 
+func withQueryLogging(arn string) zoneModifier {
+	return func(r *v1alpha1.HostedZone) {
+		r.Spec.ForProvider.QueryLogging = &v1alpha1.QueryLogging{CloudWatchLogsLogGroupARN: arn}
+	}
+}
+
+func withQueryLoggingStatus(id, arn string) zoneModifier {
+	return func(r *v1alpha1.HostedZone) {
+		r.Status.AtProvider.QueryLogging = &v1alpha1.QueryLoggingObservation{ID: id, CloudWatchLogsLogGroupARN: arn}
+	}
+}
+
+func withDNSSEC(d *v1alpha1.DNSSEC) zoneModifier {
+	return func(r *v1alpha1.HostedZone) { r.Spec.ForProvider.DNSSEC = d }
+}
+
+func withDNSSECStatus(d *v1alpha1.DNSSECObservation) zoneModifier {
+	return func(r *v1alpha1.HostedZone) { r.Status.AtProvider.DNSSEC = d }
+}
+
+func listQueryLogging(configs ...awsroute53.QueryLoggingConfig) func(*awsroute53.ListQueryLoggingConfigsInput) awsroute53.ListQueryLoggingConfigsRequest {
+	return func(input *awsroute53.ListQueryLoggingConfigsInput) awsroute53.ListQueryLoggingConfigsRequest {
+		return awsroute53.ListQueryLoggingConfigsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsroute53.ListQueryLoggingConfigsOutput{QueryLoggingConfigs: configs}, Retryer: aws.NoOpRetryer{}},
+		}
+	}
+}
+
+func getHostedZone(input *awsroute53.GetHostedZoneInput) awsroute53.GetHostedZoneRequest {
+	return awsroute53.GetHostedZoneRequest{
+		Request: &aws.Request{
+			HTTPRequest: &http.Request{},
+			Data: &awsroute53.GetHostedZoneOutput{
+				DelegationSet: &awsroute53.DelegationSet{
+					NameServers: []string{
+						"ns-2048.awsdns-64.com",
+						"ns-2049.awsdns-65.net",
+						"ns-2050.awsdns-66.org",
+						"ns-2051.awsdns-67.co.uk",
+					},
+				},
+				HostedZone: &awsroute53.HostedZone{
+					CallerReference:        &uuid,
+					Id:                     &id,
+					ResourceRecordSetCount: &rrCount,
+					Config: &awsroute53.HostedZoneConfig{
+						Comment:     c,
+						PrivateZone: b,
+					},
+				},
+				VPCs: make([]awsroute53.VPC, 0),
+			},
+			Retryer: aws.NoOpRetryer{},
+		},
+	}
+}
+
 func withComment(c string) zoneModifier {
 	return func(r *v1alpha1.HostedZone) { r.Spec.ForProvider.Config.Comment = &c }
 }
@@ -156,6 +217,7 @@ func TestObserve(t *testing.T) {
 							},
 						}
 					},
+					MockListQueryLoggingConfigsRequest: listQueryLogging(),
 				},
 				cr: instance(
 					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
@@ -172,6 +234,87 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"QueryLoggingNotUpToDate": {
+			args: args{
+				route53: &fake.MockHostedZoneClient{
+					MockGetHostedZoneRequest:           getHostedZone,
+					MockListQueryLoggingConfigsRequest: listQueryLogging(),
+				},
+				cr: instance(
+					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withQueryLogging(logGroup)),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withQueryLogging(logGroup),
+					withStatus(id, rrCount),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DNSSECUpToDate": {
+			args: args{
+				route53: &fake.MockHostedZoneClient{
+					MockGetHostedZoneRequest:           getHostedZone,
+					MockListQueryLoggingConfigsRequest: listQueryLogging(),
+				},
+				dnssec: &fake.MockDNSSECClient{
+					MockGetDNSSEC: func(input *svcsdk.GetDNSSECInput) (*svcsdk.GetDNSSECOutput, error) {
+						return &svcsdk.GetDNSSECOutput{
+							Status: &svcsdk.DNSSECStatus{ServeSignature: aws.String(hostedzone.DNSSECStatusSigning)},
+							KeySigningKeys: []*svcsdk.KeySigningKey{{
+								Name:   aws.String(ksk),
+								Status: aws.String(hostedzone.KeySigningKeyStatusActive),
+							}},
+						}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withDNSSEC(&v1alpha1.DNSSEC{KeySigningKeys: []v1alpha1.KeySigningKey{{Name: ksk}}})),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withDNSSEC(&v1alpha1.DNSSEC{KeySigningKeys: []v1alpha1.KeySigningKey{{Name: ksk}}}),
+					withStatus(id, rrCount),
+					withDNSSECStatus(&v1alpha1.DNSSECObservation{
+						ServeSignature: hostedzone.DNSSECStatusSigning,
+						KeySigningKeys: []v1alpha1.KeySigningKeyObservation{{Name: ksk, Status: hostedzone.KeySigningKeyStatusActive}},
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DNSSECClientError": {
+			args: args{
+				route53: &fake.MockHostedZoneClient{
+					MockGetHostedZoneRequest:           getHostedZone,
+					MockListQueryLoggingConfigsRequest: listQueryLogging(),
+				},
+				dnssec: &fake.MockDNSSECClient{
+					MockGetDNSSEC: func(input *svcsdk.GetDNSSECInput) (*svcsdk.GetDNSSECOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(
+					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withDNSSEC(&v1alpha1.DNSSEC{})),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withDNSSEC(&v1alpha1.DNSSEC{})),
+				err: awsclient.Wrap(errBoom, errGetDNSSEC),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -201,7 +344,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: test.NewMockClient(), client: tc.route53}
+			e := &external{kube: test.NewMockClient(), client: tc.route53, dnssec: tc.dnssec}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -358,6 +501,53 @@ func TestUpdate(t *testing.T) {
 					withComment("New Comment")),
 			},
 		},
+		"UnmanagedQueryLogging": {
+			args: args{
+				route53: &fake.MockHostedZoneClient{
+					MockUpdateHostedZoneCommentRequest: func(input *awsroute53.UpdateHostedZoneCommentInput) awsroute53.UpdateHostedZoneCommentRequest {
+						return awsroute53.UpdateHostedZoneCommentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsroute53.UpdateHostedZoneCommentOutput{}, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withQueryLoggingStatus("old", logGroup)),
+			},
+			want: want{
+				cr: instance(withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withQueryLoggingStatus("old", logGroup)),
+			},
+		},
+		"ReplaceQueryLogging": {
+			args: args{
+				route53: &fake.MockHostedZoneClient{
+					MockUpdateHostedZoneCommentRequest: func(input *awsroute53.UpdateHostedZoneCommentInput) awsroute53.UpdateHostedZoneCommentRequest {
+						return awsroute53.UpdateHostedZoneCommentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsroute53.UpdateHostedZoneCommentOutput{}, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+					MockDeleteQueryLoggingConfigRequest: func(input *awsroute53.DeleteQueryLoggingConfigInput) awsroute53.DeleteQueryLoggingConfigRequest {
+						return awsroute53.DeleteQueryLoggingConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsroute53.DeleteQueryLoggingConfigOutput{}, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+					MockCreateQueryLoggingConfigRequest: func(input *awsroute53.CreateQueryLoggingConfigInput) awsroute53.CreateQueryLoggingConfigRequest {
+						return awsroute53.CreateQueryLoggingConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withQueryLogging(logGroup),
+					withQueryLoggingStatus("old", "arn:aws:logs:us-east-1:123456789012:log-group:old")),
+			},
+			want: want{
+				cr: instance(withExternalName(strings.SplitAfter(id, hostedzone.IDPrefix)[1]),
+					withQueryLogging(logGroup),
+					withQueryLoggingStatus("old", "arn:aws:logs:us-east-1:123456789012:log-group:old")),
+				err: awsclient.Wrap(errBoom, errCreateQueryLogging),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
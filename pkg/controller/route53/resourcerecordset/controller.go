@@ -49,11 +49,16 @@ const (
 	errUpdate           = "failed to update the ResourceRecordSet resource"
 	errDelete           = "failed to delete the ResourceRecordSet resource"
 	errState            = "failed to determine resource state"
+	errGetRegistry      = "failed to get the TXT registry record of the ResourceRecordSet resource"
+	errNotOwned         = "the resource record set exists but is not owned by this ResourceRecordSet resource"
+	errRegistryOwner    = "the TXT registry record of the resource record set is owned by another owner"
+	errRegistrySetID    = "the TXTRegistry ownership policy cannot be used with a setIdentifier"
 )
 
 // SetupResourceRecordSet adds a controller that reconciles ResourceRecordSets.
 func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1alpha1.ResourceRecordSetGroupKind)
+	batcher := resourcerecordset.NewChangeBatcher(resourcerecordset.DefaultBatchInterval)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
@@ -62,7 +67,7 @@ func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rat
 		For(&v1alpha1.ResourceRecordSet{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: resourcerecordset.NewClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: resourcerecordset.NewClient, batcher: batcher}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -72,6 +77,7 @@ func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rat
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) resourcerecordset.Client
+	batcher     *resourcerecordset.ChangeBatcher
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -79,28 +85,73 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, batcher: c.batcher}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client resourcerecordset.Client
+	kube    client.Client
+	client  resourcerecordset.Client
+	batcher *resourcerecordset.ChangeBatcher
 }
 
-func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
 	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	// Changes are submitted asynchronously in batches per hosted zone. The
+	// outcome of the last batch is reported by the next Create, Update or
+	// Delete, just like a synchronous call would have. A record set with a
+	// pending DELETE is reported as existing until Route53 confirms it is
+	// gone; requesting the same DELETE again does not submit it twice.
+	key := e.key(cr)
+	if e.batcher.Pending(key) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	policy := resourcerecordset.OwnershipPolicy(cr.Spec.ForProvider)
+	if policy == v1alpha1.OwnershipPolicyTXTRegistry && cr.Spec.ForProvider.SetIdentifier != nil {
+		return managed.ExternalObservation{}, errors.New(errRegistrySetID)
+	}
+
 	rrs, err := resourcerecordset.GetResourceRecordSet(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, e.client)
 	if err != nil {
 		// Either there is err and retry. Or Resource does not exist.
+		if resourcerecordset.IsNotFound(err) && policy == v1alpha1.OwnershipPolicyTXTRegistry {
+			// Creating the record set would take over a registry record of
+			// another owner.
+			if _, err := e.isRegistryOwner(ctx, cr); err != nil {
+				return managed.ExternalObservation{}, err
+			}
+		}
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, awsclient.Wrap(resource.Ignore(resourcerecordset.IsNotFound, err), errList)
 	}
 
+	switch policy {
+	case v1alpha1.OwnershipPolicyCreateOnly:
+		owned, err := e.isCreator(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !owned {
+			return e.notOwned(cr)
+		}
+	case v1alpha1.OwnershipPolicyTXTRegistry:
+		owned, err := e.isRegistryOwner(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !owned {
+			return e.notOwned(cr)
+		}
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	resourcerecordset.LateInitialize(&cr.Spec.ForProvider, rrs)
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
@@ -121,6 +172,58 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}, nil
 }
 
+// isCreator returns whether the supplied ResourceRecordSet created its record
+// set. The ownership of a record set whose creation is pending is confirmed
+// only if Route53 accepted our CREATE, and recorded so that it does not depend
+// on the outcome kept by the batcher.
+func (e *external) isCreator(ctx context.Context, cr *v1alpha1.ResourceRecordSet) (bool, error) {
+	switch cr.GetAnnotations()[v1alpha1.AnnotationKeyOwned] {
+	case "true":
+		return true, nil
+	case v1alpha1.AnnotationValueOwnedPending:
+	default:
+		return false, nil
+	}
+	submitted, err := e.batcher.Peek(e.key(cr))
+	owned := submitted && err == nil
+	if owned {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyOwned: "true"})
+	} else {
+		meta.RemoveAnnotations(cr, v1alpha1.AnnotationKeyOwned)
+	}
+	return owned, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdate)
+}
+
+// isRegistryOwner returns whether the TXT registry record of the supplied
+// ResourceRecordSet marks it as the owner of its record set. A registry record
+// of another owner is reported as an error.
+func (e *external) isRegistryOwner(ctx context.Context, cr *v1alpha1.ResourceRecordSet) (bool, error) {
+	registry, err := resourcerecordset.GetRegistryRecordSet(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, e.client)
+	if resourcerecordset.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, awsclient.Wrap(err, errGetRegistry)
+	}
+	if !resourcerecordset.IsRegistryOwner(cr.Spec.ForProvider, *registry) {
+		if meta.WasDeleted(cr) {
+			return false, nil
+		}
+		return false, errors.New(errRegistryOwner)
+	}
+	return true, nil
+}
+
+// notOwned reports a record set that this ResourceRecordSet does not own. A
+// deleted ResourceRecordSet reports it as nonexistent so that the record set
+// is left untouched.
+func (e *external) notOwned(cr *v1alpha1.ResourceRecordSet) (managed.ExternalObservation, error) {
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	return managed.ExternalObservation{}, errors.New(errNotOwned)
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
 	if !ok {
@@ -128,11 +231,21 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Creating())
-
-	input := resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, route53.ChangeActionUpsert)
-	_, err := e.client.ChangeResourceRecordSetsRequest(input).Send(ctx)
-
-	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	if _, err := e.batcher.Result(e.key(cr)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	// The pending creation of a record set with the CreateOnly policy is
+	// recorded before the change is submitted, so that a record set is not
+	// claimed if the provider restarts while the change is in flight.
+	if resourcerecordset.OwnershipPolicy(cr.Spec.ForProvider) == v1alpha1.OwnershipPolicyCreateOnly &&
+		cr.GetAnnotations()[v1alpha1.AnnotationKeyOwned] != v1alpha1.AnnotationValueOwnedPending {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyOwned: v1alpha1.AnnotationValueOwnedPending})
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdate)
+		}
+	}
+	e.enqueue(cr, route53.ChangeActionCreate)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -140,9 +253,11 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
-	input := resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, route53.ChangeActionUpsert)
-	_, err := e.client.ChangeResourceRecordSetsRequest(input).Send(ctx)
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	if _, err := e.batcher.Result(e.key(cr)); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	e.enqueue(cr, route53.ChangeActionUpsert)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -152,11 +267,21 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.client.ChangeResourceRecordSetsRequest(
-		resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, route53.ChangeActionDelete),
-	).Send(ctx)
-
+	if _, err := e.batcher.Result(e.key(cr)); err != nil {
+		return awsclient.Wrap(err, errDelete)
+	}
 	// There is no way to confirm 404 (from response) when deleting a recordset
 	// which isn't present using ChangeResourceRecordSetRequest.
-	return awsclient.Wrap(err, errDelete)
+	e.enqueue(cr, route53.ChangeActionDelete)
+	return nil
+}
+
+func (e *external) key(cr *v1alpha1.ResourceRecordSet) string {
+	return resourcerecordset.ChangeKey(meta.GetExternalName(cr), cr.Spec.ForProvider)
+}
+
+func (e *external) enqueue(cr *v1alpha1.ResourceRecordSet, action route53.ChangeAction) {
+	name := meta.GetExternalName(cr)
+	e.batcher.Enqueue(e.client, awsclient.StringValue(cr.Spec.ForProvider.ZoneID), e.key(cr),
+		resourcerecordset.GenerateChanges(name, cr.GetName(), cr.Spec.ForProvider, action)...)
 }
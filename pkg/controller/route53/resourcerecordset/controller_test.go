@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -19,6 +19,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
@@ -71,9 +72,55 @@ type rrModifier func(*v1alpha1.ResourceRecordSet)
 type args struct {
 	kube    client.Client
 	route53 resourcerecordset.Client
+	batcher *resourcerecordset.ChangeBatcher
 	cr      resource.Managed
 }
 
+func withOwnership(p v1alpha1.OwnershipPolicy) rrModifier {
+	return func(r *v1alpha1.ResourceRecordSet) {
+		r.Spec.ForProvider.Ownership = &v1alpha1.Ownership{Policy: p}
+	}
+}
+
+func withAnnotations(a map[string]string) rrModifier {
+	return func(r *v1alpha1.ResourceRecordSet) { meta.AddAnnotations(r, a) }
+}
+
+func newBatcher() *resourcerecordset.ChangeBatcher {
+	return resourcerecordset.NewChangeBatcher(time.Hour)
+}
+
+// submitted returns a batcher that has submitted the changes of the supplied
+// ResourceRecordSet using the supplied change function.
+func submitted(cr *v1alpha1.ResourceRecordSet, fn func(*awsroute53.ChangeResourceRecordSetsInput) awsroute53.ChangeResourceRecordSetsRequest) *resourcerecordset.ChangeBatcher {
+	b := newBatcher()
+	b.Enqueue(&fake.MockResourceRecordSetClient{MockChangeResourceRecordSetsRequest: fn}, *zoneID,
+		resourcerecordset.ChangeKey(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	b.Flush(context.Background(), *zoneID)
+	return b
+}
+
+// pending returns a batcher with unsubmitted changes for the supplied
+// ResourceRecordSet.
+func pending(cr *v1alpha1.ResourceRecordSet) *resourcerecordset.ChangeBatcher {
+	b := newBatcher()
+	b.Enqueue(&fake.MockResourceRecordSetClient{}, *zoneID,
+		resourcerecordset.ChangeKey(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return b
+}
+
+func listFn(rrs ...awsroute53.ResourceRecordSet) func(*awsroute53.ListResourceRecordSetsInput) awsroute53.ListResourceRecordSetsRequest {
+	return func(in *awsroute53.ListResourceRecordSetsInput) awsroute53.ListResourceRecordSetsRequest {
+		return awsroute53.ListResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.ListResourceRecordSetsOutput{ResourceRecordSets: rrs},
+				Retryer:     aws.NoOpRetryer{},
+			},
+		}
+	}
+}
+
 func withConditions(c ...xpv1.Condition) rrModifier {
 	return func(r *v1alpha1.ResourceRecordSet) { r.Status.ConditionedStatus.Conditions = c }
 }
@@ -164,6 +211,144 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"ChangesPending": {
+			args: args{
+				batcher: pending(instance()),
+				cr:      instance(),
+			},
+			want: want{
+				cr: instance(),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateOnlyNotOwned": {
+			args: args{
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet),
+				},
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)),
+			},
+			want: want{
+				cr:  instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)),
+				err: errors.New(errNotOwned),
+			},
+		},
+		"CreateOnlyOwned": {
+			args: args{
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet),
+				},
+				batcher: submitted(instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)), changeFn),
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: "true"}),
+					withConditions(xpv1.Creating())),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: "true"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateOnlyCreateAccepted": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet),
+				},
+				batcher: submitted(instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)), changeFn),
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: v1alpha1.AnnotationValueOwnedPending}),
+					withConditions(xpv1.Creating())),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: "true"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateOnlyCreateUnknown": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet),
+				},
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: v1alpha1.AnnotationValueOwnedPending}),
+					withConditions(xpv1.Creating())),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withConditions(xpv1.Creating())),
+				err: errors.New(errNotOwned),
+			},
+		},
+		"CreateOnlyCreateRejected": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet),
+				},
+				batcher: submitted(instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)), changeErrFn),
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: v1alpha1.AnnotationValueOwnedPending}),
+					withConditions(xpv1.Creating())),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withConditions(xpv1.Creating())),
+				err: errors.New(errNotOwned),
+			},
+		},
+		"TXTRegistryNotOwned": {
+			args: args{
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet),
+				},
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyTXTRegistry)),
+			},
+			want: want{
+				cr:  instance(withOwnership(v1alpha1.OwnershipPolicyTXTRegistry)),
+				err: errors.New(errNotOwned),
+			},
+		},
+		"TXTRegistryOwned": {
+			args: args{
+				route53: &fake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: listFn(rrSet, awsroute53.ResourceRecordSet{
+						Name: aws.String("a-" + name),
+						Type: awsroute53.RRTypeTxt,
+						ResourceRecords: []awsroute53.ResourceRecord{{
+							Value: aws.String(resourcerecordset.RegistryRecordValue(instance().Spec.ForProvider, rrName)),
+						}},
+					}),
+				},
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyTXTRegistry)),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyTXTRegistry), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				route53: &fake.MockResourceRecordSetClient{
@@ -202,7 +387,15 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: test.NewMockClient(), client: tc.route53}
+			kube := tc.kube
+			if kube == nil {
+				kube = test.NewMockClient()
+			}
+			batcher := tc.batcher
+			if batcher == nil {
+				batcher = newBatcher()
+			}
+			e := &external{kube: kube, client: tc.route53, batcher: batcher}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -221,9 +414,10 @@ func TestObserve(t *testing.T) {
 func TestCreate(t *testing.T) {
 
 	type want struct {
-		cr     resource.Managed
-		result managed.ExternalCreation
-		err    error
+		cr      resource.Managed
+		result  managed.ExternalCreation
+		pending bool
+		err     error
 	}
 
 	cases := map[string]struct {
@@ -238,7 +432,39 @@ func TestCreate(t *testing.T) {
 				cr: instance(),
 			},
 			want: want{
-				cr: instance(withConditions(xpv1.Creating())),
+				cr:      instance(withConditions(xpv1.Creating())),
+				pending: true,
+			},
+		},
+		"CreateOnly": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				route53: &fake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSetsRequest: changeFn,
+				},
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: v1alpha1.AnnotationValueOwnedPending}),
+					withConditions(xpv1.Creating())),
+				pending: true,
+			},
+		},
+		"CreateOnlyKubeUpdateError": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly)),
+			},
+			want: want{
+				cr: instance(withOwnership(v1alpha1.OwnershipPolicyCreateOnly),
+					withAnnotations(map[string]string{v1alpha1.AnnotationKeyOwned: v1alpha1.AnnotationValueOwnedPending}),
+					withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errKubeUpdate),
 			},
 		},
 		"InValidInput": {
@@ -252,10 +478,8 @@ func TestCreate(t *testing.T) {
 		},
 		"ClientError": {
 			args: args{
-				route53: &fake.MockResourceRecordSetClient{
-					MockChangeResourceRecordSetsRequest: changeErrFn,
-				},
-				cr: instance(),
+				batcher: submitted(instance(), changeErrFn),
+				cr:      instance(),
 			},
 			want: want{
 				cr:  instance(withConditions(xpv1.Creating())),
@@ -266,7 +490,11 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.route53}
+			b := tc.batcher
+			if b == nil {
+				b = newBatcher()
+			}
+			e := &external{kube: tc.kube, client: tc.route53, batcher: b}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -278,15 +506,19 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.pending, b.Pending(resourcerecordset.ChangeKey(rrName, instance().Spec.ForProvider))); diff != "" {
+				t.Errorf("pending: -want, +got:\n%s", diff)
+			}
 		})
 	}
 }
 
 func TestUpdate(t *testing.T) {
 	type want struct {
-		cr     resource.Managed
-		result managed.ExternalUpdate
-		err    error
+		cr      resource.Managed
+		result  managed.ExternalUpdate
+		pending bool
+		err     error
 	}
 
 	cases := map[string]struct {
@@ -301,7 +533,8 @@ func TestUpdate(t *testing.T) {
 				cr: instance(),
 			},
 			want: want{
-				cr: instance(),
+				cr:      instance(),
+				pending: true,
 			},
 		},
 		"InValidInput": {
@@ -315,10 +548,8 @@ func TestUpdate(t *testing.T) {
 		},
 		"ClientError": {
 			args: args{
-				route53: &fake.MockResourceRecordSetClient{
-					MockChangeResourceRecordSetsRequest: changeErrFn,
-				},
-				cr: instance(),
+				batcher: submitted(instance(), changeErrFn),
+				cr:      instance(),
 			},
 			want: want{
 				cr:  instance(),
@@ -329,7 +560,11 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.route53}
+			b := tc.batcher
+			if b == nil {
+				b = newBatcher()
+			}
+			e := &external{client: tc.route53, batcher: b}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -341,14 +576,18 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.pending, b.Pending(resourcerecordset.ChangeKey(rrName, instance().Spec.ForProvider))); diff != "" {
+				t.Errorf("pending: -want, +got:\n%s", diff)
+			}
 		})
 	}
 }
 
 func TestDelete(t *testing.T) {
 	type want struct {
-		cr  resource.Managed
-		err error
+		cr      resource.Managed
+		pending bool
+		err     error
 	}
 
 	cases := map[string]struct {
@@ -363,7 +602,8 @@ func TestDelete(t *testing.T) {
 				cr: instance(),
 			},
 			want: want{
-				cr: instance(withConditions(xpv1.Deleting())),
+				cr:      instance(withConditions(xpv1.Deleting())),
+				pending: true,
 			},
 		},
 		"InValidInput": {
@@ -377,10 +617,8 @@ func TestDelete(t *testing.T) {
 		},
 		"ClientError": {
 			args: args{
-				route53: &fake.MockResourceRecordSetClient{
-					MockChangeResourceRecordSetsRequest: changeErrFn,
-				},
-				cr: instance(),
+				batcher: submitted(instance(), changeErrFn),
+				cr:      instance(),
 			},
 			want: want{
 				cr:  instance(withConditions(xpv1.Deleting())),
@@ -391,7 +629,11 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.route53}
+			b := tc.batcher
+			if b == nil {
+				b = newBatcher()
+			}
+			e := &external{client: tc.route53, batcher: b}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -400,6 +642,56 @@ func TestDelete(t *testing.T) {
 			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.pending, b.Pending(resourcerecordset.ChangeKey(rrName, instance().Spec.ForProvider))); diff != "" {
+				t.Errorf("pending: -want, +got:\n%s", diff)
+			}
 		})
 	}
 }
+
+func TestDeletePending(t *testing.T) {
+	cr := instance(func(r *v1alpha1.ResourceRecordSet) {
+		now := metav1.Now()
+		r.SetDeletionTimestamp(&now)
+	})
+	key := resourcerecordset.ChangeKey(rrName, cr.Spec.ForProvider)
+
+	calls := 0
+	e := &external{batcher: newBatcher()}
+	e.client = &fake.MockResourceRecordSetClient{
+		MockChangeResourceRecordSetsRequest: func(in *awsroute53.ChangeResourceRecordSetsInput) awsroute53.ChangeResourceRecordSetsRequest {
+			calls++
+			// The reconciler keeps requesting the deletion while it is in
+			// flight.
+			if err := e.Delete(context.Background(), cr); err != nil {
+				t.Errorf("Delete(...): %s", err)
+			}
+			return changeFn(in)
+		},
+	}
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+
+	e.batcher.Flush(context.Background(), *zoneID)
+	if diff := cmp.Diff(1, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+	if e.batcher.Pending(key) {
+		t.Errorf("Pending(...): the DELETE was enqueued again while it was in flight")
+	}
+	if ok, err := e.batcher.Result(key); !ok || err != nil {
+		t.Errorf("Result(...): want a successful result, got %t, %v", ok, err)
+	}
+}
@@ -34,6 +34,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
 )
 
 const (
@@ -41,6 +42,9 @@ const (
 	errGetNamespace               = "get-namespace failed"
 	errDeleteNamespace            = "delete-namespace failed"
 	errOperationResponseMalformed = "get-operation result malformed"
+	errListTags                   = "list-tags-for-resource failed"
+	errTagResource                = "tag-resource failed"
+	errUntagResource              = "untag-resource failed"
 )
 
 type namespace interface {
@@ -49,6 +53,7 @@ type namespace interface {
 	SetOperationID(*string)
 	GetDescription() *string
 	SetDescription(*string)
+	GetTags() []*v1alpha1.Tag
 }
 
 // NewHooks returns a new Hooks object.
@@ -135,13 +140,60 @@ func (h *Hooks) Observe(ctx context.Context, mg cpresource.Managed) (managed.Ext
 		cr.SetDescription(nsReqResp.Namespace.Description)
 		lateInited = true
 	}
+	// Only the tags of a namespace can be updated.
+	tags, err := h.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceARN: nsReqResp.Namespace.Arn,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := svcclient.DiffTags(cr.GetTags(), tags.Tags)
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceLateInitialized: lateInited,
-		ResourceUpToDate:        true, // Namespaces cannot be updated.
+		ResourceUpToDate:        len(add) == 0 && len(remove) == 0,
 	}, nil
 }
 
+// Update updates the tags of any of HTTPNamespace, PrivateDNSNamespace or
+// PublicDNSNamespace types.
+func (h *Hooks) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(namespace)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	ns, err := h.client.GetNamespaceWithContext(ctx, &svcsdk.GetNamespaceInput{
+		Id: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetNamespace)
+	}
+	tags, err := h.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceARN: ns.Namespace.Arn,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := svcclient.DiffTags(cr.GetTags(), tags.Tags)
+	if len(remove) != 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceARN: ns.Namespace.Arn,
+			TagKeys:     remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceARN: ns.Namespace.Arn,
+			Tags:        add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
 // Delete deletes any of HTTPNamespace, PrivateDNSNamespace or PublicDNSNamespace types.
 func (h *Hooks) Delete(ctx context.Context, mg cpresource.Managed) error {
 	var cr namespace
@@ -543,3 +543,73 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetNamespace: func(input *svcsdk.GetNamespaceInput) (*svcsdk.GetNamespaceOutput, error) {
+						return &svcsdk.GetNamespaceOutput{
+							Namespace: &svcsdk.Namespace{Arn: aws.String(validArn)},
+						}, nil
+					},
+					MockListTagsForResource: func(input *svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error) {
+						return &svcsdk.ListTagsForResourceOutput{
+							Tags: []*svcsdk.Tag{
+								{Key: aws.String("keep"), Value: aws.String("v")},
+								{Key: aws.String("stale"), Value: aws.String("v")},
+							},
+						}, nil
+					},
+					MockUntagResource: func(input *svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error) {
+						if diff := cmp.Diff([]*string{aws.String("stale")}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.UntagResourceOutput{}, nil
+					},
+					MockTagResource: func(input *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+						if diff := cmp.Diff([]*svcsdk.Tag{{Key: aws.String("new"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.TagResourceOutput{}, nil
+					},
+				},
+				cr: &svcapitypes.HTTPNamespace{
+					ObjectMeta: v1.ObjectMeta{
+						Annotations: map[string]string{"crossplane.io/external-name": validNSID},
+					},
+					Spec: svcapitypes.HTTPNamespaceSpec{
+						ForProvider: svcapitypes.HTTPNamespaceParameters{
+							Region: "eu-central-1",
+							Name:   aws.String("test"),
+							Tags: []*svcapitypes.Tag{
+								{Key: aws.String("keep"), Value: aws.String("v")},
+								{Key: aws.String("new"), Value: aws.String("v")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := NewHooks(tc.kube, tc.client)
+
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
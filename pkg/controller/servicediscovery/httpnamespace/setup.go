@@ -44,6 +44,7 @@ func SetupHTTPNamespace(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 			e.preCreate = preCreate
 			e.delete = h.Delete
 			e.observe = h.Observe
+			e.update = h.Update
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
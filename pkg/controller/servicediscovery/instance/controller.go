@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+)
+
+const (
+	errNotInstance      = "managed resource is not an Instance custom resource"
+	errGetEndpoints     = "cannot get endpoints of the Kubernetes Service"
+	errGetFailed        = "cannot get Instance"
+	errListFailed       = "cannot list Instances"
+	errRegisterFailed   = "cannot register Instance"
+	errDeregisterFailed = "cannot deregister Instance"
+	errNoServiceID      = "serviceId is not set"
+)
+
+// SetupInstance adds a controller that reconciles Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Instance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return nil, errors.New(errNotInstance)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+	if cr.Spec.ForProvider.ServiceID == nil {
+		return managed.ExternalObservation{}, errors.New(errNoServiceID)
+	}
+	current, err := e.current(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.InstanceIDs = sortedKeys(current)
+
+	// Only the registered instances matter once the resource is deleted, so
+	// that deregistration does not depend on the Kubernetes Service.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:   len(current) != 0,
+			ResourceUpToDate: true,
+		}, nil
+	}
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// A Kubernetes Service without ready endpoints has no instances to
+	// register, which is not considered as a missing resource.
+	exists := len(current) != 0 || (cr.Spec.ForProvider.KubernetesServiceRef != nil && len(desired) == 0)
+	if !exists {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(desired, current),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.sync(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+	return managed.ExternalUpdate{}, e.sync(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	current, err := e.current(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for id := range current {
+		if err := e.deregister(ctx, cr, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sync registers the desired instances that are missing or whose attributes
+// differ and deregisters the ones that are no longer desired. Registering an
+// existing instance updates its attributes.
+func (e *external) sync(ctx context.Context, cr *v1alpha1.Instance) error {
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return err
+	}
+	current, err := e.current(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for _, id := range sortedKeys(desired) {
+		if c, ok := current[id]; ok && svcclient.IsInstanceUpToDate(desired[id], c) {
+			continue
+		}
+		if _, err := e.client.RegisterInstanceWithContext(ctx, &svcsdk.RegisterInstanceInput{
+			ServiceId:  cr.Spec.ForProvider.ServiceID,
+			InstanceId: aws.String(id),
+			Attributes: desired[id],
+		}); err != nil {
+			return awsclient.Wrap(err, errRegisterFailed)
+		}
+	}
+	for _, id := range sortedKeys(current) {
+		if _, ok := desired[id]; ok {
+			continue
+		}
+		if err := e.deregister(ctx, cr, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *external) deregister(ctx context.Context, cr *v1alpha1.Instance, id string) error {
+	_, err := e.client.DeregisterInstanceWithContext(ctx, &svcsdk.DeregisterInstanceInput{
+		ServiceId:  cr.Spec.ForProvider.ServiceID,
+		InstanceId: aws.String(id),
+	})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsInstanceNotFound, err), errDeregisterFailed)
+}
+
+// desired returns the attributes of the instances that should be registered,
+// keyed by their IDs.
+func (e *external) desired(ctx context.Context, cr *v1alpha1.Instance) (map[string]map[string]*string, error) {
+	ref := cr.Spec.ForProvider.KubernetesServiceRef
+	if ref == nil {
+		return map[string]map[string]*string{
+			meta.GetExternalName(cr): svcclient.GenerateInstanceAttributes(cr.Spec.ForProvider.Attributes),
+		}, nil
+	}
+	ep := &corev1.Endpoints{}
+	err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, ep)
+	if kerrors.IsNotFound(err) {
+		// A deleted Kubernetes Service has no endpoints to register.
+		return map[string]map[string]*string{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetEndpoints)
+	}
+	return svcclient.GenerateEndpointInstances(meta.GetExternalName(cr), cr.Spec.ForProvider, ep), nil
+}
+
+// current returns the attributes of the instances that are registered for
+// the resource, keyed by their IDs.
+func (e *external) current(ctx context.Context, cr *v1alpha1.Instance) (map[string]map[string]*string, error) {
+	out := map[string]map[string]*string{}
+	if cr.Spec.ForProvider.KubernetesServiceRef == nil {
+		rsp, err := e.client.GetInstanceWithContext(ctx, &svcsdk.GetInstanceInput{
+			ServiceId:  cr.Spec.ForProvider.ServiceID,
+			InstanceId: aws.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return nil, awsclient.Wrap(resource.Ignore(svcclient.IsInstanceNotFound, err), errGetFailed)
+		}
+		if rsp.Instance != nil {
+			out[aws.StringValue(rsp.Instance.Id)] = rsp.Instance.Attributes
+		}
+		return out, nil
+	}
+	prefix := meta.GetExternalName(cr) + svcclient.EndpointInstanceIDSeparator
+	in := &svcsdk.ListInstancesInput{ServiceId: cr.Spec.ForProvider.ServiceID}
+	for {
+		rsp, err := e.client.ListInstancesWithContext(ctx, in)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errListFailed)
+		}
+		for _, i := range rsp.Instances {
+			if strings.HasPrefix(aws.StringValue(i.Id), prefix) {
+				out[aws.StringValue(i.Id)] = i.Attributes
+			}
+		}
+		if rsp.NextToken == nil {
+			return out, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func isUpToDate(desired, current map[string]map[string]*string) bool {
+	if len(desired) != len(current) {
+		return false
+	}
+	for id, attrs := range desired {
+		c, ok := current[id]
+		if !ok || !svcclient.IsInstanceUpToDate(attrs, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(in map[string]map[string]*string) []string {
+	var out []string
+	for k := range in {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
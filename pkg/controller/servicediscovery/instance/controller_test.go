@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery/fake"
+)
+
+const (
+	serviceID    = "srv-123"
+	instanceName = "web"
+)
+
+var (
+	deletionTime = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client svcclient.Client
+	kube   client.Client
+	cr     *v1alpha1.Instance
+}
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func withKubernetesService() instanceModifier {
+	return func(r *v1alpha1.Instance) {
+		r.Spec.ForProvider.KubernetesServiceRef = &v1alpha1.KubernetesServiceReference{Name: "web", Namespace: "default"}
+	}
+}
+
+func withDeletionTimestamp() instanceModifier {
+	return func(r *v1alpha1.Instance) { r.SetDeletionTimestamp(&deletionTime) }
+}
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInstanceIDs(ids ...string) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.AtProvider.InstanceIDs = ids }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{}
+	cr.Spec.ForProvider.ServiceID = aws.String(serviceID)
+	cr.Spec.ForProvider.Attributes = map[string]string{"AWS_INSTANCE_CNAME": "web.example.com"}
+	meta.SetExternalName(cr, instanceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func endpoints(ips ...string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		ep := obj.(*corev1.Endpoints)
+		s := corev1.EndpointSubset{Ports: []corev1.EndpointPort{{Name: "http", Port: 8080}}}
+		for _, ip := range ips {
+			s.Addresses = append(s.Addresses, corev1.EndpointAddress{IP: ip})
+		}
+		ep.Subsets = []corev1.EndpointSubset{s}
+		return nil
+	}
+}
+
+func endpointAttributes(ip string) map[string]*string {
+	return map[string]*string{
+		"AWS_INSTANCE_CNAME": aws.String("web.example.com"),
+		"AWS_INSTANCE_IPV4":  aws.String(ip),
+		"AWS_INSTANCE_PORT":  aws.String("8080"),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotRegistered": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetInstance: func(_ *svcsdk.GetInstanceInput) (*svcsdk.GetInstanceOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeInstanceNotFound, "", nil)
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withInstanceIDs()),
+			},
+		},
+		"Registered": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetInstance: func(_ *svcsdk.GetInstanceInput) (*svcsdk.GetInstanceOutput, error) {
+						return &svcsdk.GetInstanceOutput{Instance: &svcsdk.Instance{
+							Id:         aws.String(instanceName),
+							Attributes: map[string]*string{"AWS_INSTANCE_CNAME": aws.String("web.example.com")},
+						}}, nil
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:     instance(withInstanceIDs(instanceName), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"EndpointAdded": {
+			args: args{
+				kube: &test.MockClient{MockGet: endpoints("10.0.0.1", "10.0.0.2")},
+				client: &fake.MockServicediscoveryClient{
+					MockListInstances: func(_ *svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error) {
+						return &svcsdk.ListInstancesOutput{Instances: []*svcsdk.InstanceSummary{
+							{Id: aws.String("web-10-0-0-1"), Attributes: endpointAttributes("10.0.0.1")},
+							{Id: aws.String("other"), Attributes: map[string]*string{}},
+						}}, nil
+					},
+				},
+				cr: instance(withKubernetesService()),
+			},
+			want: want{
+				cr:     instance(withKubernetesService(), withInstanceIDs("web-10-0-0-1"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NoEndpoints": {
+			args: args{
+				kube: &test.MockClient{MockGet: endpoints()},
+				client: &fake.MockServicediscoveryClient{
+					MockListInstances: func(_ *svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error) {
+						return &svcsdk.ListInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withKubernetesService()),
+			},
+			want: want{
+				cr:     instance(withKubernetesService(), withInstanceIDs(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ServiceDeleted": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "endpoints"}, "web"))},
+				client: &fake.MockServicediscoveryClient{
+					MockListInstances: func(_ *svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error) {
+						return &svcsdk.ListInstancesOutput{Instances: []*svcsdk.InstanceSummary{
+							{Id: aws.String("web-10-0-0-1"), Attributes: endpointAttributes("10.0.0.1")},
+						}}, nil
+					},
+				},
+				cr: instance(withKubernetesService()),
+			},
+			want: want{
+				cr:     instance(withKubernetesService(), withInstanceIDs("web-10-0-0-1"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetEndpointsFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				client: &fake.MockServicediscoveryClient{
+					MockListInstances: func(_ *svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error) {
+						return &svcsdk.ListInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withKubernetesService()),
+			},
+			want: want{
+				cr:  instance(withKubernetesService(), withInstanceIDs()),
+				err: errors.Wrap(errBoom, errGetEndpoints),
+			},
+		},
+		"DeletedWithoutService": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				client: &fake.MockServicediscoveryClient{
+					MockListInstances: func(_ *svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error) {
+						return &svcsdk.ListInstancesOutput{Instances: []*svcsdk.InstanceSummary{
+							{Id: aws.String("web-10-0-0-1"), Attributes: endpointAttributes("10.0.0.1")},
+						}}, nil
+					},
+				},
+				cr: instance(withKubernetesService(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     instance(withKubernetesService(), withDeletionTimestamp(), withInstanceIDs("web-10-0-0-1")),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		registered   []string
+		deregistered []string
+		err          error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"EndpointsChanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: endpoints("10.0.0.1", "10.0.0.2")},
+				cr:   instance(withKubernetesService()),
+			},
+			want: want{
+				registered:   []string{"web-10-0-0-2"},
+				deregistered: []string{"web-10-0-0-3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var registered, deregistered []string
+			c := &fake.MockServicediscoveryClient{
+				MockListInstances: func(_ *svcsdk.ListInstancesInput) (*svcsdk.ListInstancesOutput, error) {
+					return &svcsdk.ListInstancesOutput{Instances: []*svcsdk.InstanceSummary{
+						{Id: aws.String("web-10-0-0-1"), Attributes: endpointAttributes("10.0.0.1")},
+						{Id: aws.String("web-10-0-0-3"), Attributes: endpointAttributes("10.0.0.3")},
+					}}, nil
+				},
+				MockRegisterInstance: func(in *svcsdk.RegisterInstanceInput) (*svcsdk.RegisterInstanceOutput, error) {
+					registered = append(registered, aws.StringValue(in.InstanceId))
+					return &svcsdk.RegisterInstanceOutput{}, nil
+				},
+				MockDeregisterInstance: func(in *svcsdk.DeregisterInstanceInput) (*svcsdk.DeregisterInstanceOutput, error) {
+					deregistered = append(deregistered, aws.StringValue(in.InstanceId))
+					return &svcsdk.DeregisterInstanceOutput{}, nil
+				},
+			}
+			e := &external{kube: tc.kube, client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.registered, registered); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deregistered, deregistered); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ func SetupPublicDNSNamespace(mgr ctrl.Manager, l logging.Logger, rl workqueue.Ra
 			e.preCreate = preCreate
 			e.delete = h.Delete
 			e.observe = h.Observe
+			e.update = h.Update
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+)
+
+const (
+	errNotService       = "managed resource is not a Service custom resource"
+	errKubeUpdateFailed = "cannot update Service custom resource"
+	errGetFailed        = "cannot get Service"
+	errCreateFailed     = "cannot create Service"
+	errUpdateFailed     = "cannot update Service"
+	errDeleteFailed     = "cannot delete Service"
+	errListTagsFailed   = "cannot list tags of Service"
+	errAddTagsFailed    = "cannot add tags to Service"
+	errRemoveTagsFailed = "cannot remove tags from Service"
+)
+
+// SetupService adds a controller that reconciles Services.
+func SetupService(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.ServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return nil, errors.New(errNotService)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	client svcclient.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotService)
+	}
+	// The ID of the service is assigned by AWS on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.GetServiceWithContext(ctx, &svcsdk.GetServiceInput{Id: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(svcclient.IsServiceNotFound, err), errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	svcclient.LateInitializeService(&cr.Spec.ForProvider, rsp.Service)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	op := cr.Status.AtProvider.OperationID
+	cr.Status.AtProvider = svcclient.GenerateServiceObservation(rsp.Service)
+	cr.Status.AtProvider.OperationID = op
+	cr.SetConditions(xpv1.Available())
+
+	tags, err := e.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{ResourceARN: rsp.Service.Arn})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTagsFailed)
+	}
+	add, remove := svcclient.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: svcclient.IsServiceUpToDate(cr.Spec.ForProvider, rsp.Service) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotService)
+	}
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := e.client.CreateServiceWithContext(ctx, svcclient.GenerateCreateServiceInput(string(cr.UID), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.Service.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotService)
+	}
+
+	// We have to get the service again because tags and the rest of the
+	// fields are updated by different calls.
+	rsp, err := e.client.GetServiceWithContext(ctx, &svcsdk.GetServiceInput{Id: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetFailed)
+	}
+	tags, err := e.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{ResourceARN: rsp.Service.Arn})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errListTagsFailed)
+	}
+	add, remove := svcclient.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceARN: rsp.Service.Arn, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceARN: rsp.Service.Arn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	if svcclient.IsServiceUpToDate(cr.Spec.ForProvider, rsp.Service) {
+		return managed.ExternalUpdate{}, nil
+	}
+	out, err := e.client.UpdateServiceWithContext(ctx, svcclient.GenerateUpdateServiceInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
+	}
+	cr.Status.AtProvider.OperationID = out.OperationId
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return errors.New(errNotService)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteServiceWithContext(ctx, &svcsdk.DeleteServiceInput{Id: aws.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsServiceNotFound, err), errDeleteFailed)
+}
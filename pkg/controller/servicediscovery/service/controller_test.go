@@ -0,0 +1,501 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery/fake"
+)
+
+const (
+	serviceID  = "srv-123"
+	serviceARN = "arn:aws:servicediscovery:us-east-1:123456789012:service/srv-123"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client svcclient.Client
+	kube   client.Client
+	cr     *v1alpha1.Service
+}
+
+type serviceModifier func(*v1alpha1.Service)
+
+func withExternalName(n string) serviceModifier {
+	return func(r *v1alpha1.Service) { meta.SetExternalName(r, n) }
+}
+
+func withDescription(d string) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withDNSConfig(c *v1alpha1.DNSConfig) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.DNSConfig = c }
+}
+
+func withTags(kv ...string) serviceModifier {
+	return func(r *v1alpha1.Service) {
+		for i := 0; i < len(kv); i += 2 {
+			r.Spec.ForProvider.Tags = append(r.Spec.ForProvider.Tags, &v1alpha1.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ServiceObservation) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.AtProvider = o }
+}
+
+func service(m ...serviceModifier) *v1alpha1.Service {
+	cr := &v1alpha1.Service{}
+	cr.Spec.ForProvider.Name = "web"
+	cr.Spec.ForProvider.NamespaceID = aws.String("ns-123")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getService(s *svcsdk.Service) func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+	return func(_ *svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+		return &svcsdk.GetServiceOutput{Service: s}, nil
+	}
+}
+
+func listTags(kv ...string) func(*svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error) {
+	return func(_ *svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error) {
+		out := &svcsdk.ListTagsForResourceOutput{}
+		for i := 0; i < len(kv); i += 2 {
+			out.Tags = append(out.Tags, &svcsdk.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Service
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{},
+				cr:     service(),
+			},
+			want: want{
+				cr: service(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: func(_ *svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeServiceNotFound, "", nil)
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: func(_ *svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID)),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: getService(&svcsdk.Service{
+						Arn:           aws.String(serviceARN),
+						Description:   aws.String("web"),
+						InstanceCount: aws.Int64(2),
+					}),
+					MockListTagsForResource: listTags("team", "a"),
+				},
+				cr: service(withExternalName(serviceID), withDescription("web"), withTags("team", "a")),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withDescription("web"), withTags("team", "a"),
+					withObservation(v1alpha1.ServiceObservation{ARN: serviceARN, InstanceCount: 2}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: getService(&svcsdk.Service{
+						Arn:         aws.String(serviceARN),
+						Description: aws.String("web"),
+					}),
+					MockListTagsForResource: listTags(),
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withDescription("web"),
+					withObservation(v1alpha1.ServiceObservation{ARN: serviceARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"KubeUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: getService(&svcsdk.Service{
+						Arn:         aws.String(serviceARN),
+						Description: aws.String("web"),
+					}),
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withDescription("web")),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: getService(&svcsdk.Service{
+						Arn:         aws.String(serviceARN),
+						Description: aws.String("web"),
+					}),
+					MockListTagsForResource: listTags("team", "b"),
+				},
+				cr: service(withExternalName(serviceID), withDescription("web"), withTags("team", "a")),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withDescription("web"), withTags("team", "a"),
+					withObservation(v1alpha1.ServiceObservation{ARN: serviceARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"DNSRecordsChanged": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockGetService: getService(&svcsdk.Service{
+						Arn:         aws.String(serviceARN),
+						Description: aws.String("web"),
+						DnsConfig: &svcsdk.DnsConfig{
+							RoutingPolicy: aws.String("MULTIVALUE"),
+							DnsRecords:    []*svcsdk.DnsRecord{{Type: aws.String("A"), TTL: aws.Int64(60)}},
+						},
+					}),
+					MockListTagsForResource: listTags(),
+				},
+				cr: service(withExternalName(serviceID), withDescription("web"), withDNSConfig(&v1alpha1.DNSConfig{
+					RoutingPolicy: aws.String("MULTIVALUE"),
+					DNSRecords:    []v1alpha1.DNSRecord{{Type: "A", TTL: 300}},
+				})),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withDescription("web"), withDNSConfig(&v1alpha1.DNSConfig{
+					RoutingPolicy: aws.String("MULTIVALUE"),
+					DNSRecords:    []v1alpha1.DNSRecord{{Type: "A", TTL: 300}},
+				}),
+					withObservation(v1alpha1.ServiceObservation{ARN: serviceARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Service
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockCreateService: func(_ *svcsdk.CreateServiceInput) (*svcsdk.CreateServiceOutput, error) {
+						return &svcsdk.CreateServiceOutput{Service: &svcsdk.Service{Id: aws.String(serviceID)}}, nil
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr:     service(withExternalName(serviceID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockCreateService: func(_ *svcsdk.CreateServiceInput) (*svcsdk.CreateServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr:  service(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr       *v1alpha1.Service
+		tagged   []*svcsdk.Tag
+		untagged []*string
+		updated  bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		service *svcsdk.Service
+		tags    []string
+		update  error
+		want
+	}{
+		"TagsOnly": {
+			args: args{
+				cr: service(withExternalName(serviceID), withDescription("web"), withTags("team", "a")),
+			},
+			service: &svcsdk.Service{Arn: aws.String(serviceARN), Description: aws.String("web")},
+			tags:    []string{"team", "b", "env", "dev"},
+			want: want{
+				cr:       service(withExternalName(serviceID), withDescription("web"), withTags("team", "a")),
+				tagged:   []*svcsdk.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				untagged: []*string{aws.String("env")},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				cr: service(withExternalName(serviceID), withDescription("new")),
+			},
+			service: &svcsdk.Service{Arn: aws.String(serviceARN), Description: aws.String("old")},
+			want: want{
+				cr: service(withExternalName(serviceID), withDescription("new"),
+					withObservation(v1alpha1.ServiceObservation{OperationID: aws.String("op-123")})),
+				updated: true,
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr: service(withExternalName(serviceID), withDescription("new")),
+			},
+			service: &svcsdk.Service{Arn: aws.String(serviceARN), Description: aws.String("old")},
+			update:  errBoom,
+			want: want{
+				cr:      service(withExternalName(serviceID), withDescription("new")),
+				updated: true,
+				err:     awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var tagged []*svcsdk.Tag
+			var untagged []*string
+			updated := false
+			c := &fake.MockServicediscoveryClient{
+				MockGetService:          getService(tc.service),
+				MockListTagsForResource: listTags(tc.tags...),
+				MockTagResource: func(in *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+					tagged = append(tagged, in.Tags...)
+					return &svcsdk.TagResourceOutput{}, nil
+				},
+				MockUntagResource: func(in *svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error) {
+					untagged = append(untagged, in.TagKeys...)
+					return &svcsdk.UntagResourceOutput{}, nil
+				},
+				MockUpdateService: func(in *svcsdk.UpdateServiceInput) (*svcsdk.UpdateServiceOutput, error) {
+					updated = true
+					if tc.update != nil {
+						return nil, tc.update
+					}
+					return &svcsdk.UpdateServiceOutput{OperationId: aws.String("op-123")}, nil
+				},
+			}
+			e := &external{kube: tc.kube, client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untagged, untagged); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Service
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockDeleteService: func(_ *svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error) {
+						return &svcsdk.DeleteServiceOutput{}, nil
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockDeleteService: func(_ *svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeServiceNotFound, "", nil)
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockServicediscoveryClient{
+					MockDeleteService: func(_ *svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
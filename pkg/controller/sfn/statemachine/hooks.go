@@ -18,8 +18,12 @@ package statemachine
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -36,6 +40,12 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errNoDefinition        = "either definition or definitionObject has to be given"
+	errUnresolvedSubst     = "definition substitution has no value"
+	errUnmarshalDefinition = "cannot unmarshal definition"
+)
+
 // SetupStateMachine adds a controller that reconciles StateMachine.
 func SetupStateMachine(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(svcapitypes.StateMachineGroupKind)
@@ -46,6 +56,8 @@ func SetupStateMachine(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.preDelete = preDelete
+			e.isUpToDate = isUpToDate
+			e.preUpdate = preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -81,11 +93,116 @@ func postObserve(_ context.Context, cr *svcapitypes.StateMachine, resp *svcsdk.D
 }
 
 func preCreate(_ context.Context, cr *svcapitypes.StateMachine, obj *svcsdk.CreateStateMachineInput) error {
+	def, err := definition(cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj.Definition = aws.String(def)
 	obj.Type = aws.String(string(cr.Spec.ForProvider.Type))
 	obj.RoleArn = cr.Spec.ForProvider.RoleARN
 	return nil
 }
 
+func isUpToDate(cr *svcapitypes.StateMachine, resp *svcsdk.DescribeStateMachineOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	if aws.StringValue(p.RoleARN) != aws.StringValue(resp.RoleArn) {
+		return false, nil
+	}
+	if p.TracingConfiguration != nil {
+		current := &svcsdk.TracingConfiguration{}
+		if resp.TracingConfiguration != nil {
+			current = resp.TracingConfiguration
+		}
+		if aws.BoolValue(p.TracingConfiguration.Enabled) != aws.BoolValue(current.Enabled) {
+			return false, nil
+		}
+	}
+	if !isLoggingConfigurationUpToDate(p.LoggingConfiguration, resp.LoggingConfiguration) {
+		return false, nil
+	}
+	def, err := definition(p)
+	if err != nil {
+		return false, err
+	}
+	return isDefinitionUpToDate(def, aws.StringValue(resp.Definition))
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.StateMachine, obj *svcsdk.UpdateStateMachineInput) error {
+	def, err := definition(cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj.StateMachineArn = aws.String(meta.GetExternalName(cr))
+	obj.Definition = aws.String(def)
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
+// definition returns the definition of the state machine with all of its
+// substitutions applied.
+func definition(p svcapitypes.StateMachineParameters) (string, error) {
+	var def string
+	switch {
+	case p.Definition != nil:
+		def = aws.StringValue(p.Definition)
+	case p.DefinitionObject != nil && len(p.DefinitionObject.Raw) != 0:
+		def = string(p.DefinitionObject.Raw)
+	default:
+		return "", errors.New(errNoDefinition)
+	}
+	for _, s := range p.DefinitionSubstitutions {
+		if s.Value == nil {
+			return "", errors.Errorf("%s: %s", errUnresolvedSubst, s.Key)
+		}
+		def = strings.ReplaceAll(def, "${"+s.Key+"}", aws.StringValue(s.Value))
+	}
+	return def, nil
+}
+
+// isDefinitionUpToDate compares the definitions as JSON documents so that
+// formatting and key order do not cause updates.
+func isDefinitionUpToDate(desired, current string) (bool, error) {
+	var d, c interface{}
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return false, errors.Wrap(err, errUnmarshalDefinition)
+	}
+	if err := json.Unmarshal([]byte(current), &c); err != nil {
+		return false, errors.Wrap(err, errUnmarshalDefinition)
+	}
+	return cmp.Equal(d, c), nil
+}
+
+func isLoggingConfigurationUpToDate(desired *svcapitypes.LoggingConfiguration, current *svcsdk.LoggingConfiguration) bool {
+	if desired == nil {
+		return true
+	}
+	if current == nil {
+		current = &svcsdk.LoggingConfiguration{}
+	}
+	if desired.Level != nil && aws.StringValue(desired.Level) != aws.StringValue(current.Level) {
+		return false
+	}
+	if desired.IncludeExecutionData != nil && aws.BoolValue(desired.IncludeExecutionData) != aws.BoolValue(current.IncludeExecutionData) {
+		return false
+	}
+	if len(desired.Destinations) != len(current.Destinations) {
+		return false
+	}
+	for i := range desired.Destinations {
+		var d, c string
+		if desired.Destinations[i].CloudWatchLogsLogGroup != nil {
+			d = aws.StringValue(desired.Destinations[i].CloudWatchLogsLogGroup.LogGroupARN)
+		}
+		if current.Destinations[i].CloudWatchLogsLogGroup != nil {
+			c = aws.StringValue(current.Destinations[i].CloudWatchLogsLogGroup.LogGroupArn)
+		}
+		if d != c {
+			return false
+		}
+	}
+	return true
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.StateMachine, resp *svcsdk.CreateStateMachineOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statemachine
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
+)
+
+const (
+	roleARN     = "arn:aws:iam::123456789012:role/sfn"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:hello"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		params svcapitypes.StateMachineParameters
+		resp   *svcsdk.DescribeStateMachineOutput
+		want   want
+	}{
+		"SameDefinitionDifferentFormat": {
+			params: svcapitypes.StateMachineParameters{
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:          awsgo.String(roleARN),
+					DefinitionObject: &runtime.RawExtension{Raw: []byte(`{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","End":true}}}`)},
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:    awsgo.String(roleARN),
+				Definition: awsgo.String("{\n  \"States\": {\"Hello\": {\"End\": true, \"Type\": \"Pass\"}},\n  \"StartAt\": \"Hello\"\n}"),
+			},
+			want: want{upToDate: true},
+		},
+		"Substituted": {
+			params: svcapitypes.StateMachineParameters{
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:    awsgo.String(roleARN),
+					Definition: awsgo.String(`{"StartAt":"Hello","States":{"Hello":{"Type":"Task","Resource":"${hello}","End":true}}}`),
+					DefinitionSubstitutions: []svcapitypes.DefinitionSubstitution{
+						{Key: "hello", Value: awsgo.String(functionARN)},
+					},
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:    awsgo.String(roleARN),
+				Definition: awsgo.String(`{"StartAt":"Hello","States":{"Hello":{"Type":"Task","Resource":"` + functionARN + `","End":true}}}`),
+			},
+			want: want{upToDate: true},
+		},
+		"DefinitionChanged": {
+			params: svcapitypes.StateMachineParameters{
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:    awsgo.String(roleARN),
+					Definition: awsgo.String(`{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","End":true}}}`),
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:    awsgo.String(roleARN),
+				Definition: awsgo.String(`{"StartAt":"Bye","States":{"Bye":{"Type":"Pass","End":true}}}`),
+			},
+			want: want{upToDate: false},
+		},
+		"TracingChanged": {
+			params: svcapitypes.StateMachineParameters{
+				TracingConfiguration: &svcapitypes.TracingConfiguration{Enabled: awsgo.Bool(true)},
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:    awsgo.String(roleARN),
+					Definition: awsgo.String(`{}`),
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:    awsgo.String(roleARN),
+				Definition: awsgo.String(`{}`),
+			},
+			want: want{upToDate: false},
+		},
+		"LoggingChanged": {
+			params: svcapitypes.StateMachineParameters{
+				LoggingConfiguration: &svcapitypes.LoggingConfiguration{Level: awsgo.String("ALL")},
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:    awsgo.String(roleARN),
+					Definition: awsgo.String(`{}`),
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:              awsgo.String(roleARN),
+				Definition:           awsgo.String(`{}`),
+				LoggingConfiguration: &svcsdk.LoggingConfiguration{Level: awsgo.String("OFF")},
+			},
+			want: want{upToDate: false},
+		},
+		"RoleChanged": {
+			params: svcapitypes.StateMachineParameters{
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:    awsgo.String("arn:aws:iam::123456789012:role/other"),
+					Definition: awsgo.String(`{}`),
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:    awsgo.String(roleARN),
+				Definition: awsgo.String(`{}`),
+			},
+			want: want{upToDate: false},
+		},
+		"UnresolvedSubstitution": {
+			params: svcapitypes.StateMachineParameters{
+				CustomStateMachineParameters: svcapitypes.CustomStateMachineParameters{
+					RoleARN:                 awsgo.String(roleARN),
+					Definition:              awsgo.String(`{}`),
+					DefinitionSubstitutions: []svcapitypes.DefinitionSubstitution{{Key: "hello"}},
+				},
+			},
+			resp: &svcsdk.DescribeStateMachineOutput{
+				RoleArn:    awsgo.String(roleARN),
+				Definition: awsgo.String(`{}`),
+			},
+			want: want{err: errors.Errorf("%s: %s", errUnresolvedSubst, "hello")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.StateMachine{Spec: svcapitypes.StateMachineSpec{ForProvider: tc.params}}
+			upToDate, err := isUpToDate(cr, tc.resp)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -63,9 +63,6 @@ func GenerateStateMachine(resp *svcsdk.DescribeStateMachineOutput) *svcapitypes.
 func GenerateCreateStateMachineInput(cr *svcapitypes.StateMachine) *svcsdk.CreateStateMachineInput {
 	res := &svcsdk.CreateStateMachineInput{}
 
-	if cr.Spec.ForProvider.Definition != nil {
-		res.SetDefinition(*cr.Spec.ForProvider.Definition)
-	}
 	if cr.Spec.ForProvider.LoggingConfiguration != nil {
 		f1 := &svcsdk.LoggingConfiguration{}
 		if cr.Spec.ForProvider.LoggingConfiguration.Destinations != nil {
@@ -123,9 +120,6 @@ func GenerateCreateStateMachineInput(cr *svcapitypes.StateMachine) *svcsdk.Creat
 func GenerateUpdateStateMachineInput(cr *svcapitypes.StateMachine) *svcsdk.UpdateStateMachineInput {
 	res := &svcsdk.UpdateStateMachineInput{}
 
-	if cr.Spec.ForProvider.Definition != nil {
-		res.SetDefinition(*cr.Spec.ForProvider.Definition)
-	}
 	if cr.Spec.ForProvider.LoggingConfiguration != nil {
 		f1 := &svcsdk.LoggingConfiguration{}
 		if cr.Spec.ForProvider.LoggingConfiguration.Destinations != nil {
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuepolicy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+)
+
+const (
+	errNotQueuePolicy = "managed resource is not a QueuePolicy custom resource"
+	errNoQueueURL     = "queue URL is not set"
+
+	errGetAttributes = "cannot get Queue attributes"
+	errPolicy        = "cannot generate Queue policy"
+	errSet           = "cannot set Queue policy"
+	errDelete        = "cannot delete Queue policy"
+)
+
+// SetupQueuePolicy adds a controller that reconciles QueuePolicies.
+func SetupQueuePolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.QueuePolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.QueuePolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.QueuePolicyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(aws.Config) sqs.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return nil, errors.New(errNotQueuePolicy)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client sqs.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotQueuePolicy)
+	}
+	if cr.Spec.ForProvider.QueueURL == nil {
+		return managed.ExternalObservation{}, errors.New(errNoQueueURL)
+	}
+
+	res, err := e.client.GetQueueAttributesRequest(&awssqs.GetQueueAttributesInput{
+		QueueUrl: cr.Spec.ForProvider.QueueURL,
+		AttributeNames: []awssqs.QueueAttributeName{
+			awssqs.QueueAttributeName(v1beta1.AttributePolicy),
+			awssqs.QueueAttributeName(v1beta1.AttributeQueueArn),
+		},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(sqs.IsNotFound, err), errGetAttributes)
+	}
+
+	cr.Status.AtProvider = v1alpha1.QueuePolicyObservation{
+		PolicyText: res.Attributes[v1beta1.AttributePolicy],
+		QueueARN:   res.Attributes[v1beta1.AttributeQueueArn],
+	}
+	if cr.Status.AtProvider.PolicyText == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	policy, err := sqs.RawQueuePolicy(cr.Spec.ForProvider, cr.Status.AtProvider.QueueARN)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPolicy)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: sqs.IsQueuePolicyUpToDate(policy, cr.Status.AtProvider.PolicyText),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotQueuePolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.set(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotQueuePolicy)
+	}
+	return managed.ExternalUpdate{}, e.set(ctx, cr)
+}
+
+func (e *external) set(ctx context.Context, cr *v1alpha1.QueuePolicy) error {
+	policy, err := sqs.RawQueuePolicy(cr.Spec.ForProvider, cr.Status.AtProvider.QueueARN)
+	if err != nil {
+		return errors.Wrap(err, errPolicy)
+	}
+	_, err = e.client.SetQueueAttributesRequest(&awssqs.SetQueueAttributesInput{
+		QueueUrl:   cr.Spec.ForProvider.QueueURL,
+		Attributes: map[string]string{v1beta1.AttributePolicy: policy},
+	}).Send(ctx)
+	return awsclient.Wrap(err, errSet)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return errors.New(errNotQueuePolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.SetQueueAttributesRequest(&awssqs.SetQueueAttributesInput{
+		QueueUrl:   cr.Spec.ForProvider.QueueURL,
+		Attributes: map[string]string{v1beta1.AttributePolicy: ""},
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(sqs.IsNotFound, err), errDelete)
+}
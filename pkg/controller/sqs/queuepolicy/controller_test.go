@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuepolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs/fake"
+)
+
+var (
+	queueURL = "someURL"
+	queueARN = "arn:aws:sqs:us-east-1:123456789012:queue"
+	topicARN = "arn:aws:sns:us-east-1:123456789012:topic"
+
+	snsPolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"AllowSNSTopicsToSendMessage","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"` + queueARN + `","Condition":{"ArnEquals":{"aws:SourceArn":"` + topicARN + `"}}}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sqs sqs.Client
+	cr  *v1alpha1.QueuePolicy
+}
+
+type policyModifier func(*v1alpha1.QueuePolicy)
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1alpha1.QueuePolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.QueuePolicyParameters) policyModifier {
+	return func(r *v1alpha1.QueuePolicy) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.QueuePolicyObservation) policyModifier {
+	return func(r *v1alpha1.QueuePolicy) { r.Status.AtProvider = o }
+}
+
+func queuePolicy(m ...policyModifier) *v1alpha1.QueuePolicy {
+	cr := &v1alpha1.QueuePolicy{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAttributes(attr map[string]string, err error) func(*awssqs.GetQueueAttributesInput) awssqs.GetQueueAttributesRequest {
+	return func(input *awssqs.GetQueueAttributesInput) awssqs.GetQueueAttributesRequest {
+		return awssqs.GetQueueAttributesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.GetQueueAttributesOutput{
+				Attributes: attr,
+			}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	params := v1alpha1.QueuePolicyParameters{
+		QueueURL:     aws.String(queueURL),
+		SNSTopicARNs: []string{topicARN},
+	}
+
+	type want struct {
+		cr     *v1alpha1.QueuePolicy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributePolicy:   snsPolicy,
+						v1beta1.AttributeQueueArn: queueARN,
+					}, nil),
+				},
+				cr: queuePolicy(withSpec(params)),
+			},
+			want: want{
+				cr: queuePolicy(withSpec(params),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.QueuePolicyObservation{
+						PolicyText: snsPolicy,
+						QueueARN:   queueARN,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+					}, nil),
+				},
+				cr: queuePolicy(withSpec(params)),
+			},
+			want: want{
+				cr: queuePolicy(withSpec(params),
+					withStatus(v1alpha1.QueuePolicyObservation{
+						QueueARN: queueARN,
+					})),
+			},
+		},
+		"GetAttributesFailed": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(nil, errBoom),
+				},
+				cr: queuePolicy(withSpec(params)),
+			},
+			want: want{
+				cr:  queuePolicy(withSpec(params)),
+				err: awsclient.Wrap(errBoom, errGetAttributes),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sqs}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}